@@ -0,0 +1,264 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/karasunokami/chat-service/internal/config"
+	jobsrepo "github.com/karasunokami/chat-service/internal/repositories/jobs"
+	messagesrepo "github.com/karasunokami/chat-service/internal/repositories/messages"
+	afcdlq "github.com/karasunokami/chat-service/internal/services/afc-dlq"
+	msgbus "github.com/karasunokami/chat-service/internal/services/msg-bus"
+	"github.com/karasunokami/chat-service/internal/services/outbox"
+	"github.com/karasunokami/chat-service/internal/store"
+
+	"go.uber.org/zap"
+)
+
+const (
+	cmdAfcDLQ = "afc-dlq"
+
+	afcDLQList   = "list"
+	afcDLQReplay = "replay"
+)
+
+var (
+	errUnknownAfcDLQCmd = errors.New("unknown afc-dlq command, expected one of: list, replay")
+	errAfcDLQMemoryBus  = errors.New("afc-dlq requires the kafka bus, the in-memory bus lives within the service process")
+)
+
+type afcDLQFlags struct {
+	reason    string
+	from      string
+	to        string
+	positions string
+	limit     int
+	direct    bool
+	dryRun    bool
+}
+
+// runAfcDLQ handles `chat-service afc-dlq list|replay [flags]`.
+func runAfcDLQ(ctx context.Context, cfg config.Config, args []string) error {
+	if !cfg.Bus.IsKafka() {
+		return errAfcDLQMemoryBus
+	}
+
+	if len(args) == 0 {
+		return errUnknownAfcDLQCmd
+	}
+
+	cmd := args[0]
+	if cmd != afcDLQList && cmd != afcDLQReplay {
+		return errUnknownAfcDLQCmd
+	}
+
+	var flags afcDLQFlags
+
+	fs := flag.NewFlagSet(cmdAfcDLQ+" "+cmd, flag.ContinueOnError)
+	fs.StringVar(&flags.reason, "reason", "", "Select the verdicts with the failure reason containing the substring")
+	fs.StringVar(&flags.from, "from", "", "Select the verdicts got into the DLQ since the time, RFC3339")
+	fs.StringVar(&flags.to, "to", "", "Select the verdicts got into the DLQ before the time, RFC3339")
+	fs.StringVar(&flags.positions, "positions", "", "Select the exact verdicts, comma separated partition:offset list")
+	fs.IntVar(&flags.limit, "limit", 0, "Max number of the selected verdicts, 0 means no limit")
+	if cmd == afcDLQReplay {
+		fs.BoolVar(&flags.direct, "direct", false, "Process the verdicts directly instead of re-publishing to the verdicts topic")
+		fs.BoolVar(&flags.dryRun, "dry-run", false, "Only show the verdicts to replay")
+	}
+
+	if err := fs.Parse(args[1:]); err != nil {
+		return fmt.Errorf("parse flags, err=%v", err)
+	}
+
+	filter, err := flags.filter()
+	if err != nil {
+		return err
+	}
+
+	afcCfg := cfg.Services.AfcVerdictsProcessor
+	scanner := afcdlq.NewKafkaScanner(afcCfg.Brokers, afcCfg.VerdictsDqlTopicName)
+
+	if cmd == afcDLQList {
+		svc, err := afcdlq.New(afcdlq.NewOptions(scanner))
+		if err != nil {
+			return fmt.Errorf("init afc dlq service, err=%v", err)
+		}
+
+		entries, err := svc.List(ctx, filter)
+		if err != nil {
+			return fmt.Errorf("list dlq, err=%v", err)
+		}
+
+		return printAfcDLQEntries(os.Stdout, entries)
+	}
+
+	opts := []afcdlq.OptOptionsSetter{afcdlq.WithDryRun(flags.dryRun)}
+
+	if flags.direct {
+		return withDirectVerdictsProcessor(ctx, cfg, func(p verdictsProcessor) error {
+			return replayAfcDLQ(ctx, scanner, filter, flags.dryRun, append(opts, afcdlq.WithProcessor(p)))
+		})
+	}
+
+	w := msgbus.NewKafkaWriter(afcCfg.Brokers, afcCfg.VerdictsTopicName, 1)
+	defer func() {
+		if err := w.Close(); err != nil {
+			zap.L().Error("Close verdicts writer", zap.Error(err))
+		}
+	}()
+
+	return replayAfcDLQ(ctx, scanner, filter, flags.dryRun, append(opts, afcdlq.WithWriter(w)))
+}
+
+type verdictsProcessor interface {
+	ProcessVerdict(ctx context.Context, m msgbus.Message) error
+}
+
+func replayAfcDLQ(
+	ctx context.Context,
+	scanner afcdlq.TopicScanner,
+	filter afcdlq.Filter,
+	dryRun bool,
+	opts []afcdlq.OptOptionsSetter,
+) error {
+	svc, err := afcdlq.New(afcdlq.NewOptions(scanner, opts...))
+	if err != nil {
+		return fmt.Errorf("init afc dlq service, err=%v", err)
+	}
+
+	results, err := svc.Replay(ctx, filter)
+	if err != nil {
+		return fmt.Errorf("replay dlq, err=%v", err)
+	}
+
+	return printAfcDLQReplayResults(os.Stdout, results, dryRun)
+}
+
+// withDirectVerdictsProcessor initializes the verdicts processor dependencies without starting the service.
+func withDirectVerdictsProcessor(ctx context.Context, cfg config.Config, f func(p verdictsProcessor) error) error {
+	psqlClient, err := store.NewPSQLClient(store.NewPSQLOptions(
+		cfg.Clients.PSQLClient.Address,
+		cfg.Clients.PSQLClient.Username,
+		cfg.Clients.PSQLClient.Password,
+		cfg.Clients.PSQLClient.Database,
+	))
+	if err != nil {
+		return fmt.Errorf("create psql client, err=%v", err)
+	}
+	defer func() {
+		if err := psqlClient.Close(); err != nil {
+			zap.L().Error("Close psql client", zap.Error(err))
+		}
+	}()
+
+	if err := checkMigrations(ctx, cfg.Clients.PSQLClient); err != nil {
+		return fmt.Errorf("check db migrations, err=%v", err)
+	}
+
+	db := store.NewDatabase(psqlClient, zap.L().Named(cmdAfcDLQ))
+
+	msgRepo, err := messagesrepo.New(messagesrepo.NewOptions(db))
+	if err != nil {
+		return fmt.Errorf("init messages repo, err=%v", err)
+	}
+
+	jobsRepo, err := jobsrepo.New(jobsrepo.NewOptions(db))
+	if err != nil {
+		return fmt.Errorf("init jobs repo, err=%v", err)
+	}
+
+	outboxService, err := outbox.New(outbox.NewOptions(
+		cfg.Services.OutboxService.Workers,
+		cfg.Services.OutboxService.IdleTime,
+		cfg.Services.OutboxService.ReserveFor,
+		jobsRepo,
+		db,
+	))
+	if err != nil {
+		return fmt.Errorf("init outbox service, err=%v", err)
+	}
+
+	p, _, err := newAfcVerdictsProcessor(ctx, cfg, nil, db, msgRepo, outboxService)
+	if err != nil {
+		return fmt.Errorf("init afc verdicts processor, err=%v", err)
+	}
+
+	return f(p)
+}
+
+func (f afcDLQFlags) filter() (afcdlq.Filter, error) {
+	filter := afcdlq.Filter{Reason: f.reason, Limit: f.limit}
+
+	var err error
+
+	if f.from != "" {
+		if filter.From, err = time.Parse(time.RFC3339, f.from); err != nil {
+			return afcdlq.Filter{}, fmt.Errorf("parse -from, err=%v", err)
+		}
+	}
+
+	if f.to != "" {
+		if filter.To, err = time.Parse(time.RFC3339, f.to); err != nil {
+			return afcdlq.Filter{}, fmt.Errorf("parse -to, err=%v", err)
+		}
+	}
+
+	if f.positions != "" {
+		for _, p := range strings.Split(f.positions, ",") {
+			partition, offset, ok := strings.Cut(strings.TrimSpace(p), ":")
+			if !ok {
+				return afcdlq.Filter{}, fmt.Errorf("invalid position %q, expected partition:offset", p)
+			}
+
+			var pos afcdlq.Position
+			if pos.Partition, err = strconv.Atoi(partition); err != nil {
+				return afcdlq.Filter{}, fmt.Errorf("parse position %q partition, err=%v", p, err)
+			}
+			if pos.Offset, err = strconv.ParseInt(offset, 10, 64); err != nil {
+				return afcdlq.Filter{}, fmt.Errorf("parse position %q offset, err=%v", p, err)
+			}
+
+			filter.Positions = append(filter.Positions, pos)
+		}
+	}
+
+	return filter, nil
+}
+
+func printAfcDLQEntries(w io.Writer, entries []afcdlq.Entry) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "PARTITION\tOFFSET\tTIME\tORIGINAL PARTITION\tREASON")
+	for _, e := range entries {
+		fmt.Fprintf(tw, "%d\t%d\t%s\t%d\t%s\n",
+			e.Partition, e.Offset, e.Time.Format(time.RFC3339), e.OriginalPartition, e.LastError)
+	}
+
+	return tw.Flush()
+}
+
+func printAfcDLQReplayResults(w io.Writer, results []afcdlq.ReplayResult, dryRun bool) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "PARTITION\tOFFSET\tTIME\tREASON\tREPLAY")
+	for _, r := range results {
+		status := "ok"
+		switch {
+		case dryRun:
+			status = "skipped, dry run"
+		case r.Err != nil:
+			status = r.Err.Error()
+		}
+		fmt.Fprintf(tw, "%d\t%d\t%s\t%s\t%s\n",
+			r.Partition, r.Offset, r.Time.Format(time.RFC3339), r.LastError, status)
+	}
+
+	return tw.Flush()
+}
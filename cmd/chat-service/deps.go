@@ -2,39 +2,62 @@ package main
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"io"
+	"net/http"
+	"time"
 
 	keycloakclient "github.com/karasunokami/chat-service/internal/clients/keycloak"
 	"github.com/karasunokami/chat-service/internal/config"
 	"github.com/karasunokami/chat-service/internal/logger"
+	"github.com/karasunokami/chat-service/internal/middlewares"
+	auditrepo "github.com/karasunokami/chat-service/internal/repositories/audit"
 	chatsrepo "github.com/karasunokami/chat-service/internal/repositories/chats"
 	jobsrepo "github.com/karasunokami/chat-service/internal/repositories/jobs"
 	messagesrepo "github.com/karasunokami/chat-service/internal/repositories/messages"
 	problemsrepo "github.com/karasunokami/chat-service/internal/repositories/problems"
+	scheduledmessagesrepo "github.com/karasunokami/chat-service/internal/repositories/scheduledmessages"
+	"github.com/karasunokami/chat-service/internal/server"
 	clientevents "github.com/karasunokami/chat-service/internal/server-client/events"
 	clientv1 "github.com/karasunokami/chat-service/internal/server-client/v1"
 	managerv1 "github.com/karasunokami/chat-service/internal/server-manager/v1"
 	errhandler2 "github.com/karasunokami/chat-service/internal/server/errhandler"
+	afclocal "github.com/karasunokami/chat-service/internal/services/afc-local"
 	afcverdictsprocessor "github.com/karasunokami/chat-service/internal/services/afc-verdicts-processor"
+	afcwatchdog "github.com/karasunokami/chat-service/internal/services/afc-watchdog"
 	inmemeventstream "github.com/karasunokami/chat-service/internal/services/event-stream/in-mem"
+	"github.com/karasunokami/chat-service/internal/services/health"
 	managerload "github.com/karasunokami/chat-service/internal/services/manager-load"
 	inmemmanagerpool "github.com/karasunokami/chat-service/internal/services/manager-pool/in-mem"
+	managerpresence "github.com/karasunokami/chat-service/internal/services/manager-presence"
 	managerscheduler "github.com/karasunokami/chat-service/internal/services/manager-scheduler"
+	messagereview "github.com/karasunokami/chat-service/internal/services/message-review"
+	msgbus "github.com/karasunokami/chat-service/internal/services/msg-bus"
 	msgproducer "github.com/karasunokami/chat-service/internal/services/msg-producer"
 	"github.com/karasunokami/chat-service/internal/services/outbox"
 	chatclosed "github.com/karasunokami/chat-service/internal/services/outbox/jobs/chat-closed"
 	clientmessageblockedjob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/client-message-blocked"
 	clientmessagesentjob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/client-message-sent"
 	managerassignedtoproblemjob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/manager-assigned-to-problem"
+	managermessagesentjob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/manager-message-sent"
 	sendclientmessagejob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/send-client-message"
+	sendinternalnotejob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/send-internal-note"
 	sendmanagermessagejob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/send-manager-message"
+	sendscheduledmessagejob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/send-scheduled-message"
+	ratelimiter "github.com/karasunokami/chat-service/internal/services/rate-limiter"
+	reviewexpirer "github.com/karasunokami/chat-service/internal/services/review-expirer"
 	"github.com/karasunokami/chat-service/internal/store"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"go.uber.org/zap"
 )
 
+var (
+	errAfcManagerMessagesLocal     = errors.New("afc_manager_messages needs the external afc, afc_local checks client messages only")
+	errAfcManagerMessagesMemoryBus = errors.New("afc_manager_messages needs the kafka bus of the external afc")
+)
+
 type serverDeps struct {
 	clientSwagger       *openapi3.T
 	clientEventsSwagger *openapi3.T
@@ -49,10 +72,27 @@ type serverDeps struct {
 	jobsRepo     *jobsrepo.Repo
 	problemsRepo *problemsrepo.Repo
 
+	scheduledMsgRepo *scheduledmessagesrepo.Repo
+	auditRepo        *auditrepo.Repo
+
 	kcClient *keycloakclient.Client
+	kcKeySet *keycloakclient.KeySet
+
+	// introspector is the keycloak client or its cache.
+	introspector       middlewares.Introspector
+	introspectionCache *keycloakclient.IntrospectionCache
+
+	introspectionFallback     bool
+	sessionRevalidationPeriod time.Duration
+
+	// holdManagerMessagesForAFC keeps the manager messages invisible for the client until the AFC verdict.
+	holdManagerMessagesForAFC bool
 
 	errHandler errhandler2.Handler
 
+	// memoryBus is the in-process message bus, nil if the Kafka one is configured.
+	memoryBus *msgbus.Memory
+
 	msgProducerService          *msgproducer.Service
 	outboxService               *outbox.Service
 	managerLogger               *zap.Logger
@@ -60,7 +100,16 @@ type serverDeps struct {
 	managerPool                 *inmemmanagerpool.Service
 	eventsStream                *inmemeventstream.Service
 	afcVerdictsProcessorService *afcverdictsprocessor.Service
+	afcVerdictsKeySet           *afcverdictsprocessor.KeySet
 	managerSchedulerService     *managerscheduler.Service
+	managerPresence             *managerpresence.Service
+	reviewResolver              *messagereview.Resolver
+	reviewExpirer               *reviewexpirer.Service
+	afcWatchdog                 *afcwatchdog.Service
+	afcLocal                    *afclocal.Service
+	healthService               *health.Service
+	clientRateLimiter           *ratelimiter.Service
+	managerRateLimiter          *ratelimiter.Service
 }
 
 func startNewDeps(ctx context.Context, cfg config.Config) (serverDeps, error) {
@@ -105,8 +154,8 @@ func startNewDeps(ctx context.Context, cfg config.Config) (serverDeps, error) {
 		d.clientLogger.Warn("Attention! PSQL client is in debug mode and env is prod")
 	}
 
-	if err = d.psqlClient.Schema.Create(ctx); err != nil {
-		return serverDeps{}, fmt.Errorf("psql client schema create, err=%v", err)
+	if err = checkMigrations(ctx, cfg.Clients.PSQLClient); err != nil {
+		return serverDeps{}, fmt.Errorf("check db migrations, err=%v", err)
 	}
 
 	// init database client
@@ -133,12 +182,59 @@ func startNewDeps(ctx context.Context, cfg config.Config) (serverDeps, error) {
 		return serverDeps{}, fmt.Errorf("init jobs repo, err=%v", err)
 	}
 
+	d.scheduledMsgRepo, err = scheduledmessagesrepo.New(scheduledmessagesrepo.NewOptions(d.db))
+	if err != nil {
+		return serverDeps{}, fmt.Errorf("init scheduled messages repo, err=%v", err)
+	}
+
+	d.auditRepo, err = auditrepo.New(auditrepo.NewOptions(d.db))
+	if err != nil {
+		return serverDeps{}, fmt.Errorf("init audit repo, err=%v", err)
+	}
+
 	// init keycloak client
 	d.kcClient, err = initKeyCloakClient(d.clientLogger, cfg.Clients.KeycloakClient, cfg.Global.IsInProdEnv())
 	if err != nil {
 		return serverDeps{}, fmt.Errorf("init init keycloak client, err=%v", err)
 	}
 
+	d.introspector = d.kcClient
+	if cacheCfg := cfg.Clients.KeycloakClient.IntrospectionCache; cacheCfg.Enabled {
+		d.introspectionCache, err = keycloakclient.NewIntrospectionCache(keycloakclient.NewIntrospectionCacheOptions(
+			d.kcClient,
+			keycloakclient.WithMaxTTL(cacheCfg.MaxTTL),
+			keycloakclient.WithNegativeTTL(cacheCfg.NegativeTTL),
+		))
+		if err != nil {
+			return serverDeps{}, fmt.Errorf("init keycloak introspection cache, err=%v", err)
+		}
+
+		d.introspector = d.introspectionCache
+	}
+
+	if cfg.Servers.Auth.IsPassive() {
+		d.kcKeySet, err = keycloakclient.NewKeySet(keycloakclient.NewKeySetOptions(
+			d.kcClient,
+			keycloakclient.WithTtl(cfg.Clients.KeycloakClient.JWKSCacheTTL),
+		))
+		if err != nil {
+			return serverDeps{}, fmt.Errorf("init keycloak key set, err=%v", err)
+		}
+
+		d.introspectionFallback = cfg.Servers.Auth.FallbackToIntrospection
+	}
+
+	d.sessionRevalidationPeriod = cfg.Servers.Auth.SessionRevalidationPeriod
+
+	if cfg.Services.AfcManagerMessages.Enabled && cfg.Services.AfcLocal.Enabled {
+		return serverDeps{}, errAfcManagerMessagesLocal
+	}
+	// There is no AFC on the in-memory bus, every manager message would wait for the afc watchdog fallback.
+	if cfg.Services.AfcManagerMessages.Enabled && !cfg.Bus.IsKafka() {
+		return serverDeps{}, errAfcManagerMessagesMemoryBus
+	}
+	d.holdManagerMessagesForAFC = cfg.Services.AfcManagerMessages.Enabled
+
 	// init server resp errors handler
 	errHandler, err := errhandler2.New(errhandler2.NewOptions(d.clientLogger, cfg.Global.IsInProdEnv(), errhandler2.ResponseBuilder))
 	if err != nil {
@@ -146,12 +242,38 @@ func startNewDeps(ctx context.Context, cfg config.Config) (serverDeps, error) {
 	}
 	d.errHandler = errHandler
 
+	// init rate limiters
+	if rlCfg := cfg.Servers.Client.RateLimit; rlCfg.Enabled {
+		d.clientRateLimiter, err = initRateLimiter(rlCfg)
+		if err != nil {
+			return serverDeps{}, fmt.Errorf("init client rate limiter, err=%v", err)
+		}
+	}
+
+	if rlCfg := cfg.Servers.Manager.RateLimit; rlCfg.Enabled {
+		d.managerRateLimiter, err = initRateLimiter(rlCfg)
+		if err != nil {
+			return serverDeps{}, fmt.Errorf("init manager rate limiter, err=%v", err)
+		}
+	}
+
+	// init message bus
+	if !cfg.Bus.IsKafka() {
+		d.memoryBus, err = msgbus.NewMemory(msgbus.NewMemoryOptions(
+			msgbus.WithTopicCapacity(cfg.Bus.MemoryTopicCapacity),
+		))
+		if err != nil {
+			return serverDeps{}, fmt.Errorf("init memory bus, err=%v", err)
+		}
+	}
+
 	// init services
-	d.msgProducerService, err = msgproducer.New(msgproducer.NewOptions(msgproducer.NewKafkaWriter(
-		cfg.Services.MessageProducerService.Brokers,
-		cfg.Services.MessageProducerService.Topic,
-		cfg.Services.MessageProducerService.BatchSize,
-	), msgproducer.WithEncryptKey(cfg.Services.MessageProducerService.EncryptKey)))
+	d.msgProducerService, err = msgproducer.New(msgproducer.NewOptions(
+		newMsgProducerWriter(cfg.Services.MessageProducerService, d.memoryBus),
+		msgproducer.WithEncryptKey(cfg.Services.MessageProducerService.EncryptKey),
+		msgproducer.WithEncryptKeys(cfg.Services.MessageProducerService.EncryptKeys),
+		msgproducer.WithActiveEncryptKeyID(cfg.Services.MessageProducerService.EncryptActiveKeyID),
+	))
 	if err != nil {
 		return serverDeps{}, fmt.Errorf("init message producer service, err=%v", err)
 	}
@@ -162,6 +284,8 @@ func startNewDeps(ctx context.Context, cfg config.Config) (serverDeps, error) {
 		cfg.Services.OutboxService.ReserveFor,
 		d.jobsRepo,
 		d.db,
+		outbox.WithDrainTimeout(cfg.Services.OutboxService.DrainTimeout),
+		outbox.WithPropagateTraceContext(cfg.Services.OutboxService.PropagateTraceContext),
 	))
 	if err != nil {
 		return serverDeps{}, fmt.Errorf("init outbox service, err=%v", err)
@@ -179,21 +303,8 @@ func startNewDeps(ctx context.Context, cfg config.Config) (serverDeps, error) {
 
 	d.eventsStream = inmemeventstream.New()
 
-	d.afcVerdictsProcessorService, err = afcverdictsprocessor.New(afcverdictsprocessor.NewOptions(
-		cfg.Services.AfcVerdictsProcessor.Brokers,
-		cfg.Services.AfcVerdictsProcessor.ConsumersCount,
-		cfg.Services.AfcVerdictsProcessor.ConsumersGroupName,
-		cfg.Services.AfcVerdictsProcessor.VerdictsTopicName,
-		afcverdictsprocessor.NewKafkaReader,
-		afcverdictsprocessor.NewKafkaDLQWriter(
-			cfg.Services.AfcVerdictsProcessor.Brokers,
-			cfg.Services.AfcVerdictsProcessor.VerdictsDqlTopicName,
-		),
-		d.db,
-		d.msgRepo,
-		d.outboxService,
-		afcverdictsprocessor.WithVerdictsSignKey(cfg.Services.AfcVerdictsProcessor.VerdictsSigningPublicKey),
-	))
+	d.afcVerdictsProcessorService, d.afcVerdictsKeySet, err = newAfcVerdictsProcessor(
+		ctx, cfg, d.memoryBus, d.db, d.msgRepo, d.outboxService)
 	if err != nil {
 		return serverDeps{}, fmt.Errorf("configure afc verdicts processor, err=%v", err)
 	}
@@ -209,6 +320,56 @@ func startNewDeps(ctx context.Context, cfg config.Config) (serverDeps, error) {
 		return serverDeps{}, fmt.Errorf("create manager scheduler service, err=%v", err)
 	}
 
+	d.managerPresence, err = managerpresence.New(managerpresence.NewOptions(
+		cfg.Services.ManagerPresence.OfflineTimeout,
+		d.managerPool,
+		d.problemsRepo,
+		managerpresence.WithCheckPeriod(cfg.Services.ManagerPresence.CheckPeriod),
+	))
+	if err != nil {
+		return serverDeps{}, fmt.Errorf("create manager presence service, err=%v", err)
+	}
+
+	d.reviewResolver, err = messagereview.New(messagereview.NewOptions(d.msgRepo, d.outboxService, d.db))
+	if err != nil {
+		return serverDeps{}, fmt.Errorf("create message review resolver, err=%v", err)
+	}
+
+	if cfg.Services.MessageReview.Enabled {
+		d.reviewExpirer, err = reviewexpirer.New(reviewexpirer.NewOptions(
+			cfg.Services.MessageReview.Timeout,
+			reviewexpirer.Decision(cfg.Services.MessageReview.DefaultDecision),
+			d.msgRepo,
+			d.reviewResolver,
+			reviewexpirer.WithCheckPeriod(cfg.Services.MessageReview.CheckPeriod),
+		))
+		if err != nil {
+			return serverDeps{}, fmt.Errorf("create review expirer service, err=%v", err)
+		}
+	}
+
+	d.afcWatchdog, err = afcwatchdog.New(afcwatchdog.NewOptions(
+		cfg.Services.AfcWatchdog.VerdictTimeout,
+		afcwatchdog.FallbackPolicy(cfg.Services.AfcWatchdog.FallbackPolicy),
+		d.msgRepo,
+		d.msgProducerService,
+		d.outboxService,
+		d.auditRepo,
+		d.db,
+		afcwatchdog.WithMaxResends(cfg.Services.AfcWatchdog.MaxResends),
+		afcwatchdog.WithCheckPeriod(cfg.Services.AfcWatchdog.CheckPeriod),
+	))
+	if err != nil {
+		return serverDeps{}, fmt.Errorf("create afc watchdog service, err=%v", err)
+	}
+
+	if cfg.Services.AfcLocal.Enabled {
+		d.afcLocal, err = newAfcLocal(cfg, d.memoryBus, d.afcVerdictsProcessorService)
+		if err != nil {
+			return serverDeps{}, fmt.Errorf("create local afc service, err=%v", err)
+		}
+	}
+
 	// register service jobs
 	sendClientMessageJob, err := sendclientmessagejob.New(sendclientmessagejob.NewOptions(
 		d.msgProducerService,
@@ -255,6 +416,31 @@ func startNewDeps(ctx context.Context, cfg config.Config) (serverDeps, error) {
 		return serverDeps{}, fmt.Errorf("create send manager message job, err=%v", err)
 	}
 
+	managerMessageSentJob, err := managermessagesentjob.New(managermessagesentjob.NewOptions(
+		d.eventsStream,
+		d.msgRepo,
+	))
+	if err != nil {
+		return serverDeps{}, fmt.Errorf("create manager message sent job, err=%v", err)
+	}
+
+	sendScheduledMessageJob, err := sendscheduledmessagejob.New(sendscheduledmessagejob.NewOptions(
+		d.scheduledMsgRepo,
+		d.msgRepo,
+		d.problemsRepo,
+		d.outboxService,
+		d.db,
+		sendscheduledmessagejob.WithHoldForAFC(d.holdManagerMessagesForAFC),
+	))
+	if err != nil {
+		return serverDeps{}, fmt.Errorf("create send scheduled message job, err=%v", err)
+	}
+
+	sendInternalNoteJob, err := sendinternalnotejob.New(sendinternalnotejob.NewOptions(d.eventsStream, d.msgRepo))
+	if err != nil {
+		return serverDeps{}, fmt.Errorf("create send internal note job, err=%v", err)
+	}
+
 	chatClosedJob, err := chatclosed.New(chatclosed.NewOptions(
 		d.msgProducerService,
 		d.msgRepo,
@@ -272,12 +458,32 @@ func startNewDeps(ctx context.Context, cfg config.Config) (serverDeps, error) {
 		clientMessageSentJob,
 		managerAssignedToProblemJob,
 		sendManagerMessageJob,
+		managerMessageSentJob,
+		sendScheduledMessageJob,
+		sendInternalNoteJob,
 		chatClosedJob,
 	)
 	if err != nil {
 		return serverDeps{}, fmt.Errorf("register jobs, err=%v", err)
 	}
 
+	// init health checks
+	d.healthService, err = health.New(health.NewOptions())
+	if err != nil {
+		return serverDeps{}, fmt.Errorf("init health service, err=%v", err)
+	}
+
+	d.healthService.AddLivenessChecker("outbox", health.CheckerFunc(d.outboxService.CheckHeartbeat))
+
+	d.healthService.AddReadinessChecker("psql", health.CheckerFunc(d.db.Ping))
+	d.healthService.AddReadinessChecker("keycloak", health.CheckerFunc(d.kcClient.Ping))
+	if d.memoryBus == nil {
+		d.healthService.AddReadinessChecker("kafka-msg-producer",
+			health.NewKafkaChecker(cfg.Services.MessageProducerService.Brokers))
+		d.healthService.AddReadinessChecker("kafka-afc-verdicts",
+			health.NewKafkaChecker(cfg.Services.AfcVerdictsProcessor.Brokers))
+	}
+
 	return d, nil
 }
 
@@ -293,6 +499,163 @@ func (d serverDeps) stop() {
 	}
 }
 
+func newMsgProducerWriter(cfg config.MessageProducerServiceConfig, memoryBus *msgbus.Memory) msgbus.Writer {
+	if memoryBus != nil {
+		return memoryBus.Writer(cfg.Topic)
+	}
+
+	return msgbus.NewKafkaWriter(cfg.Brokers, cfg.Topic, cfg.BatchSize)
+}
+
+// newAfcVerdictsProcessor creates the verdicts processor along with its key set, which is nil if JWKS is not configured.
+// The processor consumes the verdicts from memoryBus unless it is nil.
+func newAfcVerdictsProcessor(
+	ctx context.Context,
+	cfg config.Config,
+	memoryBus *msgbus.Memory,
+	db *store.Database,
+	msgRepo *messagesrepo.Repo,
+	outboxService *outbox.Service,
+) (*afcverdictsprocessor.Service, *afcverdictsprocessor.KeySet, error) {
+	var keySet *afcverdictsprocessor.KeySet
+
+	opts := []afcverdictsprocessor.OptOptionsSetter{
+		afcverdictsprocessor.WithVerdictsSignKey(cfg.Services.AfcVerdictsProcessor.VerdictsSigningPublicKey),
+		afcverdictsprocessor.WithProcessBatchSize(cfg.Services.AfcVerdictsProcessor.ProcessBatchSize),
+		afcverdictsprocessor.WithProcessBatchMaxWait(cfg.Services.AfcVerdictsProcessor.ProcessBatchMaxWait),
+		afcverdictsprocessor.WithReviewSuspicious(cfg.Services.MessageReview.Enabled),
+		afcverdictsprocessor.WithCheckManagerMessages(cfg.Services.AfcManagerMessages.Enabled),
+	}
+	if src := cfg.Services.AfcVerdictsProcessor.VerdictsJWKSSource; src != "" {
+		var err error
+		keySet, err = afcverdictsprocessor.NewKeySet(ctx, afcverdictsprocessor.NewKeySetOptions(
+			src,
+			afcverdictsprocessor.WithReloadPeriod(cfg.Services.AfcVerdictsProcessor.VerdictsJWKSReloadPeriod),
+			afcverdictsprocessor.WithFetchTimeout(cfg.Services.AfcVerdictsProcessor.VerdictsJWKSFetchTimeout),
+			afcverdictsprocessor.WithHttpClient(&http.Client{Timeout: cfg.Services.AfcVerdictsProcessor.VerdictsJWKSFetchTimeout}),
+		))
+		if err != nil {
+			return nil, nil, fmt.Errorf("create key set, err=%v", err)
+		}
+
+		opts = append(opts, afcverdictsprocessor.WithVerdictsKeySet(keySet))
+	}
+
+	var (
+		readerFactory msgbus.ReaderFactory
+		dlqWriter     msgbus.Writer
+	)
+	if memoryBus != nil {
+		readerFactory = memoryBus.Reader
+		dlqWriter = memoryBus.Writer(cfg.Services.AfcVerdictsProcessor.VerdictsDqlTopicName)
+	} else {
+		readerFactory = msgbus.KafkaReaderFactory(cfg.Services.AfcVerdictsProcessor.Brokers)
+		dlqWriter = msgbus.NewKafkaDLQWriter(
+			cfg.Services.AfcVerdictsProcessor.Brokers,
+			cfg.Services.AfcVerdictsProcessor.VerdictsDqlTopicName,
+		)
+	}
+
+	svc, err := afcverdictsprocessor.New(afcverdictsprocessor.NewOptions(
+		cfg.Services.AfcVerdictsProcessor.ConsumersCount,
+		cfg.Services.AfcVerdictsProcessor.ConsumersGroupName,
+		cfg.Services.AfcVerdictsProcessor.VerdictsTopicName,
+		readerFactory,
+		dlqWriter,
+		db,
+		msgRepo,
+		outboxService,
+		opts...,
+	))
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return svc, keySet, nil
+}
+
+// newAfcLocal creates the local AFC consuming the produced messages from memoryBus unless it is nil.
+func newAfcLocal(
+	cfg config.Config,
+	memoryBus *msgbus.Memory,
+	verdictsProcessor *afcverdictsprocessor.Service,
+) (*afclocal.Service, error) {
+	producerCfg, afcCfg := cfg.Services.MessageProducerService, cfg.Services.AfcLocal
+
+	// The messages encrypted with encrypt_key have no key ID.
+	keys := make(map[string]string, len(producerCfg.EncryptKeys)+1)
+	for id, key := range producerCfg.EncryptKeys {
+		keys[id] = key
+	}
+	if _, ok := keys[""]; !ok && producerCfg.EncryptKey != "" {
+		keys[""] = producerCfg.EncryptKey
+	}
+
+	decode := msgproducer.DecodePlain
+	if len(keys) > 0 {
+		keyring, err := msgproducer.NewKeyring(keys)
+		if err != nil {
+			return nil, fmt.Errorf("create keyring, err=%v", err)
+		}
+		decode = keyring.Decode
+	}
+
+	var reader msgbus.Reader
+	if memoryBus != nil {
+		reader = memoryBus.Reader(afcCfg.ConsumersGroupName, producerCfg.Topic)
+	} else {
+		reader = msgbus.NewKafkaReader(producerCfg.Brokers, afcCfg.ConsumersGroupName, producerCfg.Topic)
+	}
+
+	return afclocal.New(afclocal.NewOptions(
+		reader,
+		decode,
+		verdictsProcessor,
+		afclocal.WithPatterns(afcCfg.Patterns),
+		afclocal.WithBlockedWords(afcCfg.BlockedWords),
+		afclocal.WithBlockLinks(afcCfg.BlockLinks),
+		afclocal.WithAllowedLinkDomains(afcCfg.AllowedLinkDomains),
+		afclocal.WithRateLimit(afcCfg.RateLimit),
+		afclocal.WithRatePeriod(afcCfg.RatePeriod),
+	))
+}
+
+// authOptions configures the servers authentication mode, "active" by default.
+func (d serverDeps) authOptions() []server.OptOptionsSetter {
+	opts := []server.OptOptionsSetter{
+		server.WithSessionRevalidationPeriod(d.sessionRevalidationPeriod),
+	}
+
+	if d.kcKeySet == nil {
+		return opts
+	}
+
+	return append(opts,
+		server.WithKeySet(d.kcKeySet),
+		server.WithIntrospectionFallback(d.introspectionFallback),
+	)
+}
+
+func initRateLimiter(cfg config.RateLimitConfig) (*ratelimiter.Service, error) {
+	operations := make(map[string]ratelimiter.Limit, len(cfg.Operations))
+	for op, l := range cfg.Operations {
+		operations[op] = ratelimiter.Limit{RPS: l.RPS, Burst: l.Burst}
+	}
+
+	return ratelimiter.New(ratelimiter.NewOptions(
+		ratelimiter.Limit{RPS: cfg.Default.RPS, Burst: cfg.Default.Burst},
+		ratelimiter.WithOperationsLimits(operations),
+	))
+}
+
+// rateLimiter prevents passing of the nil service as the non-nil interface.
+func rateLimiter(s *ratelimiter.Service) middlewares.RateLimiter {
+	if s == nil {
+		return nil
+	}
+	return s
+}
+
 func initKeyCloakClient(logger *zap.Logger, cfg config.KeycloakClientConfig, isProdEnv bool) (*keycloakclient.Client, error) {
 	kcClient, err := keycloakclient.New(keycloakclient.NewOptions(
 		cfg.BasePath,
@@ -8,14 +8,22 @@ import (
 	"log"
 	"os/signal"
 	"syscall"
+	"time"
 
 	"github.com/karasunokami/chat-service/internal/config"
 	"github.com/karasunokami/chat-service/internal/logger"
 	serverdebug "github.com/karasunokami/chat-service/internal/server-debug"
+	"github.com/karasunokami/chat-service/internal/tracing"
 
+	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 )
 
+const (
+	serviceName            = "chat-service"
+	tracingShutdownTimeout = 3 * time.Second
+)
+
 var configPath = flag.String("config", "./configs/config.example.toml", "Path to config file")
 
 func main() {
@@ -42,6 +50,35 @@ func run() (errReturned error) {
 	configureZap(cfg.Log.Level, cfg.Global.Env, cfg.Sentry.Dsn)
 	defer logger.Sync()
 
+	// run subcommands
+	switch flag.Arg(0) {
+	case cmdMigrate:
+		return runMigrate(ctx, cfg.Clients.PSQLClient, flag.Args()[1:])
+	case cmdAfcDLQ:
+		return runAfcDLQ(ctx, cfg, flag.Args()[1:])
+	}
+
+	// configure tracing
+	tracingProvider, err := tracing.New(tracing.NewOptions(
+		serviceName,
+		cfg.Tracing.Exporter,
+		tracing.WithOtlpEndpoint(cfg.Tracing.OTLPEndpoint),
+		tracing.WithOtlpInsecure(cfg.Tracing.OTLPInsecure),
+		tracing.WithSampleRatio(cfg.Tracing.SampleRatio),
+	))
+	if err != nil {
+		return fmt.Errorf("init tracing: %v", err)
+	}
+
+	defer func() {
+		ctx, cancel := context.WithTimeout(context.Background(), tracingShutdownTimeout)
+		defer cancel()
+
+		if err := tracingProvider.Shutdown(ctx); err != nil {
+			zap.L().Error("Shutdown tracing", zap.Error(err))
+		}
+	}()
+
 	// init deps
 	deps, err := startNewDeps(ctx, cfg)
 	if err != nil {
@@ -51,11 +88,19 @@ func run() (errReturned error) {
 	defer deps.stop()
 
 	// init servers
+	var debugOpts []serverdebug.OptOptionsSetter
+	if deps.introspectionCache != nil {
+		debugOpts = append(debugOpts, serverdebug.WithIntrospectionCache(deps.introspectionCache))
+	}
+	debugOpts = append(debugOpts, serverdebug.WithAuditLog(deps.auditRepo))
+
 	srvDebug, err := serverdebug.New(serverdebug.NewOptions(
 		cfg.Servers.Debug.Addr,
 		deps.clientSwagger,
 		deps.managerSwagger,
 		deps.clientEventsSwagger,
+		deps.healthService,
+		debugOpts...,
 	))
 	if err != nil {
 		return fmt.Errorf("init debug server: %v", err)
@@ -82,6 +127,27 @@ func run() (errReturned error) {
 	eg.Go(func() error { return deps.outboxService.Run(ctx) })
 	eg.Go(func() error { return deps.afcVerdictsProcessorService.Run(ctx) })
 	eg.Go(func() error { return deps.managerSchedulerService.Run(ctx) })
+	eg.Go(func() error { return deps.managerPresence.Run(ctx) })
+	eg.Go(func() error { return deps.afcWatchdog.Run(ctx) })
+	eg.Go(func() error { return deps.healthService.Run(ctx) })
+	if deps.reviewExpirer != nil {
+		eg.Go(func() error { return deps.reviewExpirer.Run(ctx) })
+	}
+	if deps.afcLocal != nil {
+		eg.Go(func() error { return deps.afcLocal.Run(ctx) })
+	}
+	if deps.afcVerdictsKeySet != nil {
+		eg.Go(func() error { return deps.afcVerdictsKeySet.Run(ctx) })
+	}
+	if deps.introspectionCache != nil {
+		eg.Go(func() error { return deps.introspectionCache.Run(ctx) })
+	}
+	if deps.clientRateLimiter != nil {
+		eg.Go(func() error { return deps.clientRateLimiter.Run(ctx) })
+	}
+	if deps.managerRateLimiter != nil {
+		eg.Go(func() error { return deps.managerRateLimiter.Run(ctx) })
+	}
 
 	// wait for command line signal
 	if err = eg.Wait(); err != nil && !errors.Is(err, context.Canceled) {
@@ -0,0 +1,109 @@
+package main
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/karasunokami/chat-service/internal/config"
+	"github.com/karasunokami/chat-service/internal/store"
+	"github.com/karasunokami/chat-service/internal/store/migrations"
+
+	"go.uber.org/zap"
+)
+
+const (
+	cmdMigrate = "migrate"
+
+	migrateUp     = "up"
+	migrateDown   = "down"
+	migrateStatus = "status"
+)
+
+var errUnknownMigrateCmd = errors.New("unknown migrate command, expected one of: up, down, status")
+
+// runMigrate handles `chat-service migrate up|down|status`.
+func runMigrate(ctx context.Context, cfg config.PSQLClientConfig, args []string) error {
+	if len(args) != 1 {
+		return errUnknownMigrateCmd
+	}
+
+	return withMigrator(cfg, func(m *migrations.Migrator) error {
+		switch args[0] {
+		case migrateUp:
+			applied, err := m.Up(ctx)
+			for _, mg := range applied {
+				zap.L().Info("Migration applied", zap.Int64("version", mg.Version), zap.String("name", mg.Name))
+			}
+			if err != nil {
+				return fmt.Errorf("migrate up, err=%v", err)
+			}
+			if len(applied) == 0 {
+				zap.L().Info("No pending migrations")
+			}
+
+		case migrateDown:
+			reverted, err := m.Down(ctx)
+			if err != nil {
+				return fmt.Errorf("migrate down, err=%v", err)
+			}
+			zap.L().Info("Migration reverted", zap.Int64("version", reverted.Version), zap.String("name", reverted.Name))
+
+		case migrateStatus:
+			statuses, err := m.Status(ctx)
+			if err != nil {
+				return fmt.Errorf("migrate status, err=%v", err)
+			}
+			return printMigrationsStatus(os.Stdout, statuses)
+
+		default:
+			return errUnknownMigrateCmd
+		}
+
+		return nil
+	})
+}
+
+// checkMigrations refuses to start the service with not fully migrated database.
+func checkMigrations(ctx context.Context, cfg config.PSQLClientConfig) error {
+	return withMigrator(cfg, func(m *migrations.Migrator) error {
+		if err := m.CheckUpToDate(ctx); err != nil {
+			return fmt.Errorf("%v, run `chat-service migrate up`", err)
+		}
+		return nil
+	})
+}
+
+func withMigrator(cfg config.PSQLClientConfig, f func(m *migrations.Migrator) error) error {
+	db, err := store.NewPgxDB(store.NewPgxOptions(cfg.Address, cfg.Username, cfg.Password, cfg.Database))
+	if err != nil {
+		return fmt.Errorf("init pgx db, err=%v", err)
+	}
+	defer db.Close()
+
+	m, err := migrations.New(migrations.NewOptions(db))
+	if err != nil {
+		return fmt.Errorf("init migrator, err=%v", err)
+	}
+
+	return f(m)
+}
+
+func printMigrationsStatus(w io.Writer, statuses []migrations.Status) error {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "VERSION\tNAME\tAPPLIED AT")
+	for _, s := range statuses {
+		appliedAt := "pending"
+		if s.Applied() {
+			appliedAt = s.AppliedAt.Format(time.RFC3339)
+		}
+		fmt.Fprintf(tw, "%d\t%s\t%s\n", s.Version, s.Name, appliedAt)
+	}
+
+	return tw.Flush()
+}
@@ -26,15 +26,18 @@ func initServerClient(
 	// build server client
 	srv, err := server.New(server.NewOptions(
 		deps.clientLogger,
+		nameServerClient,
 		clientServerConfig.Addr,
 		clientServerConfig.AllowOrigins,
 		clientServerConfig.SecWsProtocol,
 		clientServerConfig.RequiredAccess.Resource,
 		clientServerConfig.RequiredAccess.Role,
-		serverclient.NewHandlersRegistrar(deps.clientSwagger, serverHandlers, deps.errHandler.Handle),
-		deps.kcClient,
+		serverclient.NewHandlersRegistrar(deps.clientSwagger, serverHandlers, deps.errHandler.Handle, rateLimiter(deps.clientRateLimiter)),
+		deps.introspector,
 		deps.eventsStream,
 		clientevents.Adapter{},
+		deps.healthService,
+		append(deps.authOptions(), server.WithMaxWsConnectionsPerUser(clientServerConfig.RateLimit.WsConnectionsLimit()))...,
 	))
 	if err != nil {
 		return nil, fmt.Errorf("build server: %v", err)
@@ -9,11 +9,22 @@ import (
 	managerevents "github.com/karasunokami/chat-service/internal/server-manager/events"
 	managerv1 "github.com/karasunokami/chat-service/internal/server-manager/v1"
 	canreceiveproblems "github.com/karasunokami/chat-service/internal/usecases/manager/can-receive-problems"
+	cancelscheduledmessage "github.com/karasunokami/chat-service/internal/usecases/manager/cancel-scheduled-message"
 	closechat "github.com/karasunokami/chat-service/internal/usecases/manager/close-chat"
 	freehands "github.com/karasunokami/chat-service/internal/usecases/manager/free-hands"
 	getchats "github.com/karasunokami/chat-service/internal/usecases/manager/get-chats"
 	gethistory "github.com/karasunokami/chat-service/internal/usecases/manager/get-history"
+	getscheduledmessages "github.com/karasunokami/chat-service/internal/usecases/manager/get-scheduled-messages"
+	schedulemessage "github.com/karasunokami/chat-service/internal/usecases/manager/schedule-message"
+	sendinternalnote "github.com/karasunokami/chat-service/internal/usecases/manager/send-internal-note"
 	sendmessage "github.com/karasunokami/chat-service/internal/usecases/manager/send-message"
+	setstatus "github.com/karasunokami/chat-service/internal/usecases/manager/set-status"
+	getchathistory "github.com/karasunokami/chat-service/internal/usecases/supervisor/get-chat-history"
+	getmanagerstatuses "github.com/karasunokami/chat-service/internal/usecases/supervisor/get-manager-statuses"
+	getmessagesforreview "github.com/karasunokami/chat-service/internal/usecases/supervisor/get-messages-for-review"
+	getopenproblems "github.com/karasunokami/chat-service/internal/usecases/supervisor/get-open-problems"
+	resolvemessagereview "github.com/karasunokami/chat-service/internal/usecases/supervisor/resolve-message-review"
+	sendwhisper "github.com/karasunokami/chat-service/internal/usecases/supervisor/send-whisper"
 )
 
 const nameServerManager = "server-manager"
@@ -30,15 +41,32 @@ func initServerManager(
 	// build manager server client
 	srv, err := server.New(server.NewOptions(
 		deps.managerLogger,
+		nameServerManager,
 		managerServerConfig.Addr,
 		managerServerConfig.AllowOrigins,
 		managerServerConfig.SecWsProtocol,
 		managerServerConfig.RequiredAccess.Resource,
 		managerServerConfig.RequiredAccess.Role,
-		servermanager.NewHandlersRegistrar(deps.managerSwagger, serverHandlers, deps.errHandler.Handle),
-		deps.kcClient,
+		servermanager.NewHandlersRegistrar(
+			deps.managerSwagger,
+			serverHandlers,
+			deps.errHandler.Handle,
+			servermanager.NewAuthenticationFunc(
+				managerServerConfig.RequiredAccess.Resource,
+				managerServerConfig.RequiredAccess.Role,
+				managerServerConfig.RequiredAccess.SupervisorRole,
+			),
+			rateLimiter(deps.managerRateLimiter),
+		),
+		deps.introspector,
 		deps.eventsStream,
 		managerevents.Adapter{},
+		deps.healthService,
+		append(deps.authOptions(),
+			server.WithMaxWsConnectionsPerUser(managerServerConfig.RateLimit.WsConnectionsLimit()),
+			server.WithSupervisorRole(managerServerConfig.RequiredAccess.SupervisorRole),
+			server.WithPresence(deps.managerPresence),
+		)...,
 	))
 	if err != nil {
 		return nil, fmt.Errorf("build server: %v", err)
@@ -60,22 +88,36 @@ func initManagerServerHandlers(deps serverDeps) (managerv1.Handlers, error) {
 	freeHandsUseCase, err := freehands.New(freehands.NewOptions(
 		deps.managerLoad,
 		deps.managerPool,
+		deps.auditRepo,
+		deps.managerPresence,
 	))
 	if err != nil {
 		return managerv1.Handlers{}, fmt.Errorf("init free hands usecase: %v", err)
 	}
 
+	setStatusUseCase, err := setstatus.New(setstatus.NewOptions(deps.managerPresence, deps.auditRepo))
+	if err != nil {
+		return managerv1.Handlers{}, fmt.Errorf("init set status usecase: %v", err)
+	}
+
 	getChatsUseCase, err := getchats.New(getchats.NewOptions(deps.chatRepo))
 	if err != nil {
 		return managerv1.Handlers{}, fmt.Errorf("init get chats usecase: %v", err)
 	}
 
-	getHistoryUseCase, err := gethistory.New(gethistory.NewOptions(deps.msgRepo))
+	getHistoryUseCase, err := gethistory.New(gethistory.NewOptions(deps.msgRepo, deps.auditRepo, deps.db))
 	if err != nil {
 		return managerv1.Handlers{}, fmt.Errorf("init get history usecase: %v", err)
 	}
 
-	sendMessageUseCase, err := sendmessage.New(sendmessage.NewOptions(deps.msgRepo, deps.outboxService, deps.problemsRepo, deps.db))
+	sendMessageUseCase, err := sendmessage.New(sendmessage.NewOptions(
+		deps.msgRepo,
+		deps.outboxService,
+		deps.problemsRepo,
+		deps.db,
+		deps.auditRepo,
+		sendmessage.WithHoldForAFC(deps.holdManagerMessagesForAFC),
+	))
 	if err != nil {
 		return managerv1.Handlers{}, fmt.Errorf("init send message usecase: %v", err)
 	}
@@ -85,19 +127,97 @@ func initManagerServerHandlers(deps serverDeps) (managerv1.Handlers, error) {
 		deps.problemsRepo,
 		deps.msgRepo,
 		deps.db,
+		deps.auditRepo,
 	))
 	if err != nil {
 		return managerv1.Handlers{}, fmt.Errorf("init resolve problem usecase: %v", err)
 	}
 
+	sendInternalNoteUseCase, err := sendinternalnote.New(sendinternalnote.NewOptions(
+		deps.msgRepo,
+		deps.outboxService,
+		deps.problemsRepo,
+		deps.db,
+		deps.auditRepo,
+	))
+	if err != nil {
+		return managerv1.Handlers{}, fmt.Errorf("init send internal note usecase: %v", err)
+	}
+
+	scheduleMessageUseCase, err := schedulemessage.New(schedulemessage.NewOptions(
+		deps.scheduledMsgRepo,
+		deps.outboxService,
+		deps.problemsRepo,
+		deps.db,
+	))
+	if err != nil {
+		return managerv1.Handlers{}, fmt.Errorf("init schedule message usecase: %v", err)
+	}
+
+	getScheduledMessagesUseCase, err := getscheduledmessages.New(getscheduledmessages.NewOptions(deps.scheduledMsgRepo))
+	if err != nil {
+		return managerv1.Handlers{}, fmt.Errorf("init get scheduled messages usecase: %v", err)
+	}
+
+	cancelScheduledMessageUseCase, err := cancelscheduledmessage.New(cancelscheduledmessage.NewOptions(deps.scheduledMsgRepo))
+	if err != nil {
+		return managerv1.Handlers{}, fmt.Errorf("init cancel scheduled message usecase: %v", err)
+	}
+
+	getOpenProblemsUseCase, err := getopenproblems.New(getopenproblems.NewOptions(deps.problemsRepo))
+	if err != nil {
+		return managerv1.Handlers{}, fmt.Errorf("init get open problems usecase: %v", err)
+	}
+
+	supervisorGetHistoryUseCase, err := getchathistory.New(getchathistory.NewOptions(deps.msgRepo))
+	if err != nil {
+		return managerv1.Handlers{}, fmt.Errorf("init supervisor get chat history usecase: %v", err)
+	}
+
+	sendWhisperUseCase, err := sendwhisper.New(sendwhisper.NewOptions(
+		deps.msgRepo,
+		deps.outboxService,
+		deps.problemsRepo,
+		deps.db,
+	))
+	if err != nil {
+		return managerv1.Handlers{}, fmt.Errorf("init supervisor send whisper usecase: %v", err)
+	}
+
+	getManagerStatusesUseCase, err := getmanagerstatuses.New(getmanagerstatuses.NewOptions(deps.managerPresence))
+	if err != nil {
+		return managerv1.Handlers{}, fmt.Errorf("init supervisor get manager statuses usecase: %v", err)
+	}
+
+	getMessagesForReviewUseCase, err := getmessagesforreview.New(getmessagesforreview.NewOptions(deps.msgRepo))
+	if err != nil {
+		return managerv1.Handlers{}, fmt.Errorf("init supervisor get messages for review usecase: %v", err)
+	}
+
+	resolveMessageReviewUseCase, err := resolvemessagereview.New(resolvemessagereview.NewOptions(deps.reviewResolver))
+	if err != nil {
+		return managerv1.Handlers{}, fmt.Errorf("init supervisor resolve message review usecase: %v", err)
+	}
+
 	// create manager handlers
 	serverV1Handlers, err := managerv1.NewHandlers(managerv1.NewOptions(
 		canReceiveProblemsUseCase,
 		freeHandsUseCase,
+		setStatusUseCase,
 		getChatsUseCase,
 		getHistoryUseCase,
 		sendMessageUseCase,
 		closeChatUseCase,
+		sendInternalNoteUseCase,
+		scheduleMessageUseCase,
+		getScheduledMessagesUseCase,
+		cancelScheduledMessageUseCase,
+		getOpenProblemsUseCase,
+		supervisorGetHistoryUseCase,
+		sendWhisperUseCase,
+		getManagerStatusesUseCase,
+		getMessagesForReviewUseCase,
+		resolveMessageReviewUseCase,
 	))
 	if err != nil {
 		return managerv1.Handlers{}, fmt.Errorf("create v1 handlers: %v", err)
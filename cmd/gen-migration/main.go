@@ -0,0 +1,78 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+
+	"github.com/karasunokami/chat-service/internal/store/migrate"
+
+	atlasmigrate "ariga.io/atlas/sql/migrate"
+	"ariga.io/atlas/sql/sqltool"
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/schema"
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/stdlib"
+)
+
+var (
+	dir    = flag.String("dir", "./internal/store/migrations", "Path to migrations directory")
+	devDSN = flag.String("dev-dsn", "", "Connection string of an empty dev database used to replay migrations")
+	name   = flag.String("name", "", "Name of the new migration")
+	rehash = flag.Bool("rehash", false, "Only recalculate atlas.sum after manual changes of migration files")
+)
+
+func main() {
+	flag.Parse()
+
+	if err := run(context.Background()); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func run(ctx context.Context) error {
+	migrationsDir, err := sqltool.NewGolangMigrateDir(*dir)
+	if err != nil {
+		return fmt.Errorf("open migrations dir, err=%v", err)
+	}
+
+	if *rehash {
+		sum, err := migrationsDir.Checksum()
+		if err != nil {
+			return fmt.Errorf("calculate checksum, err=%v", err)
+		}
+		return atlasmigrate.WriteSumFile(migrationsDir, sum)
+	}
+
+	if *devDSN == "" || *name == "" {
+		return fmt.Errorf("both -dev-dsn and -name are required")
+	}
+
+	cfg, err := pgx.ParseConfig(*devDSN)
+	if err != nil {
+		return fmt.Errorf("parse dev dsn, err=%v", err)
+	}
+
+	db := stdlib.OpenDB(*cfg)
+	defer db.Close()
+
+	// The dev database state is computed by replaying existing migrations,
+	// so the new migration contains only changes of the ent schema.
+	err = migrate.NewSchema(entsql.OpenDB(dialect.Postgres, db)).NamedDiff(ctx, *name,
+		schema.WithDir(migrationsDir),
+		schema.WithMigrationMode(schema.ModeReplay),
+		schema.WithDialect(dialect.Postgres),
+		schema.WithFormatter(sqltool.GolangMigrateFormatter),
+		schema.WithDropColumn(true),
+		schema.WithDropIndex(true),
+	)
+	if err != nil {
+		return fmt.Errorf("generate migration, err=%v", err)
+	}
+
+	log.Printf("migration %q generated in %s\n", *name, *dir)
+
+	return nil
+}
@@ -0,0 +1,79 @@
+package keycloakclient
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"math/big"
+	"net/http"
+)
+
+var ErrUnsupportedKeyType = errors.New("unsupported key type")
+
+const (
+	keyTypeRSA = "RSA"
+	keyUseSig  = "sig"
+)
+
+// JWK is a JSON Web Key of the realm, see https://www.rfc-editor.org/rfc/rfc7517.
+type JWK struct {
+	Kid string `json:"kid"`
+	Kty string `json:"kty"`
+	Alg string `json:"alg"`
+	Use string `json:"use"`
+	N   string `json:"n"`
+	E   string `json:"e"`
+}
+
+// IsSigningKey returns true if the key is intended for signatures verification.
+func (k JWK) IsSigningKey() bool {
+	return k.Use == "" || k.Use == keyUseSig
+}
+
+// RSAPublicKey decodes the key modulus and exponent.
+func (k JWK) RSAPublicKey() (*rsa.PublicKey, error) {
+	if k.Kty != keyTypeRSA {
+		return nil, fmt.Errorf("%w: %q", ErrUnsupportedKeyType, k.Kty)
+	}
+
+	n, err := base64.RawURLEncoding.DecodeString(k.N)
+	if err != nil {
+		return nil, fmt.Errorf("decode modulus, err=%v", err)
+	}
+
+	e, err := base64.RawURLEncoding.DecodeString(k.E)
+	if err != nil {
+		return nil, fmt.Errorf("decode exponent, err=%v", err)
+	}
+
+	return &rsa.PublicKey{
+		N: new(big.Int).SetBytes(n),
+		E: int(new(big.Int).SetBytes(e).Int64()),
+	}, nil
+}
+
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// GetCerts returns the realm public keys. It doesn't require client authentication.
+func (c *Client) GetCerts(ctx context.Context) (*JWKS, error) {
+	url := fmt.Sprintf("realms/%s/protocol/openid-connect/certs", c.realm)
+
+	var result JWKS
+
+	resp, err := c.cli.R().
+		SetContext(ctx).
+		SetResult(&result).
+		Get(url)
+	if err != nil {
+		return nil, fmt.Errorf("send request to keycloak: %v", err)
+	}
+	if resp.StatusCode() != http.StatusOK {
+		return nil, fmt.Errorf("errored keycloak response: %v", resp.Status())
+	}
+
+	return &result, nil
+}
@@ -0,0 +1,23 @@
+package keycloakclient
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+)
+
+// Ping checks that the realm is available.
+func (c *Client) Ping(ctx context.Context) error {
+	url := fmt.Sprintf("realms/%s", c.realm)
+
+	resp, err := c.cli.R().SetContext(ctx).Get(url)
+	if err != nil {
+		return fmt.Errorf("send request to keycloak: %v", err)
+	}
+
+	if resp.StatusCode() != http.StatusOK {
+		return fmt.Errorf("errored keycloak response: %v", resp.Status())
+	}
+
+	return nil
+}
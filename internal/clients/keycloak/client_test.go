@@ -54,3 +54,25 @@ func (s *KeycloakSuite) TestIntrospectTokenWithInvalidSignature() {
 	s.Require().NoError(err)
 	s.False(result.Active)
 }
+
+func (s *KeycloakSuite) TestPing() {
+	s.Require().NoError(s.kc.Ping(s.Ctx))
+}
+
+func (s *KeycloakSuite) TestGetCerts() {
+	certs, err := s.kc.GetCerts(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(certs.Keys)
+
+	var signingKeys int
+	for _, k := range certs.Keys {
+		if !k.IsSigningKey() {
+			continue
+		}
+		signingKeys++
+
+		_, err := k.RSAPublicKey()
+		s.NoError(err)
+	}
+	s.Positive(signingKeys)
+}
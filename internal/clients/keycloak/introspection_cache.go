@@ -0,0 +1,188 @@
+package keycloakclient
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"golang.org/x/sync/singleflight"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/introspector_mock.gen.go -package=keycloakclientmocks introspector
+
+type introspector interface {
+	IntrospectToken(ctx context.Context, token string) (*IntrospectTokenResult, error)
+}
+
+//go:generate options-gen -out-filename=introspection_cache_options.gen.go -from-struct=IntrospectionCacheOptions
+type IntrospectionCacheOptions struct {
+	introspector introspector `option:"mandatory" validate:"required"`
+
+	// maxTTL bounds the caching time of active tokens, so revoked tokens are rejected in reasonable time.
+	maxTTL time.Duration `default:"1m" validate:"min=10ms,max=1h"`
+
+	// negativeTTL is the caching time of inactive tokens. Zero disables negative caching.
+	negativeTTL time.Duration `default:"5s" validate:"min=0,max=1m"`
+
+	cleanupPeriod time.Duration `default:"1m" validate:"min=10ms,max=1h"`
+}
+
+type IntrospectionCacheStats struct {
+	Hits   uint64 `json:"hits"`
+	Misses uint64 `json:"misses"`
+	Size   int    `json:"size"`
+}
+
+type introspectionEntry struct {
+	result    IntrospectTokenResult
+	expiresAt time.Time
+}
+
+// IntrospectionCache caches results of the token introspection.
+// Concurrent introspections of the same token are collapsed into a single request.
+type IntrospectionCache struct {
+	IntrospectionCacheOptions
+
+	group singleflight.Group
+
+	mu      sync.RWMutex
+	entries map[string]introspectionEntry
+
+	hits   atomic.Uint64
+	misses atomic.Uint64
+}
+
+func NewIntrospectionCache(opts IntrospectionCacheOptions) (*IntrospectionCache, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate options: %v", err)
+	}
+
+	return &IntrospectionCache{
+		IntrospectionCacheOptions: opts,
+		entries:                   make(map[string]introspectionEntry),
+	}, nil
+}
+
+// Run periodically removes expired entries.
+func (c *IntrospectionCache) Run(ctx context.Context) error {
+	t := time.NewTicker(c.cleanupPeriod)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+			c.cleanup()
+		}
+	}
+}
+
+func (c *IntrospectionCache) IntrospectToken(ctx context.Context, token string) (*IntrospectTokenResult, error) {
+	key := tokenHash(token)
+
+	if res, ok := c.get(key); ok {
+		c.hits.Add(1)
+		introspectionCacheRequestsCounter.WithLabelValues("hit").Inc()
+		return res, nil
+	}
+
+	c.misses.Add(1)
+	introspectionCacheRequestsCounter.WithLabelValues("miss").Inc()
+
+	// NOTE: The context of the first caller is used for the collapsed request.
+	v, err, _ := c.group.Do(key, func() (any, error) {
+		// The result could be cached by the just finished flight.
+		if res, ok := c.get(key); ok {
+			return *res, nil
+		}
+
+		res, err := c.introspector.IntrospectToken(ctx, token)
+		if err != nil {
+			return nil, err
+		}
+
+		c.set(key, *res)
+
+		return *res, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	res := v.(IntrospectTokenResult)
+	return &res, nil
+}
+
+func (c *IntrospectionCache) Stats() IntrospectionCacheStats {
+	c.mu.RLock()
+	size := len(c.entries)
+	c.mu.RUnlock()
+
+	return IntrospectionCacheStats{
+		Hits:   c.hits.Load(),
+		Misses: c.misses.Load(),
+		Size:   size,
+	}
+}
+
+func (c *IntrospectionCache) get(key string) (*IntrospectTokenResult, bool) {
+	c.mu.RLock()
+	e, ok := c.entries[key]
+	c.mu.RUnlock()
+
+	if !ok || !time.Now().Before(e.expiresAt) {
+		return nil, false
+	}
+
+	res := e.result
+	return &res, true
+}
+
+func (c *IntrospectionCache) set(key string, res IntrospectTokenResult) {
+	now := time.Now()
+
+	ttl := c.negativeTTL
+	if res.Active {
+		ttl = c.maxTTL
+		if untilExp := time.Unix(int64(res.Exp), 0).Sub(now); untilExp < ttl {
+			ttl = untilExp
+		}
+	}
+
+	if ttl <= 0 {
+		return
+	}
+
+	c.mu.Lock()
+	c.entries[key] = introspectionEntry{result: res, expiresAt: now.Add(ttl)}
+	size := len(c.entries)
+	c.mu.Unlock()
+
+	introspectionCacheSizeGauge.Set(float64(size))
+}
+
+func (c *IntrospectionCache) cleanup() {
+	now := time.Now()
+
+	c.mu.Lock()
+	for k, e := range c.entries {
+		if !now.Before(e.expiresAt) {
+			delete(c.entries, k)
+		}
+	}
+	size := len(c.entries)
+	c.mu.Unlock()
+
+	introspectionCacheSizeGauge.Set(float64(size))
+}
+
+// tokenHash is used as a cache key to not keep raw tokens in memory.
+func tokenHash(token string) string {
+	h := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(h[:])
+}
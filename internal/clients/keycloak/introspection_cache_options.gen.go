@@ -0,0 +1,86 @@
+// Code generated by options-gen. DO NOT EDIT.
+package keycloakclient
+
+import (
+	fmt461e464ebed9 "fmt"
+	"time"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptIntrospectionCacheOptionsSetter func(o *IntrospectionCacheOptions)
+
+func NewIntrospectionCacheOptions(
+	introspector introspector,
+	options ...OptIntrospectionCacheOptionsSetter,
+) IntrospectionCacheOptions {
+	o := IntrospectionCacheOptions{}
+
+	// Setting defaults from field tag (if present)
+	o.maxTTL, _ = time.ParseDuration("1m")
+	o.negativeTTL, _ = time.ParseDuration("5s")
+	o.cleanupPeriod, _ = time.ParseDuration("1m")
+
+	o.introspector = introspector
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func WithMaxTTL(opt time.Duration) OptIntrospectionCacheOptionsSetter {
+	return func(o *IntrospectionCacheOptions) {
+		o.maxTTL = opt
+	}
+}
+
+func WithNegativeTTL(opt time.Duration) OptIntrospectionCacheOptionsSetter {
+	return func(o *IntrospectionCacheOptions) {
+		o.negativeTTL = opt
+	}
+}
+
+func WithCleanupPeriod(opt time.Duration) OptIntrospectionCacheOptionsSetter {
+	return func(o *IntrospectionCacheOptions) {
+		o.cleanupPeriod = opt
+	}
+}
+
+func (o *IntrospectionCacheOptions) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("introspector", _validate_IntrospectionCacheOptions_introspector(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("maxTTL", _validate_IntrospectionCacheOptions_maxTTL(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("negativeTTL", _validate_IntrospectionCacheOptions_negativeTTL(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("cleanupPeriod", _validate_IntrospectionCacheOptions_cleanupPeriod(o)))
+	return errs.AsError()
+}
+
+func _validate_IntrospectionCacheOptions_introspector(o *IntrospectionCacheOptions) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.introspector, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `introspector` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_IntrospectionCacheOptions_maxTTL(o *IntrospectionCacheOptions) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.maxTTL, "min=10ms,max=1h"); err != nil {
+		return fmt461e464ebed9.Errorf("field `maxTTL` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_IntrospectionCacheOptions_negativeTTL(o *IntrospectionCacheOptions) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.negativeTTL, "min=0,max=1m"); err != nil {
+		return fmt461e464ebed9.Errorf("field `negativeTTL` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_IntrospectionCacheOptions_cleanupPeriod(o *IntrospectionCacheOptions) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.cleanupPeriod, "min=10ms,max=1h"); err != nil {
+		return fmt461e464ebed9.Errorf("field `cleanupPeriod` did not pass the test: %w", err)
+	}
+	return nil
+}
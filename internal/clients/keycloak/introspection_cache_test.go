@@ -0,0 +1,174 @@
+package keycloakclient_test
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+
+	keycloakclient "github.com/karasunokami/chat-service/internal/clients/keycloak"
+	keycloakclientmocks "github.com/karasunokami/chat-service/internal/clients/keycloak/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const cachedToken = "token"
+
+func TestIntrospectionCache_IntrospectToken(t *testing.T) {
+	ctx := context.Background()
+	exp := int(time.Now().Add(time.Hour).Unix())
+
+	t.Run("active token is cached", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		introspector := keycloakclientmocks.NewMockintrospector(ctrl)
+		introspector.EXPECT().IntrospectToken(ctx, cachedToken).
+			Return(&keycloakclient.IntrospectTokenResult{Active: true, Exp: exp}, nil).Times(1)
+
+		c, err := keycloakclient.NewIntrospectionCache(keycloakclient.NewIntrospectionCacheOptions(introspector))
+		require.NoError(t, err)
+
+		for i := 0; i < 3; i++ {
+			res, err := c.IntrospectToken(ctx, cachedToken)
+			require.NoError(t, err)
+			assert.True(t, res.Active)
+		}
+
+		assert.Equal(t, keycloakclient.IntrospectionCacheStats{Hits: 2, Misses: 1, Size: 1}, c.Stats())
+	})
+
+	t.Run("ttl is bounded by token exp", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		introspector := keycloakclientmocks.NewMockintrospector(ctrl)
+		introspector.EXPECT().IntrospectToken(ctx, cachedToken).
+			Return(&keycloakclient.IntrospectTokenResult{Active: true, Exp: int(time.Now().Unix())}, nil).Times(2)
+
+		c, err := keycloakclient.NewIntrospectionCache(keycloakclient.NewIntrospectionCacheOptions(introspector))
+		require.NoError(t, err)
+
+		for i := 0; i < 2; i++ {
+			_, err := c.IntrospectToken(ctx, cachedToken)
+			require.NoError(t, err)
+		}
+	})
+
+	t.Run("max ttl", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		introspector := keycloakclientmocks.NewMockintrospector(ctrl)
+		introspector.EXPECT().IntrospectToken(ctx, cachedToken).
+			Return(&keycloakclient.IntrospectTokenResult{Active: true, Exp: exp}, nil).Times(2)
+
+		c, err := keycloakclient.NewIntrospectionCache(keycloakclient.NewIntrospectionCacheOptions(introspector,
+			keycloakclient.WithMaxTTL(50*time.Millisecond)))
+		require.NoError(t, err)
+
+		_, err = c.IntrospectToken(ctx, cachedToken)
+		require.NoError(t, err)
+
+		time.Sleep(100 * time.Millisecond)
+
+		_, err = c.IntrospectToken(ctx, cachedToken)
+		require.NoError(t, err)
+	})
+
+	t.Run("negative cache", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		introspector := keycloakclientmocks.NewMockintrospector(ctrl)
+		introspector.EXPECT().IntrospectToken(ctx, cachedToken).
+			Return(&keycloakclient.IntrospectTokenResult{Active: false}, nil).Times(1)
+
+		c, err := keycloakclient.NewIntrospectionCache(keycloakclient.NewIntrospectionCacheOptions(introspector))
+		require.NoError(t, err)
+
+		for i := 0; i < 2; i++ {
+			res, err := c.IntrospectToken(ctx, cachedToken)
+			require.NoError(t, err)
+			assert.False(t, res.Active)
+		}
+	})
+
+	t.Run("errors are not cached", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		introspector := keycloakclientmocks.NewMockintrospector(ctrl)
+		errUnexpected := errors.New("unexpected")
+		gomock.InOrder(
+			introspector.EXPECT().IntrospectToken(ctx, cachedToken).Return(nil, errUnexpected),
+			introspector.EXPECT().IntrospectToken(ctx, cachedToken).
+				Return(&keycloakclient.IntrospectTokenResult{Active: true, Exp: exp}, nil),
+		)
+
+		c, err := keycloakclient.NewIntrospectionCache(keycloakclient.NewIntrospectionCacheOptions(introspector))
+		require.NoError(t, err)
+
+		_, err = c.IntrospectToken(ctx, cachedToken)
+		require.ErrorIs(t, err, errUnexpected)
+
+		res, err := c.IntrospectToken(ctx, cachedToken)
+		require.NoError(t, err)
+		assert.True(t, res.Active)
+	})
+
+	t.Run("concurrent introspections are collapsed", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		introspector := keycloakclientmocks.NewMockintrospector(ctrl)
+
+		release := make(chan struct{})
+		introspector.EXPECT().IntrospectToken(ctx, cachedToken).
+			DoAndReturn(func(_ context.Context, _ string) (*keycloakclient.IntrospectTokenResult, error) {
+				<-release
+				return &keycloakclient.IntrospectTokenResult{Active: true, Exp: exp}, nil
+			}).Times(1)
+
+		c, err := keycloakclient.NewIntrospectionCache(keycloakclient.NewIntrospectionCacheOptions(introspector))
+		require.NoError(t, err)
+
+		const callers = 10
+
+		var wg sync.WaitGroup
+		wg.Add(callers)
+		for i := 0; i < callers; i++ {
+			go func() {
+				defer wg.Done()
+
+				res, err := c.IntrospectToken(ctx, cachedToken)
+				assert.NoError(t, err)
+				assert.True(t, res.Active)
+			}()
+		}
+
+		// Let all callers to join the single flight.
+		assert.Eventually(t, func() bool { return c.Stats().Misses == callers }, time.Second, time.Millisecond)
+		close(release)
+		wg.Wait()
+	})
+}
+
+func TestIntrospectionCache_Run(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	introspector := keycloakclientmocks.NewMockintrospector(ctrl)
+	introspector.EXPECT().IntrospectToken(ctx, cachedToken).
+		Return(&keycloakclient.IntrospectTokenResult{Active: false}, nil)
+
+	c, err := keycloakclient.NewIntrospectionCache(keycloakclient.NewIntrospectionCacheOptions(introspector,
+		keycloakclient.WithNegativeTTL(10*time.Millisecond),
+		keycloakclient.WithCleanupPeriod(10*time.Millisecond),
+	))
+	require.NoError(t, err)
+
+	_, err = c.IntrospectToken(ctx, cachedToken)
+	require.NoError(t, err)
+	require.Equal(t, 1, c.Stats().Size)
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- c.Run(ctx) }()
+
+	assert.Eventually(t, func() bool { return c.Stats().Size == 0 }, time.Second, 5*time.Millisecond)
+
+	cancel()
+	require.NoError(t, <-errCh)
+}
@@ -0,0 +1,152 @@
+package keycloakclient
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+	"golang.org/x/sync/singleflight"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/certs_getter_mock.gen.go -package=keycloakclientmocks certsGetter
+
+var ErrKeyNotFound = errors.New("key not found")
+
+type certsGetter interface {
+	GetCerts(ctx context.Context) (*JWKS, error)
+}
+
+//go:generate options-gen -out-filename=key_set_options.gen.go -from-struct=KeySetOptions
+type KeySetOptions struct {
+	client certsGetter `option:"mandatory" validate:"required"`
+
+	// ttl is the max time the fetched keys are used without refreshing.
+	ttl time.Duration `default:"1h" validate:"min=1m,max=24h"`
+
+	// minRefreshInterval prevents hammering keycloak with tokens signed by unknown keys.
+	minRefreshInterval time.Duration `default:"10s" validate:"min=0,max=1h"`
+}
+
+// KeySet caches the realm signing keys. The keys are refreshed
+// on TTL expiration or when a token is signed by an unknown key.
+// Concurrent refreshes are collapsed into a single request, the cached keys are available meanwhile.
+type KeySet struct {
+	KeySetOptions
+
+	lg *zap.Logger
+
+	group singleflight.Group
+
+	mu          sync.RWMutex
+	keys        map[string]crypto.PublicKey
+	fetchedAt   time.Time
+	refreshedAt time.Time
+}
+
+func NewKeySet(opts KeySetOptions) (*KeySet, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate options: %v", err)
+	}
+
+	return &KeySet{
+		KeySetOptions: opts,
+		lg:            zap.L().Named("keycloak-key-set"),
+		keys:          map[string]crypto.PublicKey{},
+	}, nil
+}
+
+// PublicKey returns the realm public key by its ID.
+func (ks *KeySet) PublicKey(ctx context.Context, kid string) (crypto.PublicKey, error) {
+	k, ok, fetchedAt, refreshedAt := ks.lookup(kid)
+
+	if time.Since(fetchedAt) > ks.ttl {
+		if err := ks.refresh(ctx, refreshedAt); err != nil {
+			return nil, err
+		}
+		k, ok, _, refreshedAt = ks.lookup(kid)
+	}
+
+	if ok {
+		return k, nil
+	}
+
+	// The realm keys might be rotated.
+	if time.Since(refreshedAt) >= ks.minRefreshInterval {
+		if err := ks.refresh(ctx, refreshedAt); err != nil {
+			return nil, err
+		}
+
+		if k, ok, _, _ = ks.lookup(kid); ok {
+			return k, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%w: kid=%q", ErrKeyNotFound, kid)
+}
+
+func (ks *KeySet) lookup(kid string) (k crypto.PublicKey, ok bool, fetchedAt, refreshedAt time.Time) {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	k, ok = ks.keys[kid]
+	return k, ok, ks.fetchedAt, ks.refreshedAt
+}
+
+// refresh collapses the concurrent fetches of the keys.
+// NOTE: The context of the first caller is used for the collapsed request.
+func (ks *KeySet) refresh(ctx context.Context, seen time.Time) error {
+	_, err, _ := ks.group.Do("certs", func() (any, error) {
+		return nil, ks.fetch(ctx, seen)
+	})
+
+	return err
+}
+
+// fetch gets the keys unless they were refreshed after the seen time, e.g. by the just finished flight.
+func (ks *KeySet) fetch(ctx context.Context, seen time.Time) error {
+	ks.mu.RLock()
+	refreshed := !ks.refreshedAt.Equal(seen)
+	ks.mu.RUnlock()
+
+	if refreshed {
+		return nil
+	}
+
+	now := time.Now()
+
+	jwks, err := ks.client.GetCerts(ctx)
+	if err != nil {
+		ks.mu.Lock()
+		ks.refreshedAt = now
+		ks.mu.Unlock()
+
+		return fmt.Errorf("get certs, err=%v", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(jwks.Keys))
+	for _, k := range jwks.Keys {
+		if !k.IsSigningKey() {
+			continue
+		}
+
+		pk, err := k.RSAPublicKey()
+		if err != nil {
+			ks.lg.Warn("Skip realm key", zap.String("kid", k.Kid), zap.Error(err))
+			continue
+		}
+		keys[k.Kid] = pk
+	}
+
+	ks.mu.Lock()
+	defer ks.mu.Unlock()
+
+	ks.keys = keys
+	ks.fetchedAt = now
+	ks.refreshedAt = now
+
+	return nil
+}
@@ -0,0 +1,71 @@
+// Code generated by options-gen. DO NOT EDIT.
+package keycloakclient
+
+import (
+	fmt461e464ebed9 "fmt"
+	"time"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptKeySetOptionsSetter func(o *KeySetOptions)
+
+func NewKeySetOptions(
+	client certsGetter,
+	options ...OptKeySetOptionsSetter,
+) KeySetOptions {
+	o := KeySetOptions{}
+
+	// Setting defaults from field tag (if present)
+	o.ttl, _ = time.ParseDuration("1h")
+	o.minRefreshInterval, _ = time.ParseDuration("10s")
+
+	o.client = client
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func WithTtl(opt time.Duration) OptKeySetOptionsSetter {
+	return func(o *KeySetOptions) {
+		o.ttl = opt
+	}
+}
+
+func WithMinRefreshInterval(opt time.Duration) OptKeySetOptionsSetter {
+	return func(o *KeySetOptions) {
+		o.minRefreshInterval = opt
+	}
+}
+
+func (o *KeySetOptions) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("client", _validate_KeySetOptions_client(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("ttl", _validate_KeySetOptions_ttl(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("minRefreshInterval", _validate_KeySetOptions_minRefreshInterval(o)))
+	return errs.AsError()
+}
+
+func _validate_KeySetOptions_client(o *KeySetOptions) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.client, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `client` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_KeySetOptions_ttl(o *KeySetOptions) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.ttl, "min=1m,max=24h"); err != nil {
+		return fmt461e464ebed9.Errorf("field `ttl` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_KeySetOptions_minRefreshInterval(o *KeySetOptions) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.minRefreshInterval, "min=0,max=1h"); err != nil {
+		return fmt461e464ebed9.Errorf("field `minRefreshInterval` did not pass the test: %w", err)
+	}
+	return nil
+}
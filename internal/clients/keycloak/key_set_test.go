@@ -0,0 +1,155 @@
+package keycloakclient_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"errors"
+	"math/big"
+	"sync"
+	"testing"
+	"time"
+
+	keycloakclient "github.com/karasunokami/chat-service/internal/clients/keycloak"
+	keycloakclientmocks "github.com/karasunokami/chat-service/internal/clients/keycloak/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeySet_PublicKey(t *testing.T) {
+	ctx := context.Background()
+
+	key1, jwk1 := newJWK(t, "kid-1")
+	key2, jwk2 := newJWK(t, "kid-2")
+	encKey := keycloakclient.JWK{Kid: "kid-enc", Kty: "RSA", Use: "enc", N: jwk1.N, E: jwk1.E}
+
+	t.Run("keys are cached", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		certs := keycloakclientmocks.NewMockcertsGetter(ctrl)
+		certs.EXPECT().GetCerts(ctx).Return(&keycloakclient.JWKS{Keys: []keycloakclient.JWK{jwk1, encKey}}, nil).Times(1)
+
+		ks, err := keycloakclient.NewKeySet(keycloakclient.NewKeySetOptions(certs))
+		require.NoError(t, err)
+
+		for i := 0; i < 3; i++ {
+			pk, err := ks.PublicKey(ctx, "kid-1")
+			require.NoError(t, err)
+			assert.True(t, key1.PublicKey.Equal(pk))
+		}
+	})
+
+	t.Run("refresh on unknown kid", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		certs := keycloakclientmocks.NewMockcertsGetter(ctrl)
+		gomock.InOrder(
+			certs.EXPECT().GetCerts(ctx).Return(&keycloakclient.JWKS{Keys: []keycloakclient.JWK{jwk1}}, nil),
+			certs.EXPECT().GetCerts(ctx).Return(&keycloakclient.JWKS{Keys: []keycloakclient.JWK{jwk1, jwk2}}, nil),
+		)
+
+		ks, err := keycloakclient.NewKeySet(keycloakclient.NewKeySetOptions(certs,
+			keycloakclient.WithMinRefreshInterval(0)))
+		require.NoError(t, err)
+
+		_, err = ks.PublicKey(ctx, "kid-1")
+		require.NoError(t, err)
+
+		pk, err := ks.PublicKey(ctx, "kid-2")
+		require.NoError(t, err)
+		assert.True(t, key2.PublicKey.Equal(pk))
+	})
+
+	t.Run("refresh is rate limited", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		certs := keycloakclientmocks.NewMockcertsGetter(ctrl)
+		certs.EXPECT().GetCerts(ctx).Return(&keycloakclient.JWKS{Keys: []keycloakclient.JWK{jwk1}}, nil).Times(1)
+
+		ks, err := keycloakclient.NewKeySet(keycloakclient.NewKeySetOptions(certs,
+			keycloakclient.WithMinRefreshInterval(time.Hour)))
+		require.NoError(t, err)
+
+		for i := 0; i < 3; i++ {
+			_, err = ks.PublicKey(ctx, "unknown")
+			require.ErrorIs(t, err, keycloakclient.ErrKeyNotFound)
+		}
+
+		_, err = ks.PublicKey(ctx, "kid-enc")
+		require.ErrorIs(t, err, keycloakclient.ErrKeyNotFound)
+	})
+
+	t.Run("cached keys are available during refresh", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		certs := keycloakclientmocks.NewMockcertsGetter(ctrl)
+
+		fetching, release := make(chan struct{}), make(chan struct{})
+		gomock.InOrder(
+			certs.EXPECT().GetCerts(ctx).Return(&keycloakclient.JWKS{Keys: []keycloakclient.JWK{jwk1}}, nil),
+			certs.EXPECT().GetCerts(ctx).DoAndReturn(func(context.Context) (*keycloakclient.JWKS, error) {
+				close(fetching)
+				<-release
+				return &keycloakclient.JWKS{Keys: []keycloakclient.JWK{jwk1, jwk2}}, nil
+			}),
+		)
+
+		ks, err := keycloakclient.NewKeySet(keycloakclient.NewKeySetOptions(certs,
+			keycloakclient.WithMinRefreshInterval(0)))
+		require.NoError(t, err)
+
+		_, err = ks.PublicKey(ctx, "kid-1")
+		require.NoError(t, err)
+
+		const waiters = 5
+		var wg sync.WaitGroup
+		wg.Add(waiters)
+		for i := 0; i < waiters; i++ {
+			go func() {
+				defer wg.Done()
+
+				pk, err := ks.PublicKey(ctx, "kid-2")
+				assert.NoError(t, err)
+				assert.True(t, key2.PublicKey.Equal(pk))
+			}()
+		}
+		<-fetching
+
+		// The slow refresh does not block the known keys.
+		pk, err := ks.PublicKey(ctx, "kid-1")
+		require.NoError(t, err)
+		assert.True(t, key1.PublicKey.Equal(pk))
+
+		// All the waiters get the keys of the single refresh.
+		close(release)
+		wg.Wait()
+	})
+
+	t.Run("certs error", func(t *testing.T) {
+		ctrl := gomock.NewController(t)
+		certs := keycloakclientmocks.NewMockcertsGetter(ctrl)
+		certs.EXPECT().GetCerts(ctx).Return(nil, errors.New("unexpected"))
+
+		ks, err := keycloakclient.NewKeySet(keycloakclient.NewKeySetOptions(certs))
+		require.NoError(t, err)
+
+		_, err = ks.PublicKey(ctx, "kid-1")
+		require.Error(t, err)
+		require.NotErrorIs(t, err, keycloakclient.ErrKeyNotFound)
+	})
+}
+
+func newJWK(t *testing.T, kid string) (*rsa.PrivateKey, keycloakclient.JWK) {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	return key, keycloakclient.JWK{
+		Kid: kid,
+		Kty: "RSA",
+		Alg: "RS256",
+		Use: "sig",
+		N:   base64.RawURLEncoding.EncodeToString(key.PublicKey.N.Bytes()),
+		E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.PublicKey.E)).Bytes()),
+	}
+}
@@ -0,0 +1,24 @@
+package keycloakclient
+
+import (
+	"github.com/karasunokami/chat-service/internal/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	introspectionCacheRequestsCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "keycloak_introspection_cache",
+		Name:      "requests_total",
+		Help:      "Number of token introspections by the cache result.",
+	}, []string{"result"})
+
+	introspectionCacheSizeGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "keycloak_introspection_cache",
+		Name:      "size",
+		Help:      "Number of cached introspection results.",
+	})
+)
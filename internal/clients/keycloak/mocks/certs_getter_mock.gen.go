@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: key_set.go
+
+// Package keycloakclientmocks is a generated GoMock package.
+package keycloakclientmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	keycloakclient "github.com/karasunokami/chat-service/internal/clients/keycloak"
+)
+
+// MockcertsGetter is a mock of certsGetter interface.
+type MockcertsGetter struct {
+	ctrl     *gomock.Controller
+	recorder *MockcertsGetterMockRecorder
+}
+
+// MockcertsGetterMockRecorder is the mock recorder for MockcertsGetter.
+type MockcertsGetterMockRecorder struct {
+	mock *MockcertsGetter
+}
+
+// NewMockcertsGetter creates a new mock instance.
+func NewMockcertsGetter(ctrl *gomock.Controller) *MockcertsGetter {
+	mock := &MockcertsGetter{ctrl: ctrl}
+	mock.recorder = &MockcertsGetterMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcertsGetter) EXPECT() *MockcertsGetterMockRecorder {
+	return m.recorder
+}
+
+// GetCerts mocks base method.
+func (m *MockcertsGetter) GetCerts(ctx context.Context) (*keycloakclient.JWKS, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCerts", ctx)
+	ret0, _ := ret[0].(*keycloakclient.JWKS)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCerts indicates an expected call of GetCerts.
+func (mr *MockcertsGetterMockRecorder) GetCerts(ctx interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCerts", reflect.TypeOf((*MockcertsGetter)(nil).GetCerts), ctx)
+}
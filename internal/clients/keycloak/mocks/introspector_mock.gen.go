@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: introspection_cache.go
+
+// Package keycloakclientmocks is a generated GoMock package.
+package keycloakclientmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	keycloakclient "github.com/karasunokami/chat-service/internal/clients/keycloak"
+)
+
+// Mockintrospector is a mock of introspector interface.
+type Mockintrospector struct {
+	ctrl     *gomock.Controller
+	recorder *MockintrospectorMockRecorder
+}
+
+// MockintrospectorMockRecorder is the mock recorder for Mockintrospector.
+type MockintrospectorMockRecorder struct {
+	mock *Mockintrospector
+}
+
+// NewMockintrospector creates a new mock instance.
+func NewMockintrospector(ctrl *gomock.Controller) *Mockintrospector {
+	mock := &Mockintrospector{ctrl: ctrl}
+	mock.recorder = &MockintrospectorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mockintrospector) EXPECT() *MockintrospectorMockRecorder {
+	return m.recorder
+}
+
+// IntrospectToken mocks base method.
+func (m *Mockintrospector) IntrospectToken(ctx context.Context, token string) (*keycloakclient.IntrospectTokenResult, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IntrospectToken", ctx, token)
+	ret0, _ := ret[0].(*keycloakclient.IntrospectTokenResult)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IntrospectToken indicates an expected call of IntrospectToken.
+func (mr *MockintrospectorMockRecorder) IntrospectToken(ctx, token interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IntrospectToken", reflect.TypeOf((*Mockintrospector)(nil).IntrospectToken), ctx, token)
+}
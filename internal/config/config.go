@@ -7,6 +7,8 @@ type Config struct {
 	Log      LogConfig      `toml:"log"`
 	Servers  ServersConfig  `toml:"servers"`
 	Sentry   SentryConfig   `toml:"sentry"`
+	Tracing  TracingConfig  `toml:"tracing"`
+	Bus      BusConfig      `toml:"bus"`
 	Clients  ClientsConfig  `toml:"clients"`
 	Services ServicesConfig `toml:"services"`
 }
@@ -29,6 +31,23 @@ type ServersConfig struct {
 	Debug   DebugServerConfig   `toml:"debug"`
 	Client  ClientServerConfig  `toml:"client"`
 	Manager ManagerServerConfig `toml:"manager"`
+	Auth    AuthConfig          `toml:"auth"`
+}
+
+const (
+	AuthModeActive  = "active"
+	AuthModePassive = "passive"
+)
+
+type AuthConfig struct {
+	Mode                    string `toml:"mode" validate:"required,oneof=active passive"`
+	FallbackToIntrospection bool   `toml:"fallback_to_introspection"`
+	// SessionRevalidationPeriod is the period of websocket sessions tokens introspection. Zero disables it.
+	SessionRevalidationPeriod time.Duration `toml:"session_revalidation_period" validate:"omitempty,min=1s,max=1h"`
+}
+
+func (c AuthConfig) IsPassive() bool {
+	return c.Mode == AuthModePassive
 }
 
 type DebugServerConfig struct {
@@ -40,6 +59,7 @@ type ClientServerConfig struct {
 	AllowOrigins   []string             `toml:"allow_origins" validate:"required"`
 	RequiredAccess RequiredAccessConfig `toml:"required_access" validate:"required"`
 	SecWsProtocol  string               `toml:"sec_ws_protocol" validate:"required"`
+	RateLimit      RateLimitConfig      `toml:"rate_limit"`
 }
 
 type ManagerServerConfig struct {
@@ -47,28 +67,85 @@ type ManagerServerConfig struct {
 	AllowOrigins   []string             `toml:"allow_origins" validate:"required"`
 	RequiredAccess RequiredAccessConfig `toml:"required_access" validate:"required"`
 	SecWsProtocol  string               `toml:"sec_ws_protocol" validate:"required"`
+	RateLimit      RateLimitConfig      `toml:"rate_limit"`
+}
+
+type RateLimitConfig struct {
+	Enabled bool `toml:"enabled"`
+	// Default is the per-user limit of each operation.
+	Default RateLimit `toml:"default"`
+	// Operations overrides the default limit by the swagger operation ID, e.g. PostSendMessage.
+	Operations              map[string]RateLimit `toml:"operations" validate:"dive"`
+	MaxWsConnectionsPerUser int                  `toml:"max_ws_connections_per_user" validate:"min=0,max=1000"`
+}
+
+// WsConnectionsLimit returns zero (no limit) if the rate limiting is disabled.
+func (c RateLimitConfig) WsConnectionsLimit() int {
+	if !c.Enabled {
+		return 0
+	}
+	return c.MaxWsConnectionsPerUser
+}
+
+type RateLimit struct {
+	RPS   float64 `toml:"rps" validate:"omitempty,gt=0"`
+	Burst int     `toml:"burst" validate:"omitempty,min=1"`
 }
 
 type RequiredAccessConfig struct {
 	Resource string `toml:"resource" validate:"required"`
 	Role     string `toml:"role" validate:"required"`
+	// SupervisorRole grants the access to all open chats. Used by the manager server only, empty disables it.
+	SupervisorRole string `toml:"supervisor_role"`
 }
 
 type SentryConfig struct {
 	Dsn string `toml:"dsn" validate:"required,unix_addr"`
 }
 
+type TracingConfig struct {
+	Exporter     string  `toml:"exporter" validate:"required,oneof=none stdout otlp"`
+	OTLPEndpoint string  `toml:"otlp_endpoint" validate:"required_if=Exporter otlp,omitempty,hostname_port"`
+	OTLPInsecure bool    `toml:"otlp_insecure"`
+	SampleRatio  float64 `toml:"sample_ratio" validate:"min=0,max=1"`
+}
+
+const (
+	BusKindKafka  = "kafka"
+	BusKindMemory = "memory"
+)
+
+type BusConfig struct {
+	// Kind is the message bus implementation, the in-memory one runs within the process and needs no broker.
+	Kind                string `toml:"kind" validate:"omitempty,oneof=kafka memory"`
+	MemoryTopicCapacity int    `toml:"memory_topic_capacity" validate:"required_if=Kind memory,omitempty,min=1"`
+}
+
+// IsKafka reports whether the services need the Kafka brokers, Kafka is the default bus.
+func (c *BusConfig) IsKafka() bool {
+	return c.Kind == "" || c.Kind == BusKindKafka
+}
+
 type ClientsConfig struct {
 	KeycloakClient KeycloakClientConfig `toml:"keycloak" validate:"required"`
 	PSQLClient     PSQLClientConfig     `toml:"psql" validate:"required"`
 }
 
 type KeycloakClientConfig struct {
-	BasePath     string `toml:"base_path" validate:"required,uri"`
-	Realm        string `toml:"realm" validate:"required"`
-	ClientID     string `toml:"client_id" validate:"required"`
-	ClientSecret string `toml:"client_secret" validate:"required"`
-	DebugMode    bool   `toml:"debug_mode"`
+	BasePath     string        `toml:"base_path" validate:"required,uri"`
+	Realm        string        `toml:"realm" validate:"required"`
+	ClientID     string        `toml:"client_id" validate:"required"`
+	ClientSecret string        `toml:"client_secret" validate:"required"`
+	DebugMode    bool          `toml:"debug_mode"`
+	JWKSCacheTTL time.Duration `toml:"jwks_cache_ttl" validate:"min=1m,max=24h"`
+
+	IntrospectionCache IntrospectionCacheConfig `toml:"introspection_cache"`
+}
+
+type IntrospectionCacheConfig struct {
+	Enabled     bool          `toml:"enabled"`
+	MaxTTL      time.Duration `toml:"max_ttl" validate:"required_if=Enabled true,omitempty,min=1s,max=1h"`
+	NegativeTTL time.Duration `toml:"negative_ttl" validate:"min=0,max=1m"`
 }
 
 type PSQLClientConfig struct {
@@ -85,6 +162,11 @@ type ServicesConfig struct {
 	ManagerLoad            ManagerLoadServiceConfig          `toml:"manager_load" validate:"required"`
 	AfcVerdictsProcessor   AfcVerdictsProcessorServiceConfig `toml:"afc_verdicts_processor" validate:"required"`
 	ManagerScheduler       ManagerSchedulerConfig            `toml:"manager_scheduler" validate:"required"`
+	ManagerPresence        ManagerPresenceConfig             `toml:"manager_presence" validate:"required"`
+	MessageReview          MessageReviewConfig               `toml:"message_review"`
+	AfcWatchdog            AfcWatchdogConfig                 `toml:"afc_watchdog" validate:"required"`
+	AfcLocal               AfcLocalConfig                    `toml:"afc_local"`
+	AfcManagerMessages     AfcManagerMessagesConfig          `toml:"afc_manager_messages"`
 }
 
 type MessageProducerServiceConfig struct {
@@ -92,12 +174,23 @@ type MessageProducerServiceConfig struct {
 	Topic      string   `toml:"topic" validate:"required"`
 	BatchSize  int      `toml:"batch_size" validate:"required,gte=1,lte=100"`
 	EncryptKey string   `toml:"encrypt_key"`
+
+	// EncryptKeys is the keyring of the hex encoded keys by their IDs, it takes precedence over EncryptKey.
+	EncryptKeys        map[string]string `toml:"encrypt_keys" validate:"dive,hexadecimal"`
+	EncryptActiveKeyID string            `toml:"encrypt_active_key_id" validate:"required_with=EncryptKeys"`
 }
 
 type OutboxServiceConfig struct {
 	Workers    int           `toml:"workers" validate:"required,gte=1,lte=100"`
 	IdleTime   time.Duration `toml:"idle_time" validate:"required"`
 	ReserveFor time.Duration `toml:"reserve_for" validate:"required"`
+
+	// DrainTimeout is the grace period of the in-flight jobs on shutdown, zero cancels them right away.
+	DrainTimeout time.Duration `toml:"drain_timeout" validate:"min=0,max=5m"`
+
+	// PropagateTraceContext wraps the job payloads with the trace context.
+	// The replicas without the unwrapping fail such jobs, so it is enabled after the rollout.
+	PropagateTraceContext bool `toml:"propagate_trace_context"`
 }
 
 type ManagerLoadServiceConfig struct {
@@ -105,14 +198,72 @@ type ManagerLoadServiceConfig struct {
 }
 
 type AfcVerdictsProcessorServiceConfig struct {
-	Brokers                  []string `toml:"brokers" validate:"required"`
+	Brokers                  []string `toml:"brokers" validate:"dive,hostname_port"`
 	ConsumersCount           int      `toml:"consumers_count" validate:"required,gte=1,lte=100"`
 	ConsumersGroupName       string   `toml:"consumers_group_name" validate:"required"`
 	VerdictsTopicName        string   `toml:"verdicts_topic_name" validate:"required"`
 	VerdictsDqlTopicName     string   `toml:"verdicts_dql_topic_name" validate:"required"`
 	VerdictsSigningPublicKey string   `toml:"verdicts_signing_public_key"`
+
+	// VerdictsJWKSSource is the JWKS file path or URL with the signing keys selected by the verdict kid header.
+	VerdictsJWKSSource       string        `toml:"verdicts_jwks_source"`
+	VerdictsJWKSReloadPeriod time.Duration `toml:"verdicts_jwks_reload_period" validate:"required_with=VerdictsJWKSSource"`
+	VerdictsJWKSFetchTimeout time.Duration `toml:"verdicts_jwks_fetch_timeout" validate:"required_with=VerdictsJWKSSource"`
+
+	// ProcessBatchSize is the max number of verdicts applied in a single transaction.
+	ProcessBatchSize int `toml:"process_batch_size" validate:"required,gte=1,lte=1000"`
+	// ProcessBatchMaxWait is the max time to wait for the batch to fill up.
+	ProcessBatchMaxWait time.Duration `toml:"process_batch_max_wait" validate:"required"`
 }
 
 type ManagerSchedulerConfig struct {
 	Period time.Duration `toml:"period" validate:"required"`
 }
+
+type ManagerPresenceConfig struct {
+	// OfflineTimeout is the time after which the problems of the offline manager are flagged for reassignment.
+	OfflineTimeout time.Duration `toml:"offline_timeout" validate:"required"`
+	CheckPeriod    time.Duration `toml:"check_period" validate:"required"`
+}
+
+type MessageReviewConfig struct {
+	// Enabled puts the suspicious messages into the manual review queue instead of blocking them.
+	Enabled bool `toml:"enabled"`
+	// Timeout is the time after which the unreviewed message gets the default decision.
+	Timeout         time.Duration `toml:"timeout" validate:"required_if=Enabled true"`
+	DefaultDecision string        `toml:"default_decision" validate:"required_if=Enabled true,omitempty,oneof=approve reject"`
+	CheckPeriod     time.Duration `toml:"check_period" validate:"required_if=Enabled true"`
+}
+
+type AfcWatchdogConfig struct {
+	// VerdictTimeout is the time after which the message without the AFC verdict is resent to AFC.
+	VerdictTimeout time.Duration `toml:"verdict_timeout" validate:"required"`
+	// MaxResends is the number of resends after which the fallback policy is applied.
+	MaxResends     int           `toml:"max_resends" validate:"gte=0,lte=100"`
+	FallbackPolicy string        `toml:"fallback_policy" validate:"required,oneof=deliver block"`
+	CheckPeriod    time.Duration `toml:"check_period" validate:"required"`
+}
+
+type AfcLocalConfig struct {
+	// Enabled checks the produced client messages within the process, the external AFC should be disabled then.
+	Enabled            bool   `toml:"enabled"`
+	ConsumersGroupName string `toml:"consumers_group_name" validate:"required_if=Enabled true"`
+
+	// Patterns are the regular expressions of the forbidden content, e.g. card numbers.
+	Patterns     []string `toml:"patterns"`
+	BlockedWords []string `toml:"blocked_words"`
+	// BlockLinks makes the messages with links suspicious, except the links to AllowedLinkDomains and their subdomains.
+	BlockLinks         bool     `toml:"block_links"`
+	AllowedLinkDomains []string `toml:"allowed_link_domains"`
+	// RateLimit is the max number of client messages within RatePeriod, zero disables the rule.
+	// It is counted per chat, which is the same as per client since the client has the only chat.
+	RateLimit  int           `toml:"rate_limit" validate:"gte=0,lte=10000"`
+	RatePeriod time.Duration `toml:"rate_period" validate:"required_if=Enabled true"`
+}
+
+type AfcManagerMessagesConfig struct {
+	// Enabled holds the manager messages invisible for the client until the AFC verdict.
+	// It requires the external AFC over the kafka bus, the local one checks the client messages only.
+	// The held messages without the verdict are released or blocked by the afc watchdog like the client ones.
+	Enabled bool `toml:"enabled"`
+}
@@ -1,6 +1,7 @@
 package config
 
 import (
+	"errors"
 	"fmt"
 	"os"
 
@@ -27,5 +28,30 @@ func ParseAndValidate(filename string) (Config, error) {
 		return Config{}, fmt.Errorf("validate, err=%v", err)
 	}
 
+	err = validateBrokers(cfg)
+	if err != nil {
+		return Config{}, fmt.Errorf("validate, err=%v", err)
+	}
+
 	return cfg, nil
 }
+
+var errNoBrokers = errors.New("brokers are required with the kafka bus")
+
+// validateBrokers requires the brokers of the bus clients if the bus kind is kafka.
+// The validate tags cannot refer to the fields of another section.
+func validateBrokers(cfg Config) error {
+	if !cfg.Bus.IsKafka() {
+		return nil
+	}
+
+	if len(cfg.Services.MessageProducerService.Brokers) == 0 {
+		return fmt.Errorf("services.msg_producer, err=%w", errNoBrokers)
+	}
+
+	if len(cfg.Services.AfcVerdictsProcessor.Brokers) == 0 {
+		return fmt.Errorf("services.afc_verdicts_processor, err=%w", errNoBrokers)
+	}
+
+	return nil
+}
@@ -1,9 +1,12 @@
 package config_test
 
 import (
+	"os"
 	"path/filepath"
 	"runtime"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/karasunokami/chat-service/internal/config"
 
@@ -11,11 +14,13 @@ import (
 	"github.com/stretchr/testify/require"
 )
 
-var configExamplePath string
+var configExamplePath, configE2EMemoryBusPath string
 
 func init() {
 	_, currentFile, _, _ := runtime.Caller(0)
-	configExamplePath = filepath.Join(filepath.Dir(currentFile), "..", "..", "configs", "config.example.toml")
+	configsDir := filepath.Join(filepath.Dir(currentFile), "..", "..", "configs")
+	configExamplePath = filepath.Join(configsDir, "config.example.toml")
+	configE2EMemoryBusPath = filepath.Join(configsDir, "config.e2e-memory-bus.toml")
 }
 
 func TestParseAndValidate(t *testing.T) {
@@ -23,3 +28,112 @@ func TestParseAndValidate(t *testing.T) {
 	require.NoError(t, err)
 	assert.NotEmpty(t, cfg.Log.Level)
 }
+
+func TestParseAndValidate_E2EMemoryBus(t *testing.T) {
+	cfg, err := config.ParseAndValidate(configE2EMemoryBusPath)
+	require.NoError(t, err)
+	assert.False(t, cfg.Bus.IsKafka())
+	assert.True(t, cfg.Services.AfcLocal.Enabled)
+}
+
+func TestParseAndValidate_OutboxDrainTimeout(t *testing.T) {
+	for _, tc := range []struct {
+		value   string
+		want    time.Duration
+		wantErr bool
+	}{
+		{value: "0s", want: 0},
+		{value: "5m", want: 5 * time.Minute},
+		{value: "6m", wantErr: true},
+	} {
+		t.Run(tc.value, func(t *testing.T) {
+			path := writeExampleConfig(t, `drain_timeout = "10s"`, `drain_timeout = "`+tc.value+`"`)
+
+			cfg, err := config.ParseAndValidate(path)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+			assert.Equal(t, tc.want, cfg.Services.OutboxService.DrainTimeout)
+		})
+	}
+}
+
+func TestParseAndValidate_Brokers(t *testing.T) {
+	const brokers = `brokers = ["localhost:9092"]`
+
+	for _, tc := range []struct {
+		name    string
+		oldnew  []string
+		wantErr bool
+	}{
+		{name: "kafka bus", oldnew: []string{brokers, `brokers = []`}, wantErr: true},
+		{name: "default bus", oldnew: []string{brokers, `brokers = []`, `kind = "kafka"`, ``}, wantErr: true},
+		{name: "memory bus", oldnew: []string{brokers, `brokers = []`, `kind = "kafka"`, `kind = "memory"`}},
+		{name: "invalid broker", oldnew: []string{brokers, `brokers = ["localhost"]`}, wantErr: true},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			path := writeExampleConfig(t, tc.oldnew...)
+
+			_, err := config.ParseAndValidate(path)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestParseAndValidate_MessageReview(t *testing.T) {
+	const section = `enabled = false # Suspicious messages are blocked right away if disabled.
+timeout = "1h"
+default_decision = "reject" # approve or reject.
+check_period = "10s"`
+
+	for _, tc := range []struct {
+		name    string
+		section string
+		wantErr bool
+	}{
+		{name: "disabled without settings", section: `enabled = false`},
+		{name: "enabled without settings", section: `enabled = true`, wantErr: true},
+		{
+			name:    "enabled with invalid decision",
+			section: `enabled = true` + "\n" + `timeout = "1h"` + "\n" + `default_decision = "postpone"` + "\n" + `check_period = "10s"`,
+			wantErr: true,
+		},
+		{
+			name:    "enabled",
+			section: `enabled = true` + "\n" + `timeout = "1h"` + "\n" + `default_decision = "approve"` + "\n" + `check_period = "10s"`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			path := writeExampleConfig(t, section, tc.section)
+
+			_, err := config.ParseAndValidate(path)
+			if tc.wantErr {
+				require.Error(t, err)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+// writeExampleConfig writes the example config with the replaced old, new string pairs to the temp dir.
+func writeExampleConfig(t *testing.T, oldnew ...string) string {
+	t.Helper()
+
+	data, err := os.ReadFile(configExamplePath)
+	require.NoError(t, err)
+	for i := 0; i < len(oldnew); i += 2 {
+		require.Contains(t, string(data), oldnew[i])
+	}
+
+	path := filepath.Join(t.TempDir(), "config.toml")
+	require.NoError(t, os.WriteFile(path, []byte(strings.NewReplacer(oldnew...).Replace(string(data))), 0o600))
+
+	return path
+}
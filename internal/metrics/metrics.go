@@ -0,0 +1,15 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+)
+
+// Namespace is a common prefix for all service metrics.
+const Namespace = "chat_service"
+
+// Handler exposes metrics from the default registry.
+func Handler() http.Handler {
+	return promhttp.Handler()
+}
@@ -18,6 +18,7 @@ type claims struct {
 	jwt.StandardClaims
 	Audience        keycloakclient.StringsSliceFromStringOrSlice `json:"aud,omitempty"`
 	Subject         types.UserID                                 `json:"sub,omitempty"`
+	AuthorizedParty string                                       `json:"azp,omitempty"`
 	ResourcesAccess resourceAccess                               `json:"resource_access"`
 	// Exp field is copy of claims ExpiresAt int64 field
 	// it must be copied after parsing jwt to be accessible from handlers
@@ -54,6 +55,25 @@ func (c claims) ExpiresAtUnix() int64 {
 	return c.Exp
 }
 
+func (c claims) HasResourceRole(resource, role string) bool {
+	return c.ResourcesAccess.HasResourceRole(resource, role)
+}
+
+// IsIssuedFor returns true if the token is issued for the resource
+// either as the authorized party or as one of the audiences.
+func (c claims) IsIssuedFor(resource string) bool {
+	if c.AuthorizedParty == resource {
+		return true
+	}
+
+	for _, aud := range c.Audience {
+		if aud == resource {
+			return true
+		}
+	}
+	return false
+}
+
 type resourceAccess map[string]struct {
 	Roles []string `json:"roles"`
 }
@@ -71,3 +91,13 @@ func (ra resourceAccess) HasResourceRole(resource, role string) bool {
 	}
 	return false
 }
+
+// HasAnyResourceRole returns true if the resource has at least one of the roles.
+func (ra resourceAccess) HasAnyResourceRole(resource string, roles []string) bool {
+	for _, role := range roles {
+		if ra.HasResourceRole(resource, role) {
+			return true
+		}
+	}
+	return false
+}
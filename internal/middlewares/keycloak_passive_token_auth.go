@@ -0,0 +1,83 @@
+package middlewares
+
+import (
+	"context"
+	"crypto"
+	"errors"
+	"fmt"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/labstack/echo/v4"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/key_set_mock.gen.go -package=middlewaresmocks KeySet
+
+var (
+	ErrTokenIsNotIssuedForResource = errors.New("token is not issued for resource")
+	ErrNoKeyID                     = errors.New("no key id in token header")
+
+	errKeyUnavailable = errors.New("key unavailable")
+)
+
+// signingMethods are the algorithms of the Keycloak realm RSA keys.
+var signingMethods = []string{
+	jwt.SigningMethodRS256.Alg(),
+	jwt.SigningMethodRS384.Alg(),
+	jwt.SigningMethodRS512.Alg(),
+}
+
+type KeySet interface {
+	PublicKey(ctx context.Context, kid string) (crypto.PublicKey, error)
+}
+
+// NewKeyCloakPassiveTokenAuth returns a middleware that implements "passive" authentication:
+// the token signature and claims are verified locally by the realm public keys.
+// If the keys are unavailable and fallback is not nil, the token is introspected by the Keycloak server.
+func NewKeyCloakPassiveTokenAuth(keySet KeySet, fallback Introspector, resource string, roles ...string) echo.MiddlewareFunc {
+	return NewTokenAuth(NewKeyCloakPassiveTokenVerifier(keySet, fallback, resource, roles...))
+}
+
+// NewKeyCloakPassiveTokenVerifier returns the verifier of the "passive" authentication.
+func NewKeyCloakPassiveTokenVerifier(keySet KeySet, fallback Introspector, resource string, roles ...string) TokenVerifier {
+	return func(ctx context.Context, tokenStr string) (*jwt.Token, error) {
+		token, err := verifyLocally(ctx, keySet, tokenStr, resource, roles)
+		if err != nil && errors.Is(err, errKeyUnavailable) && fallback != nil {
+			return introspect(ctx, fallback, tokenStr, resource, roles)
+		}
+		return token, err
+	}
+}
+
+func verifyLocally(ctx context.Context, keySet KeySet, tokenStr, resource string, roles []string) (*jwt.Token, error) {
+	cl := claims{}
+
+	token, err := jwt.NewParser(
+		jwt.WithValidMethods(signingMethods),
+		// Claims are validated below with the rest of checks.
+		jwt.WithoutClaimsValidation(),
+	).ParseWithClaims(tokenStr, &cl, func(t *jwt.Token) (any, error) {
+		kid, _ := t.Header["kid"].(string)
+		if kid == "" {
+			return nil, ErrNoKeyID
+		}
+
+		k, err := keySet.PublicKey(ctx, kid)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %w", errKeyUnavailable, err)
+		}
+		return k, nil
+	})
+	if err != nil {
+		return nil, fmt.Errorf("jwt parse with claims, err=%w", err)
+	}
+
+	if err := validateClaims(&cl, resource, roles); err != nil {
+		return nil, err
+	}
+
+	if !cl.IsIssuedFor(resource) {
+		return nil, ErrTokenIsNotIssuedForResource
+	}
+
+	return token, nil
+}
@@ -0,0 +1,248 @@
+package middlewares_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	keycloakclient "github.com/karasunokami/chat-service/internal/clients/keycloak"
+	"github.com/karasunokami/chat-service/internal/middlewares"
+	middlewaresmocks "github.com/karasunokami/chat-service/internal/middlewares/mocks"
+	"github.com/karasunokami/chat-service/internal/types"
+
+	"github.com/golang-jwt/jwt/v4"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	passiveKeyID  = "passive-kid"
+	passiveUserID = "5cb40dc0-a249-4783-a301-9e1f3cf3ea41"
+)
+
+func TestNewKeyCloakPassiveTokenAuth(t *testing.T) {
+	suite.Run(t, new(KeycloakPassiveTokenAuthSuite))
+}
+
+type KeycloakPassiveTokenAuthSuite struct {
+	suite.Suite
+	ctrl         *gomock.Controller
+	keySet       *middlewaresmocks.MockKeySet
+	introspector *middlewaresmocks.MockIntrospector
+	key          *rsa.PrivateKey
+	req          *http.Request
+	ctx          echo.Context
+}
+
+func (s *KeycloakPassiveTokenAuthSuite) SetupSuite() {
+	var err error
+	s.key, err = rsa.GenerateKey(rand.Reader, 2048)
+	s.Require().NoError(err)
+}
+
+func (s *KeycloakPassiveTokenAuthSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.keySet = middlewaresmocks.NewMockKeySet(s.ctrl)
+	s.introspector = middlewaresmocks.NewMockIntrospector(s.ctrl)
+
+	s.req = httptest.NewRequest(http.MethodPost, "/getHistory", nil)
+	s.ctx = echo.New().NewContext(s.req, httptest.NewRecorder())
+}
+
+func (s *KeycloakPassiveTokenAuthSuite) TearDownTest() {
+	s.ctrl.Finish()
+}
+
+// Positive.
+
+func (s *KeycloakPassiveTokenAuthSuite) TestValidToken() {
+	token := s.signToken(s.key, s.validClaims())
+	s.req.Header.Add(echo.HeaderAuthorization, "Bearer "+token)
+
+	s.keySet.EXPECT().PublicKey(s.req.Context(), passiveKeyID).Return(&s.key.PublicKey, nil)
+
+	var uid types.UserID
+	err := s.middleware(nil)(func(c echo.Context) error {
+		uid = middlewares.MustUserID(c)
+		return nil
+	})(s.ctx)
+	s.Require().NoError(err)
+	s.Equal(passiveUserID, uid.String())
+}
+
+func (s *KeycloakPassiveTokenAuthSuite) TestValidToken_Audience() {
+	cl := s.validClaims()
+	cl["azp"] = "another-client"
+	cl["aud"] = []string{"account", requiredResource}
+
+	token := s.signToken(s.key, cl)
+	s.req.Header.Add("Sec-WebSocket-Protocol", "chat-service-protocol, "+token)
+
+	s.keySet.EXPECT().PublicKey(s.req.Context(), passiveKeyID).Return(&s.key.PublicKey, nil)
+
+	err := s.middleware(nil)(func(c echo.Context) error { return nil })(s.ctx)
+	s.Require().NoError(err)
+}
+
+func (s *KeycloakPassiveTokenAuthSuite) TestFallbackToIntrospection() {
+	token := s.signToken(s.key, s.validClaims())
+	s.req.Header.Add(echo.HeaderAuthorization, "Bearer "+token)
+
+	s.keySet.EXPECT().PublicKey(s.req.Context(), passiveKeyID).Return(nil, keycloakclient.ErrKeyNotFound)
+	s.introspector.EXPECT().IntrospectToken(s.req.Context(), token).
+		Return(&keycloakclient.IntrospectTokenResult{Active: true}, nil)
+
+	err := s.middleware(s.introspector)(func(c echo.Context) error { return nil })(s.ctx)
+	s.Require().NoError(err)
+}
+
+func (s *KeycloakPassiveTokenAuthSuite) TestVerifyUserToken() {
+	exp := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	cl := s.validClaims()
+	cl["exp"] = exp.Unix()
+	token := s.signToken(s.key, cl)
+
+	ctx := context.Background()
+	s.keySet.EXPECT().PublicKey(ctx, passiveKeyID).Return(&s.key.PublicKey, nil)
+
+	verifier := middlewares.NewKeyCloakPassiveTokenVerifier(s.keySet, nil, requiredResource, requiredRole)
+	uid, tokenExp, err := verifier.VerifyUserToken(ctx, token)
+	s.Require().NoError(err)
+	s.Equal(passiveUserID, uid.String())
+	s.True(exp.Equal(tokenExp))
+}
+
+// Negative.
+
+func (s *KeycloakPassiveTokenAuthSuite) TestKeyUnavailable_NoFallback() {
+	token := s.signToken(s.key, s.validClaims())
+	s.req.Header.Add(echo.HeaderAuthorization, "Bearer "+token)
+
+	s.keySet.EXPECT().PublicKey(s.req.Context(), passiveKeyID).Return(nil, keycloakclient.ErrKeyNotFound)
+
+	err := s.middleware(nil)(s.unreachable)(s.ctx)
+	s.assertHTTPCode(err, http.StatusUnauthorized)
+	s.ErrorIs(err, keycloakclient.ErrKeyNotFound)
+}
+
+func (s *KeycloakPassiveTokenAuthSuite) TestInvalidSignature_NoFallback() {
+	anotherKey, err := rsa.GenerateKey(rand.Reader, 2048)
+	s.Require().NoError(err)
+
+	token := s.signToken(anotherKey, s.validClaims())
+	s.req.Header.Add(echo.HeaderAuthorization, "Bearer "+token)
+
+	s.keySet.EXPECT().PublicKey(s.req.Context(), passiveKeyID).Return(&s.key.PublicKey, nil)
+
+	err = s.middleware(s.introspector)(s.unreachable)(s.ctx)
+	s.assertHTTPCode(err, http.StatusUnauthorized)
+
+	var jwtErr *jwt.ValidationError
+	s.Require().ErrorAs(err, &jwtErr)
+	s.NotZero(jwtErr.Errors & jwt.ValidationErrorSignatureInvalid)
+}
+
+func (s *KeycloakPassiveTokenAuthSuite) TestUnexpectedSigningMethod() {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodHS256, s.validClaims()).SignedString([]byte("secret"))
+	s.Require().NoError(err)
+	s.req.Header.Add(echo.HeaderAuthorization, "Bearer "+token)
+
+	err = s.middleware(nil)(s.unreachable)(s.ctx)
+	s.assertHTTPCode(err, http.StatusUnauthorized)
+}
+
+func (s *KeycloakPassiveTokenAuthSuite) TestNoKeyID() {
+	token, err := jwt.NewWithClaims(jwt.SigningMethodRS256, s.validClaims()).SignedString(s.key)
+	s.Require().NoError(err)
+	s.req.Header.Add(echo.HeaderAuthorization, "Bearer "+token)
+
+	err = s.middleware(s.introspector)(s.unreachable)(s.ctx)
+	s.assertHTTPCode(err, http.StatusUnauthorized)
+	s.ErrorIs(err, middlewares.ErrNoKeyID)
+}
+
+func (s *KeycloakPassiveTokenAuthSuite) TestExpiredToken() {
+	cl := s.validClaims()
+	cl["exp"] = time.Now().Add(-time.Minute).Unix()
+
+	token := s.signToken(s.key, cl)
+	s.req.Header.Add(echo.HeaderAuthorization, "Bearer "+token)
+
+	s.keySet.EXPECT().PublicKey(s.req.Context(), passiveKeyID).Return(&s.key.PublicKey, nil)
+
+	err := s.middleware(nil)(s.unreachable)(s.ctx)
+	s.assertHTTPCode(err, http.StatusUnauthorized)
+}
+
+func (s *KeycloakPassiveTokenAuthSuite) TestNotIssuedForResource() {
+	cl := s.validClaims()
+	cl["azp"] = "another-client"
+
+	token := s.signToken(s.key, cl)
+	s.req.Header.Add(echo.HeaderAuthorization, "Bearer "+token)
+
+	s.keySet.EXPECT().PublicKey(s.req.Context(), passiveKeyID).Return(&s.key.PublicKey, nil)
+
+	err := s.middleware(nil)(s.unreachable)(s.ctx)
+	s.assertHTTPCode(err, http.StatusUnauthorized)
+	s.ErrorIs(err, middlewares.ErrTokenIsNotIssuedForResource)
+}
+
+func (s *KeycloakPassiveTokenAuthSuite) TestNoRequiredRole() {
+	cl := s.validClaims()
+	cl["resource_access"] = map[string]any{
+		requiredResource: map[string]any{"roles": []string{"another-role"}},
+	}
+
+	token := s.signToken(s.key, cl)
+	s.req.Header.Add(echo.HeaderAuthorization, "Bearer "+token)
+
+	s.keySet.EXPECT().PublicKey(s.req.Context(), passiveKeyID).Return(&s.key.PublicKey, nil)
+
+	err := s.middleware(nil)(s.unreachable)(s.ctx)
+	s.assertHTTPCode(err, http.StatusUnauthorized)
+	s.ErrorIs(err, middlewares.ErrNoRequiredResourceRole)
+}
+
+func (s *KeycloakPassiveTokenAuthSuite) middleware(fallback middlewares.Introspector) echo.MiddlewareFunc {
+	return middlewares.NewKeyCloakPassiveTokenAuth(s.keySet, fallback, requiredResource, requiredRole)
+}
+
+func (s *KeycloakPassiveTokenAuthSuite) validClaims() jwt.MapClaims {
+	return jwt.MapClaims{
+		"exp": time.Now().Add(time.Hour).Unix(),
+		"iat": time.Now().Add(-time.Minute).Unix(),
+		"sub": passiveUserID,
+		"aud": "account",
+		"azp": requiredResource,
+		"resource_access": map[string]any{
+			requiredResource: map[string]any{"roles": []string{requiredRole}},
+		},
+	}
+}
+
+func (s *KeycloakPassiveTokenAuthSuite) signToken(key *rsa.PrivateKey, cl jwt.MapClaims) string {
+	t := jwt.NewWithClaims(jwt.SigningMethodRS256, cl)
+	t.Header["kid"] = passiveKeyID
+
+	token, err := t.SignedString(key)
+	s.Require().NoError(err)
+	return token
+}
+
+func (s *KeycloakPassiveTokenAuthSuite) unreachable(_ echo.Context) error {
+	s.Fail("unreachable")
+	return nil
+}
+
+func (s *KeycloakPassiveTokenAuthSuite) assertHTTPCode(err error, code int) {
+	var httpErr *echo.HTTPError
+	s.Require().ErrorAs(err, &httpErr)
+	s.Equal(code, httpErr.Code)
+}
@@ -28,49 +28,98 @@ type Introspector interface {
 	IntrospectToken(ctx context.Context, token string) (*keycloakclient.IntrospectTokenResult, error)
 }
 
+// TokenVerifier verifies the token the same way as the authentication middleware.
+type TokenVerifier func(ctx context.Context, tokenStr string) (*jwt.Token, error)
+
 // NewKeyCloakTokenAuth returns a middleware that implements "active" authentication:
 // each request is verified by the Keycloak server.
-func NewKeyCloakTokenAuth(introspector Introspector, resource, role string) echo.MiddlewareFunc {
+// The token must have at least one of the roles of the resource.
+func NewKeyCloakTokenAuth(introspector Introspector, resource string, roles ...string) echo.MiddlewareFunc {
+	return NewTokenAuth(NewKeyCloakTokenVerifier(introspector, resource, roles...))
+}
+
+// NewKeyCloakTokenVerifier returns the verifier of the "active" authentication.
+func NewKeyCloakTokenVerifier(introspector Introspector, resource string, roles ...string) TokenVerifier {
+	return func(ctx context.Context, tokenStr string) (*jwt.Token, error) {
+		return introspect(ctx, introspector, tokenStr, resource, roles)
+	}
+}
+
+func NewTokenAuth(verify TokenVerifier) echo.MiddlewareFunc {
 	return middleware.KeyAuthWithConfig(middleware.KeyAuthConfig{
 		KeyLookup:  "header:Authorization,header:Sec-WebSocket-Protocol",
 		AuthScheme: "Bearer",
 		Validator: func(tokenStr string, eCtx echo.Context) (bool, error) {
-			extractedTokenStr := extractToken(tokenStr)
-
-			res, err := introspector.IntrospectToken(eCtx.Request().Context(), extractedTokenStr)
+			token, err := verify(eCtx.Request().Context(), extractToken(tokenStr))
 			if err != nil {
-				return false, fmt.Errorf("introspect token, err=%w", err)
+				return false, err
 			}
 
-			if !res.Active {
-				return false, ErrTokenIsNotActive
-			}
+			eCtx.Set(tokenCtxKey, token)
 
-			cl := claims{}
+			return true, nil
+		},
+	})
+}
 
-			token, _, err := jwt.NewParser().ParseUnverified(extractedTokenStr, &cl)
-			if err != nil {
-				return false, fmt.Errorf("jwt parse with claims, err=%v", err)
-			}
+// VerifyUserToken returns the owner and the expiration time of the valid token.
+func (v TokenVerifier) VerifyUserToken(ctx context.Context, tokenStr string) (types.UserID, time.Time, error) {
+	token, err := v(ctx, tokenStr)
+	if err != nil {
+		return types.UserIDNil, time.Time{}, err
+	}
 
-			err = cl.Valid()
-			if err != nil {
-				return false, fmt.Errorf("validate claims, err=%w", err)
-			}
+	uid, ok := tokenUserID(token)
+	if !ok {
+		return types.UserIDNil, time.Time{}, errors.New("no user id in token")
+	}
 
-			if !cl.ResourcesAccess.HasResourceRole(resource, role) {
-				return false, ErrNoRequiredResourceRole
-			}
+	exp, ok := tokenExpiresAt(token)
+	if !ok {
+		return types.UserIDNil, time.Time{}, errors.New("no exp in token")
+	}
 
-			// Copy standard exp field to custom exp claims field to prevent
-			// overriding by json decode
-			cl.Exp = cl.ExpiresAt
+	return uid, time.Unix(exp, 0), nil
+}
 
-			eCtx.Set(tokenCtxKey, token)
+func introspect(ctx context.Context, introspector Introspector, tokenStr, resource string, roles []string) (*jwt.Token, error) {
+	res, err := introspector.IntrospectToken(ctx, tokenStr)
+	if err != nil {
+		return nil, fmt.Errorf("introspect token, err=%w", err)
+	}
 
-			return true, nil
-		},
-	})
+	if !res.Active {
+		return nil, ErrTokenIsNotActive
+	}
+
+	cl := claims{}
+
+	token, _, err := jwt.NewParser().ParseUnverified(tokenStr, &cl)
+	if err != nil {
+		return nil, fmt.Errorf("jwt parse with claims, err=%v", err)
+	}
+
+	if err := validateClaims(&cl, resource, roles); err != nil {
+		return nil, err
+	}
+
+	return token, nil
+}
+
+func validateClaims(cl *claims, resource string, roles []string) error {
+	if err := cl.Valid(); err != nil {
+		return fmt.Errorf("validate claims, err=%w", err)
+	}
+
+	if !cl.ResourcesAccess.HasAnyResourceRole(resource, roles) {
+		return ErrNoRequiredResourceRole
+	}
+
+	// Copy standard exp field to custom exp claims field to prevent
+	// overriding by json decode
+	cl.Exp = cl.ExpiresAt
+
+	return nil
 }
 
 func MustUserID(eCtx echo.Context) types.UserID {
@@ -90,6 +139,33 @@ func MustExpiresAt(eCtx echo.Context) time.Time {
 	return time.Unix(exp, 0)
 }
 
+// HasResourceRole reports whether the token of the request has the role of the resource,
+// e.g. to grant access to a part of the API to the users with the additional role.
+func HasResourceRole(eCtx echo.Context, resource, role string) bool {
+	tt, ok := extractTokenFromContext(eCtx)
+	if !ok {
+		return false
+	}
+
+	rolesProvider, ok := tt.Claims.(interface {
+		HasResourceRole(resource, role string) bool
+	})
+	if !ok {
+		return false
+	}
+	return rolesProvider.HasResourceRole(resource, role)
+}
+
+// RawToken returns the encoded token of the request, e.g. to revalidate a long-living session.
+func RawToken(eCtx echo.Context) (string, bool) {
+	tt, ok := extractTokenFromContext(eCtx)
+	if !ok || tt.Raw == "" {
+		return "", false
+	}
+
+	return tt.Raw, true
+}
+
 func extractToken(tokenStr string) string {
 	parts := strings.Split(tokenStr, ", ")
 
@@ -106,6 +182,10 @@ func userID(eCtx echo.Context) (types.UserID, bool) {
 		return types.UserIDNil, false
 	}
 
+	return tokenUserID(tt)
+}
+
+func tokenUserID(tt *jwt.Token) (types.UserID, bool) {
 	userIDProvider, ok := tt.Claims.(interface{ UserID() types.UserID })
 	if !ok {
 		return types.UserIDNil, false
@@ -119,6 +199,10 @@ func expiresAt(eCtx echo.Context) (int64, bool) {
 		return 0, false
 	}
 
+	return tokenExpiresAt(tt)
+}
+
+func tokenExpiresAt(tt *jwt.Token) (int64, bool) {
 	if expProvider, ok := tt.Claims.(interface{ ExpiresAt() int64 }); ok {
 		return expProvider.ExpiresAt(), true
 	}
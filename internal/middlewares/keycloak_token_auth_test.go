@@ -106,6 +106,26 @@ func (s *KeycloakTokenAuthSuite) TestValidToken_Ws() {
 	s.Equal("5cb40dc0-a249-4783-a301-9e1f3cf3ea41", uid.String())
 }
 
+func (s *KeycloakTokenAuthSuite) TestValidToken_AnyOfRoles() {
+	const token = "eyJhbGciOiJSUzI1NiIsInR5cCIgOiAiSldUIiwia2lkIiA6ICJIR1lJcHN1UXlsZFNJZTB1T0JaeEpuQjBkZlFuTWI5LUlFcmx6NHk5ek9BIn0.eyJleHAiOjI2NjcxOTk1ODAsImlhdCI6MTY2NzE5OTI4MCwiYXV0aF90aW1lIjoxNjY3MTk4OTI4LCJqdGkiOiI5NGQ3ZDBkNS0zZTZmLTQ5NGItYTkzYy1hYjliMDkxMzQ3YmEiLCJpc3MiOiJodHRwOi8vbG9jYWxob3N0OjMwMTAvcmVhbG1zL0JhbmsiLCJhdWQiOiJhY2NvdW50Iiwic3ViIjoiNWNiNDBkYzAtYTI0OS00NzgzLWEzMDEtOWUxZjNjZjNlYTQxIiwidHlwIjoiQmVhcmVyIiwiYXpwIjoiY2hhdC11aS1jbGllbnQiLCJub25jZSI6ImJhMzdmZDVhLThjMzktNDgxNC1hZmNiLTk1MmExOGI3MjY3ZCIsInNlc3Npb25fc3RhdGUiOiJkODZkMTk4ZS1jMWM1LTRlZGQtODM1MC0zNjFlZTU4MTcxZjIiLCJhY3IiOiIwIiwiYWxsb3dlZC1vcmlnaW5zIjpbIiIsIioiXSwicmVhbG1fYWNjZXNzIjp7InJvbGVzIjpbIm9mZmxpbmVfYWNjZXNzIiwiZGVmYXVsdC1yb2xlcy1iYW5rIiwidW1hX2F1dGhvcml6YXRpb24iXX0sInJlc291cmNlX2FjY2VzcyI6eyJjaGF0LXVpLWNsaWVudCI6eyJyb2xlcyI6WyJzdXBwb3J0LWNoYXQtY2xpZW50Il19LCJhY2NvdW50Ijp7InJvbGVzIjpbIm1hbmFnZS1hY2NvdW50IiwibWFuYWdlLWFjY291bnQtbGlua3MiLCJ2aWV3LXByb2ZpbGUiXX19LCJzY29wZSI6Im9wZW5pZCBwcm9maWxlIGVtYWlsIiwic2lkIjoiZDg2ZDE5OGUtYzFjNS00ZWRkLTgzNTAtMzYxZWU1ODE3MWYyIiwiZW1haWxfdmVyaWZpZWQiOnRydWUsInByZWZlcnJlZF91c2VybmFtZSI6ImJvbmQwMDciLCJnaXZlbl9uYW1lIjoiIiwiZmFtaWx5X25hbWUiOiIiLCJlbWFpbCI6ImJvbmQwMDdAdWsuY29tIn0.we-dont-check-signature" //nolint:lll
+	s.req.Header.Add(echo.HeaderAuthorization, "Bearer "+token)
+
+	s.introspector.EXPECT().IntrospectToken(s.req.Context(), token).Return(&keycloakclient.IntrospectTokenResult{Active: true}, nil)
+
+	authMdlwr := middlewares.NewKeyCloakTokenAuth(s.introspector, requiredResource, "support-chat-supervisor", requiredRole)
+
+	var hasRequiredRole, hasSupervisorRole bool
+
+	err := authMdlwr(func(c echo.Context) error {
+		hasRequiredRole = middlewares.HasResourceRole(c, requiredResource, requiredRole)
+		hasSupervisorRole = middlewares.HasResourceRole(c, requiredResource, "support-chat-supervisor")
+		return nil
+	})(s.ctx)
+	s.Require().NoError(err)
+	s.True(hasRequiredRole)
+	s.False(hasSupervisorRole)
+}
+
 // Negative.
 
 func (s *KeycloakTokenAuthSuite) TestNoAuthorizationHeader() {
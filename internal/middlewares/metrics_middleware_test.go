@@ -0,0 +1,43 @@
+package middlewares_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/karasunokami/chat-service/internal/metrics"
+	"github.com/karasunokami/chat-service/internal/middlewares"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewMetricsMiddleware(t *testing.T) {
+	swagger := &openapi3.T{Paths: openapi3.Paths{
+		"/sendMessage": &openapi3.PathItem{Post: &openapi3.Operation{}},
+		"/getHistory":  &openapi3.PathItem{Post: &openapi3.Operation{OperationID: "history"}},
+	}}
+
+	e := echo.New()
+	g := e.Group("v1", middlewares.NewMetricsMiddleware("metrics-test", swagger))
+	g.POST("/sendMessage", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+	g.POST("/getHistory", func(c echo.Context) error { return echo.NewHTTPError(http.StatusBadRequest) })
+	g.POST("/unknown", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+
+	for _, path := range []string{"/v1/sendMessage", "/v1/sendMessage", "/v1/getHistory", "/v1/unknown"} {
+		e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, path, nil))
+	}
+
+	resp := httptest.NewRecorder()
+	metrics.Handler().ServeHTTP(resp, httptest.NewRequest(http.MethodGet, "/metrics", nil))
+	require.Equal(t, http.StatusOK, resp.Code)
+
+	body := resp.Body.String()
+	assert.Contains(t, body,
+		`chat_service_http_request_duration_seconds_count{operation_id="PostSendMessage",server="metrics-test",status="200"} 2`)
+	assert.Contains(t, body,
+		`chat_service_http_request_duration_seconds_count{operation_id="history",server="metrics-test",status="400"} 1`)
+	assert.NotContains(t, body, `operation_id="PostUnknown"`)
+}
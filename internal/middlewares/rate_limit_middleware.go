@@ -0,0 +1,52 @@
+package middlewares
+
+import (
+	"errors"
+	"net/http"
+
+	internalerrors "github.com/karasunokami/chat-service/internal/errors"
+	"github.com/karasunokami/chat-service/internal/metrics"
+	"github.com/karasunokami/chat-service/internal/types"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/labstack/echo/v4"
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/rate_limiter_mock.gen.go -package=middlewaresmocks RateLimiter
+
+var ErrRateLimitExceeded = errors.New("rate limit exceeded")
+
+var rateLimitedRequestsCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+	Namespace: metrics.Namespace,
+	Subsystem: "http",
+	Name:      "rate_limited_requests_total",
+	Help:      "Number of requests rejected by the rate limiter.",
+}, []string{"server", "operation_id"})
+
+type RateLimiter interface {
+	Allow(userID types.UserID, operation string) bool
+}
+
+// NewRateLimitMiddleware limits the rate of the user requests to handlers described in the swagger.
+// The middleware must be used after the authentication.
+func NewRateLimitMiddleware(server string, swagger *openapi3.T, limiter RateLimiter) echo.MiddlewareFunc {
+	operations := swaggerOperations(swagger)
+
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			opID, ok := findOperationID(operations, c.Request().Method, c.Path())
+			if !ok {
+				return next(c)
+			}
+
+			if !limiter.Allow(MustUserID(c), opID) {
+				rateLimitedRequestsCounter.WithLabelValues(server, opID).Inc()
+				return internalerrors.NewServerError(http.StatusTooManyRequests, "too many requests", ErrRateLimitExceeded)
+			}
+
+			return next(c)
+		}
+	}
+}
@@ -0,0 +1,60 @@
+package middlewares_test
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	internalerrors "github.com/karasunokami/chat-service/internal/errors"
+	"github.com/karasunokami/chat-service/internal/middlewares"
+	middlewaresmocks "github.com/karasunokami/chat-service/internal/middlewares/mocks"
+	"github.com/karasunokami/chat-service/internal/types"
+
+	"github.com/getkin/kin-openapi/openapi3"
+	"github.com/golang/mock/gomock"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewRateLimitMiddleware(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	limiter := middlewaresmocks.NewMockRateLimiter(ctrl)
+
+	swagger := &openapi3.T{Paths: openapi3.Paths{
+		"/sendMessage": &openapi3.PathItem{Post: &openapi3.Operation{}},
+	}}
+
+	uid := types.NewUserID()
+
+	var handlerErr error
+	e := echo.New()
+	e.HTTPErrorHandler = func(err error, _ echo.Context) { handlerErr = err }
+
+	g := e.Group("v1", middlewares.AuthWith(uid), middlewares.NewRateLimitMiddleware("rate-limit-test", swagger, limiter))
+	g.POST("/sendMessage", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+	g.POST("/unknown", func(c echo.Context) error { return c.NoContent(http.StatusOK) })
+
+	t.Run("allowed", func(t *testing.T) {
+		limiter.EXPECT().Allow(uid, "PostSendMessage").Return(true)
+
+		resp := httptest.NewRecorder()
+		e.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, "/v1/sendMessage", nil))
+		assert.Equal(t, http.StatusOK, resp.Code)
+	})
+
+	t.Run("limited", func(t *testing.T) {
+		limiter.EXPECT().Allow(uid, "PostSendMessage").Return(false)
+
+		handlerErr = nil
+		e.ServeHTTP(httptest.NewRecorder(), httptest.NewRequest(http.MethodPost, "/v1/sendMessage", nil))
+		require.ErrorIs(t, handlerErr, middlewares.ErrRateLimitExceeded)
+		assert.Equal(t, http.StatusTooManyRequests, internalerrors.GetServerErrorCode(handlerErr))
+	})
+
+	t.Run("unknown operation is not limited", func(t *testing.T) {
+		resp := httptest.NewRecorder()
+		e.ServeHTTP(resp, httptest.NewRequest(http.MethodPost, "/v1/unknown", nil))
+		assert.Equal(t, http.StatusOK, resp.Code)
+	})
+}
@@ -0,0 +1,34 @@
+package middlewares
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	oapimdlwr "github.com/deepmap/oapi-codegen/pkg/middleware"
+	"github.com/getkin/kin-openapi/openapi3filter"
+)
+
+var ErrUnknownSecurityScheme = errors.New("unknown security scheme")
+
+// NewResourceRoleAuthenticationFunc returns the OpenAPI request validator authentication function.
+// The token authenticated by the auth middleware must have the resource role mapped from the operation security scheme.
+func NewResourceRoleAuthenticationFunc(resource string, schemeRoles map[string]string) openapi3filter.AuthenticationFunc {
+	return func(ctx context.Context, input *openapi3filter.AuthenticationInput) error {
+		role, ok := schemeRoles[input.SecuritySchemeName]
+		if !ok || role == "" {
+			return fmt.Errorf("%w: %q", ErrUnknownSecurityScheme, input.SecuritySchemeName)
+		}
+
+		eCtx := oapimdlwr.GetEchoContext(ctx)
+		if eCtx == nil {
+			return errors.New("no echo context in request context")
+		}
+
+		if !HasResourceRole(eCtx, resource, role) {
+			return ErrNoRequiredResourceRole
+		}
+
+		return nil
+	}
+}
@@ -0,0 +1,57 @@
+package middlewares_test
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/karasunokami/chat-service/internal/middlewares"
+	"github.com/karasunokami/chat-service/internal/types"
+
+	oapimdlwr "github.com/deepmap/oapi-codegen/pkg/middleware"
+	"github.com/getkin/kin-openapi/openapi3filter"
+	"github.com/labstack/echo/v4"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestNewResourceRoleAuthenticationFunc(t *testing.T) {
+	const (
+		managerRole    = "support-chat-manager"
+		supervisorRole = "support-chat-supervisor"
+	)
+
+	authenticate := middlewares.NewResourceRoleAuthenticationFunc(requiredResource, map[string]string{
+		"manager":    managerRole,
+		"supervisor": supervisorRole,
+	})
+
+	newCtx := func(roles ...string) context.Context {
+		eCtx := echo.New().NewContext(httptest.NewRequest(http.MethodPost, "/", nil), httptest.NewRecorder())
+		middlewares.SetTokenWithRoles(eCtx, types.NewUserID(), requiredResource, roles...)
+		return context.WithValue(context.Background(), oapimdlwr.EchoContextKey, eCtx) //nolint:staticcheck
+	}
+
+	input := func(scheme string) *openapi3filter.AuthenticationInput {
+		return &openapi3filter.AuthenticationInput{SecuritySchemeName: scheme}
+	}
+
+	t.Run("role of scheme", func(t *testing.T) {
+		assert.NoError(t, authenticate(newCtx(managerRole), input("manager")))
+		assert.NoError(t, authenticate(newCtx(managerRole, supervisorRole), input("supervisor")))
+	})
+
+	t.Run("no role of scheme", func(t *testing.T) {
+		err := authenticate(newCtx(managerRole), input("supervisor"))
+		require.ErrorIs(t, err, middlewares.ErrNoRequiredResourceRole)
+
+		err = authenticate(newCtx(supervisorRole), input("manager"))
+		require.ErrorIs(t, err, middlewares.ErrNoRequiredResourceRole)
+	})
+
+	t.Run("unknown scheme", func(t *testing.T) {
+		err := authenticate(newCtx(managerRole), input("admin"))
+		require.ErrorIs(t, err, middlewares.ErrUnknownSecurityScheme)
+	})
+}
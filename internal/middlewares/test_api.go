@@ -17,6 +17,38 @@ func AuthWithExp(uid types.UserID, exp int64) echo.MiddlewareFunc {
 	return authWith(uid, exp)
 }
 
+func AuthWithRawToken(uid types.UserID, raw string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			c.Set(tokenCtxKey, &jwt.Token{
+				Raw:    raw,
+				Claims: &claimsMock{uid: uid, exp: time.Now().Add(time.Hour).Unix()},
+				Valid:  true,
+			})
+
+			return next(c)
+		}
+	}
+}
+
+// AuthWithRoles sets the token with the roles of the resource.
+func AuthWithRoles(uid types.UserID, resource string, roles ...string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			SetTokenWithRoles(c, uid, resource, roles...)
+
+			return next(c)
+		}
+	}
+}
+
+func SetTokenWithRoles(c echo.Context, uid types.UserID, resource string, roles ...string) {
+	c.Set(tokenCtxKey, &jwt.Token{
+		Claims: &claimsMock{uid: uid, exp: time.Now().Add(time.Hour).Unix(), resource: resource, roles: roles},
+		Valid:  true,
+	})
+}
+
 func SetToken(c echo.Context, uid types.UserID) {
 	c.Set(tokenCtxKey, &jwt.Token{Claims: &claimsMock{uid: uid, exp: time.Now().Add(time.Hour).Unix()}, Valid: true})
 }
@@ -36,8 +68,10 @@ func authWith(uid types.UserID, exp int64) echo.MiddlewareFunc {
 }
 
 type claimsMock struct {
-	uid types.UserID
-	exp int64
+	uid      types.UserID
+	exp      int64
+	resource string
+	roles    []string
 }
 
 func (m *claimsMock) Valid() error {
@@ -51,3 +85,16 @@ func (m *claimsMock) UserID() types.UserID {
 func (m *claimsMock) ExpiresAt() int64 {
 	return m.exp
 }
+
+func (m *claimsMock) HasResourceRole(resource, role string) bool {
+	if resource != m.resource {
+		return false
+	}
+
+	for _, r := range m.roles {
+		if r == role {
+			return true
+		}
+	}
+	return false
+}
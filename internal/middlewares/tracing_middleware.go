@@ -0,0 +1,45 @@
+package middlewares
+
+import (
+	"github.com/karasunokami/chat-service/internal/errors"
+	"github.com/karasunokami/chat-service/internal/tracing"
+
+	"github.com/labstack/echo/v4"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/propagation"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// NewTracingMiddleware starts a server span for every request.
+// The incoming trace context is taken from the request headers.
+func NewTracingMiddleware(server string) echo.MiddlewareFunc {
+	return func(next echo.HandlerFunc) echo.HandlerFunc {
+		return func(c echo.Context) error {
+			req := c.Request()
+
+			ctx := otel.GetTextMapPropagator().Extract(req.Context(), propagation.HeaderCarrier(req.Header))
+			ctx, span := tracing.Start(ctx, server+" "+c.Path(),
+				trace.WithSpanKind(trace.SpanKindServer),
+				trace.WithAttributes(
+					semconv.HTTPMethod(req.Method),
+					semconv.HTTPRoute(c.Path()),
+					attribute.String("http.request_id", req.Header.Get(echo.HeaderXRequestID)),
+				),
+			)
+			c.SetRequest(req.WithContext(ctx))
+
+			err := next(c)
+
+			status := c.Response().Status
+			if err != nil {
+				status = errors.GetServerErrorCode(err)
+			}
+			span.SetAttributes(semconv.HTTPStatusCode(status))
+			tracing.End(span, err)
+
+			return err
+		}
+	}
+}
@@ -0,0 +1,95 @@
+package auditrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/karasunokami/chat-service/internal/store"
+	"github.com/karasunokami/chat-service/internal/store/auditrecord"
+	"github.com/karasunokami/chat-service/internal/store/predicate"
+	"github.com/karasunokami/chat-service/internal/types"
+)
+
+const maxLimit = 1000
+
+var ErrInvalidFilter = errors.New("invalid filter")
+
+// Create appends the record to the audit log.
+// It is written in the transaction of the context, so the record is saved only with the action results.
+func (r *Repo) Create(ctx context.Context, rec Record) error {
+	q := r.db.AuditRecord(ctx).Create().
+		SetAction(auditrecord.Action(rec.Action)).
+		SetRequestID(rec.RequestID)
+
+	if !rec.ManagerID.IsZero() {
+		q.SetManagerID(rec.ManagerID)
+	}
+	if !rec.ChatID.IsZero() {
+		q.SetChatID(rec.ChatID)
+	}
+	if !rec.ProblemID.IsZero() {
+		q.SetProblemID(rec.ProblemID)
+	}
+	if !rec.MessageID.IsZero() {
+		q.SetMessageID(rec.MessageID)
+	}
+
+	if err := q.Exec(ctx); err != nil {
+		return fmt.Errorf("db create audit record, err=%v", err)
+	}
+
+	return nil
+}
+
+// Filter selects records created in [From, To). Zero ManagerID means any manager.
+type Filter struct {
+	ManagerID types.UserID
+	From      time.Time
+	To        time.Time
+	Limit     int
+}
+
+func (f Filter) Validate() error {
+	if f.From.IsZero() || f.To.IsZero() || !f.From.Before(f.To) {
+		return errors.New("from must be before to")
+	}
+
+	if f.Limit <= 0 || f.Limit > maxLimit {
+		return fmt.Errorf("limit must be in [1, %d]", maxLimit)
+	}
+
+	return nil
+}
+
+// Find returns the records ordered by creation time.
+func (r *Repo) Find(ctx context.Context, f Filter) ([]Record, error) {
+	if err := f.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFilter, err)
+	}
+
+	where := []predicate.AuditRecord{
+		auditrecord.CreatedAtGTE(f.From),
+		auditrecord.CreatedAtLT(f.To),
+	}
+	if !f.ManagerID.IsZero() {
+		where = append(where, auditrecord.ManagerIDEQ(f.ManagerID))
+	}
+
+	result, err := r.db.AuditRecord(ctx).Query().
+		Where(where...).
+		Order(store.Asc(auditrecord.FieldCreatedAt), store.Asc(auditrecord.FieldID)).
+		Limit(f.Limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db select audit records, err=%v", err)
+	}
+
+	records := make([]Record, len(result))
+	for i, rec := range result {
+		records[i] = storeRecordToRepoRecord(rec)
+	}
+
+	return records, nil
+}
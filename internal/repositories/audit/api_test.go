@@ -0,0 +1,159 @@
+//go:build integration
+
+package auditrepo_test
+
+import (
+	"testing"
+	"time"
+
+	auditrepo "github.com/karasunokami/chat-service/internal/repositories/audit"
+	"github.com/karasunokami/chat-service/internal/testingh"
+	"github.com/karasunokami/chat-service/internal/types"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type AuditRepoSuite struct {
+	testingh.DBSuite
+	repo *auditrepo.Repo
+}
+
+func TestAuditRepoSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, &AuditRepoSuite{DBSuite: testingh.NewDBSuite("TestAuditRepoSuite")})
+}
+
+func (s *AuditRepoSuite) SetupSuite() {
+	s.DBSuite.SetupSuite()
+
+	var err error
+
+	s.repo, err = auditrepo.New(auditrepo.NewOptions(s.Database))
+	s.Require().NoError(err)
+}
+
+func (s *AuditRepoSuite) SetupTest() {
+	s.DBSuite.SetupTest()
+	s.Database.AuditRecord(s.Ctx).Delete().ExecX(s.Ctx)
+}
+
+func (s *AuditRepoSuite) Test_CreateAndFind() {
+	// Arrange.
+	managerID := types.NewUserID()
+	chatID := types.NewChatID()
+	problemID := types.NewProblemID()
+	reqID := types.NewRequestID()
+	from := time.Now().Add(-time.Minute)
+
+	// Action.
+	err := s.repo.Create(s.Ctx, auditrepo.Record{
+		ManagerID: managerID,
+		Action:    auditrepo.ActionCloseChat,
+		ChatID:    chatID,
+		ProblemID: problemID,
+		RequestID: reqID,
+	})
+	s.Require().NoError(err)
+
+	err = s.repo.Create(s.Ctx, auditrepo.Record{
+		ManagerID: managerID,
+		Action:    auditrepo.ActionFreeHands,
+		RequestID: types.NewRequestID(),
+	})
+	s.Require().NoError(err)
+
+	// Assert.
+	records, err := s.repo.Find(s.Ctx, auditrepo.Filter{
+		ManagerID: managerID,
+		From:      from,
+		To:        time.Now().Add(time.Minute),
+		Limit:     10,
+	})
+	s.Require().NoError(err)
+	s.Require().Len(records, 2)
+
+	s.NotEmpty(records[0].ID)
+	s.Equal(managerID, records[0].ManagerID)
+	s.Equal(auditrepo.ActionCloseChat, records[0].Action)
+	s.Equal(chatID, records[0].ChatID)
+	s.Equal(problemID, records[0].ProblemID)
+	s.Equal(reqID, records[0].RequestID)
+	s.NotZero(records[0].CreatedAt)
+
+	s.Equal(auditrepo.ActionFreeHands, records[1].Action)
+	s.True(records[1].ChatID.IsZero())
+	s.True(records[1].ProblemID.IsZero())
+}
+
+func (s *AuditRepoSuite) Test_Create_SystemAction() {
+	// Arrange.
+	chatID := types.NewChatID()
+	msgID := types.NewMessageID()
+	from := time.Now().Add(-time.Minute)
+
+	// Action.
+	err := s.repo.Create(s.Ctx, auditrepo.Record{
+		Action:    auditrepo.ActionAFCTimeoutDeliver,
+		ChatID:    chatID,
+		MessageID: msgID,
+		RequestID: types.NewRequestID(),
+	})
+	s.Require().NoError(err)
+
+	// Assert.
+	records, err := s.repo.Find(s.Ctx, auditrepo.Filter{From: from, To: time.Now().Add(time.Minute), Limit: 10})
+	s.Require().NoError(err)
+	s.Require().Len(records, 1)
+
+	s.True(records[0].ManagerID.IsZero())
+	s.Equal(auditrepo.ActionAFCTimeoutDeliver, records[0].Action)
+	s.Equal(chatID, records[0].ChatID)
+	s.Equal(msgID, records[0].MessageID)
+}
+
+func (s *AuditRepoSuite) Test_Find_Filter() {
+	// Arrange.
+	managerID := types.NewUserID()
+	anotherManagerID := types.NewUserID()
+
+	for _, id := range []types.UserID{managerID, anotherManagerID, managerID} {
+		err := s.repo.Create(s.Ctx, auditrepo.Record{
+			ManagerID: id,
+			Action:    auditrepo.ActionGetChatHistory,
+			ChatID:    types.NewChatID(),
+			RequestID: types.NewRequestID(),
+		})
+		s.Require().NoError(err)
+	}
+
+	from, to := time.Now().Add(-time.Minute), time.Now().Add(time.Minute)
+
+	s.Run("by manager", func() {
+		records, err := s.repo.Find(s.Ctx, auditrepo.Filter{ManagerID: managerID, From: from, To: to, Limit: 10})
+		s.Require().NoError(err)
+		s.Len(records, 2)
+	})
+
+	s.Run("any manager", func() {
+		records, err := s.repo.Find(s.Ctx, auditrepo.Filter{From: from, To: to, Limit: 10})
+		s.Require().NoError(err)
+		s.Len(records, 3)
+	})
+
+	s.Run("limit", func() {
+		records, err := s.repo.Find(s.Ctx, auditrepo.Filter{From: from, To: to, Limit: 1})
+		s.Require().NoError(err)
+		s.Len(records, 1)
+	})
+
+	s.Run("time range", func() {
+		records, err := s.repo.Find(s.Ctx, auditrepo.Filter{From: to, To: to.Add(time.Minute), Limit: 10})
+		s.Require().NoError(err)
+		s.Empty(records)
+	})
+
+	s.Run("invalid filter", func() {
+		_, err := s.repo.Find(s.Ctx, auditrepo.Filter{From: to, To: from, Limit: 10})
+		s.Require().ErrorIs(err, auditrepo.ErrInvalidFilter)
+	})
+}
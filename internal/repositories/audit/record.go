@@ -0,0 +1,51 @@
+package auditrepo
+
+import (
+	"time"
+
+	"github.com/karasunokami/chat-service/internal/store"
+	"github.com/karasunokami/chat-service/internal/store/auditrecord"
+	"github.com/karasunokami/chat-service/internal/types"
+)
+
+type Action string
+
+const (
+	ActionGetChatHistory   Action = Action(auditrecord.ActionGetChatHistory)
+	ActionSendMessage      Action = Action(auditrecord.ActionSendMessage)
+	ActionCloseChat        Action = Action(auditrecord.ActionCloseChat)
+	ActionFreeHands        Action = Action(auditrecord.ActionFreeHands)
+	ActionSendInternalNote Action = Action(auditrecord.ActionSendInternalNote)
+	ActionSetStatus        Action = Action(auditrecord.ActionSetStatus)
+
+	// ActionAFCTimeoutDeliver and ActionAFCTimeoutBlock are the system fallback decisions
+	// for the messages, which never got the AFC verdict.
+	ActionAFCTimeoutDeliver Action = Action(auditrecord.ActionAfcTimeoutDeliver)
+	ActionAFCTimeoutBlock   Action = Action(auditrecord.ActionAfcTimeoutBlock)
+)
+
+// Record is the manager action. ChatID and ProblemID are nil if the action is not related to the chat.
+// ManagerID is nil for the system actions, MessageID is set only for the actions on the single message.
+type Record struct {
+	ID        types.AuditRecordID `json:"id"`
+	ManagerID types.UserID        `json:"managerId"`
+	Action    Action              `json:"action"`
+	ChatID    types.ChatID        `json:"chatId"`
+	ProblemID types.ProblemID     `json:"problemId"`
+	MessageID types.MessageID     `json:"messageId"`
+	RequestID types.RequestID     `json:"requestId"`
+	CreatedAt time.Time           `json:"createdAt"`
+}
+
+func storeRecordToRepoRecord(r *store.AuditRecord) Record {
+	return Record{
+		ID:        r.ID,
+		ManagerID: r.ManagerID,
+		Action:    Action(r.Action),
+		ChatID:    r.ChatID,
+		ProblemID: r.ProblemID,
+		MessageID: r.MessageID,
+		RequestID: r.RequestID,
+		CreatedAt: r.CreatedAt,
+	}
+}
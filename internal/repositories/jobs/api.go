@@ -6,6 +6,8 @@ import (
 	"fmt"
 	"time"
 
+	"github.com/karasunokami/chat-service/internal/store"
+	"github.com/karasunokami/chat-service/internal/store/job"
 	"github.com/karasunokami/chat-service/internal/types"
 )
 
@@ -55,6 +57,10 @@ func (r *Repo) FindAndReserveJob(ctx context.Context, until time.Time) (Job, err
 	return j, nil
 }
 
+func (r *Repo) CountAvailableJobs(ctx context.Context) (int, error) {
+	return r.db.Job(ctx).Query().Where(job.AvailableAtLTE(time.Now())).Count(ctx)
+}
+
 func (r *Repo) CreateJob(ctx context.Context, name, payload string, availableAt time.Time) (types.JobID, error) {
 	j, err := r.db.Job(ctx).Create().
 		SetName(name).
@@ -68,6 +74,34 @@ func (r *Repo) CreateJob(ctx context.Context, name, payload string, availableAt
 	return j.ID, nil
 }
 
+// CreateJobs creates a job with the same name for every payload in a single insert.
+func (r *Repo) CreateJobs(ctx context.Context, name string, payloads []string, availableAt time.Time) ([]types.JobID, error) {
+	if len(payloads) == 0 {
+		return nil, nil
+	}
+
+	builders := make([]*store.JobCreate, 0, len(payloads))
+	for _, p := range payloads {
+		builders = append(builders, r.db.Job(ctx).Create().
+			SetName(name).
+			SetPayload(p).
+			SetAvailableAt(availableAt),
+		)
+	}
+
+	jobs, err := r.db.Job(ctx).CreateBulk(builders...).Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("create jobs: %v", err)
+	}
+
+	ids := make([]types.JobID, 0, len(jobs))
+	for _, j := range jobs {
+		ids = append(ids, j.ID)
+	}
+
+	return ids, nil
+}
+
 func (r *Repo) CreateFailedJob(ctx context.Context, name, payload, reason string) error {
 	return r.db.FailedJob(ctx).Create().
 		SetName(name).
@@ -79,3 +113,8 @@ func (r *Repo) CreateFailedJob(ctx context.Context, name, payload, reason string
 func (r *Repo) DeleteJob(ctx context.Context, jobID types.JobID) error {
 	return r.db.Job(ctx).DeleteOneID(jobID).Exec(ctx)
 }
+
+// ReleaseJob drops the job reservation, so the job becomes available for reserving immediately.
+func (r *Repo) ReleaseJob(ctx context.Context, jobID types.JobID) error {
+	return r.db.Job(ctx).UpdateOneID(jobID).SetReservedUntil(time.Now()).Exec(ctx)
+}
@@ -189,6 +189,39 @@ func (s *JobsRepoSuite) Test_CreateJob_Multiple() {
 	s.Equal(jobs, count)
 }
 
+func (s *JobsRepoSuite) Test_CreateJobs() {
+	// Arrange.
+	payloads := []string{`{"n":1}`, `{"n":2}`, `{"n":3}`}
+
+	// Action.
+	jobIDs, err := s.repo.CreateJobs(s.Ctx, name, payloads, availableAt)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Require().Len(jobIDs, len(payloads))
+
+	for i, jobID := range jobIDs {
+		job, err := s.Database.Job(s.Ctx).Get(s.Ctx, jobID)
+		s.Require().NoError(err)
+		s.Equal(name, job.Name)
+		s.Equal(payloads[i], job.Payload)
+		s.Equal(availableAt.Unix(), job.AvailableAt.Unix())
+	}
+}
+
+func (s *JobsRepoSuite) Test_CreateJobs_Empty() {
+	// Action.
+	jobIDs, err := s.repo.CreateJobs(s.Ctx, name, nil, availableAt)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Empty(jobIDs)
+
+	count, err := s.Database.Job(s.Ctx).Query().Count(s.Ctx)
+	s.Require().NoError(err)
+	s.Equal(0, count)
+}
+
 func (s *JobsRepoSuite) Test_CreateFailedJob() {
 	err := s.repo.CreateFailedJob(s.Ctx, name, payload, reason)
 
@@ -250,3 +283,29 @@ func (s *JobsRepoSuite) Test_DeleteJob_NoJobs() {
 	// Assert.
 	s.Require().Error(err)
 }
+
+func (s *JobsRepoSuite) Test_ReleaseJob() {
+	// Arrange.
+	jobExpected, err := s.Database.Job(s.Ctx).Create().
+		SetName(name).
+		SetPayload(payload).
+		SetAvailableAt(availableAt).
+		Save(s.Ctx)
+	s.Require().NoError(err)
+
+	reserved, err := s.repo.FindAndReserveJob(s.Ctx, reservationTime())
+	s.Require().NoError(err)
+	s.Require().Equal(jobExpected.ID, reserved.ID)
+
+	// Action.
+	err = s.repo.ReleaseJob(s.Ctx, jobExpected.ID)
+
+	// Assert.
+	s.Require().NoError(err)
+
+	// Checking if job can be reserved again.
+	job, err := s.repo.FindAndReserveJob(s.Ctx, reservationTime())
+	s.Require().NoError(err)
+	s.Equal(jobExpected.ID, job.ID)
+	s.Equal(2, job.Attempts)
+}
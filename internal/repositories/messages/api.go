@@ -112,6 +112,55 @@ func (r *Repo) CreateFullVisible(
 	return storeMessageToRepoMessage(mes), nil
 }
 
+// CreateManagerVisible creates a manager message, which is held invisible for the client until the AFC verdict.
+func (r *Repo) CreateManagerVisible(
+	ctx context.Context,
+	reqID types.RequestID,
+	problemID types.ProblemID,
+	chatID types.ChatID,
+	authorID types.UserID,
+	msgBody string,
+) (*Message, error) {
+	mes, err := r.db.Message(ctx).Create().
+		SetInitialRequestID(reqID).
+		SetProblemID(problemID).
+		SetChatID(chatID).
+		SetAuthorID(authorID).
+		SetBody(msgBody).
+		SetIsVisibleForManager(true).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db create new message, err=%v", err)
+	}
+
+	return storeMessageToRepoMessage(mes), nil
+}
+
+// CreateInternalNote creates a staff note, which is visible only to the managers and supervisors.
+func (r *Repo) CreateInternalNote(
+	ctx context.Context,
+	reqID types.RequestID,
+	problemID types.ProblemID,
+	chatID types.ChatID,
+	authorID types.UserID,
+	noteBody string,
+) (*Message, error) {
+	mes, err := r.db.Message(ctx).Create().
+		SetInitialRequestID(reqID).
+		SetProblemID(problemID).
+		SetChatID(chatID).
+		SetAuthorID(authorID).
+		SetBody(noteBody).
+		SetIsVisibleForManager(true).
+		SetIsInternalNote(true).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db create internal note, err=%v", err)
+	}
+
+	return storeMessageToRepoMessage(mes), nil
+}
+
 // GetFirstProblemMessage get first message of problem by problem id.
 func (r *Repo) GetFirstProblemMessage(ctx context.Context, problemID types.ProblemID) (*Message, error) {
 	mes, err := r.db.Message(ctx).Query().
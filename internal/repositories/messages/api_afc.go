@@ -2,8 +2,10 @@ package messagesrepo
 
 import (
 	"context"
+	"fmt"
 	"time"
 
+	"github.com/karasunokami/chat-service/internal/store/message"
 	"github.com/karasunokami/chat-service/internal/types"
 )
 
@@ -21,3 +23,50 @@ func (r *Repo) BlockMessage(ctx context.Context, msgID types.MessageID) error {
 		SetCheckedAt(time.Now()).
 		Exec(ctx)
 }
+
+// MarkManyAsVisibleForManager is a bulk version of MarkAsVisibleForManager.
+// It returns ErrMsgNotFound if at least one of the messages does not exist.
+func (r *Repo) MarkManyAsVisibleForManager(ctx context.Context, msgIDs []types.MessageID) error {
+	if len(msgIDs) == 0 {
+		return nil
+	}
+
+	n, err := r.db.Message(ctx).Update().
+		Where(message.IDIn(msgIDs...)).
+		SetIsVisibleForManager(true).
+		SetIsVisibleForClient(true).
+		SetCheckedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("db update messages, err=%v", err)
+	}
+
+	if n != len(msgIDs) {
+		return fmt.Errorf("%w: updated %d of %d messages", ErrMsgNotFound, n, len(msgIDs))
+	}
+
+	return nil
+}
+
+// BlockMessages is a bulk version of BlockMessage.
+// It returns ErrMsgNotFound if at least one of the messages does not exist.
+func (r *Repo) BlockMessages(ctx context.Context, msgIDs []types.MessageID) error {
+	if len(msgIDs) == 0 {
+		return nil
+	}
+
+	n, err := r.db.Message(ctx).Update().
+		Where(message.IDIn(msgIDs...)).
+		SetIsBlocked(true).
+		SetCheckedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("db update messages, err=%v", err)
+	}
+
+	if n != len(msgIDs) {
+		return fmt.Errorf("%w: updated %d of %d messages", ErrMsgNotFound, n, len(msgIDs))
+	}
+
+	return nil
+}
@@ -0,0 +1,61 @@
+package messagesrepo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/karasunokami/chat-service/internal/store/message"
+	"github.com/karasunokami/chat-service/internal/store/predicate"
+	"github.com/karasunokami/chat-service/internal/types"
+)
+
+// GetHeldManagerMessageIDs returns the IDs of the given messages, which are the manager messages held for the AFC verdict.
+func (r *Repo) GetHeldManagerMessageIDs(ctx context.Context, msgIDs []types.MessageID) ([]types.MessageID, error) {
+	if len(msgIDs) == 0 {
+		return nil, nil
+	}
+
+	ids, err := r.db.Message(ctx).Query().
+		Where(message.IDIn(msgIDs...), heldManagerMessage()).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db select held manager messages, err=%v", err)
+	}
+
+	return ids, nil
+}
+
+// ReleaseManagerMessages makes the held manager messages visible for the client.
+// It returns ErrMsgNotFound if at least one of the messages is not held.
+func (r *Repo) ReleaseManagerMessages(ctx context.Context, msgIDs []types.MessageID) error {
+	if len(msgIDs) == 0 {
+		return nil
+	}
+
+	n, err := r.db.Message(ctx).Update().
+		Where(message.IDIn(msgIDs...), heldManagerMessage()).
+		SetIsVisibleForClient(true).
+		SetCheckedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("db update messages, err=%v", err)
+	}
+
+	if n != len(msgIDs) {
+		return fmt.Errorf("%w: updated %d of %d messages", ErrMsgNotFound, n, len(msgIDs))
+	}
+
+	return nil
+}
+
+func heldManagerMessage() predicate.Message {
+	return message.And(
+		message.CheckedAtIsNil(),
+		message.IsVisibleForClient(false),
+		message.IsVisibleForManager(true),
+		message.IsBlocked(false),
+		message.IsService(false),
+		message.IsInternalNote(false),
+	)
+}
@@ -4,6 +4,7 @@ package messagesrepo_test
 
 import (
 	"testing"
+	"time"
 
 	messagesrepo "github.com/karasunokami/chat-service/internal/repositories/messages"
 	"github.com/karasunokami/chat-service/internal/testingh"
@@ -65,6 +66,280 @@ func (s *MsgRepoAntiFraudAPISuite) TestBlockMessage() {
 	s.False(msg.IsVisibleForManager)
 }
 
+func (s *MsgRepoAntiFraudAPISuite) TestMarkManyAsVisibleForManager() {
+	// Arrange.
+	msgIDs := []types.MessageID{s.createMessage(), s.createMessage()}
+
+	// Action.
+	err := s.repo.MarkManyAsVisibleForManager(s.Ctx, msgIDs)
+	s.Require().NoError(err)
+
+	// Assert.
+	for _, msgID := range msgIDs {
+		msg := s.Database.Message(s.Ctx).GetX(s.Ctx, msgID)
+		s.False(msg.IsBlocked)
+		s.False(msg.CheckedAt.IsZero())
+		s.True(msg.IsVisibleForClient)
+		s.True(msg.IsVisibleForManager)
+	}
+}
+
+func (s *MsgRepoAntiFraudAPISuite) TestBlockMessages() {
+	// Arrange.
+	msgIDs := []types.MessageID{s.createMessage(), s.createMessage()}
+
+	// Action.
+	err := s.repo.BlockMessages(s.Ctx, msgIDs)
+	s.Require().NoError(err)
+
+	// Assert.
+	for _, msgID := range msgIDs {
+		msg := s.Database.Message(s.Ctx).GetX(s.Ctx, msgID)
+		s.True(msg.IsBlocked)
+		s.False(msg.CheckedAt.IsZero())
+		s.False(msg.IsVisibleForManager)
+	}
+}
+
+func (s *MsgRepoAntiFraudAPISuite) TestBlockMessages_UnknownMessage() {
+	// Arrange.
+	msgIDs := []types.MessageID{s.createMessage(), types.NewMessageID()}
+
+	// Action.
+	err := s.repo.BlockMessages(s.Ctx, msgIDs)
+
+	// Assert.
+	s.Require().ErrorIs(err, messagesrepo.ErrMsgNotFound)
+}
+
+func (s *MsgRepoAntiFraudAPISuite) TestReviewApproved() {
+	// Arrange.
+	msgID := s.createMessage()
+
+	// Action.
+	err := s.repo.RequestReview(s.Ctx, []types.MessageID{msgID})
+	s.Require().NoError(err)
+
+	// Assert.
+	msgs, err := s.repo.GetMessagesForReview(s.Ctx, 100)
+	s.Require().NoError(err)
+	s.Require().True(containsMessage(msgs, msgID))
+
+	ids, err := s.repo.GetReviewExpiredMessageIDs(s.Ctx, time.Now().Add(time.Minute), 100)
+	s.Require().NoError(err)
+	s.Contains(ids, msgID)
+
+	err = s.repo.ApproveReviewedMessage(s.Ctx, msgID)
+	s.Require().NoError(err)
+
+	msg := s.Database.Message(s.Ctx).GetX(s.Ctx, msgID)
+	s.False(msg.IsBlocked)
+	s.True(msg.IsVisibleForManager)
+	s.True(msg.ReviewRequestedAt.IsZero())
+	s.False(msg.CheckedAt.IsZero())
+
+	err = s.repo.RejectReviewedMessage(s.Ctx, msgID)
+	s.Require().ErrorIs(err, messagesrepo.ErrMsgNotUnderReview)
+}
+
+func (s *MsgRepoAntiFraudAPISuite) TestReviewRejected() {
+	// Arrange.
+	msgID := s.createMessage()
+	err := s.repo.RequestReview(s.Ctx, []types.MessageID{msgID})
+	s.Require().NoError(err)
+
+	// Action.
+	err = s.repo.RejectReviewedMessage(s.Ctx, msgID)
+	s.Require().NoError(err)
+
+	// Assert.
+	msg := s.Database.Message(s.Ctx).GetX(s.Ctx, msgID)
+	s.True(msg.IsBlocked)
+	s.False(msg.IsVisibleForManager)
+	s.True(msg.ReviewRequestedAt.IsZero())
+
+	msgs, err := s.repo.GetMessagesForReview(s.Ctx, 100)
+	s.Require().NoError(err)
+	s.False(containsMessage(msgs, msgID))
+
+	err = s.repo.ApproveReviewedMessage(s.Ctx, msgID)
+	s.Require().ErrorIs(err, messagesrepo.ErrMsgNotUnderReview)
+}
+
+func (s *MsgRepoAntiFraudAPISuite) TestGetReviewExpiredMessageIDs_SkipFreshReview() {
+	// Arrange.
+	msgID := s.createMessage()
+	err := s.repo.RequestReview(s.Ctx, []types.MessageID{msgID})
+	s.Require().NoError(err)
+
+	// Action.
+	ids, err := s.repo.GetReviewExpiredMessageIDs(s.Ctx, time.Now().Add(-time.Minute), 100)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.NotContains(ids, msgID)
+}
+
+func (s *MsgRepoAntiFraudAPISuite) TestGetUncheckedMessages() {
+	// Arrange.
+	uncheckedID := s.createMessage()
+	checkedID := s.createMessage()
+	err := s.repo.MarkAsVisibleForManager(s.Ctx, checkedID)
+	s.Require().NoError(err)
+
+	// Action.
+	msgs, err := s.repo.GetUncheckedMessages(s.Ctx, time.Now().Add(time.Minute), 1000)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.True(containsMessage(msgs, uncheckedID))
+	s.False(containsMessage(msgs, checkedID))
+}
+
+func (s *MsgRepoAntiFraudAPISuite) TestGetUncheckedMessages_HeldManagerMessages() {
+	// Arrange.
+	heldID := s.createManagerMessage()
+	releasedID := s.createManagerMessage()
+	s.Require().NoError(s.repo.ReleaseManagerMessages(s.Ctx, []types.MessageID{releasedID}))
+
+	// Action.
+	msgs, err := s.repo.GetUncheckedMessages(s.Ctx, time.Now().Add(time.Minute), 1000)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.True(containsMessage(msgs, heldID))
+	s.False(containsMessage(msgs, releasedID))
+	for _, msg := range msgs {
+		if msg.ID == heldID {
+			s.True(msg.IsVisibleForManager)
+		}
+	}
+}
+
+func (s *MsgRepoAntiFraudAPISuite) TestGetUncheckedMessages_SkipRecentlyResent() {
+	// Arrange.
+	msgID := s.createMessage()
+	err := s.repo.MarkAFCResent(s.Ctx, msgID)
+	s.Require().NoError(err)
+
+	// Action.
+	msgs, err := s.repo.GetUncheckedMessages(s.Ctx, time.Now().Add(-time.Minute), 1000)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.False(containsMessage(msgs, msgID))
+}
+
+func (s *MsgRepoAntiFraudAPISuite) TestMarkAFCResent() {
+	// Arrange.
+	msgID := s.createMessage()
+
+	// Action.
+	for i := 0; i < 2; i++ {
+		err := s.repo.MarkAFCResent(s.Ctx, msgID)
+		s.Require().NoError(err)
+	}
+
+	// Assert.
+	msg := s.Database.Message(s.Ctx).GetX(s.Ctx, msgID)
+	s.Equal(2, msg.AfcResends)
+	s.False(msg.AfcResentAt.IsZero())
+	s.True(msg.CheckedAt.IsZero())
+}
+
+func (s *MsgRepoAntiFraudAPISuite) TestDeliverUnchecked() {
+	// Arrange.
+	msgID := s.createMessage()
+
+	// Action.
+	err := s.repo.DeliverUnchecked(s.Ctx, msgID)
+	s.Require().NoError(err)
+
+	// Assert.
+	msg := s.Database.Message(s.Ctx).GetX(s.Ctx, msgID)
+	s.True(msg.IsVisibleForManager)
+	s.False(msg.IsBlocked)
+	s.False(msg.CheckedAt.IsZero())
+
+	err = s.repo.BlockUnchecked(s.Ctx, msgID)
+	s.Require().ErrorIs(err, messagesrepo.ErrMsgAlreadyChecked)
+}
+
+func (s *MsgRepoAntiFraudAPISuite) TestBlockUnchecked_AlreadyChecked() {
+	// Arrange.
+	msgID := s.createMessage()
+	err := s.repo.MarkAsVisibleForManager(s.Ctx, msgID)
+	s.Require().NoError(err)
+
+	// Action.
+	err = s.repo.BlockUnchecked(s.Ctx, msgID)
+
+	// Assert.
+	s.Require().ErrorIs(err, messagesrepo.ErrMsgAlreadyChecked)
+	msg := s.Database.Message(s.Ctx).GetX(s.Ctx, msgID)
+	s.False(msg.IsBlocked)
+}
+
+func (s *MsgRepoAntiFraudAPISuite) TestGetHeldManagerMessageIDs() {
+	// Arrange.
+	heldID := s.createManagerMessage()
+	clientMsgID := s.createMessage()
+
+	releasedID := s.createManagerMessage()
+	s.Require().NoError(s.repo.ReleaseManagerMessages(s.Ctx, []types.MessageID{releasedID}))
+
+	problemID, chatID := s.createProblemAndChat(types.NewUserID())
+	note, err := s.repo.CreateInternalNote(s.Ctx, types.NewRequestID(), problemID, chatID, types.NewUserID(), msgBody)
+	s.Require().NoError(err)
+
+	// Action.
+	ids, err := s.repo.GetHeldManagerMessageIDs(s.Ctx, []types.MessageID{heldID, clientMsgID, releasedID, note.ID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal([]types.MessageID{heldID}, ids)
+}
+
+func (s *MsgRepoAntiFraudAPISuite) TestReleaseManagerMessages() {
+	// Arrange.
+	msgIDs := []types.MessageID{s.createManagerMessage(), s.createManagerMessage()}
+
+	// Action.
+	err := s.repo.ReleaseManagerMessages(s.Ctx, msgIDs)
+	s.Require().NoError(err)
+
+	// Assert.
+	for _, msgID := range msgIDs {
+		msg := s.Database.Message(s.Ctx).GetX(s.Ctx, msgID)
+		s.False(msg.IsBlocked)
+		s.False(msg.CheckedAt.IsZero())
+		s.True(msg.IsVisibleForClient)
+		s.True(msg.IsVisibleForManager)
+	}
+}
+
+func (s *MsgRepoAntiFraudAPISuite) TestReleaseManagerMessages_NotHeld() {
+	// Arrange.
+	clientMsgID := s.createMessage()
+
+	// Action.
+	err := s.repo.ReleaseManagerMessages(s.Ctx, []types.MessageID{s.createManagerMessage(), clientMsgID})
+
+	// Assert.
+	s.Require().ErrorIs(err, messagesrepo.ErrMsgNotFound)
+}
+
+func (s *MsgRepoAntiFraudAPISuite) createManagerMessage() types.MessageID {
+	s.T().Helper()
+
+	problemID, chatID := s.createProblemAndChat(types.NewUserID())
+
+	msg, err := s.repo.CreateManagerVisible(s.Ctx, types.NewRequestID(), problemID, chatID, types.NewUserID(), msgBody)
+	s.Require().NoError(err)
+
+	return msg.ID
+}
+
 func (s *MsgRepoAntiFraudAPISuite) createMessage() types.MessageID {
 	s.T().Helper()
 
@@ -100,3 +375,12 @@ func (s *MsgRepoAntiFraudAPISuite) createProblemAndChat(clientID types.UserID) (
 
 	return problem.ID, chat.ID
 }
+
+func containsMessage(msgs []messagesrepo.Message, msgID types.MessageID) bool {
+	for _, m := range msgs {
+		if m.ID == msgID {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,102 @@
+package messagesrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/karasunokami/chat-service/internal/store"
+	"github.com/karasunokami/chat-service/internal/store/message"
+	"github.com/karasunokami/chat-service/internal/store/predicate"
+	"github.com/karasunokami/chat-service/internal/types"
+)
+
+var ErrMsgAlreadyChecked = errors.New("message is already checked")
+
+// GetUncheckedMessages returns the client messages and the held manager messages still waiting
+// for the AFC verdict, which were created (or resent to AFC last time) before the given time.
+// The held manager messages are the only ones visible for the manager among them.
+func (r *Repo) GetUncheckedMessages(ctx context.Context, before time.Time, limit int) ([]Message, error) {
+	msgs, err := r.db.Message(ctx).Query().
+		Where(
+			message.Or(uncheckedClientMessage(), heldManagerMessage()),
+			message.Or(
+				message.And(message.AfcResentAtIsNil(), message.CreatedAtLT(before)),
+				message.AfcResentAtLT(before),
+			),
+		).
+		Order(store.Asc(message.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db select unchecked messages, err=%v", err)
+	}
+
+	return storeMessagesToRepoMessages(msgs), nil
+}
+
+// MarkAFCResent remembers that the message was sent to AFC one more time.
+func (r *Repo) MarkAFCResent(ctx context.Context, msgID types.MessageID) error {
+	err := r.db.Message(ctx).UpdateOneID(msgID).
+		AddAfcResends(1).
+		SetAfcResentAt(time.Now()).
+		Exec(ctx)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return ErrMsgNotFound
+		}
+		return fmt.Errorf("db update message, err=%v", err)
+	}
+
+	return nil
+}
+
+// DeliverUnchecked makes the message without the AFC verdict visible for the manager.
+// It returns ErrMsgAlreadyChecked if the verdict has been applied in the meantime.
+func (r *Repo) DeliverUnchecked(ctx context.Context, msgID types.MessageID) error {
+	n, err := r.db.Message(ctx).Update().
+		Where(message.ID(msgID), uncheckedClientMessage()).
+		SetIsVisibleForManager(true).
+		SetIsVisibleForClient(true).
+		SetCheckedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("db update message, err=%v", err)
+	}
+
+	if n == 0 {
+		return ErrMsgAlreadyChecked
+	}
+
+	return nil
+}
+
+// BlockUnchecked blocks the message without the AFC verdict.
+// It returns ErrMsgAlreadyChecked if the verdict has been applied in the meantime.
+func (r *Repo) BlockUnchecked(ctx context.Context, msgID types.MessageID) error {
+	n, err := r.db.Message(ctx).Update().
+		Where(message.ID(msgID), uncheckedClientMessage()).
+		SetIsBlocked(true).
+		SetCheckedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("db update message, err=%v", err)
+	}
+
+	if n == 0 {
+		return ErrMsgAlreadyChecked
+	}
+
+	return nil
+}
+
+func uncheckedClientMessage() predicate.Message {
+	return message.And(
+		message.CheckedAtIsNil(),
+		message.IsVisibleForManager(false),
+		message.IsBlocked(false),
+		message.IsService(false),
+		message.IsInternalNote(false),
+	)
+}
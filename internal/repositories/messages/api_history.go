@@ -43,7 +43,10 @@ func (r *Repo) GetClientChatMessages(
 	}
 
 	query := r.buildMessagesQuery(ctx, limit, cursor)
-	query = query.Where(message.HasChatWith(chat.ClientID(clientID)))
+	query = query.Where(
+		message.HasChatWith(chat.ClientID(clientID)),
+		message.IsVisibleForClient(true),
+	)
 
 	msgs, err := query.All(ctx)
 	if err != nil {
@@ -104,11 +107,47 @@ func (r *Repo) GetManagerChatMessages(
 	}, nil
 }
 
-func (r *Repo) buildMessagesQuery(ctx context.Context, limit int, cursor *Cursor) *store.MessageQuery {
-	predicates := []predicate.Message{
-		message.IsVisibleForClient(true),
+// GetChatMessages returns Nth page of messages in the chat visible for managers, regardless of the assigned manager.
+// It is used by supervisors to read any chat.
+func (r *Repo) GetChatMessages(
+	ctx context.Context,
+	chatID types.ChatID,
+	pageSize int,
+	cursor *Cursor,
+) ([]Message, *Cursor, error) {
+	err := validateParams(pageSize, cursor)
+	if err != nil {
+		return nil, nil, fmt.Errorf("validate params, err=%w", err)
+	}
+
+	limit := pageSize
+	if cursor != nil {
+		limit = cursor.PageSize
+	}
+
+	query := r.buildMessagesQuery(ctx, limit, cursor)
+	query = query.Where(
+		message.ChatIDEQ(chatID),
+		message.IsVisibleForManager(true),
+	)
+
+	msgs, err := query.All(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("query messages, err=%v", err)
+	}
+
+	if len(msgs) <= limit {
+		return storeMessagesToRepoMessages(msgs), nil, nil
 	}
 
+	return storeMessagesToRepoMessages(msgs[:limit]), &Cursor{
+		LastCreatedAt: msgs[limit-1].CreatedAt,
+		PageSize:      limit,
+	}, nil
+}
+
+func (r *Repo) buildMessagesQuery(ctx context.Context, limit int, cursor *Cursor) *store.MessageQuery {
+	var predicates []predicate.Message
 	if cursor != nil {
 		predicates = append(predicates, message.CreatedAtLT(cursor.LastCreatedAt))
 	}
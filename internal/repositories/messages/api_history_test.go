@@ -303,6 +303,80 @@ func (s *MsgRepoHistoryAPISuite) Test_GetManagerChatMessages() {
 	})
 }
 
+func (s *MsgRepoHistoryAPISuite) Test_GetChatMessages() {
+	s.Run("invalid page size", func() {
+		msgs, next, err := s.repo.GetChatMessages(s.Ctx, types.NewChatID(), 9, nil)
+		s.Require().ErrorIs(err, messagesrepo.ErrInvalidPageSize)
+		s.Nil(next)
+		s.Empty(msgs)
+	})
+
+	s.Run("messages of all problems of the chat", func() {
+		clientID := types.NewUserID()
+
+		resolvedProblemID, chatID := s.createProblemAndChatAndManager(clientID, types.NewUserID())
+		_, err := s.Database.Problem(s.Ctx).UpdateOneID(resolvedProblemID).SetResolvedAt(time.Now()).Save(s.Ctx)
+		s.Require().NoError(err)
+		oldMsgs := s.createMessages(3, chatID, resolvedProblemID, clientID, true, true, false)
+
+		openProblem, err := s.Database.Problem(s.Ctx).Create().SetChatID(chatID).SetManagerID(types.NewUserID()).Save(s.Ctx)
+		s.Require().NoError(err)
+		newMsgs := s.createMessages(3, chatID, openProblem.ID, clientID, true, true, false)
+
+		// Messages invisible for managers must be ignored.
+		s.createMessages(2, chatID, openProblem.ID, clientID, true, false, false)
+
+		// Messages from other chat must be ignored.
+		otherProblemID, otherChatID := s.createProblemAndChat(types.NewUserID())
+		s.createMessages(2, otherChatID, otherProblemID, clientID, true, true, false)
+
+		msgs, next, err := s.repo.GetChatMessages(s.Ctx, chatID, 10, nil)
+		s.Require().NoError(err)
+		s.Nil(next)
+
+		expected := append(newMsgs, oldMsgs...) //nolint:gocritic
+		s.Equal(
+			apply[*store.Message, msg](expected, newMsgFromStoreMsg),
+			apply[messagesrepo.Message, msg](msgs, newMsgFromRepoMsg),
+		)
+	})
+}
+
+func (s *MsgRepoHistoryAPISuite) Test_InternalNotesVisibility() {
+	clientID := types.NewUserID()
+	managerID := types.NewUserID()
+
+	problemID, chatID := s.createProblemAndChatAndManager(clientID, managerID)
+	msgs := s.createMessages(2, chatID, problemID, clientID, true, true, false)
+
+	note, err := s.repo.CreateInternalNote(s.Ctx, types.NewRequestID(), problemID, chatID, managerID, "client verified by phone")
+	s.Require().NoError(err)
+
+	s.Run("client does not see notes", func() {
+		clientMsgs, _, err := s.repo.GetClientChatMessages(s.Ctx, clientID, 10, nil)
+		s.Require().NoError(err)
+		s.Equal(
+			apply[*store.Message, msg](msgs, newMsgFromStoreMsg),
+			apply[messagesrepo.Message, msg](clientMsgs, newMsgFromRepoMsg),
+		)
+	})
+
+	s.Run("manager sees notes", func() {
+		managerMsgs, _, err := s.repo.GetManagerChatMessages(s.Ctx, chatID, managerID, 10, nil)
+		s.Require().NoError(err)
+		s.Require().Len(managerMsgs, 3)
+		s.Equal(note.ID, managerMsgs[0].ID)
+		s.True(managerMsgs[0].IsInternalNote)
+	})
+
+	s.Run("supervisor sees notes", func() {
+		chatMsgs, _, err := s.repo.GetChatMessages(s.Ctx, chatID, 10, nil)
+		s.Require().NoError(err)
+		s.Require().Len(chatMsgs, 3)
+		s.Equal(note.ID, chatMsgs[0].ID)
+	})
+}
+
 func (s *MsgRepoHistoryAPISuite) createProblemAndChat(clientID types.UserID) (types.ProblemID, types.ChatID) {
 	s.T().Helper()
 
@@ -0,0 +1,106 @@
+package messagesrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/karasunokami/chat-service/internal/store"
+	"github.com/karasunokami/chat-service/internal/store/message"
+	"github.com/karasunokami/chat-service/internal/types"
+)
+
+var ErrMsgNotUnderReview = errors.New("message is not under review")
+
+// RequestReview puts the suspicious messages into the manual review queue.
+// It returns ErrMsgNotFound if at least one of the messages does not exist.
+func (r *Repo) RequestReview(ctx context.Context, msgIDs []types.MessageID) error {
+	if len(msgIDs) == 0 {
+		return nil
+	}
+
+	now := time.Now()
+
+	n, err := r.db.Message(ctx).Update().
+		Where(message.IDIn(msgIDs...)).
+		SetReviewRequestedAt(now).
+		SetCheckedAt(now).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("db update messages, err=%v", err)
+	}
+
+	if n != len(msgIDs) {
+		return fmt.Errorf("%w: updated %d of %d messages", ErrMsgNotFound, n, len(msgIDs))
+	}
+
+	return nil
+}
+
+// GetMessagesForReview returns the oldest messages waiting for the supervisor decision.
+func (r *Repo) GetMessagesForReview(ctx context.Context, limit int) ([]Message, error) {
+	msgs, err := r.db.Message(ctx).Query().
+		Where(message.ReviewRequestedAtNotNil()).
+		Order(store.Asc(message.FieldReviewRequestedAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db select messages for review, err=%v", err)
+	}
+
+	return storeMessagesToRepoMessages(msgs), nil
+}
+
+// GetReviewExpiredMessageIDs returns the messages waiting for the decision since before the given time.
+func (r *Repo) GetReviewExpiredMessageIDs(ctx context.Context, before time.Time, limit int) ([]types.MessageID, error) {
+	ids, err := r.db.Message(ctx).Query().
+		Where(message.ReviewRequestedAtLT(before)).
+		Order(store.Asc(message.FieldReviewRequestedAt)).
+		Limit(limit).
+		IDs(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db select review expired messages, err=%v", err)
+	}
+
+	return ids, nil
+}
+
+// ApproveReviewedMessage takes the message out of the review queue and makes it visible for the manager.
+// It returns ErrMsgNotUnderReview if the message is not in the review queue.
+func (r *Repo) ApproveReviewedMessage(ctx context.Context, msgID types.MessageID) error {
+	n, err := r.db.Message(ctx).Update().
+		Where(message.ID(msgID), message.ReviewRequestedAtNotNil()).
+		ClearReviewRequestedAt().
+		SetIsVisibleForManager(true).
+		SetIsVisibleForClient(true).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("db update message, err=%v", err)
+	}
+
+	if n == 0 {
+		return ErrMsgNotUnderReview
+	}
+
+	return nil
+}
+
+// RejectReviewedMessage takes the message out of the review queue and blocks it.
+// It returns ErrMsgNotUnderReview if the message is not in the review queue.
+func (r *Repo) RejectReviewedMessage(ctx context.Context, msgID types.MessageID) error {
+	n, err := r.db.Message(ctx).Update().
+		Where(message.ID(msgID), message.ReviewRequestedAtNotNil()).
+		ClearReviewRequestedAt().
+		SetIsBlocked(true).
+		Save(ctx)
+	if err != nil {
+		return fmt.Errorf("db update message, err=%v", err)
+	}
+
+	if n == 0 {
+		return ErrMsgNotUnderReview
+	}
+
+	return nil
+}
@@ -311,6 +311,56 @@ func (s *MsgRepoAPISuite) Test_CreateFullVisible() {
 	}
 }
 
+func (s *MsgRepoAPISuite) Test_CreateManagerVisible() {
+	managerID := types.NewUserID()
+
+	problemID, chatID := s.createProblemAndChat(types.NewUserID())
+	initialRequestID := types.NewRequestID()
+
+	msg, err := s.repo.CreateManagerVisible(s.Ctx, initialRequestID, problemID, chatID, managerID, msgBody)
+	s.Require().NoError(err)
+	s.Require().NotNil(msg)
+	s.NotEmpty(msg.ID)
+	s.Equal(chatID, msg.ChatID)
+	s.Equal(managerID, msg.AuthorID)
+	s.Equal(msgBody, msg.Body)
+	s.False(msg.IsVisibleForClient)
+	s.True(msg.IsVisibleForManager)
+	s.False(msg.IsBlocked)
+	s.False(msg.IsInternalNote)
+	s.Equal(initialRequestID, msg.InitialRequestID)
+
+	dbMsg, err := s.Database.Message(s.Ctx).Get(s.Ctx, msg.ID)
+	s.Require().NoError(err)
+	s.False(dbMsg.IsVisibleForClient)
+	s.True(dbMsg.CheckedAt.IsZero())
+}
+
+func (s *MsgRepoAPISuite) Test_CreateInternalNote() {
+	authorID := types.NewUserID()
+
+	problemID, chatID := s.createProblemAndChat(types.NewUserID())
+	initialRequestID := types.NewRequestID()
+
+	msg, err := s.repo.CreateInternalNote(s.Ctx, initialRequestID, problemID, chatID, authorID, msgBody)
+	s.Require().NoError(err)
+	s.Require().NotNil(msg)
+	s.NotEmpty(msg.ID)
+	s.Equal(chatID, msg.ChatID)
+	s.Equal(authorID, msg.AuthorID)
+	s.Equal(msgBody, msg.Body)
+	s.False(msg.IsVisibleForClient)
+	s.True(msg.IsVisibleForManager)
+	s.True(msg.IsInternalNote)
+	s.False(msg.IsService)
+	s.Equal(initialRequestID, msg.InitialRequestID)
+
+	dbMsg, err := s.Database.Message(s.Ctx).Get(s.Ctx, msg.ID)
+	s.Require().NoError(err)
+	s.True(dbMsg.IsInternalNote)
+	s.False(dbMsg.IsVisibleForClient)
+}
+
 func (s *MsgRepoAPISuite) createProblemAndChat(clientID types.UserID) (types.ProblemID, types.ChatID) {
 	s.T().Helper()
 
@@ -17,11 +17,16 @@ type Message struct {
 	Body string
 
 	CreatedAt time.Time
+	// ReviewRequestedAt is not zero while the message waits for the supervisor decision.
+	ReviewRequestedAt time.Time
+	// AFCResends is the number of times the message was resent to AFC because of the missing verdict.
+	AFCResends int
 
 	IsVisibleForClient  bool
 	IsVisibleForManager bool
 	IsBlocked           bool
 	IsService           bool
+	IsInternalNote      bool
 }
 
 func storeMessageToRepoMessage(m *store.Message) *Message {
@@ -31,10 +36,13 @@ func storeMessageToRepoMessage(m *store.Message) *Message {
 		AuthorID:            m.AuthorID,
 		Body:                m.Body,
 		CreatedAt:           m.CreatedAt,
+		ReviewRequestedAt:   m.ReviewRequestedAt,
+		AFCResends:          m.AfcResends,
 		IsVisibleForClient:  m.IsVisibleForClient,
 		IsVisibleForManager: m.IsVisibleForManager,
 		IsBlocked:           m.IsBlocked,
 		IsService:           m.IsService,
+		IsInternalNote:      m.IsInternalNote,
 		InitialRequestID:    m.InitialRequestID,
 		ProblemID:           m.ProblemID,
 	}
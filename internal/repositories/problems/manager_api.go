@@ -71,3 +71,39 @@ func (r *Repo) MarkProblemAsResolved(ctx context.Context, problemID types.Proble
 
 	return nil
 }
+
+// RequestManagerProblemsReassignment flags the open problems of the manager for reassignment.
+// The problems flagged before are left untouched. Returns the number of the newly flagged problems.
+func (r *Repo) RequestManagerProblemsReassignment(ctx context.Context, managerID types.UserID) (int, error) {
+	n, err := r.db.Problem(ctx).Update().
+		Where(
+			problem.ManagerIDEQ(managerID),
+			problem.ResolvedAtIsNil(),
+			problem.ReassignRequestedAtIsNil(),
+		).
+		SetReassignRequestedAt(time.Now()).
+		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("update manager open problems, err=%v", err)
+	}
+
+	return n, nil
+}
+
+// CancelManagerProblemsReassignment removes the reassignment flag from the open problems of the manager.
+// Returns the number of the affected problems.
+func (r *Repo) CancelManagerProblemsReassignment(ctx context.Context, managerID types.UserID) (int, error) {
+	n, err := r.db.Problem(ctx).Update().
+		Where(
+			problem.ManagerIDEQ(managerID),
+			problem.ResolvedAtIsNil(),
+			problem.ReassignRequestedAtNotNil(),
+		).
+		ClearReassignRequestedAt().
+		Save(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("update manager open problems, err=%v", err)
+	}
+
+	return n, nil
+}
@@ -145,6 +145,48 @@ func (s *ProblemsRepoManagerAPISuite) Test_CantMarkAnotherManagerProblemAsResolv
 	})
 }
 
+func (s *ProblemsRepoManagerAPISuite) Test_ManagerProblemsReassignment() {
+	managerID := types.NewUserID()
+
+	_, problemID := s.createChatWithProblemAssignedTo(managerID)
+	_, anotherProblemID := s.createChatWithProblemAssignedTo(types.NewUserID())
+
+	_, resolvedProblemID := s.createChatWithProblemAssignedTo(managerID)
+	s.Require().NoError(s.repo.MarkProblemAsResolved(s.Ctx, resolvedProblemID))
+
+	s.Run("request reassignment", func() {
+		n, err := s.repo.RequestManagerProblemsReassignment(s.Ctx, managerID)
+		s.Require().NoError(err)
+		s.Equal(1, n)
+
+		p, err := s.Database.Problem(s.Ctx).Get(s.Ctx, problemID)
+		s.Require().NoError(err)
+		s.False(p.ReassignRequestedAt.IsZero())
+
+		for _, id := range []types.ProblemID{anotherProblemID, resolvedProblemID} {
+			p, err := s.Database.Problem(s.Ctx).Get(s.Ctx, id)
+			s.Require().NoError(err)
+			s.True(p.ReassignRequestedAt.IsZero())
+		}
+	})
+
+	s.Run("request reassignment again", func() {
+		n, err := s.repo.RequestManagerProblemsReassignment(s.Ctx, managerID)
+		s.Require().NoError(err)
+		s.Equal(0, n)
+	})
+
+	s.Run("cancel reassignment", func() {
+		n, err := s.repo.CancelManagerProblemsReassignment(s.Ctx, managerID)
+		s.Require().NoError(err)
+		s.Equal(1, n)
+
+		p, err := s.Database.Problem(s.Ctx).Get(s.Ctx, problemID)
+		s.Require().NoError(err)
+		s.True(p.ReassignRequestedAt.IsZero())
+	})
+}
+
 func (s *ProblemsRepoManagerAPISuite) createChatWithProblemAssignedTo(managerID types.UserID) (types.ChatID, types.ProblemID) {
 	s.T().Helper()
 
@@ -0,0 +1,73 @@
+package problemsrepo
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/karasunokami/chat-service/internal/store"
+	"github.com/karasunokami/chat-service/internal/store/problem"
+	"github.com/karasunokami/chat-service/internal/types"
+)
+
+// OpenProblem is the problem not resolved yet. ManagerID is zero if the manager was not assigned.
+// ReassignRequestedAt is zero if the problem is not flagged for reassignment.
+type OpenProblem struct {
+	ID                  types.ProblemID
+	ChatID              types.ChatID
+	ClientID            types.UserID
+	ManagerID           types.UserID
+	CreatedAt           time.Time
+	ReassignRequestedAt time.Time
+}
+
+// GetOpenProblems returns the open problems of all chats from the oldest one.
+func (r *Repo) GetOpenProblems(ctx context.Context, limit int) ([]OpenProblem, error) {
+	problems, err := r.db.Problem(ctx).Query().
+		Where(problem.ResolvedAtIsNil()).
+		WithChat().
+		Order(store.Asc(problem.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("fetch open problems, err=%v", err)
+	}
+
+	result := make([]OpenProblem, 0, len(problems))
+	for _, p := range problems {
+		result = append(result, storeProblemToOpenProblem(p))
+	}
+
+	return result, nil
+}
+
+// GetChatOpenProblem returns the open problem of the chat regardless of its manager.
+func (r *Repo) GetChatOpenProblem(ctx context.Context, chatID types.ChatID) (OpenProblem, error) {
+	p, err := r.db.Problem(ctx).Query().
+		Where(
+			problem.ChatIDEQ(chatID),
+			problem.ResolvedAtIsNil(),
+		).
+		WithChat().
+		First(ctx)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return OpenProblem{}, ErrNotFound
+		}
+
+		return OpenProblem{}, fmt.Errorf("fetch open problem by chat id, err=%v", err)
+	}
+
+	return storeProblemToOpenProblem(p), nil
+}
+
+func storeProblemToOpenProblem(p *store.Problem) OpenProblem {
+	return OpenProblem{
+		ID:                  p.ID,
+		ChatID:              p.ChatID,
+		ClientID:            p.Edges.Chat.ClientID,
+		ManagerID:           p.ManagerID,
+		CreatedAt:           p.CreatedAt,
+		ReassignRequestedAt: p.ReassignRequestedAt,
+	}
+}
@@ -0,0 +1,108 @@
+//go:build integration
+
+package problemsrepo_test
+
+import (
+	"testing"
+	"time"
+
+	problemsrepo "github.com/karasunokami/chat-service/internal/repositories/problems"
+	"github.com/karasunokami/chat-service/internal/testingh"
+	"github.com/karasunokami/chat-service/internal/types"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ProblemsRepoSupervisorAPISuite struct {
+	testingh.DBSuite
+	repo *problemsrepo.Repo
+}
+
+func TestProblemsRepoSupervisorAPISuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, &ProblemsRepoSupervisorAPISuite{DBSuite: testingh.NewDBSuite("TestProblemsRepoSupervisorAPISuite")})
+}
+
+func (s *ProblemsRepoSupervisorAPISuite) SetupSuite() {
+	s.DBSuite.SetupSuite()
+
+	var err error
+
+	s.repo, err = problemsrepo.New(problemsrepo.NewOptions(s.Database))
+	s.Require().NoError(err)
+}
+
+func (s *ProblemsRepoSupervisorAPISuite) Test_GetOpenProblems() {
+	// Arrange.
+	managerID := types.NewUserID()
+
+	assignedClientID := types.NewUserID()
+	assignedChat, err := s.Database.Chat(s.Ctx).Create().SetClientID(assignedClientID).Save(s.Ctx)
+	s.Require().NoError(err)
+	assigned, err := s.Database.Problem(s.Ctx).Create().SetChatID(assignedChat.ID).SetManagerID(managerID).Save(s.Ctx)
+	s.Require().NoError(err)
+
+	waitingChat, err := s.Database.Chat(s.Ctx).Create().SetClientID(types.NewUserID()).Save(s.Ctx)
+	s.Require().NoError(err)
+	waiting, err := s.Database.Problem(s.Ctx).Create().SetChatID(waitingChat.ID).Save(s.Ctx)
+	s.Require().NoError(err)
+
+	resolvedChat, err := s.Database.Chat(s.Ctx).Create().SetClientID(types.NewUserID()).Save(s.Ctx)
+	s.Require().NoError(err)
+	_, err = s.Database.Problem(s.Ctx).Create().
+		SetChatID(resolvedChat.ID).
+		SetManagerID(managerID).
+		SetResolvedAt(time.Now()).
+		Save(s.Ctx)
+	s.Require().NoError(err)
+
+	// Action.
+	problems, err := s.repo.GetOpenProblems(s.Ctx, 10)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Require().Len(problems, 2)
+
+	s.Equal(assigned.ID, problems[0].ID)
+	s.Equal(assignedChat.ID, problems[0].ChatID)
+	s.Equal(assignedClientID, problems[0].ClientID)
+	s.Equal(managerID, problems[0].ManagerID)
+	s.NotZero(problems[0].CreatedAt)
+
+	s.Equal(waiting.ID, problems[1].ID)
+	s.True(problems[1].ManagerID.IsZero())
+
+	s.Run("limit", func() {
+		problems, err := s.repo.GetOpenProblems(s.Ctx, 1)
+		s.Require().NoError(err)
+		s.Len(problems, 1)
+	})
+}
+
+func (s *ProblemsRepoSupervisorAPISuite) Test_GetChatOpenProblem() {
+	s.Run("open problem", func() {
+		clientID := types.NewUserID()
+		chat, err := s.Database.Chat(s.Ctx).Create().SetClientID(clientID).Save(s.Ctx)
+		s.Require().NoError(err)
+
+		_, err = s.Database.Problem(s.Ctx).Create().SetChatID(chat.ID).SetResolvedAt(time.Now()).Save(s.Ctx)
+		s.Require().NoError(err)
+		open, err := s.Database.Problem(s.Ctx).Create().SetChatID(chat.ID).Save(s.Ctx)
+		s.Require().NoError(err)
+
+		p, err := s.repo.GetChatOpenProblem(s.Ctx, chat.ID)
+		s.Require().NoError(err)
+		s.Equal(open.ID, p.ID)
+		s.Equal(chat.ID, p.ChatID)
+		s.Equal(clientID, p.ClientID)
+		s.True(p.ManagerID.IsZero())
+	})
+
+	s.Run("no open problem", func() {
+		chat, err := s.Database.Chat(s.Ctx).Create().SetClientID(types.NewUserID()).Save(s.Ctx)
+		s.Require().NoError(err)
+
+		_, err = s.repo.GetChatOpenProblem(s.Ctx, chat.ID)
+		s.Require().ErrorIs(err, problemsrepo.ErrNotFound)
+	})
+}
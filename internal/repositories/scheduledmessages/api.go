@@ -0,0 +1,105 @@
+package scheduledmessagesrepo
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/karasunokami/chat-service/internal/store"
+	"github.com/karasunokami/chat-service/internal/store/scheduledmessage"
+	"github.com/karasunokami/chat-service/internal/types"
+)
+
+var ErrMsgNotFound = errors.New("scheduled message not found")
+
+func (r *Repo) Create(
+	ctx context.Context,
+	reqID types.RequestID,
+	problemID types.ProblemID,
+	chatID types.ChatID,
+	managerID types.UserID,
+	msgBody string,
+	deliverAt time.Time,
+) (*Message, error) {
+	mes, err := r.db.ScheduledMessage(ctx).Create().
+		SetInitialRequestID(reqID).
+		SetProblemID(problemID).
+		SetChatID(chatID).
+		SetManagerID(managerID).
+		SetBody(msgBody).
+		SetDeliverAt(deliverAt).
+		Save(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db create new scheduled message, err=%v", err)
+	}
+
+	return storeMessageToRepoMessage(mes), nil
+}
+
+func (r *Repo) GetByID(ctx context.Context, id types.ScheduledMessageID) (*Message, error) {
+	mes, err := r.db.ScheduledMessage(ctx).Get(ctx, id)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil, ErrMsgNotFound
+		}
+
+		return nil, fmt.Errorf("db select scheduled message by id, err=%v", err)
+	}
+
+	return storeMessageToRepoMessage(mes), nil
+}
+
+func (r *Repo) GetByRequestID(ctx context.Context, reqID types.RequestID) (*Message, error) {
+	mes, err := r.db.ScheduledMessage(ctx).Query().Where(scheduledmessage.InitialRequestID(reqID)).Only(ctx)
+	if err != nil {
+		if store.IsNotFound(err) {
+			return nil, ErrMsgNotFound
+		}
+
+		return nil, fmt.Errorf("db select scheduled message by request id, err=%v", err)
+	}
+
+	return storeMessageToRepoMessage(mes), nil
+}
+
+// GetManagerMessages returns manager scheduled messages ordered by delivery time.
+func (r *Repo) GetManagerMessages(ctx context.Context, managerID types.UserID) ([]Message, error) {
+	result, err := r.db.ScheduledMessage(ctx).Query().
+		Where(scheduledmessage.ManagerIDEQ(managerID)).
+		Order(store.Asc(scheduledmessage.FieldDeliverAt)).
+		All(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("db select manager scheduled messages, err=%v", err)
+	}
+
+	msgs := make([]Message, len(result))
+	for i, m := range result {
+		msgs[i] = *storeMessageToRepoMessage(m)
+	}
+
+	return msgs, nil
+}
+
+// DeleteManagerMessage deletes the scheduled message only if it belongs to the manager.
+func (r *Repo) DeleteManagerMessage(ctx context.Context, managerID types.UserID, id types.ScheduledMessageID) error {
+	n, err := r.db.ScheduledMessage(ctx).Delete().
+		Where(
+			scheduledmessage.IDEQ(id),
+			scheduledmessage.ManagerIDEQ(managerID),
+		).
+		Exec(ctx)
+	if err != nil {
+		return fmt.Errorf("db delete manager scheduled message, err=%v", err)
+	}
+
+	if n == 0 {
+		return ErrMsgNotFound
+	}
+
+	return nil
+}
+
+func (r *Repo) Delete(ctx context.Context, id types.ScheduledMessageID) error {
+	return r.db.ScheduledMessage(ctx).DeleteOneID(id).Exec(ctx)
+}
@@ -0,0 +1,136 @@
+//go:build integration
+
+package scheduledmessagesrepo_test
+
+import (
+	"testing"
+	"time"
+
+	scheduledmessagesrepo "github.com/karasunokami/chat-service/internal/repositories/scheduledmessages"
+	"github.com/karasunokami/chat-service/internal/testingh"
+	"github.com/karasunokami/chat-service/internal/types"
+
+	"github.com/stretchr/testify/suite"
+)
+
+type ScheduledMessagesRepoSuite struct {
+	testingh.DBSuite
+	repo *scheduledmessagesrepo.Repo
+}
+
+func TestScheduledMessagesRepoSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, &ScheduledMessagesRepoSuite{DBSuite: testingh.NewDBSuite("TestScheduledMessagesRepoSuite")})
+}
+
+func (s *ScheduledMessagesRepoSuite) SetupSuite() {
+	s.DBSuite.SetupSuite()
+
+	var err error
+
+	s.repo, err = scheduledmessagesrepo.New(scheduledmessagesrepo.NewOptions(s.Database))
+	s.Require().NoError(err)
+}
+
+func (s *ScheduledMessagesRepoSuite) SetupTest() {
+	s.DBSuite.SetupTest()
+	s.Database.ScheduledMessage(s.Ctx).Delete().ExecX(s.Ctx)
+}
+
+func (s *ScheduledMessagesRepoSuite) Test_CreateAndGetByID() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	problemID := types.NewProblemID()
+	chatID := types.NewChatID()
+	managerID := types.NewUserID()
+	deliverAt := time.Now().Add(time.Hour).Truncate(time.Millisecond)
+
+	// Action.
+	msg, err := s.repo.Create(s.Ctx, reqID, problemID, chatID, managerID, "Follow up", deliverAt)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Require().NotEmpty(msg.ID)
+
+	got, err := s.repo.GetByID(s.Ctx, msg.ID)
+	s.Require().NoError(err)
+	s.Equal(reqID, got.InitialRequestID)
+	s.Equal(problemID, got.ProblemID)
+	s.Equal(chatID, got.ChatID)
+	s.Equal(managerID, got.ManagerID)
+	s.Equal("Follow up", got.Body)
+	s.True(deliverAt.Equal(got.DeliverAt))
+}
+
+func (s *ScheduledMessagesRepoSuite) Test_GetByID_NotFound() {
+	_, err := s.repo.GetByID(s.Ctx, types.NewScheduledMessageID())
+	s.Require().ErrorIs(err, scheduledmessagesrepo.ErrMsgNotFound)
+}
+
+func (s *ScheduledMessagesRepoSuite) Test_GetByRequestID() {
+	reqID := types.NewRequestID()
+	msg, err := s.repo.Create(s.Ctx, reqID, types.NewProblemID(), types.NewChatID(), types.NewUserID(),
+		"Follow up", time.Now().Add(time.Hour))
+	s.Require().NoError(err)
+
+	got, err := s.repo.GetByRequestID(s.Ctx, reqID)
+	s.Require().NoError(err)
+	s.Equal(msg.ID, got.ID)
+
+	_, err = s.repo.GetByRequestID(s.Ctx, types.NewRequestID())
+	s.Require().ErrorIs(err, scheduledmessagesrepo.ErrMsgNotFound)
+}
+
+func (s *ScheduledMessagesRepoSuite) Test_GetManagerMessages() {
+	// Arrange.
+	managerID := types.NewUserID()
+	now := time.Now()
+
+	later, err := s.repo.Create(s.Ctx, types.NewRequestID(), types.NewProblemID(), types.NewChatID(),
+		managerID, "later", now.Add(2*time.Hour))
+	s.Require().NoError(err)
+
+	sooner, err := s.repo.Create(s.Ctx, types.NewRequestID(), types.NewProblemID(), types.NewChatID(),
+		managerID, "sooner", now.Add(time.Hour))
+	s.Require().NoError(err)
+
+	_, err = s.repo.Create(s.Ctx, types.NewRequestID(), types.NewProblemID(), types.NewChatID(),
+		types.NewUserID(), "another manager", now.Add(time.Hour))
+	s.Require().NoError(err)
+
+	// Action.
+	msgs, err := s.repo.GetManagerMessages(s.Ctx, managerID)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Require().Len(msgs, 2)
+	s.Equal(sooner.ID, msgs[0].ID)
+	s.Equal(later.ID, msgs[1].ID)
+}
+
+func (s *ScheduledMessagesRepoSuite) Test_DeleteManagerMessage() {
+	// Arrange.
+	managerID := types.NewUserID()
+
+	msg, err := s.repo.Create(s.Ctx, types.NewRequestID(), types.NewProblemID(), types.NewChatID(),
+		managerID, "body", time.Now().Add(time.Hour))
+	s.Require().NoError(err)
+
+	s.Run("another manager cannot delete", func() {
+		err := s.repo.DeleteManagerMessage(s.Ctx, types.NewUserID(), msg.ID)
+		s.Require().ErrorIs(err, scheduledmessagesrepo.ErrMsgNotFound)
+	})
+
+	s.Run("owner deletes", func() {
+		err := s.repo.DeleteManagerMessage(s.Ctx, managerID, msg.ID)
+		s.Require().NoError(err)
+
+		_, err = s.repo.GetByID(s.Ctx, msg.ID)
+		s.Require().ErrorIs(err, scheduledmessagesrepo.ErrMsgNotFound)
+	})
+
+	s.Run("already deleted", func() {
+		err := s.repo.DeleteManagerMessage(s.Ctx, managerID, msg.ID)
+		s.Require().ErrorIs(err, scheduledmessagesrepo.ErrMsgNotFound)
+	})
+}
@@ -0,0 +1,34 @@
+package scheduledmessagesrepo
+
+import (
+	"time"
+
+	"github.com/karasunokami/chat-service/internal/store"
+	"github.com/karasunokami/chat-service/internal/types"
+)
+
+type Message struct {
+	ID               types.ScheduledMessageID
+	ChatID           types.ChatID
+	ProblemID        types.ProblemID
+	ManagerID        types.UserID
+	InitialRequestID types.RequestID
+
+	Body string
+
+	DeliverAt time.Time
+	CreatedAt time.Time
+}
+
+func storeMessageToRepoMessage(m *store.ScheduledMessage) *Message {
+	return &Message{
+		ID:               m.ID,
+		ChatID:           m.ChatID,
+		ProblemID:        m.ProblemID,
+		ManagerID:        m.ManagerID,
+		InitialRequestID: m.InitialRequestID,
+		Body:             m.Body,
+		DeliverAt:        m.DeliverAt,
+		CreatedAt:        m.CreatedAt,
+	}
+}
@@ -1,6 +1,7 @@
 package serverclient
 
 import (
+	"github.com/karasunokami/chat-service/internal/middlewares"
 	clientv1 "github.com/karasunokami/chat-service/internal/server-client/v1"
 
 	oapimdlwr "github.com/deepmap/oapi-codegen/pkg/middleware"
@@ -9,19 +10,29 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
+const serverName = "client"
+
 func NewHandlersRegistrar(
 	v1Swagger *openapi3.T,
 	v1Handlers clientv1.ServerInterface,
 	httpErrorHandler echo.HTTPErrorHandler,
+	rateLimiter middlewares.RateLimiter, // Optional.
 ) func(e *echo.Echo) {
 	return func(e *echo.Echo) {
-		v1 := e.Group("v1", oapimdlwr.OapiRequestValidatorWithOptions(v1Swagger, &oapimdlwr.Options{
-			Options: openapi3filter.Options{
-				ExcludeRequestBody:  false,
-				ExcludeResponseBody: true,
-				AuthenticationFunc:  openapi3filter.NoopAuthenticationFunc,
-			},
-		}))
+		mws := []echo.MiddlewareFunc{middlewares.NewMetricsMiddleware(serverName, v1Swagger)}
+		if rateLimiter != nil {
+			mws = append(mws, middlewares.NewRateLimitMiddleware(serverName, v1Swagger, rateLimiter))
+		}
+
+		v1 := e.Group("v1", append(mws,
+			oapimdlwr.OapiRequestValidatorWithOptions(v1Swagger, &oapimdlwr.Options{
+				Options: openapi3filter.Options{
+					ExcludeRequestBody:  false,
+					ExcludeResponseBody: true,
+					AuthenticationFunc:  openapi3filter.NoopAuthenticationFunc,
+				},
+			}),
+		)...)
 		clientv1.RegisterHandlers(v1, v1Handlers)
 
 		e.HTTPErrorHandler = httpErrorHandler
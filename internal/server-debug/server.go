@@ -6,11 +6,17 @@ import (
 	"fmt"
 	"net/http"
 	_ "net/http/pprof" //nolint:gosec
+	"strconv"
 	"time"
 
 	"github.com/karasunokami/chat-service/internal/buildinfo"
+	keycloakclient "github.com/karasunokami/chat-service/internal/clients/keycloak"
 	"github.com/karasunokami/chat-service/internal/logger"
+	"github.com/karasunokami/chat-service/internal/metrics"
 	"github.com/karasunokami/chat-service/internal/middlewares"
+	auditrepo "github.com/karasunokami/chat-service/internal/repositories/audit"
+	"github.com/karasunokami/chat-service/internal/services/health"
+	"github.com/karasunokami/chat-service/internal/types"
 
 	"github.com/getkin/kin-openapi/openapi3"
 	"github.com/labstack/echo/v4"
@@ -23,14 +29,37 @@ import (
 const (
 	readHeaderTimeout = time.Second
 	shutdownTimeout   = 3 * time.Second
+
+	defaultAuditPeriod = 24 * time.Hour
+	defaultAuditLimit  = 100
 )
 
+type healthReporter interface {
+	Live(ctx context.Context) health.Report
+	Ready() health.Report
+}
+
+type introspectionCache interface {
+	Stats() keycloakclient.IntrospectionCacheStats
+}
+
+type auditLog interface {
+	Find(ctx context.Context, f auditrepo.Filter) ([]auditrepo.Record, error)
+}
+
 //go:generate options-gen -out-filename=server_options.gen.go -from-struct=Options
 type Options struct {
-	addr                string      `option:"mandatory" validate:"required,hostname_port"`
-	clientV1Swagger     *openapi3.T `option:"mandatory" validate:"required"`
-	managerV1Swagger    *openapi3.T `option:"mandatory" validate:"required"`
-	clientEventsSwagger *openapi3.T `option:"mandatory" validate:"required"`
+	addr                string         `option:"mandatory" validate:"required,hostname_port"`
+	clientV1Swagger     *openapi3.T    `option:"mandatory" validate:"required"`
+	managerV1Swagger    *openapi3.T    `option:"mandatory" validate:"required"`
+	clientEventsSwagger *openapi3.T    `option:"mandatory" validate:"required"`
+	health              healthReporter `option:"mandatory" validate:"required"`
+
+	// introspectionCache is set if the Keycloak introspection results are cached.
+	introspectionCache introspectionCache
+
+	// auditLog is set if the manager actions are available for querying.
+	auditLog auditLog
 }
 
 type Server struct {
@@ -39,6 +68,9 @@ type Server struct {
 	clientV1Swagger     *openapi3.T
 	managerV1Swagger    *openapi3.T
 	clientEventsSwagger *openapi3.T
+	health              healthReporter
+	introspectionCache  introspectionCache
+	auditLog            auditLog
 }
 
 func New(opts Options) (*Server, error) {
@@ -70,6 +102,9 @@ func New(opts Options) (*Server, error) {
 		clientV1Swagger:     opts.clientV1Swagger,
 		managerV1Swagger:    opts.managerV1Swagger,
 		clientEventsSwagger: opts.clientEventsSwagger,
+		health:              opts.health,
+		introspectionCache:  opts.introspectionCache,
+		auditLog:            opts.auditLog,
 		srv: &http.Server{
 			Addr:              opts.addr,
 			Handler:           e,
@@ -78,6 +113,9 @@ func New(opts Options) (*Server, error) {
 	}
 
 	e.GET("/version", s.Version)
+	e.GET("/metrics", echo.WrapHandler(metrics.Handler()))
+	e.GET("/health/live", s.HealthLive)
+	e.GET("/health/ready", s.HealthReady)
 	e.GET("/debug/*", echo.WrapHandler(http.DefaultServeMux))
 	e.GET("/debug/error", s.DebugError)
 	e.GET("/schema/client", s.SchemaClient)
@@ -88,11 +126,25 @@ func New(opts Options) (*Server, error) {
 
 	index := newIndexPage()
 	index.addPage("/version", "Get build information")
+	index.addPage("/metrics", "Get Prometheus metrics")
+	index.addPage("/health/live", "Check the service is alive")
+	index.addPage("/health/ready", "Check the service is ready to serve")
 	index.addPage("/debug/pprof", "Go std profiler")
 	index.addPage("/debug/pprof/profile?seconds=30", "Take half-min profile")
 	index.addPage("/debug/error", "Debug Sentry error event")
 	index.addPage("/schema/client", "Get client Open API specification")
 	index.addPage("/schema/manager", "Get manager Open API specification")
+
+	if s.introspectionCache != nil {
+		e.GET("/cache/introspection", s.IntrospectionCacheStats)
+		index.addPage("/cache/introspection", "Get token introspection cache hits and misses")
+	}
+
+	if s.auditLog != nil {
+		e.GET("/audit", s.AuditRecords)
+		index.addPage("/audit?managerId=&from=&to=&limit=", "Find manager actions in the audit log")
+	}
+
 	e.GET("/", index.handler)
 
 	return s, nil
@@ -131,6 +183,101 @@ func (s *Server) Version(c echo.Context) error {
 	return nil
 }
 
+func (s *Server) HealthLive(c echo.Context) error {
+	return s.writeHealthReport(c, s.health.Live(c.Request().Context()))
+}
+
+func (s *Server) HealthReady(c echo.Context) error {
+	return s.writeHealthReport(c, s.health.Ready())
+}
+
+func (s *Server) writeHealthReport(c echo.Context, r health.Report) error {
+	code := http.StatusOK
+	if !r.OK() {
+		code = http.StatusServiceUnavailable
+	}
+
+	if err := c.JSON(code, r); err != nil {
+		return fmt.Errorf("encode health report to response, err=%v", err)
+	}
+
+	return nil
+}
+
+func (s *Server) IntrospectionCacheStats(c echo.Context) error {
+	err := c.JSON(http.StatusOK, s.introspectionCache.Stats())
+	if err != nil {
+		return fmt.Errorf("encode introspection cache stats to response, err=%v", err)
+	}
+
+	return nil
+}
+
+// AuditRecords returns the manager actions for the last day by default.
+// The time range is set by "from" and "to" query params in RFC3339.
+func (s *Server) AuditRecords(c echo.Context) error {
+	f, err := parseAuditFilter(c)
+	if err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+	}
+
+	records, err := s.auditLog.Find(c.Request().Context(), f)
+	if err != nil {
+		if errors.Is(err, auditrepo.ErrInvalidFilter) {
+			return echo.NewHTTPError(http.StatusBadRequest, err.Error())
+		}
+		return fmt.Errorf("find audit records, err=%v", err)
+	}
+
+	if err := c.JSON(http.StatusOK, records); err != nil {
+		return fmt.Errorf("encode audit records to response, err=%v", err)
+	}
+
+	return nil
+}
+
+func parseAuditFilter(c echo.Context) (auditrepo.Filter, error) {
+	f := auditrepo.Filter{
+		To:    time.Now(),
+		Limit: defaultAuditLimit,
+	}
+
+	if v := c.QueryParam("managerId"); v != "" {
+		id, err := types.Parse[types.UserID](v)
+		if err != nil {
+			return auditrepo.Filter{}, fmt.Errorf("invalid managerId: %v", err)
+		}
+		f.ManagerID = id
+	}
+
+	if v := c.QueryParam("to"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return auditrepo.Filter{}, fmt.Errorf("invalid to: %v", err)
+		}
+		f.To = t
+	}
+
+	f.From = f.To.Add(-defaultAuditPeriod)
+	if v := c.QueryParam("from"); v != "" {
+		t, err := time.Parse(time.RFC3339, v)
+		if err != nil {
+			return auditrepo.Filter{}, fmt.Errorf("invalid from: %v", err)
+		}
+		f.From = t
+	}
+
+	if v := c.QueryParam("limit"); v != "" {
+		limit, err := strconv.Atoi(v)
+		if err != nil {
+			return auditrepo.Filter{}, fmt.Errorf("invalid limit: %v", err)
+		}
+		f.Limit = limit
+	}
+
+	return f, nil
+}
+
 func (s *Server) LogLevel(c echo.Context) error {
 	level := c.FormValue("level")
 
@@ -17,6 +17,7 @@ func NewOptions(
 	clientV1Swagger *openapi3.T,
 	managerV1Swagger *openapi3.T,
 	clientEventsSwagger *openapi3.T,
+	health healthReporter,
 	options ...OptOptionsSetter,
 ) Options {
 	o := Options{}
@@ -27,6 +28,7 @@ func NewOptions(
 	o.clientV1Swagger = clientV1Swagger
 	o.managerV1Swagger = managerV1Swagger
 	o.clientEventsSwagger = clientEventsSwagger
+	o.health = health
 
 	for _, opt := range options {
 		opt(&o)
@@ -34,12 +36,25 @@ func NewOptions(
 	return o
 }
 
+func WithIntrospectionCache(opt introspectionCache) OptOptionsSetter {
+	return func(o *Options) {
+		o.introspectionCache = opt
+	}
+}
+
+func WithAuditLog(opt auditLog) OptOptionsSetter {
+	return func(o *Options) {
+		o.auditLog = opt
+	}
+}
+
 func (o *Options) Validate() error {
 	errs := new(errors461e464ebed9.ValidationErrors)
 	errs.Add(errors461e464ebed9.NewValidationError("addr", _validate_Options_addr(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("clientV1Swagger", _validate_Options_clientV1Swagger(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("managerV1Swagger", _validate_Options_managerV1Swagger(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("clientEventsSwagger", _validate_Options_clientEventsSwagger(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("health", _validate_Options_health(o)))
 	return errs.AsError()
 }
 
@@ -70,3 +85,10 @@ func _validate_Options_clientEventsSwagger(o *Options) error {
 	}
 	return nil
 }
+
+func _validate_Options_health(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.health, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `health` did not pass the test: %w", err)
+	}
+	return nil
+}
@@ -41,6 +41,24 @@ func (Adapter) Adapt(ev eventstream.Event) (any, error) {
 			RequestId:           v.RequestID,
 		})
 
+	case *eventstream.NewInternalNoteEvent:
+		err = event.FromNewInternalNoteEvent(NewInternalNoteEvent{
+			AuthorId:  v.AuthorID,
+			Body:      v.NoteBody,
+			ChatId:    v.ChatID,
+			CreatedAt: v.CreatedAt,
+			EventId:   v.EventID,
+			MessageId: v.MessageID,
+			RequestId: v.RequestID,
+		})
+
+	case *eventstream.MessageBlockedEvent:
+		err = event.FromMessageBlockedEvent(MessageBlockedEvent{
+			EventId:   v.EventID,
+			MessageId: v.MessageID,
+			RequestId: v.RequestID,
+		})
+
 	default:
 		return nil, fmt.Errorf("unknown manager event: %v (%T)", v, v)
 	}
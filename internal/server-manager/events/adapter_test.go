@@ -3,6 +3,7 @@ package managerevents_test
 import (
 	"encoding/json"
 	"testing"
+	"time"
 
 	managerevents "github.com/karasunokami/chat-service/internal/server-manager/events"
 	eventstream "github.com/karasunokami/chat-service/internal/services/event-stream"
@@ -36,6 +37,42 @@ func TestAdapter_Adapt(t *testing.T) {
 				"canTakeMoreProblems": true
 			}`,
 		},
+		{
+			name: "internal note",
+			ev: eventstream.NewNewInternalNoteEvent(
+				types.MustParse[types.EventID]("d0ffbd36-bc30-11ed-8286-461e464ebed8"),
+				types.MustParse[types.RequestID]("cee5f290-bc30-11ed-b7fe-461e464ebed8"),
+				types.MustParse[types.ChatID]("cb36a888-bc30-11ed-b843-461e464ebed8"),
+				types.MustParse[types.MessageID]("2c3e8b0e-bc31-11ed-9b52-461e464ebed8"),
+				time.Date(2023, 3, 8, 12, 0, 0, 0, time.UTC),
+				"client verified by phone",
+				types.MustParse[types.UserID]("4d55ddf0-3216-48a3-b5f8-3b6bb72980ec"),
+			),
+			expJSON: `{
+				"eventId": "d0ffbd36-bc30-11ed-8286-461e464ebed8",
+				"eventType": "NewInternalNoteEvent",
+				"chatId": "cb36a888-bc30-11ed-b843-461e464ebed8",
+				"requestId": "cee5f290-bc30-11ed-b7fe-461e464ebed8",
+				"messageId": "2c3e8b0e-bc31-11ed-9b52-461e464ebed8",
+				"authorId": "4d55ddf0-3216-48a3-b5f8-3b6bb72980ec",
+				"body": "client verified by phone",
+				"createdAt": "2023-03-08T12:00:00Z"
+			}`,
+		},
+		{
+			name: "message blocked",
+			ev: eventstream.NewMessageBlockedEvent(
+				types.MustParse[types.EventID]("d0ffbd36-bc30-11ed-8286-461e464ebed8"),
+				types.MustParse[types.RequestID]("cee5f290-bc30-11ed-b7fe-461e464ebed8"),
+				types.MustParse[types.MessageID]("2c3e8b0e-bc31-11ed-9b52-461e464ebed8"),
+			),
+			expJSON: `{
+				"eventId": "d0ffbd36-bc30-11ed-8286-461e464ebed8",
+				"eventType": "MessageBlockedEvent",
+				"requestId": "cee5f290-bc30-11ed-b7fe-461e464ebed8",
+				"messageId": "2c3e8b0e-bc31-11ed-9b52-461e464ebed8"
+			}`,
+		},
 	}
 
 	for _, tt := range cases {
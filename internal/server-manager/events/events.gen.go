@@ -42,6 +42,14 @@ type Event struct {
 	union     json.RawMessage
 }
 
+// MessageBlockedEvent defines model for MessageBlockedEvent.
+type MessageBlockedEvent struct {
+	EventId   types.EventID   `json:"eventId"`
+	EventType string          `json:"eventType"`
+	MessageId types.MessageID `json:"messageId"`
+	RequestId types.RequestID `json:"requestId"`
+}
+
 // NewChatEvent defines model for NewChatEvent.
 type NewChatEvent struct {
 	CanTakeMoreProblems bool            `json:"canTakeMoreProblems"`
@@ -52,6 +60,18 @@ type NewChatEvent struct {
 	RequestId           types.RequestID `json:"requestId"`
 }
 
+// NewInternalNoteEvent defines model for NewInternalNoteEvent.
+type NewInternalNoteEvent struct {
+	AuthorId  types.UserID    `json:"authorId"`
+	Body      string          `json:"body"`
+	ChatId    types.ChatID    `json:"chatId"`
+	CreatedAt time.Time       `json:"createdAt"`
+	EventId   types.EventID   `json:"eventId"`
+	EventType string          `json:"eventType"`
+	MessageId types.MessageID `json:"messageId"`
+	RequestId types.RequestID `json:"requestId"`
+}
+
 // NewMessageEvent defines model for NewMessageEvent.
 type NewMessageEvent struct {
 	AuthorId  types.UserID    `json:"authorId"`
@@ -154,6 +174,66 @@ func (t *Event) MergeChatClosedEvent(v ChatClosedEvent) error {
 	return err
 }
 
+// AsNewInternalNoteEvent returns the union data inside the Event as a NewInternalNoteEvent
+func (t Event) AsNewInternalNoteEvent() (NewInternalNoteEvent, error) {
+	var body NewInternalNoteEvent
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromNewInternalNoteEvent overwrites any union data inside the Event as the provided NewInternalNoteEvent
+func (t *Event) FromNewInternalNoteEvent(v NewInternalNoteEvent) error {
+	t.EventType = "NewInternalNoteEvent"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeNewInternalNoteEvent performs a merge with any union data inside the Event, using the provided NewInternalNoteEvent
+func (t *Event) MergeNewInternalNoteEvent(v NewInternalNoteEvent) error {
+	t.EventType = "NewInternalNoteEvent"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
+// AsMessageBlockedEvent returns the union data inside the Event as a MessageBlockedEvent
+func (t Event) AsMessageBlockedEvent() (MessageBlockedEvent, error) {
+	var body MessageBlockedEvent
+	err := json.Unmarshal(t.union, &body)
+	return body, err
+}
+
+// FromMessageBlockedEvent overwrites any union data inside the Event as the provided MessageBlockedEvent
+func (t *Event) FromMessageBlockedEvent(v MessageBlockedEvent) error {
+	t.EventType = "MessageBlockedEvent"
+
+	b, err := json.Marshal(v)
+	t.union = b
+	return err
+}
+
+// MergeMessageBlockedEvent performs a merge with any union data inside the Event, using the provided MessageBlockedEvent
+func (t *Event) MergeMessageBlockedEvent(v MessageBlockedEvent) error {
+	t.EventType = "MessageBlockedEvent"
+
+	b, err := json.Marshal(v)
+	if err != nil {
+		return err
+	}
+
+	merged, err := runtime.JsonMerge(t.union, b)
+	t.union = merged
+	return err
+}
+
 func (t Event) Discriminator() (string, error) {
 	var discriminator struct {
 		Discriminator string `json:"eventType"`
@@ -170,8 +250,12 @@ func (t Event) ValueByDiscriminator() (interface{}, error) {
 	switch discriminator {
 	case "ChatClosedEvent":
 		return t.AsChatClosedEvent()
+	case "MessageBlockedEvent":
+		return t.AsMessageBlockedEvent()
 	case "NewChatEvent":
 		return t.AsNewChatEvent()
+	case "NewInternalNoteEvent":
+		return t.AsNewInternalNoteEvent()
 	case "NewMessageEvent":
 		return t.AsNewMessageEvent()
 	default:
@@ -225,18 +309,20 @@ func (t *Event) UnmarshalJSON(b []byte) error {
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+xWTW/bOBD9K8LsAnuhLWf3Eui2SYoihyRFk56CHGhpLDGWSJUzshsY+u/FUIo/laRI",
-	"2yCH+mKanCHfvDeP9ApSV9XOomWCZAWUFljpMDzRhB8WaFl+1N7V6NlgWEKZPs9kOHO+0gwJNI3JQAE/",
-	"1AgJEHtjc1DwbZS7UT8pXzQOe56fba+NTFU73x2kuYAEcsNFMx2nrorn2mtqrJvrysRpoXlE6BcmxdhY",
-	"Rm91GYeNoW1Vh+wmHLfaw9Iq8Pi1QXo18s99+m/B3sMzHjNIbtcUb4PeLu9uDdhN7zFlKe+00HxaOsJs",
-	"rZsuy6sZJLcr+NvjDBL4K94IHvdqxxupW7WvdartjZ7jhfP4ybtpiRVtcTt1rkRt5XQp77XMCvI3oXWo",
-	"mjX0Q07vWgVrLjNDqTeVsZqdl4lK17VUlKwOqR8mej9MwSUuZfLZrJ2YkHKBRDrHl7J2wlr1qOzDpa6k",
-	"yk03tQqcxR/olB0o0iwvBO8heD5+n532Tg1dPE/Ye8hAT1pll/Y/PhFcpfmJW/0LoX8bB3f0beFVg9wP",
-	"m/nAO79Cet1w4fz7Y07B1GUPgy/hu21Cj5ox+593oGWaccSmwgN8rYKq0/O11fTt8Ca9u4GqNk3Tq6S2",
-	"OntNwlATy6bGzlzQ1XApqyfazqPrphbQkegTXWirc/RR6Fl54xboyTgLCSyOwn1fo9W1gQT+Gx+NJ6BC",
-	"paGfY+JmKoMcu4cP5eGruUs/56ghpGjmfJSjRa/Z2DwK9y2Noysu0C8NYWQ4yhyS/YfHEM6TSGdFKPiI",
-	"fC2HCD9UO0udk/6dTMJd6iw/urOuS5OGxPieBMDjn1QZPefY3q39R4GIhJ6C03crOsMFlq6u0HLURYGC",
-	"xpeQwJKSOC5dqsvCESfHk+OjeEkiw/cAAAD//9rdvLI6CwAA",
+	"H4sIAAAAAAAC/+xYwW7bRhD9lcW0QC8r0W4vAW+x0xY62Cka9xT4sCJH4kbkDrszlGsI/Pdil4wkSoxl",
+	"CImRAPZFFDmz++a9ecO1NpBRVZNDJwzpBjgrsDLx8sow/r5GJ+FL7alGLxbjIwy3Z3m4XJCvjEAKTWNz",
+	"0CCPNUIKLN66JWj4b7KkSX8zfPA0rjl7t/9sYquafLeRkQJSWFopmvk0oypZGW+4cbQylU2ywsiE0a9t",
+	"hol1gt6ZMokLQ9vqDtld3G5zgKXV4PHfBvls5H/36d8Eew/Peswh/bileB/0fnn3W8A0/4SZhPKuCyPX",
+	"JTHmW91MWb5fQPpxAz97XEAKPyU7wZNe7WQndasPtc6MuzMrvCGPf3mal1jxHrdzohKNC7uH8s5lNiB/",
+	"EVrHqtlCP+b0vtWw5TK3nHlbWWeEfLhRmboOFaWbY+rHiT4M03CDzGaJVyVlqxPJY6EabvEhrPpk5iAm",
+	"psx6nm5J8FTqcWxcoodzKnsQ1urP3fV4ayqEdK+jWw3k8BndOqim1SeDDxA8HX+o0DPWP+bnVNKYku29",
+	"HpuyX5hlY9Pii3NhtMe+xmyouoXPdX2P60WMv4M64nINOQZv12LJQQp3BarKOLNEr/pEVWCZqwV59faP",
+	"a2VZzTs2lXF5+OpIVI6lXaPHXAkpKVBlpUUn06DB0Kavgzngiuyci+wfRv8yr4yOvj28epT78bfH+Kz9",
+	"GvqbRgry3x99GuaUP46ev77bTvRoBPO3MoCWG8GJ2AqP8LX6xxx9etc0vUp6r723JDxzQrKYxSIMPlTW",
+	"dfOuMDJVM4kDEdfoFaOT8Wk4PD68GuLVED+UIeKi1i0o6mqlDE+vjFupD00dQKugj7rpTxGxZxk0rNFz",
+	"Z6H1ZTzy1uhMbSGF36aX0wvQsdLYzwlLMw8XS+z+/xg4cCaqYeR4JFmiQ2/EuqWKJ0GeqvdSoH+wjMqK",
+	"ygnZ/SJTiPuFSHJBKPgT5UPYJPDDNTnunPTrxUX4yMjJZ3fWdWmzmJh8YnK73wogfdqxvVv7Pw1BJPQc",
+	"nT6s6B2usaS6CiOjiwINjS8hhQdOk6SkzJQFsaRvLt5cJg8cZPh/AIPWMOrBEAAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
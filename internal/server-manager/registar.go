@@ -1,6 +1,7 @@
 package servermanager
 
 import (
+	"github.com/karasunokami/chat-service/internal/middlewares"
 	managerv1 "github.com/karasunokami/chat-service/internal/server-manager/v1"
 
 	oapimdlwr "github.com/deepmap/oapi-codegen/pkg/middleware"
@@ -9,19 +10,45 @@ import (
 	"github.com/labstack/echo/v4"
 )
 
+const serverName = "manager"
+
+// Security schemes of the v1 API.
+const (
+	managerSecurityScheme    = "bearerAuth"
+	supervisorSecurityScheme = "supervisorAuth"
+)
+
+// NewAuthenticationFunc checks the resource role required by the security scheme of the operation.
+// The supervisor operations are forbidden if the supervisorRole is empty.
+func NewAuthenticationFunc(resource, managerRole, supervisorRole string) openapi3filter.AuthenticationFunc {
+	return middlewares.NewResourceRoleAuthenticationFunc(resource, map[string]string{
+		managerSecurityScheme:    managerRole,
+		supervisorSecurityScheme: supervisorRole,
+	})
+}
+
 func NewHandlersRegistrar(
 	v1Swagger *openapi3.T,
 	v1Handlers managerv1.ServerInterface,
 	httpErrorHandler echo.HTTPErrorHandler,
+	authenticationFunc openapi3filter.AuthenticationFunc,
+	rateLimiter middlewares.RateLimiter, // Optional.
 ) func(e *echo.Echo) {
 	return func(e *echo.Echo) {
-		v1 := e.Group("v1", oapimdlwr.OapiRequestValidatorWithOptions(v1Swagger, &oapimdlwr.Options{
-			Options: openapi3filter.Options{
-				ExcludeRequestBody:  false,
-				ExcludeResponseBody: true,
-				AuthenticationFunc:  openapi3filter.NoopAuthenticationFunc,
-			},
-		}))
+		mws := []echo.MiddlewareFunc{middlewares.NewMetricsMiddleware(serverName, v1Swagger)}
+		if rateLimiter != nil {
+			mws = append(mws, middlewares.NewRateLimitMiddleware(serverName, v1Swagger, rateLimiter))
+		}
+
+		v1 := e.Group("v1", append(mws,
+			oapimdlwr.OapiRequestValidatorWithOptions(v1Swagger, &oapimdlwr.Options{
+				Options: openapi3filter.Options{
+					ExcludeRequestBody:  false,
+					ExcludeResponseBody: true,
+					AuthenticationFunc:  authenticationFunc,
+				},
+			}),
+		)...)
 		managerv1.RegisterHandlers(v1, v1Handlers)
 
 		e.HTTPErrorHandler = httpErrorHandler
@@ -34,10 +34,11 @@ func (h Handlers) PostGetChatHistory(eCtx echo.Context, params PostGetChatHistor
 	page := make([]Message, 0, len(resp.Messages))
 	for _, m := range resp.Messages {
 		mm := Message{
-			AuthorId:  m.AuthorID,
-			Body:      m.Body,
-			CreatedAt: m.CreatedAt,
-			Id:        m.ID,
+			AuthorId:       m.AuthorID,
+			Body:           m.Body,
+			CreatedAt:      m.CreatedAt,
+			Id:             m.ID,
+			IsInternalNote: m.IsInternalNote,
 		}
 		page = append(page, mm)
 	}
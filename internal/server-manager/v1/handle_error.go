@@ -6,11 +6,22 @@ import (
 
 	internalerrors "github.com/karasunokami/chat-service/internal/errors"
 	canreceiveproblems "github.com/karasunokami/chat-service/internal/usecases/manager/can-receive-problems"
+	cancelscheduledmessage "github.com/karasunokami/chat-service/internal/usecases/manager/cancel-scheduled-message"
 	closechat "github.com/karasunokami/chat-service/internal/usecases/manager/close-chat"
 	freehands "github.com/karasunokami/chat-service/internal/usecases/manager/free-hands"
 	getchats "github.com/karasunokami/chat-service/internal/usecases/manager/get-chats"
 	gethistory "github.com/karasunokami/chat-service/internal/usecases/manager/get-history"
+	getscheduledmessages "github.com/karasunokami/chat-service/internal/usecases/manager/get-scheduled-messages"
+	schedulemessage "github.com/karasunokami/chat-service/internal/usecases/manager/schedule-message"
+	sendinternalnote "github.com/karasunokami/chat-service/internal/usecases/manager/send-internal-note"
 	sendmessage "github.com/karasunokami/chat-service/internal/usecases/manager/send-message"
+	setstatus "github.com/karasunokami/chat-service/internal/usecases/manager/set-status"
+	getchathistory "github.com/karasunokami/chat-service/internal/usecases/supervisor/get-chat-history"
+	getmanagerstatuses "github.com/karasunokami/chat-service/internal/usecases/supervisor/get-manager-statuses"
+	getmessagesforreview "github.com/karasunokami/chat-service/internal/usecases/supervisor/get-messages-for-review"
+	getopenproblems "github.com/karasunokami/chat-service/internal/usecases/supervisor/get-open-problems"
+	resolvemessagereview "github.com/karasunokami/chat-service/internal/usecases/supervisor/resolve-message-review"
+	sendwhisper "github.com/karasunokami/chat-service/internal/usecases/supervisor/send-whisper"
 )
 
 const defaultHandleErrorMessage = "cannot handle something"
@@ -27,12 +38,35 @@ func getErrorCode(err error) int {
 		errors.Is(err, sendmessage.ErrInvalidRequest),
 		errors.Is(err, gethistory.ErrInvalidRequest),
 		errors.Is(err, gethistory.ErrInvalidCursor),
-		errors.Is(err, closechat.ErrInvalidRequest):
+		errors.Is(err, closechat.ErrInvalidRequest),
+		errors.Is(err, schedulemessage.ErrInvalidRequest),
+		errors.Is(err, schedulemessage.ErrDeliverAtInPast),
+		errors.Is(err, schedulemessage.ErrDeliverAtTooLate),
+		errors.Is(err, getscheduledmessages.ErrInvalidRequest),
+		errors.Is(err, cancelscheduledmessage.ErrInvalidRequest),
+		errors.Is(err, getopenproblems.ErrInvalidRequest),
+		errors.Is(err, getchathistory.ErrInvalidRequest),
+		errors.Is(err, getchathistory.ErrInvalidCursor),
+		errors.Is(err, sendinternalnote.ErrInvalidRequest),
+		errors.Is(err, sendwhisper.ErrInvalidRequest),
+		errors.Is(err, setstatus.ErrInvalidRequest),
+		errors.Is(err, getmanagerstatuses.ErrInvalidRequest),
+		errors.Is(err, getmessagesforreview.ErrInvalidRequest),
+		errors.Is(err, resolvemessagereview.ErrInvalidRequest):
 		return http.StatusBadRequest
 	case errors.Is(err, freehands.ErrManagerOverload):
 		return int(ErrorCodeFreeHandsManagerOverloadError)
-	case errors.Is(err, closechat.ErrProblemNotFound):
+	case errors.Is(err, freehands.ErrManagerNotOnline):
+		return int(ErrorCodeFreeHandsManagerNotOnlineError)
+	case errors.Is(err, closechat.ErrProblemNotFound),
+		errors.Is(err, schedulemessage.ErrProblemNotFound),
+		errors.Is(err, sendinternalnote.ErrProblemNotFound),
+		errors.Is(err, sendwhisper.ErrProblemNotFound):
 		return int(ErrorCodeProblemNotFoundError)
+	case errors.Is(err, cancelscheduledmessage.ErrScheduledMessageNotFound):
+		return int(ErrorCodeScheduledMessageNotFoundError)
+	case errors.Is(err, resolvemessagereview.ErrMessageNotUnderReview):
+		return int(ErrorCodeMessageNotUnderReviewError)
 	}
 
 	return http.StatusInternalServerError
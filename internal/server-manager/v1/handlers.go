@@ -5,11 +5,22 @@ import (
 	"fmt"
 
 	canreceiveproblems "github.com/karasunokami/chat-service/internal/usecases/manager/can-receive-problems"
+	cancelscheduledmessage "github.com/karasunokami/chat-service/internal/usecases/manager/cancel-scheduled-message"
 	closechat "github.com/karasunokami/chat-service/internal/usecases/manager/close-chat"
 	freehands "github.com/karasunokami/chat-service/internal/usecases/manager/free-hands"
 	getchats "github.com/karasunokami/chat-service/internal/usecases/manager/get-chats"
 	gethistory "github.com/karasunokami/chat-service/internal/usecases/manager/get-history"
+	getscheduledmessages "github.com/karasunokami/chat-service/internal/usecases/manager/get-scheduled-messages"
+	schedulemessage "github.com/karasunokami/chat-service/internal/usecases/manager/schedule-message"
+	sendinternalnote "github.com/karasunokami/chat-service/internal/usecases/manager/send-internal-note"
 	sendmessage "github.com/karasunokami/chat-service/internal/usecases/manager/send-message"
+	setstatus "github.com/karasunokami/chat-service/internal/usecases/manager/set-status"
+	getchathistory "github.com/karasunokami/chat-service/internal/usecases/supervisor/get-chat-history"
+	getmanagerstatuses "github.com/karasunokami/chat-service/internal/usecases/supervisor/get-manager-statuses"
+	getmessagesforreview "github.com/karasunokami/chat-service/internal/usecases/supervisor/get-messages-for-review"
+	getopenproblems "github.com/karasunokami/chat-service/internal/usecases/supervisor/get-open-problems"
+	resolvemessagereview "github.com/karasunokami/chat-service/internal/usecases/supervisor/resolve-message-review"
+	sendwhisper "github.com/karasunokami/chat-service/internal/usecases/supervisor/send-whisper"
 )
 
 //go:generate mockgen -source=$GOFILE -destination=mocks/handlers_mocks.gen.go -package=managerv1mocks
@@ -22,6 +33,10 @@ type freeHandsUseCase interface {
 	Handle(ctx context.Context, req freehands.Request) error
 }
 
+type setStatusUseCase interface {
+	Handle(ctx context.Context, req setstatus.Request) (setstatus.Response, error)
+}
+
 type getChatsUseCase interface {
 	Handle(ctx context.Context, req getchats.Request) (getchats.Response, error)
 }
@@ -34,18 +49,71 @@ type sendMessageUseCase interface {
 	Handle(ctx context.Context, req sendmessage.Request) (sendmessage.Response, error)
 }
 
+type sendInternalNoteUseCase interface {
+	Handle(ctx context.Context, req sendinternalnote.Request) (sendinternalnote.Response, error)
+}
+
 type closeChatUseCase interface {
 	Handle(ctx context.Context, req closechat.Request) error
 }
 
+type scheduleMessageUseCase interface {
+	Handle(ctx context.Context, req schedulemessage.Request) (schedulemessage.Response, error)
+}
+
+type getScheduledMessagesUseCase interface {
+	Handle(ctx context.Context, req getscheduledmessages.Request) (getscheduledmessages.Response, error)
+}
+
+type cancelScheduledMessageUseCase interface {
+	Handle(ctx context.Context, req cancelscheduledmessage.Request) error
+}
+
+type getOpenProblemsUseCase interface {
+	Handle(ctx context.Context, req getopenproblems.Request) (getopenproblems.Response, error)
+}
+
+type supervisorGetHistoryUseCase interface {
+	Handle(ctx context.Context, req getchathistory.Request) (getchathistory.Response, error)
+}
+
+type getManagerStatusesUseCase interface {
+	Handle(ctx context.Context, req getmanagerstatuses.Request) (getmanagerstatuses.Response, error)
+}
+
+type sendWhisperUseCase interface {
+	Handle(ctx context.Context, req sendwhisper.Request) (sendwhisper.Response, error)
+}
+
+type getMessagesForReviewUseCase interface {
+	Handle(ctx context.Context, req getmessagesforreview.Request) (getmessagesforreview.Response, error)
+}
+
+type resolveMessageReviewUseCase interface {
+	Handle(ctx context.Context, req resolvemessagereview.Request) error
+}
+
 //go:generate options-gen --out-filename=handlers_options.gen.go --from-struct=Options
 type Options struct {
 	canReceiveProblems canReceiveProblemsUseCase `option:"mandatory" validate:"required"`
 	freeHands          freeHandsUseCase          `option:"mandatory" validate:"required"`
+	setStatus          setStatusUseCase          `option:"mandatory" validate:"required"`
 	getChats           getChatsUseCase           `option:"mandatory" validate:"required"`
 	getHistory         getHistoryUseCase         `option:"mandatory" validate:"required"`
 	sendMessage        sendMessageUseCase        `option:"mandatory" validate:"required"`
 	closeChat          closeChatUseCase          `option:"mandatory" validate:"required"`
+	sendInternalNote   sendInternalNoteUseCase   `option:"mandatory" validate:"required"`
+
+	scheduleMessage        scheduleMessageUseCase        `option:"mandatory" validate:"required"`
+	getScheduledMessages   getScheduledMessagesUseCase   `option:"mandatory" validate:"required"`
+	cancelScheduledMessage cancelScheduledMessageUseCase `option:"mandatory" validate:"required"`
+
+	getOpenProblems      getOpenProblemsUseCase      `option:"mandatory" validate:"required"`
+	supervisorGetHistory supervisorGetHistoryUseCase `option:"mandatory" validate:"required"`
+	sendWhisper          sendWhisperUseCase          `option:"mandatory" validate:"required"`
+	getManagerStatuses   getManagerStatusesUseCase   `option:"mandatory" validate:"required"`
+	getMessagesForReview getMessagesForReviewUseCase `option:"mandatory" validate:"required"`
+	resolveMessageReview resolveMessageReviewUseCase `option:"mandatory" validate:"required"`
 }
 
 type Handlers struct {
@@ -0,0 +1,33 @@
+package managerv1
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/karasunokami/chat-service/internal/middlewares"
+	cancelscheduledmessage "github.com/karasunokami/chat-service/internal/usecases/manager/cancel-scheduled-message"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (h Handlers) PostCancelScheduledMessage(eCtx echo.Context, params PostCancelScheduledMessageParams) error {
+	ctx := eCtx.Request().Context()
+	managerID := middlewares.MustUserID(eCtx)
+
+	req := CancelScheduledMessageRequest{}
+	err := eCtx.Bind(&req)
+	if err != nil {
+		return fmt.Errorf("bind request, err=%w", err)
+	}
+
+	err = h.cancelScheduledMessage.Handle(ctx, cancelscheduledmessage.Request{
+		ID:                 params.XRequestID,
+		ManagerID:          managerID,
+		ScheduledMessageID: req.Id,
+	})
+	if err != nil {
+		return newHandleError(err, getErrorCode(err))
+	}
+
+	return eCtx.JSON(http.StatusOK, CancelScheduledMessageResponse{})
+}
@@ -0,0 +1,53 @@
+package managerv1_test
+
+import (
+	"fmt"
+	"net/http"
+
+	internalerrors "github.com/karasunokami/chat-service/internal/errors"
+	managerv1 "github.com/karasunokami/chat-service/internal/server-manager/v1"
+	"github.com/karasunokami/chat-service/internal/types"
+	cancelscheduledmessage "github.com/karasunokami/chat-service/internal/usecases/manager/cancel-scheduled-message"
+)
+
+func (s *HandlersSuite) TestCancelScheduledMessage_UseCase_NotFoundError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	msgID := types.NewScheduledMessageID()
+
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/cancelScheduledMessage", fmt.Sprintf(`{"id": %q}`, msgID))
+	s.cancelScheduledMessageUseCase.EXPECT().Handle(eCtx.Request().Context(), cancelscheduledmessage.Request{
+		ID:                 reqID,
+		ManagerID:          s.managerID,
+		ScheduledMessageID: msgID,
+	}).Return(cancelscheduledmessage.ErrScheduledMessageNotFound)
+
+	// Action.
+	err := s.handlers.PostCancelScheduledMessage(eCtx, managerv1.PostCancelScheduledMessageParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.EqualValues(managerv1.ErrorCodeScheduledMessageNotFoundError, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestCancelScheduledMessage_Usecase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	msgID := types.NewScheduledMessageID()
+
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/cancelScheduledMessage", fmt.Sprintf(`{"id": %q}`, msgID))
+	s.cancelScheduledMessageUseCase.EXPECT().Handle(eCtx.Request().Context(), cancelscheduledmessage.Request{
+		ID:                 reqID,
+		ManagerID:          s.managerID,
+		ScheduledMessageID: msgID,
+	}).Return(nil)
+
+	// Action.
+	err := s.handlers.PostCancelScheduledMessage(eCtx, managerv1.PostCancelScheduledMessageParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(`{"data": null}`, resp.Body.String())
+}
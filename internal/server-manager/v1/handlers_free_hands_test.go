@@ -87,6 +87,24 @@ func (s *HandlersSuite) TestFreeHands_Usecase_ManagerOverloadError() {
 	s.Empty(resp.Body)
 }
 
+func (s *HandlersSuite) TestFreeHands_Usecase_ManagerNotOnlineError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/freeHands", "")
+	s.freeHandsUseCase.EXPECT().Handle(eCtx.Request().Context(), freehands.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+	}).Return(freehands.ErrManagerNotOnline)
+
+	// Action.
+	err := s.handlers.PostFreeHands(eCtx, managerv1.PostFreeHandsParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.EqualValues(managerv1.ErrorCodeFreeHandsManagerNotOnlineError, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
 func (s *HandlersSuite) TestFreeHands_Usecase_Success() {
 	// Arrange.
 	reqID := types.NewRequestID()
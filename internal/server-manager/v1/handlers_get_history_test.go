@@ -100,10 +100,11 @@ func (s *HandlersSuite) TestGetHistory_Usecase_Success() {
 			CreatedAt: time.Unix(1, 1).UTC(),
 		},
 		{
-			ID:        types.NewMessageID(),
-			AuthorID:  types.NewUserID(),
-			Body:      "hello 2!",
-			CreatedAt: time.Unix(2, 2).UTC(),
+			ID:             types.NewMessageID(),
+			AuthorID:       types.NewUserID(),
+			Body:           "hello 2!",
+			CreatedAt:      time.Unix(2, 2).UTC(),
+			IsInternalNote: true,
 		},
 	}
 	s.getHistoryUseCase.EXPECT().Handle(eCtx.Request().Context(), gethistory.Request{
@@ -131,13 +132,15 @@ func (s *HandlersSuite) TestGetHistory_Usecase_Success() {
                 "authorId": %q,
                 "body": "hello!",
                 "createdAt": "1970-01-01T00:00:01.000000001Z",
-                "id": %q
+                "id": %q,
+                "isInternalNote": false
             },
             {
                 "authorId": %q,
                 "body": "hello 2!",
                 "createdAt": "1970-01-01T00:00:02.000000002Z",
-                "id": %q
+                "id": %q,
+                "isInternalNote": true
             }
         ],
         "next": ""
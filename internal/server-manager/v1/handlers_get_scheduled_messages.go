@@ -0,0 +1,38 @@
+package managerv1
+
+import (
+	"net/http"
+
+	"github.com/karasunokami/chat-service/internal/middlewares"
+	getscheduledmessages "github.com/karasunokami/chat-service/internal/usecases/manager/get-scheduled-messages"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (h Handlers) PostGetScheduledMessages(eCtx echo.Context, params PostGetScheduledMessagesParams) error {
+	ctx := eCtx.Request().Context()
+	managerID := middlewares.MustUserID(eCtx)
+
+	resp, err := h.getScheduledMessages.Handle(ctx, getscheduledmessages.Request{
+		ID:        params.XRequestID,
+		ManagerID: managerID,
+	})
+	if err != nil {
+		return newHandleError(err, getErrorCode(err))
+	}
+
+	msgs := make([]ScheduledMessage, 0, len(resp.Messages))
+	for _, m := range resp.Messages {
+		msgs = append(msgs, ScheduledMessage{
+			Id:        m.ID,
+			ChatId:    m.ChatID,
+			Body:      m.Body,
+			DeliverAt: m.DeliverAt,
+			CreatedAt: m.CreatedAt,
+		})
+	}
+
+	return eCtx.JSON(http.StatusOK, GetScheduledMessagesResponse{
+		Data: &ScheduledMessageList{Messages: msgs},
+	})
+}
@@ -0,0 +1,75 @@
+package managerv1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	managerv1 "github.com/karasunokami/chat-service/internal/server-manager/v1"
+	"github.com/karasunokami/chat-service/internal/types"
+	getscheduledmessages "github.com/karasunokami/chat-service/internal/usecases/manager/get-scheduled-messages"
+)
+
+func (s *HandlersSuite) TestGetScheduledMessages_Usecase_Error() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/getScheduledMessages", "")
+	s.getScheduledMessagesUseCase.EXPECT().Handle(eCtx.Request().Context(), getscheduledmessages.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+	}).Return(getscheduledmessages.Response{}, errors.New("something went wrong"))
+
+	// Action.
+	err := s.handlers.PostGetScheduledMessages(eCtx, managerv1.PostGetScheduledMessagesParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestGetScheduledMessages_Usecase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/getScheduledMessages", "")
+
+	msgID := types.NewScheduledMessageID()
+	chatID := types.NewChatID()
+	s.getScheduledMessagesUseCase.EXPECT().Handle(eCtx.Request().Context(), getscheduledmessages.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+	}).Return(getscheduledmessages.Response{
+		Messages: []getscheduledmessages.Message{
+			{
+				ID:        msgID,
+				ChatID:    chatID,
+				Body:      "Good morning!",
+				DeliverAt: time.Date(2030, 1, 1, 10, 0, 0, 0, time.UTC),
+				CreatedAt: time.Unix(1, 1).UTC(),
+			},
+		},
+	}, nil)
+
+	// Action.
+	err := s.handlers.PostGetScheduledMessages(eCtx, managerv1.PostGetScheduledMessagesParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(fmt.Sprintf(`
+{
+    "data":
+    {
+        "messages":
+        [
+            {
+                "id": %q,
+                "chatId": %q,
+                "body": "Good morning!",
+                "deliverAt": "2030-01-01T10:00:00Z",
+                "createdAt": "1970-01-01T00:00:01.000000001Z"
+            }
+        ]
+    }
+}`, msgID, chatID), resp.Body.String())
+}
@@ -13,10 +13,21 @@ type OptOptionsSetter func(o *Options)
 func NewOptions(
 	canReceiveProblems canReceiveProblemsUseCase,
 	freeHands freeHandsUseCase,
+	setStatus setStatusUseCase,
 	getChats getChatsUseCase,
 	getHistory getHistoryUseCase,
 	sendMessage sendMessageUseCase,
 	closeChat closeChatUseCase,
+	sendInternalNote sendInternalNoteUseCase,
+	scheduleMessage scheduleMessageUseCase,
+	getScheduledMessages getScheduledMessagesUseCase,
+	cancelScheduledMessage cancelScheduledMessageUseCase,
+	getOpenProblems getOpenProblemsUseCase,
+	supervisorGetHistory supervisorGetHistoryUseCase,
+	sendWhisper sendWhisperUseCase,
+	getManagerStatuses getManagerStatusesUseCase,
+	getMessagesForReview getMessagesForReviewUseCase,
+	resolveMessageReview resolveMessageReviewUseCase,
 	options ...OptOptionsSetter,
 ) Options {
 	o := Options{}
@@ -25,10 +36,21 @@ func NewOptions(
 
 	o.canReceiveProblems = canReceiveProblems
 	o.freeHands = freeHands
+	o.setStatus = setStatus
 	o.getChats = getChats
 	o.getHistory = getHistory
 	o.sendMessage = sendMessage
 	o.closeChat = closeChat
+	o.sendInternalNote = sendInternalNote
+	o.scheduleMessage = scheduleMessage
+	o.getScheduledMessages = getScheduledMessages
+	o.cancelScheduledMessage = cancelScheduledMessage
+	o.getOpenProblems = getOpenProblems
+	o.supervisorGetHistory = supervisorGetHistory
+	o.sendWhisper = sendWhisper
+	o.getManagerStatuses = getManagerStatuses
+	o.getMessagesForReview = getMessagesForReview
+	o.resolveMessageReview = resolveMessageReview
 
 	for _, opt := range options {
 		opt(&o)
@@ -40,10 +62,21 @@ func (o *Options) Validate() error {
 	errs := new(errors461e464ebed9.ValidationErrors)
 	errs.Add(errors461e464ebed9.NewValidationError("canReceiveProblems", _validate_Options_canReceiveProblems(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("freeHands", _validate_Options_freeHands(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("setStatus", _validate_Options_setStatus(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("getChats", _validate_Options_getChats(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("getHistory", _validate_Options_getHistory(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("sendMessage", _validate_Options_sendMessage(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("closeChat", _validate_Options_closeChat(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("sendInternalNote", _validate_Options_sendInternalNote(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("scheduleMessage", _validate_Options_scheduleMessage(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("getScheduledMessages", _validate_Options_getScheduledMessages(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("cancelScheduledMessage", _validate_Options_cancelScheduledMessage(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("getOpenProblems", _validate_Options_getOpenProblems(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("supervisorGetHistory", _validate_Options_supervisorGetHistory(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("sendWhisper", _validate_Options_sendWhisper(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("getManagerStatuses", _validate_Options_getManagerStatuses(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("getMessagesForReview", _validate_Options_getMessagesForReview(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("resolveMessageReview", _validate_Options_resolveMessageReview(o)))
 	return errs.AsError()
 }
 
@@ -61,6 +94,13 @@ func _validate_Options_freeHands(o *Options) error {
 	return nil
 }
 
+func _validate_Options_setStatus(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.setStatus, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `setStatus` did not pass the test: %w", err)
+	}
+	return nil
+}
+
 func _validate_Options_getChats(o *Options) error {
 	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.getChats, "required"); err != nil {
 		return fmt461e464ebed9.Errorf("field `getChats` did not pass the test: %w", err)
@@ -88,3 +128,73 @@ func _validate_Options_closeChat(o *Options) error {
 	}
 	return nil
 }
+
+func _validate_Options_sendInternalNote(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.sendInternalNote, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `sendInternalNote` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_scheduleMessage(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.scheduleMessage, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `scheduleMessage` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_getScheduledMessages(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.getScheduledMessages, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `getScheduledMessages` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_cancelScheduledMessage(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.cancelScheduledMessage, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `cancelScheduledMessage` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_getOpenProblems(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.getOpenProblems, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `getOpenProblems` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_supervisorGetHistory(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.supervisorGetHistory, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `supervisorGetHistory` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_sendWhisper(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.sendWhisper, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `sendWhisper` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_getManagerStatuses(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.getManagerStatuses, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `getManagerStatuses` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_getMessagesForReview(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.getMessagesForReview, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `getMessagesForReview` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_resolveMessageReview(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.resolveMessageReview, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `resolveMessageReview` did not pass the test: %w", err)
+	}
+	return nil
+}
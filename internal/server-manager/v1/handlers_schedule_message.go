@@ -0,0 +1,41 @@
+package managerv1
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/karasunokami/chat-service/internal/middlewares"
+	schedulemessage "github.com/karasunokami/chat-service/internal/usecases/manager/schedule-message"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (h Handlers) PostScheduleMessage(eCtx echo.Context, params PostScheduleMessageParams) error {
+	ctx := eCtx.Request().Context()
+	managerID := middlewares.MustUserID(eCtx)
+
+	req := ScheduleMessageRequest{}
+	err := eCtx.Bind(&req)
+	if err != nil {
+		return fmt.Errorf("bind request, err=%w", err)
+	}
+
+	resp, err := h.scheduleMessage.Handle(ctx, schedulemessage.Request{
+		ID:          params.XRequestID,
+		ManagerID:   managerID,
+		ChatID:      req.ChatId,
+		MessageBody: req.MessageBody,
+		DeliverAt:   req.DeliverAt,
+	})
+	if err != nil {
+		return newHandleError(err, getErrorCode(err))
+	}
+
+	return eCtx.JSON(http.StatusOK, ScheduleMessageResponse{
+		Data: &ScheduledMessageWithoutBody{
+			Id:        resp.ScheduledMessageID,
+			DeliverAt: resp.DeliverAt,
+			CreatedAt: resp.CreatedAt,
+		},
+	})
+}
@@ -0,0 +1,117 @@
+package managerv1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	internalerrors "github.com/karasunokami/chat-service/internal/errors"
+	managerv1 "github.com/karasunokami/chat-service/internal/server-manager/v1"
+	"github.com/karasunokami/chat-service/internal/types"
+	schedulemessage "github.com/karasunokami/chat-service/internal/usecases/manager/schedule-message"
+)
+
+func (s *HandlersSuite) TestScheduleMessage_BindRequestError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/scheduleMessage", `{"messageBody": "Can`)
+
+	// Action.
+	err := s.handlers.PostScheduleMessage(eCtx, managerv1.PostScheduleMessageParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusBadRequest, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestScheduleMessage_Usecase_DeliverAtInPast() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+	deliverAt := time.Date(2020, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/scheduleMessage",
+		fmt.Sprintf(`{"messageBody": "Hello", "chatId": %q, "deliverAt": %q}`, chatID, deliverAt.Format(time.RFC3339)))
+
+	s.scheduleMessageUseCase.EXPECT().Handle(eCtx.Request().Context(), schedulemessage.Request{
+		ID:          reqID,
+		ManagerID:   s.managerID,
+		ChatID:      chatID,
+		MessageBody: "Hello",
+		DeliverAt:   deliverAt,
+	}).Return(schedulemessage.Response{}, schedulemessage.ErrDeliverAtInPast)
+
+	// Action.
+	err := s.handlers.PostScheduleMessage(eCtx, managerv1.PostScheduleMessageParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusBadRequest, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestScheduleMessage_Usecase_UnknownError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+	deliverAt := time.Date(2030, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/scheduleMessage",
+		fmt.Sprintf(`{"messageBody": "Hello", "chatId": %q, "deliverAt": %q}`, chatID, deliverAt.Format(time.RFC3339)))
+
+	s.scheduleMessageUseCase.EXPECT().Handle(eCtx.Request().Context(), schedulemessage.Request{
+		ID:          reqID,
+		ManagerID:   s.managerID,
+		ChatID:      chatID,
+		MessageBody: "Hello",
+		DeliverAt:   deliverAt,
+	}).Return(schedulemessage.Response{}, errors.New("something went wrong"))
+
+	// Action.
+	err := s.handlers.PostScheduleMessage(eCtx, managerv1.PostScheduleMessageParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestScheduleMessage_Usecase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+	deliverAt := time.Date(2030, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/scheduleMessage",
+		fmt.Sprintf(`{"messageBody": "Hello", "chatId": %q, "deliverAt": %q}`, chatID, deliverAt.Format(time.RFC3339)))
+
+	msgID := types.NewScheduledMessageID()
+	s.scheduleMessageUseCase.EXPECT().Handle(eCtx.Request().Context(), schedulemessage.Request{
+		ID:          reqID,
+		ManagerID:   s.managerID,
+		ChatID:      chatID,
+		MessageBody: "Hello",
+		DeliverAt:   deliverAt,
+	}).Return(schedulemessage.Response{
+		ScheduledMessageID: msgID,
+		DeliverAt:          deliverAt,
+		CreatedAt:          time.Unix(1, 1).UTC(),
+	}, nil)
+
+	// Action.
+	err := s.handlers.PostScheduleMessage(eCtx, managerv1.PostScheduleMessageParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(fmt.Sprintf(`
+{
+    "data":
+    {
+        "id": %q,
+        "deliverAt": "2030-01-01T10:00:00Z",
+        "createdAt": "1970-01-01T00:00:01.000000001Z"
+    }
+}`, msgID), resp.Body.String())
+}
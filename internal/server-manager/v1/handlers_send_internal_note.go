@@ -0,0 +1,40 @@
+package managerv1
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/karasunokami/chat-service/internal/middlewares"
+	sendinternalnote "github.com/karasunokami/chat-service/internal/usecases/manager/send-internal-note"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (h Handlers) PostSendInternalNote(eCtx echo.Context, params PostSendInternalNoteParams) error {
+	ctx := eCtx.Request().Context()
+	managerID := middlewares.MustUserID(eCtx)
+
+	req := SendInternalNoteRequest{}
+	err := eCtx.Bind(&req)
+	if err != nil {
+		return fmt.Errorf("bind request, err=%w", err)
+	}
+
+	resp, err := h.sendInternalNote.Handle(ctx, sendinternalnote.Request{
+		ID:        params.XRequestID,
+		ManagerID: managerID,
+		ChatID:    req.ChatId,
+		NoteBody:  req.NoteBody,
+	})
+	if err != nil {
+		return newHandleError(err, getErrorCode(err))
+	}
+
+	return eCtx.JSON(http.StatusOK, SendInternalNoteResponse{
+		Data: &MessageWithoutBody{
+			AuthorId:  managerID,
+			CreatedAt: resp.CreatedAt,
+			Id:        resp.MessageID,
+		},
+	})
+}
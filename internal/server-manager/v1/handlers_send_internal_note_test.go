@@ -0,0 +1,110 @@
+package managerv1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	internalerrors "github.com/karasunokami/chat-service/internal/errors"
+	managerv1 "github.com/karasunokami/chat-service/internal/server-manager/v1"
+	"github.com/karasunokami/chat-service/internal/types"
+	sendinternalnote "github.com/karasunokami/chat-service/internal/usecases/manager/send-internal-note"
+)
+
+func (s *HandlersSuite) TestSendInternalNote_BindRequestError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/sendInternalNote", `{"noteBody": "Client`)
+
+	// Action.
+	err := s.handlers.PostSendInternalNote(eCtx, managerv1.PostSendInternalNoteParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusBadRequest, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestSendInternalNote_Usecase_ProblemNotFound() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/sendInternalNote",
+		fmt.Sprintf(`{"noteBody": "Client verified by phone", "chatId": %q}`, chatID))
+
+	s.sendInternalNoteUseCase.EXPECT().Handle(eCtx.Request().Context(), sendinternalnote.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+		ChatID:    chatID,
+		NoteBody:  "Client verified by phone",
+	}).Return(sendinternalnote.Response{}, sendinternalnote.ErrProblemNotFound)
+
+	// Action.
+	err := s.handlers.PostSendInternalNote(eCtx, managerv1.PostSendInternalNoteParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.EqualValues(managerv1.ErrorCodeProblemNotFoundError, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestSendInternalNote_Usecase_UnknownError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/sendInternalNote",
+		fmt.Sprintf(`{"noteBody": "Client verified by phone", "chatId": %q}`, chatID))
+
+	s.sendInternalNoteUseCase.EXPECT().Handle(eCtx.Request().Context(), sendinternalnote.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+		ChatID:    chatID,
+		NoteBody:  "Client verified by phone",
+	}).Return(sendinternalnote.Response{}, errors.New("something went wrong"))
+
+	// Action.
+	err := s.handlers.PostSendInternalNote(eCtx, managerv1.PostSendInternalNoteParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestSendInternalNote_Usecase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/sendInternalNote",
+		fmt.Sprintf(`{"noteBody": "Client verified by phone", "chatId": %q}`, chatID))
+
+	msgID := types.NewMessageID()
+	s.sendInternalNoteUseCase.EXPECT().Handle(eCtx.Request().Context(), sendinternalnote.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+		ChatID:    chatID,
+		NoteBody:  "Client verified by phone",
+	}).Return(sendinternalnote.Response{
+		MessageID: msgID,
+		CreatedAt: time.Unix(1, 1).UTC(),
+	}, nil)
+
+	// Action.
+	err := s.handlers.PostSendInternalNote(eCtx, managerv1.PostSendInternalNoteParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(fmt.Sprintf(`
+{
+    "data":
+    {
+        "authorId": "%s",
+        "createdAt": "1970-01-01T00:00:01.000000001Z",
+        "id": "%s"
+    }
+}`, s.managerID, msgID), resp.Body.String())
+}
@@ -0,0 +1,35 @@
+package managerv1
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/karasunokami/chat-service/internal/middlewares"
+	managerpresence "github.com/karasunokami/chat-service/internal/services/manager-presence"
+	setstatus "github.com/karasunokami/chat-service/internal/usecases/manager/set-status"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (h Handlers) PostSetStatus(eCtx echo.Context, params PostSetStatusParams) error {
+	ctx := eCtx.Request().Context()
+	managerID := middlewares.MustUserID(eCtx)
+
+	req := SetStatusRequest{}
+	if err := eCtx.Bind(&req); err != nil {
+		return fmt.Errorf("bind request, err=%w", err)
+	}
+
+	resp, err := h.setStatus.Handle(ctx, setstatus.Request{
+		ID:        params.XRequestID,
+		ManagerID: managerID,
+		Status:    managerpresence.Status(req.Status),
+	})
+	if err != nil {
+		return newHandleError(err, getErrorCode(err))
+	}
+
+	return eCtx.JSON(http.StatusOK, SetStatusResponse{
+		Data: &ManagerStatusData{Status: ManagerStatus(resp.Status)},
+	})
+}
@@ -0,0 +1,65 @@
+package managerv1_test
+
+import (
+	"net/http"
+
+	internalerrors "github.com/karasunokami/chat-service/internal/errors"
+	managerv1 "github.com/karasunokami/chat-service/internal/server-manager/v1"
+	managerpresence "github.com/karasunokami/chat-service/internal/services/manager-presence"
+	"github.com/karasunokami/chat-service/internal/types"
+	setstatus "github.com/karasunokami/chat-service/internal/usecases/manager/set-status"
+)
+
+func (s *HandlersSuite) TestSetStatus_BindRequestError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/setStatus", `{"status": "aw`)
+
+	// Action.
+	err := s.handlers.PostSetStatus(eCtx, managerv1.PostSetStatusParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusBadRequest, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestSetStatus_Usecase_InvalidRequest() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/setStatus", `{"status": "offline"}`)
+
+	s.setStatusUseCase.EXPECT().Handle(eCtx.Request().Context(), setstatus.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+		Status:    managerpresence.StatusOffline,
+	}).Return(setstatus.Response{}, setstatus.ErrInvalidRequest)
+
+	// Action.
+	err := s.handlers.PostSetStatus(eCtx, managerv1.PostSetStatusParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusBadRequest, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestSetStatus_Usecase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/setStatus", `{"status": "away"}`)
+
+	s.setStatusUseCase.EXPECT().Handle(eCtx.Request().Context(), setstatus.Request{
+		ID:        reqID,
+		ManagerID: s.managerID,
+		Status:    managerpresence.StatusAway,
+	}).Return(setstatus.Response{Status: managerpresence.StatusAway}, nil)
+
+	// Action.
+	err := s.handlers.PostSetStatus(eCtx, managerv1.PostSetStatusParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(`{"data": {"status": "away"}}`, resp.Body.String())
+}
@@ -0,0 +1,48 @@
+package managerv1
+
+import (
+	"net/http"
+
+	"github.com/karasunokami/chat-service/internal/middlewares"
+	getchathistory "github.com/karasunokami/chat-service/internal/usecases/supervisor/get-chat-history"
+	"github.com/karasunokami/chat-service/pkg/pointer"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (h Handlers) PostSupervisorGetChatHistory(eCtx echo.Context, params PostSupervisorGetChatHistoryParams) error {
+	ctx := eCtx.Request().Context()
+	supervisorID := middlewares.MustUserID(eCtx)
+
+	req := GetHistoryRequest{}
+	if err := eCtx.Bind(&req); err != nil {
+		return echo.NewHTTPError(http.StatusBadRequest)
+	}
+
+	resp, err := h.supervisorGetHistory.Handle(ctx, getchathistory.Request{
+		ID:           params.XRequestID,
+		ChatID:       req.ChatId,
+		SupervisorID: supervisorID,
+		PageSize:     pointer.Indirect(req.PageSize),
+		Cursor:       pointer.Indirect(req.Cursor),
+	})
+	if err != nil {
+		return newHandleError(err, getErrorCode(err))
+	}
+
+	page := make([]Message, 0, len(resp.Messages))
+	for _, m := range resp.Messages {
+		page = append(page, Message{
+			AuthorId:       m.AuthorID,
+			Body:           m.Body,
+			CreatedAt:      m.CreatedAt,
+			Id:             m.ID,
+			IsInternalNote: m.IsInternalNote,
+		})
+	}
+
+	return eCtx.JSON(http.StatusOK, GetHistoryResponse{Data: &MessagesPage{
+		Messages: page,
+		Next:     resp.NextCursor,
+	}})
+}
@@ -0,0 +1,121 @@
+package managerv1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	internalerrors "github.com/karasunokami/chat-service/internal/errors"
+	managerv1 "github.com/karasunokami/chat-service/internal/server-manager/v1"
+	"github.com/karasunokami/chat-service/internal/types"
+	getchathistory "github.com/karasunokami/chat-service/internal/usecases/supervisor/get-chat-history"
+)
+
+func (s *HandlersSuite) TestSupervisorGetChatHistory_BindRequestError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/supervisor/getChatHistory", `{"page_size":`)
+
+	// Action.
+	err := s.handlers.PostSupervisorGetChatHistory(eCtx, managerv1.PostSupervisorGetChatHistoryParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusBadRequest, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestSupervisorGetChatHistory_Usecase_InvalidCursor() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/supervisor/getChatHistory",
+		fmt.Sprintf(`{"chatId":%q,"cursor":"abracadabra"}`, chatID))
+	s.supervisorGetHistoryUseCase.EXPECT().Handle(eCtx.Request().Context(), getchathistory.Request{
+		ID:           reqID,
+		ChatID:       chatID,
+		SupervisorID: s.managerID,
+		Cursor:       "abracadabra",
+	}).Return(getchathistory.Response{}, getchathistory.ErrInvalidCursor)
+
+	// Action.
+	err := s.handlers.PostSupervisorGetChatHistory(eCtx, managerv1.PostSupervisorGetChatHistoryParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusBadRequest, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestSupervisorGetChatHistory_Usecase_UnknownError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/supervisor/getChatHistory",
+		fmt.Sprintf(`{"chatId":%q,"pageSize":10}`, chatID))
+	s.supervisorGetHistoryUseCase.EXPECT().Handle(eCtx.Request().Context(), getchathistory.Request{
+		ID:           reqID,
+		ChatID:       chatID,
+		SupervisorID: s.managerID,
+		PageSize:     10,
+	}).Return(getchathistory.Response{}, errors.New("something went wrong"))
+
+	// Action.
+	err := s.handlers.PostSupervisorGetChatHistory(eCtx, managerv1.PostSupervisorGetChatHistoryParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusInternalServerError, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestSupervisorGetChatHistory_Usecase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/supervisor/getChatHistory",
+		fmt.Sprintf(`{"chatId":%q,"pageSize":10}`, chatID))
+
+	msgs := []getchathistory.Message{
+		{
+			ID:        types.NewMessageID(),
+			AuthorID:  types.NewUserID(),
+			Body:      "hello!",
+			CreatedAt: time.Unix(1, 1).UTC(),
+		},
+	}
+	s.supervisorGetHistoryUseCase.EXPECT().Handle(eCtx.Request().Context(), getchathistory.Request{
+		ID:           reqID,
+		ChatID:       chatID,
+		SupervisorID: s.managerID,
+		PageSize:     10,
+	}).Return(getchathistory.Response{
+		Messages:   msgs,
+		NextCursor: "next",
+	}, nil)
+
+	// Action.
+	err := s.handlers.PostSupervisorGetChatHistory(eCtx, managerv1.PostSupervisorGetChatHistoryParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(fmt.Sprintf(`
+{
+    "data":
+    {
+        "messages":
+        [
+            {
+                "authorId": %q,
+                "body": "hello!",
+                "createdAt": "1970-01-01T00:00:01.000000001Z",
+                "id": %q,
+                "isInternalNote": false
+            }
+        ],
+        "next": "next"
+    }
+}`, msgs[0].AuthorID, msgs[0].ID), resp.Body.String())
+}
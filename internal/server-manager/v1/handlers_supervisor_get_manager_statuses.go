@@ -0,0 +1,36 @@
+package managerv1
+
+import (
+	"net/http"
+
+	"github.com/karasunokami/chat-service/internal/middlewares"
+	getmanagerstatuses "github.com/karasunokami/chat-service/internal/usecases/supervisor/get-manager-statuses"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (h Handlers) PostSupervisorGetManagerStatuses(eCtx echo.Context, params PostSupervisorGetManagerStatusesParams) error {
+	ctx := eCtx.Request().Context()
+	supervisorID := middlewares.MustUserID(eCtx)
+
+	resp, err := h.getManagerStatuses.Handle(ctx, getmanagerstatuses.Request{
+		ID:           params.XRequestID,
+		SupervisorID: supervisorID,
+	})
+	if err != nil {
+		return newHandleError(err, getErrorCode(err))
+	}
+
+	managers := make([]ManagerStatusInfo, 0, len(resp.Managers))
+	for _, m := range resp.Managers {
+		managers = append(managers, ManagerStatusInfo{
+			ManagerId: m.ManagerID,
+			Status:    ManagerStatus(m.Status),
+			Since:     m.Since,
+		})
+	}
+
+	return eCtx.JSON(http.StatusOK, GetManagerStatusesResponse{
+		Data: &ManagerStatusList{Managers: managers},
+	})
+}
@@ -0,0 +1,65 @@
+package managerv1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	managerv1 "github.com/karasunokami/chat-service/internal/server-manager/v1"
+	managerpresence "github.com/karasunokami/chat-service/internal/services/manager-presence"
+	"github.com/karasunokami/chat-service/internal/types"
+	getmanagerstatuses "github.com/karasunokami/chat-service/internal/usecases/supervisor/get-manager-statuses"
+)
+
+func (s *HandlersSuite) TestSupervisorGetManagerStatuses_Usecase_Error() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/supervisor/getManagerStatuses", "")
+	s.getManagerStatusesUseCase.EXPECT().Handle(eCtx.Request().Context(), getmanagerstatuses.Request{
+		ID:           reqID,
+		SupervisorID: s.managerID,
+	}).Return(getmanagerstatuses.Response{}, errors.New("something went wrong"))
+
+	// Action.
+	err := s.handlers.PostSupervisorGetManagerStatuses(eCtx,
+		managerv1.PostSupervisorGetManagerStatusesParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestSupervisorGetManagerStatuses_Usecase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/supervisor/getManagerStatuses", "")
+
+	managers := []getmanagerstatuses.ManagerStatus{
+		{ManagerID: types.NewUserID(), Status: managerpresence.StatusOnline, Since: time.Unix(1, 0).UTC()},
+		{ManagerID: types.NewUserID(), Status: managerpresence.StatusOffline, Since: time.Unix(2, 0).UTC()},
+	}
+	s.getManagerStatusesUseCase.EXPECT().Handle(eCtx.Request().Context(), getmanagerstatuses.Request{
+		ID:           reqID,
+		SupervisorID: s.managerID,
+	}).Return(getmanagerstatuses.Response{Managers: managers}, nil)
+
+	// Action.
+	err := s.handlers.PostSupervisorGetManagerStatuses(eCtx,
+		managerv1.PostSupervisorGetManagerStatusesParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(fmt.Sprintf(`
+{
+    "data":
+    {
+        "managers":
+        [
+            {"managerId": %q, "status": "online", "since": "1970-01-01T00:00:01Z"},
+            {"managerId": %q, "status": "offline", "since": "1970-01-01T00:00:02Z"}
+        ]
+    }
+}`, managers[0].ManagerID, managers[1].ManagerID), resp.Body.String())
+}
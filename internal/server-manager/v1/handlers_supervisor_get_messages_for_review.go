@@ -0,0 +1,42 @@
+package managerv1
+
+import (
+	"net/http"
+
+	"github.com/karasunokami/chat-service/internal/middlewares"
+	getmessagesforreview "github.com/karasunokami/chat-service/internal/usecases/supervisor/get-messages-for-review"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (h Handlers) PostSupervisorGetMessagesForReview(
+	eCtx echo.Context,
+	params PostSupervisorGetMessagesForReviewParams,
+) error {
+	ctx := eCtx.Request().Context()
+	supervisorID := middlewares.MustUserID(eCtx)
+
+	resp, err := h.getMessagesForReview.Handle(ctx, getmessagesforreview.Request{
+		ID:           params.XRequestID,
+		SupervisorID: supervisorID,
+	})
+	if err != nil {
+		return newHandleError(err, getErrorCode(err))
+	}
+
+	messages := make([]ReviewMessage, 0, len(resp.Messages))
+	for _, m := range resp.Messages {
+		messages = append(messages, ReviewMessage{
+			Id:                m.ID,
+			ChatId:            m.ChatID,
+			AuthorId:          m.AuthorID,
+			Body:              m.Body,
+			CreatedAt:         m.CreatedAt,
+			ReviewRequestedAt: m.ReviewRequestedAt,
+		})
+	}
+
+	return eCtx.JSON(http.StatusOK, GetMessagesForReviewResponse{
+		Data: &ReviewMessageList{Messages: messages},
+	})
+}
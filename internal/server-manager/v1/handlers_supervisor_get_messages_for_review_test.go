@@ -0,0 +1,74 @@
+package managerv1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	managerv1 "github.com/karasunokami/chat-service/internal/server-manager/v1"
+	"github.com/karasunokami/chat-service/internal/types"
+	getmessagesforreview "github.com/karasunokami/chat-service/internal/usecases/supervisor/get-messages-for-review"
+)
+
+func (s *HandlersSuite) TestSupervisorGetMessagesForReview_Usecase_Error() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/supervisor/getMessagesForReview", "")
+	s.getMessagesForReviewUseCase.EXPECT().Handle(eCtx.Request().Context(), getmessagesforreview.Request{
+		ID:           reqID,
+		SupervisorID: s.managerID,
+	}).Return(getmessagesforreview.Response{}, errors.New("something went wrong"))
+
+	// Action.
+	err := s.handlers.PostSupervisorGetMessagesForReview(eCtx,
+		managerv1.PostSupervisorGetMessagesForReviewParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestSupervisorGetMessagesForReview_Usecase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/supervisor/getMessagesForReview", "")
+
+	msg := getmessagesforreview.Message{
+		ID:                types.NewMessageID(),
+		ChatID:            types.NewChatID(),
+		AuthorID:          types.NewUserID(),
+		Body:              "My card number is 4242 4242 4242 4242",
+		CreatedAt:         time.Unix(1, 0).UTC(),
+		ReviewRequestedAt: time.Unix(2, 0).UTC(),
+	}
+	s.getMessagesForReviewUseCase.EXPECT().Handle(eCtx.Request().Context(), getmessagesforreview.Request{
+		ID:           reqID,
+		SupervisorID: s.managerID,
+	}).Return(getmessagesforreview.Response{Messages: []getmessagesforreview.Message{msg}}, nil)
+
+	// Action.
+	err := s.handlers.PostSupervisorGetMessagesForReview(eCtx,
+		managerv1.PostSupervisorGetMessagesForReviewParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(fmt.Sprintf(`
+{
+    "data":
+    {
+        "messages":
+        [
+            {
+                "id": %q,
+                "chatId": %q,
+                "authorId": %q,
+                "body": "My card number is 4242 4242 4242 4242",
+                "createdAt": "1970-01-01T00:00:01Z",
+                "reviewRequestedAt": "1970-01-01T00:00:02Z"
+            }
+        ]
+    }
+}`, msg.ID, msg.ChatID, msg.AuthorID), resp.Body.String())
+}
@@ -0,0 +1,41 @@
+package managerv1
+
+import (
+	"net/http"
+
+	"github.com/karasunokami/chat-service/internal/middlewares"
+	getopenproblems "github.com/karasunokami/chat-service/internal/usecases/supervisor/get-open-problems"
+	"github.com/karasunokami/chat-service/pkg/pointer"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (h Handlers) PostSupervisorGetOpenProblems(eCtx echo.Context, params PostSupervisorGetOpenProblemsParams) error {
+	ctx := eCtx.Request().Context()
+	supervisorID := middlewares.MustUserID(eCtx)
+
+	resp, err := h.getOpenProblems.Handle(ctx, getopenproblems.Request{
+		ID:           params.XRequestID,
+		SupervisorID: supervisorID,
+	})
+	if err != nil {
+		return newHandleError(err, getErrorCode(err))
+	}
+
+	problems := make([]OpenProblem, 0, len(resp.Problems))
+	for _, p := range resp.Problems {
+		problems = append(problems, OpenProblem{
+			ProblemId:           p.ID,
+			ChatId:              p.ChatID,
+			ClientId:            p.ClientID,
+			ManagerId:           pointer.PtrWithZeroAsNil(p.ManagerID),
+			CreatedAt:           p.CreatedAt,
+			WaitTimeSeconds:     int64(p.WaitTime.Seconds()),
+			ReassignRequestedAt: pointer.PtrWithZeroAsNil(p.ReassignRequestedAt),
+		})
+	}
+
+	return eCtx.JSON(http.StatusOK, GetOpenProblemsResponse{
+		Data: &OpenProblemList{Problems: problems},
+	})
+}
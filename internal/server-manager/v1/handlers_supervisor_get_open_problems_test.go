@@ -0,0 +1,114 @@
+package managerv1_test
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"time"
+
+	internalerrors "github.com/karasunokami/chat-service/internal/errors"
+	managerv1 "github.com/karasunokami/chat-service/internal/server-manager/v1"
+	"github.com/karasunokami/chat-service/internal/types"
+	getopenproblems "github.com/karasunokami/chat-service/internal/usecases/supervisor/get-open-problems"
+)
+
+func (s *HandlersSuite) TestSupervisorGetOpenProblems_Usecase_InvalidRequest() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/supervisor/getOpenProblems", "")
+	s.getOpenProblemsUseCase.EXPECT().Handle(eCtx.Request().Context(), getopenproblems.Request{
+		ID:           reqID,
+		SupervisorID: s.managerID,
+	}).Return(getopenproblems.Response{}, getopenproblems.ErrInvalidRequest)
+
+	// Action.
+	err := s.handlers.PostSupervisorGetOpenProblems(eCtx, managerv1.PostSupervisorGetOpenProblemsParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusBadRequest, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestSupervisorGetOpenProblems_Usecase_UnknownError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/supervisor/getOpenProblems", "")
+	s.getOpenProblemsUseCase.EXPECT().Handle(eCtx.Request().Context(), getopenproblems.Request{
+		ID:           reqID,
+		SupervisorID: s.managerID,
+	}).Return(getopenproblems.Response{}, errors.New("something went wrong"))
+
+	// Action.
+	err := s.handlers.PostSupervisorGetOpenProblems(eCtx, managerv1.PostSupervisorGetOpenProblemsParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusInternalServerError, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestSupervisorGetOpenProblems_Usecase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/supervisor/getOpenProblems", "")
+
+	problems := []getopenproblems.Problem{
+		{
+			ID:        types.NewProblemID(),
+			ChatID:    types.NewChatID(),
+			ClientID:  types.NewUserID(),
+			CreatedAt: time.Unix(1, 0).UTC(),
+			WaitTime:  90 * time.Second,
+		},
+		{
+			ID:        types.NewProblemID(),
+			ChatID:    types.NewChatID(),
+			ClientID:  types.NewUserID(),
+			ManagerID: types.NewUserID(),
+			CreatedAt: time.Unix(2, 0).UTC(),
+			WaitTime:  30*time.Second + 500*time.Millisecond,
+
+			ReassignRequestedAt: time.Unix(3, 0).UTC(),
+		},
+	}
+	s.getOpenProblemsUseCase.EXPECT().Handle(eCtx.Request().Context(), getopenproblems.Request{
+		ID:           reqID,
+		SupervisorID: s.managerID,
+	}).Return(getopenproblems.Response{Problems: problems}, nil)
+
+	// Action.
+	err := s.handlers.PostSupervisorGetOpenProblems(eCtx, managerv1.PostSupervisorGetOpenProblemsParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(fmt.Sprintf(`
+{
+    "data":
+    {
+        "problems":
+        [
+            {
+                "problemId": %q,
+                "chatId": %q,
+                "clientId": %q,
+                "createdAt": "1970-01-01T00:00:01Z",
+                "waitTimeSeconds": 90
+            },
+            {
+                "problemId": %q,
+                "chatId": %q,
+                "clientId": %q,
+                "managerId": %q,
+                "createdAt": "1970-01-01T00:00:02Z",
+                "waitTimeSeconds": 30,
+                "reassignRequestedAt": "1970-01-01T00:00:03Z"
+            }
+        ]
+    }
+}`,
+		problems[0].ID, problems[0].ChatID, problems[0].ClientID,
+		problems[1].ID, problems[1].ChatID, problems[1].ClientID, problems[1].ManagerID,
+	), resp.Body.String())
+}
@@ -0,0 +1,43 @@
+package managerv1
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/karasunokami/chat-service/internal/middlewares"
+	"github.com/karasunokami/chat-service/internal/types"
+	resolvemessagereview "github.com/karasunokami/chat-service/internal/usecases/supervisor/resolve-message-review"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (h Handlers) PostSupervisorApproveMessage(eCtx echo.Context, params PostSupervisorApproveMessageParams) error {
+	return h.handleReviewDecision(eCtx, params.XRequestID, resolvemessagereview.DecisionApprove)
+}
+
+func (h Handlers) PostSupervisorRejectMessage(eCtx echo.Context, params PostSupervisorRejectMessageParams) error {
+	return h.handleReviewDecision(eCtx, params.XRequestID, resolvemessagereview.DecisionReject)
+}
+
+func (h Handlers) handleReviewDecision(eCtx echo.Context, reqID types.RequestID, d resolvemessagereview.Decision) error {
+	ctx := eCtx.Request().Context()
+	supervisorID := middlewares.MustUserID(eCtx)
+
+	req := ReviewDecisionRequest{}
+	err := eCtx.Bind(&req)
+	if err != nil {
+		return fmt.Errorf("bind request, err=%w", err)
+	}
+
+	err = h.resolveMessageReview.Handle(ctx, resolvemessagereview.Request{
+		ID:           reqID,
+		SupervisorID: supervisorID,
+		MessageID:    req.MessageId,
+		Decision:     d,
+	})
+	if err != nil {
+		return newHandleError(err, getErrorCode(err))
+	}
+
+	return eCtx.JSON(http.StatusOK, ReviewDecisionResponse{})
+}
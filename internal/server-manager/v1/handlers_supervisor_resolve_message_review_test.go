@@ -0,0 +1,97 @@
+package managerv1_test
+
+import (
+	"fmt"
+	"net/http"
+
+	internalerrors "github.com/karasunokami/chat-service/internal/errors"
+	managerv1 "github.com/karasunokami/chat-service/internal/server-manager/v1"
+	"github.com/karasunokami/chat-service/internal/types"
+	resolvemessagereview "github.com/karasunokami/chat-service/internal/usecases/supervisor/resolve-message-review"
+)
+
+func (s *HandlersSuite) TestSupervisorApproveMessage_Usecase_InvalidRequest() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/supervisor/approveMessage", `{}`)
+
+	s.resolveMessageReviewUseCase.EXPECT().Handle(eCtx.Request().Context(), resolvemessagereview.Request{
+		ID:           reqID,
+		SupervisorID: s.managerID,
+		Decision:     resolvemessagereview.DecisionApprove,
+	}).Return(resolvemessagereview.ErrInvalidRequest)
+
+	// Action.
+	err := s.handlers.PostSupervisorApproveMessage(eCtx, managerv1.PostSupervisorApproveMessageParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusBadRequest, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestSupervisorApproveMessage_Usecase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	msgID := types.NewMessageID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/supervisor/approveMessage", fmt.Sprintf(`{"messageId": %q}`, msgID))
+
+	s.resolveMessageReviewUseCase.EXPECT().Handle(eCtx.Request().Context(), resolvemessagereview.Request{
+		ID:           reqID,
+		SupervisorID: s.managerID,
+		MessageID:    msgID,
+		Decision:     resolvemessagereview.DecisionApprove,
+	}).Return(nil)
+
+	// Action.
+	err := s.handlers.PostSupervisorApproveMessage(eCtx, managerv1.PostSupervisorApproveMessageParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(`{"data": null}`, resp.Body.String())
+}
+
+func (s *HandlersSuite) TestSupervisorRejectMessage_Usecase_MessageNotUnderReview() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	msgID := types.NewMessageID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/supervisor/rejectMessage", fmt.Sprintf(`{"messageId": %q}`, msgID))
+
+	s.resolveMessageReviewUseCase.EXPECT().Handle(eCtx.Request().Context(), resolvemessagereview.Request{
+		ID:           reqID,
+		SupervisorID: s.managerID,
+		MessageID:    msgID,
+		Decision:     resolvemessagereview.DecisionReject,
+	}).Return(resolvemessagereview.ErrMessageNotUnderReview)
+
+	// Action.
+	err := s.handlers.PostSupervisorRejectMessage(eCtx, managerv1.PostSupervisorRejectMessageParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.EqualValues(managerv1.ErrorCodeMessageNotUnderReviewError, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestSupervisorRejectMessage_Usecase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	msgID := types.NewMessageID()
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/supervisor/rejectMessage", fmt.Sprintf(`{"messageId": %q}`, msgID))
+
+	s.resolveMessageReviewUseCase.EXPECT().Handle(eCtx.Request().Context(), resolvemessagereview.Request{
+		ID:           reqID,
+		SupervisorID: s.managerID,
+		MessageID:    msgID,
+		Decision:     resolvemessagereview.DecisionReject,
+	}).Return(nil)
+
+	// Action.
+	err := s.handlers.PostSupervisorRejectMessage(eCtx, managerv1.PostSupervisorRejectMessageParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(`{"data": null}`, resp.Body.String())
+}
@@ -0,0 +1,40 @@
+package managerv1
+
+import (
+	"fmt"
+	"net/http"
+
+	"github.com/karasunokami/chat-service/internal/middlewares"
+	sendwhisper "github.com/karasunokami/chat-service/internal/usecases/supervisor/send-whisper"
+
+	"github.com/labstack/echo/v4"
+)
+
+func (h Handlers) PostSupervisorSendInternalNote(eCtx echo.Context, params PostSupervisorSendInternalNoteParams) error {
+	ctx := eCtx.Request().Context()
+	supervisorID := middlewares.MustUserID(eCtx)
+
+	req := SendInternalNoteRequest{}
+	err := eCtx.Bind(&req)
+	if err != nil {
+		return fmt.Errorf("bind request, err=%w", err)
+	}
+
+	resp, err := h.sendWhisper.Handle(ctx, sendwhisper.Request{
+		ID:           params.XRequestID,
+		SupervisorID: supervisorID,
+		ChatID:       req.ChatId,
+		NoteBody:     req.NoteBody,
+	})
+	if err != nil {
+		return newHandleError(err, getErrorCode(err))
+	}
+
+	return eCtx.JSON(http.StatusOK, SendInternalNoteResponse{
+		Data: &MessageWithoutBody{
+			AuthorId:  supervisorID,
+			CreatedAt: resp.CreatedAt,
+			Id:        resp.MessageID,
+		},
+	})
+}
@@ -0,0 +1,94 @@
+package managerv1_test
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	internalerrors "github.com/karasunokami/chat-service/internal/errors"
+	managerv1 "github.com/karasunokami/chat-service/internal/server-manager/v1"
+	"github.com/karasunokami/chat-service/internal/types"
+	sendwhisper "github.com/karasunokami/chat-service/internal/usecases/supervisor/send-whisper"
+)
+
+func (s *HandlersSuite) TestSupervisorSendInternalNote_Usecase_InvalidRequest() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/supervisor/sendInternalNote", fmt.Sprintf(`{"chatId": %q}`, chatID))
+
+	s.sendWhisperUseCase.EXPECT().Handle(eCtx.Request().Context(), sendwhisper.Request{
+		ID:           reqID,
+		SupervisorID: s.managerID,
+		ChatID:       chatID,
+	}).Return(sendwhisper.Response{}, sendwhisper.ErrInvalidRequest)
+
+	// Action.
+	err := s.handlers.PostSupervisorSendInternalNote(eCtx, managerv1.PostSupervisorSendInternalNoteParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.Equal(http.StatusBadRequest, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestSupervisorSendInternalNote_Usecase_ProblemNotFound() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/supervisor/sendInternalNote",
+		fmt.Sprintf(`{"noteBody": "Ask for the contract number", "chatId": %q}`, chatID))
+
+	s.sendWhisperUseCase.EXPECT().Handle(eCtx.Request().Context(), sendwhisper.Request{
+		ID:           reqID,
+		SupervisorID: s.managerID,
+		ChatID:       chatID,
+		NoteBody:     "Ask for the contract number",
+	}).Return(sendwhisper.Response{}, sendwhisper.ErrProblemNotFound)
+
+	// Action.
+	err := s.handlers.PostSupervisorSendInternalNote(eCtx, managerv1.PostSupervisorSendInternalNoteParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().Error(err)
+	s.EqualValues(managerv1.ErrorCodeProblemNotFoundError, internalerrors.GetServerErrorCode(err))
+	s.Empty(resp.Body)
+}
+
+func (s *HandlersSuite) TestSupervisorSendInternalNote_Usecase_Success() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	chatID := types.NewChatID()
+
+	resp, eCtx := s.newEchoCtx(reqID, "/v1/supervisor/sendInternalNote",
+		fmt.Sprintf(`{"noteBody": "Ask for the contract number", "chatId": %q}`, chatID))
+
+	msgID := types.NewMessageID()
+	s.sendWhisperUseCase.EXPECT().Handle(eCtx.Request().Context(), sendwhisper.Request{
+		ID:           reqID,
+		SupervisorID: s.managerID,
+		ChatID:       chatID,
+		NoteBody:     "Ask for the contract number",
+	}).Return(sendwhisper.Response{
+		MessageID: msgID,
+		CreatedAt: time.Unix(1, 1).UTC(),
+	}, nil)
+
+	// Action.
+	err := s.handlers.PostSupervisorSendInternalNote(eCtx, managerv1.PostSupervisorSendInternalNoteParams{XRequestID: reqID})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(http.StatusOK, resp.Code)
+	s.JSONEq(fmt.Sprintf(`
+{
+    "data":
+    {
+        "authorId": "%s",
+        "createdAt": "1970-01-01T00:00:01.000000001Z",
+        "id": "%s"
+    }
+}`, s.managerID, msgID), resp.Body.String())
+}
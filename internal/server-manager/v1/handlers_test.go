@@ -26,10 +26,24 @@ type HandlersSuite struct {
 
 	managerID          types.UserID
 	freeHandsUseCase   *managerv1mocks.MockfreeHandsUseCase
+	setStatusUseCase   *managerv1mocks.MocksetStatusUseCase
 	getChatsUseCase    *managerv1mocks.MockgetChatsUseCase
 	getHistoryUseCase  *managerv1mocks.MockgetHistoryUseCase
 	sendMessageUseCase *managerv1mocks.MocksendMessageUseCase
 	closeChatUseCase   *managerv1mocks.MockcloseChatUseCase
+
+	sendInternalNoteUseCase *managerv1mocks.MocksendInternalNoteUseCase
+
+	scheduleMessageUseCase        *managerv1mocks.MockscheduleMessageUseCase
+	getScheduledMessagesUseCase   *managerv1mocks.MockgetScheduledMessagesUseCase
+	cancelScheduledMessageUseCase *managerv1mocks.MockcancelScheduledMessageUseCase
+
+	getOpenProblemsUseCase      *managerv1mocks.MockgetOpenProblemsUseCase
+	supervisorGetHistoryUseCase *managerv1mocks.MocksupervisorGetHistoryUseCase
+	sendWhisperUseCase          *managerv1mocks.MocksendWhisperUseCase
+	getManagerStatusesUseCase   *managerv1mocks.MockgetManagerStatusesUseCase
+	getMessagesForReviewUseCase *managerv1mocks.MockgetMessagesForReviewUseCase
+	resolveMessageReviewUseCase *managerv1mocks.MockresolveMessageReviewUseCase
 }
 
 func TestHandlersSuite(t *testing.T) {
@@ -41,19 +55,41 @@ func (s *HandlersSuite) SetupTest() {
 	s.ctrl = gomock.NewController(s.T())
 	s.canReceiveProblemsUseCase = managerv1mocks.NewMockcanReceiveProblemsUseCase(s.ctrl)
 	s.freeHandsUseCase = managerv1mocks.NewMockfreeHandsUseCase(s.ctrl)
+	s.setStatusUseCase = managerv1mocks.NewMocksetStatusUseCase(s.ctrl)
 	s.getChatsUseCase = managerv1mocks.NewMockgetChatsUseCase(s.ctrl)
 	s.getHistoryUseCase = managerv1mocks.NewMockgetHistoryUseCase(s.ctrl)
 	s.sendMessageUseCase = managerv1mocks.NewMocksendMessageUseCase(s.ctrl)
 	s.closeChatUseCase = managerv1mocks.NewMockcloseChatUseCase(s.ctrl)
+	s.sendInternalNoteUseCase = managerv1mocks.NewMocksendInternalNoteUseCase(s.ctrl)
+	s.scheduleMessageUseCase = managerv1mocks.NewMockscheduleMessageUseCase(s.ctrl)
+	s.getScheduledMessagesUseCase = managerv1mocks.NewMockgetScheduledMessagesUseCase(s.ctrl)
+	s.cancelScheduledMessageUseCase = managerv1mocks.NewMockcancelScheduledMessageUseCase(s.ctrl)
+	s.getOpenProblemsUseCase = managerv1mocks.NewMockgetOpenProblemsUseCase(s.ctrl)
+	s.supervisorGetHistoryUseCase = managerv1mocks.NewMocksupervisorGetHistoryUseCase(s.ctrl)
+	s.sendWhisperUseCase = managerv1mocks.NewMocksendWhisperUseCase(s.ctrl)
+	s.getManagerStatusesUseCase = managerv1mocks.NewMockgetManagerStatusesUseCase(s.ctrl)
+	s.getMessagesForReviewUseCase = managerv1mocks.NewMockgetMessagesForReviewUseCase(s.ctrl)
+	s.resolveMessageReviewUseCase = managerv1mocks.NewMockresolveMessageReviewUseCase(s.ctrl)
 	{
 		var err error
 		s.handlers, err = managerv1.NewHandlers(managerv1.NewOptions(
 			s.canReceiveProblemsUseCase,
 			s.freeHandsUseCase,
+			s.setStatusUseCase,
 			s.getChatsUseCase,
 			s.getHistoryUseCase,
 			s.sendMessageUseCase,
 			s.closeChatUseCase,
+			s.sendInternalNoteUseCase,
+			s.scheduleMessageUseCase,
+			s.getScheduledMessagesUseCase,
+			s.cancelScheduledMessageUseCase,
+			s.getOpenProblemsUseCase,
+			s.supervisorGetHistoryUseCase,
+			s.sendWhisperUseCase,
+			s.getManagerStatusesUseCase,
+			s.getMessagesForReviewUseCase,
+			s.resolveMessageReviewUseCase,
 		))
 		s.Require().NoError(err)
 	}
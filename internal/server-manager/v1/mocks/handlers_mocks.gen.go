@@ -10,11 +10,22 @@ import (
 
 	gomock "github.com/golang/mock/gomock"
 	canreceiveproblems "github.com/karasunokami/chat-service/internal/usecases/manager/can-receive-problems"
+	cancelscheduledmessage "github.com/karasunokami/chat-service/internal/usecases/manager/cancel-scheduled-message"
 	closechat "github.com/karasunokami/chat-service/internal/usecases/manager/close-chat"
 	freehands "github.com/karasunokami/chat-service/internal/usecases/manager/free-hands"
 	getchats "github.com/karasunokami/chat-service/internal/usecases/manager/get-chats"
 	gethistory "github.com/karasunokami/chat-service/internal/usecases/manager/get-history"
+	getscheduledmessages "github.com/karasunokami/chat-service/internal/usecases/manager/get-scheduled-messages"
+	schedulemessage "github.com/karasunokami/chat-service/internal/usecases/manager/schedule-message"
+	sendinternalnote "github.com/karasunokami/chat-service/internal/usecases/manager/send-internal-note"
 	sendmessage "github.com/karasunokami/chat-service/internal/usecases/manager/send-message"
+	setstatus "github.com/karasunokami/chat-service/internal/usecases/manager/set-status"
+	getchathistory "github.com/karasunokami/chat-service/internal/usecases/supervisor/get-chat-history"
+	getmanagerstatuses "github.com/karasunokami/chat-service/internal/usecases/supervisor/get-manager-statuses"
+	getmessagesforreview "github.com/karasunokami/chat-service/internal/usecases/supervisor/get-messages-for-review"
+	getopenproblems "github.com/karasunokami/chat-service/internal/usecases/supervisor/get-open-problems"
+	resolvemessagereview "github.com/karasunokami/chat-service/internal/usecases/supervisor/resolve-message-review"
+	sendwhisper "github.com/karasunokami/chat-service/internal/usecases/supervisor/send-whisper"
 )
 
 // MockcanReceiveProblemsUseCase is a mock of canReceiveProblemsUseCase interface.
@@ -92,6 +103,44 @@ func (mr *MockfreeHandsUseCaseMockRecorder) Handle(ctx, req interface{}) *gomock
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockfreeHandsUseCase)(nil).Handle), ctx, req)
 }
 
+// MocksetStatusUseCase is a mock of setStatusUseCase interface.
+type MocksetStatusUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MocksetStatusUseCaseMockRecorder
+}
+
+// MocksetStatusUseCaseMockRecorder is the mock recorder for MocksetStatusUseCase.
+type MocksetStatusUseCaseMockRecorder struct {
+	mock *MocksetStatusUseCase
+}
+
+// NewMocksetStatusUseCase creates a new mock instance.
+func NewMocksetStatusUseCase(ctrl *gomock.Controller) *MocksetStatusUseCase {
+	mock := &MocksetStatusUseCase{ctrl: ctrl}
+	mock.recorder = &MocksetStatusUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksetStatusUseCase) EXPECT() *MocksetStatusUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MocksetStatusUseCase) Handle(ctx context.Context, req setstatus.Request) (setstatus.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, req)
+	ret0, _ := ret[0].(setstatus.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MocksetStatusUseCaseMockRecorder) Handle(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MocksetStatusUseCase)(nil).Handle), ctx, req)
+}
+
 // MockgetChatsUseCase is a mock of getChatsUseCase interface.
 type MockgetChatsUseCase struct {
 	ctrl     *gomock.Controller
@@ -206,6 +255,44 @@ func (mr *MocksendMessageUseCaseMockRecorder) Handle(ctx, req interface{}) *gomo
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MocksendMessageUseCase)(nil).Handle), ctx, req)
 }
 
+// MocksendInternalNoteUseCase is a mock of sendInternalNoteUseCase interface.
+type MocksendInternalNoteUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MocksendInternalNoteUseCaseMockRecorder
+}
+
+// MocksendInternalNoteUseCaseMockRecorder is the mock recorder for MocksendInternalNoteUseCase.
+type MocksendInternalNoteUseCaseMockRecorder struct {
+	mock *MocksendInternalNoteUseCase
+}
+
+// NewMocksendInternalNoteUseCase creates a new mock instance.
+func NewMocksendInternalNoteUseCase(ctrl *gomock.Controller) *MocksendInternalNoteUseCase {
+	mock := &MocksendInternalNoteUseCase{ctrl: ctrl}
+	mock.recorder = &MocksendInternalNoteUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksendInternalNoteUseCase) EXPECT() *MocksendInternalNoteUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MocksendInternalNoteUseCase) Handle(ctx context.Context, req sendinternalnote.Request) (sendinternalnote.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, req)
+	ret0, _ := ret[0].(sendinternalnote.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MocksendInternalNoteUseCaseMockRecorder) Handle(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MocksendInternalNoteUseCase)(nil).Handle), ctx, req)
+}
+
 // MockcloseChatUseCase is a mock of closeChatUseCase interface.
 type MockcloseChatUseCase struct {
 	ctrl     *gomock.Controller
@@ -242,3 +329,343 @@ func (mr *MockcloseChatUseCaseMockRecorder) Handle(ctx, req interface{}) *gomock
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockcloseChatUseCase)(nil).Handle), ctx, req)
 }
+
+// MockscheduleMessageUseCase is a mock of scheduleMessageUseCase interface.
+type MockscheduleMessageUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockscheduleMessageUseCaseMockRecorder
+}
+
+// MockscheduleMessageUseCaseMockRecorder is the mock recorder for MockscheduleMessageUseCase.
+type MockscheduleMessageUseCaseMockRecorder struct {
+	mock *MockscheduleMessageUseCase
+}
+
+// NewMockscheduleMessageUseCase creates a new mock instance.
+func NewMockscheduleMessageUseCase(ctrl *gomock.Controller) *MockscheduleMessageUseCase {
+	mock := &MockscheduleMessageUseCase{ctrl: ctrl}
+	mock.recorder = &MockscheduleMessageUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockscheduleMessageUseCase) EXPECT() *MockscheduleMessageUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockscheduleMessageUseCase) Handle(ctx context.Context, req schedulemessage.Request) (schedulemessage.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, req)
+	ret0, _ := ret[0].(schedulemessage.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockscheduleMessageUseCaseMockRecorder) Handle(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockscheduleMessageUseCase)(nil).Handle), ctx, req)
+}
+
+// MockgetScheduledMessagesUseCase is a mock of getScheduledMessagesUseCase interface.
+type MockgetScheduledMessagesUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockgetScheduledMessagesUseCaseMockRecorder
+}
+
+// MockgetScheduledMessagesUseCaseMockRecorder is the mock recorder for MockgetScheduledMessagesUseCase.
+type MockgetScheduledMessagesUseCaseMockRecorder struct {
+	mock *MockgetScheduledMessagesUseCase
+}
+
+// NewMockgetScheduledMessagesUseCase creates a new mock instance.
+func NewMockgetScheduledMessagesUseCase(ctrl *gomock.Controller) *MockgetScheduledMessagesUseCase {
+	mock := &MockgetScheduledMessagesUseCase{ctrl: ctrl}
+	mock.recorder = &MockgetScheduledMessagesUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockgetScheduledMessagesUseCase) EXPECT() *MockgetScheduledMessagesUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockgetScheduledMessagesUseCase) Handle(ctx context.Context, req getscheduledmessages.Request) (getscheduledmessages.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, req)
+	ret0, _ := ret[0].(getscheduledmessages.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockgetScheduledMessagesUseCaseMockRecorder) Handle(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockgetScheduledMessagesUseCase)(nil).Handle), ctx, req)
+}
+
+// MockcancelScheduledMessageUseCase is a mock of cancelScheduledMessageUseCase interface.
+type MockcancelScheduledMessageUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockcancelScheduledMessageUseCaseMockRecorder
+}
+
+// MockcancelScheduledMessageUseCaseMockRecorder is the mock recorder for MockcancelScheduledMessageUseCase.
+type MockcancelScheduledMessageUseCaseMockRecorder struct {
+	mock *MockcancelScheduledMessageUseCase
+}
+
+// NewMockcancelScheduledMessageUseCase creates a new mock instance.
+func NewMockcancelScheduledMessageUseCase(ctrl *gomock.Controller) *MockcancelScheduledMessageUseCase {
+	mock := &MockcancelScheduledMessageUseCase{ctrl: ctrl}
+	mock.recorder = &MockcancelScheduledMessageUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockcancelScheduledMessageUseCase) EXPECT() *MockcancelScheduledMessageUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockcancelScheduledMessageUseCase) Handle(ctx context.Context, req cancelscheduledmessage.Request) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockcancelScheduledMessageUseCaseMockRecorder) Handle(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockcancelScheduledMessageUseCase)(nil).Handle), ctx, req)
+}
+
+// MockgetOpenProblemsUseCase is a mock of getOpenProblemsUseCase interface.
+type MockgetOpenProblemsUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockgetOpenProblemsUseCaseMockRecorder
+}
+
+// MockgetOpenProblemsUseCaseMockRecorder is the mock recorder for MockgetOpenProblemsUseCase.
+type MockgetOpenProblemsUseCaseMockRecorder struct {
+	mock *MockgetOpenProblemsUseCase
+}
+
+// NewMockgetOpenProblemsUseCase creates a new mock instance.
+func NewMockgetOpenProblemsUseCase(ctrl *gomock.Controller) *MockgetOpenProblemsUseCase {
+	mock := &MockgetOpenProblemsUseCase{ctrl: ctrl}
+	mock.recorder = &MockgetOpenProblemsUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockgetOpenProblemsUseCase) EXPECT() *MockgetOpenProblemsUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockgetOpenProblemsUseCase) Handle(ctx context.Context, req getopenproblems.Request) (getopenproblems.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, req)
+	ret0, _ := ret[0].(getopenproblems.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockgetOpenProblemsUseCaseMockRecorder) Handle(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockgetOpenProblemsUseCase)(nil).Handle), ctx, req)
+}
+
+// MocksupervisorGetHistoryUseCase is a mock of supervisorGetHistoryUseCase interface.
+type MocksupervisorGetHistoryUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MocksupervisorGetHistoryUseCaseMockRecorder
+}
+
+// MocksupervisorGetHistoryUseCaseMockRecorder is the mock recorder for MocksupervisorGetHistoryUseCase.
+type MocksupervisorGetHistoryUseCaseMockRecorder struct {
+	mock *MocksupervisorGetHistoryUseCase
+}
+
+// NewMocksupervisorGetHistoryUseCase creates a new mock instance.
+func NewMocksupervisorGetHistoryUseCase(ctrl *gomock.Controller) *MocksupervisorGetHistoryUseCase {
+	mock := &MocksupervisorGetHistoryUseCase{ctrl: ctrl}
+	mock.recorder = &MocksupervisorGetHistoryUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksupervisorGetHistoryUseCase) EXPECT() *MocksupervisorGetHistoryUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MocksupervisorGetHistoryUseCase) Handle(ctx context.Context, req getchathistory.Request) (getchathistory.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, req)
+	ret0, _ := ret[0].(getchathistory.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MocksupervisorGetHistoryUseCaseMockRecorder) Handle(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MocksupervisorGetHistoryUseCase)(nil).Handle), ctx, req)
+}
+
+// MockgetManagerStatusesUseCase is a mock of getManagerStatusesUseCase interface.
+type MockgetManagerStatusesUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockgetManagerStatusesUseCaseMockRecorder
+}
+
+// MockgetManagerStatusesUseCaseMockRecorder is the mock recorder for MockgetManagerStatusesUseCase.
+type MockgetManagerStatusesUseCaseMockRecorder struct {
+	mock *MockgetManagerStatusesUseCase
+}
+
+// NewMockgetManagerStatusesUseCase creates a new mock instance.
+func NewMockgetManagerStatusesUseCase(ctrl *gomock.Controller) *MockgetManagerStatusesUseCase {
+	mock := &MockgetManagerStatusesUseCase{ctrl: ctrl}
+	mock.recorder = &MockgetManagerStatusesUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockgetManagerStatusesUseCase) EXPECT() *MockgetManagerStatusesUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockgetManagerStatusesUseCase) Handle(ctx context.Context, req getmanagerstatuses.Request) (getmanagerstatuses.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, req)
+	ret0, _ := ret[0].(getmanagerstatuses.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockgetManagerStatusesUseCaseMockRecorder) Handle(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockgetManagerStatusesUseCase)(nil).Handle), ctx, req)
+}
+
+// MocksendWhisperUseCase is a mock of sendWhisperUseCase interface.
+type MocksendWhisperUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MocksendWhisperUseCaseMockRecorder
+}
+
+// MocksendWhisperUseCaseMockRecorder is the mock recorder for MocksendWhisperUseCase.
+type MocksendWhisperUseCaseMockRecorder struct {
+	mock *MocksendWhisperUseCase
+}
+
+// NewMocksendWhisperUseCase creates a new mock instance.
+func NewMocksendWhisperUseCase(ctrl *gomock.Controller) *MocksendWhisperUseCase {
+	mock := &MocksendWhisperUseCase{ctrl: ctrl}
+	mock.recorder = &MocksendWhisperUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MocksendWhisperUseCase) EXPECT() *MocksendWhisperUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MocksendWhisperUseCase) Handle(ctx context.Context, req sendwhisper.Request) (sendwhisper.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, req)
+	ret0, _ := ret[0].(sendwhisper.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MocksendWhisperUseCaseMockRecorder) Handle(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MocksendWhisperUseCase)(nil).Handle), ctx, req)
+}
+
+// MockgetMessagesForReviewUseCase is a mock of getMessagesForReviewUseCase interface.
+type MockgetMessagesForReviewUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockgetMessagesForReviewUseCaseMockRecorder
+}
+
+// MockgetMessagesForReviewUseCaseMockRecorder is the mock recorder for MockgetMessagesForReviewUseCase.
+type MockgetMessagesForReviewUseCaseMockRecorder struct {
+	mock *MockgetMessagesForReviewUseCase
+}
+
+// NewMockgetMessagesForReviewUseCase creates a new mock instance.
+func NewMockgetMessagesForReviewUseCase(ctrl *gomock.Controller) *MockgetMessagesForReviewUseCase {
+	mock := &MockgetMessagesForReviewUseCase{ctrl: ctrl}
+	mock.recorder = &MockgetMessagesForReviewUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockgetMessagesForReviewUseCase) EXPECT() *MockgetMessagesForReviewUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockgetMessagesForReviewUseCase) Handle(ctx context.Context, req getmessagesforreview.Request) (getmessagesforreview.Response, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, req)
+	ret0, _ := ret[0].(getmessagesforreview.Response)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockgetMessagesForReviewUseCaseMockRecorder) Handle(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockgetMessagesForReviewUseCase)(nil).Handle), ctx, req)
+}
+
+// MockresolveMessageReviewUseCase is a mock of resolveMessageReviewUseCase interface.
+type MockresolveMessageReviewUseCase struct {
+	ctrl     *gomock.Controller
+	recorder *MockresolveMessageReviewUseCaseMockRecorder
+}
+
+// MockresolveMessageReviewUseCaseMockRecorder is the mock recorder for MockresolveMessageReviewUseCase.
+type MockresolveMessageReviewUseCaseMockRecorder struct {
+	mock *MockresolveMessageReviewUseCase
+}
+
+// NewMockresolveMessageReviewUseCase creates a new mock instance.
+func NewMockresolveMessageReviewUseCase(ctrl *gomock.Controller) *MockresolveMessageReviewUseCase {
+	mock := &MockresolveMessageReviewUseCase{ctrl: ctrl}
+	mock.recorder = &MockresolveMessageReviewUseCaseMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockresolveMessageReviewUseCase) EXPECT() *MockresolveMessageReviewUseCaseMockRecorder {
+	return m.recorder
+}
+
+// Handle mocks base method.
+func (m *MockresolveMessageReviewUseCase) Handle(ctx context.Context, req resolvemessagereview.Request) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Handle", ctx, req)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Handle indicates an expected call of Handle.
+func (mr *MockresolveMessageReviewUseCaseMockRecorder) Handle(ctx, req interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Handle", reflect.TypeOf((*MockresolveMessageReviewUseCase)(nil).Handle), ctx, req)
+}
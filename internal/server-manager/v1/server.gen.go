@@ -21,15 +21,37 @@ import (
 )
 
 const (
-	BearerAuthScopes = "bearerAuth.Scopes"
+	BearerAuthScopes     = "bearerAuth.Scopes"
+	SupervisorAuthScopes = "supervisorAuth.Scopes"
 )
 
 // Defines values for ErrorCode.
 const (
-	ErrorCodeFreeHandsManagerOverloadError ErrorCode = 5000
-	ErrorCodeProblemNotFoundError          ErrorCode = 5001
+	ErrorCodeFreeHandsManagerOverloadError  ErrorCode = 5000
+	ErrorCodeProblemNotFoundError           ErrorCode = 5001
+	ErrorCodeScheduledMessageNotFoundError  ErrorCode = 5002
+	ErrorCodeMessageNotUnderReviewError     ErrorCode = 5003
+	ErrorCodeFreeHandsManagerNotOnlineError ErrorCode = 5004
 )
 
+// Defines values for ManagerStatus.
+const (
+	ManagerStatusAway    ManagerStatus = "away"
+	ManagerStatusOffline ManagerStatus = "offline"
+	ManagerStatusOnline  ManagerStatus = "online"
+)
+
+// CancelScheduledMessageRequest defines model for CancelScheduledMessageRequest.
+type CancelScheduledMessageRequest struct {
+	Id types.ScheduledMessageID `json:"id"`
+}
+
+// CancelScheduledMessageResponse defines model for CancelScheduledMessageResponse.
+type CancelScheduledMessageResponse struct {
+	Data  *map[string]interface{} `json:"data"`
+	Error *Error                  `json:"error,omitempty"`
+}
+
 // Chat defines model for Chat.
 type Chat struct {
 	ChatId   types.ChatID `json:"chatId"`
@@ -99,17 +121,69 @@ type GetHistoryResponse struct {
 	Error *Error        `json:"error,omitempty"`
 }
 
+// GetManagerStatusesResponse defines model for GetManagerStatusesResponse.
+type GetManagerStatusesResponse struct {
+	Data  *ManagerStatusList `json:"data,omitempty"`
+	Error *Error             `json:"error,omitempty"`
+}
+
+// GetMessagesForReviewResponse defines model for GetMessagesForReviewResponse.
+type GetMessagesForReviewResponse struct {
+	Data  *ReviewMessageList `json:"data,omitempty"`
+	Error *Error             `json:"error,omitempty"`
+}
+
+// GetOpenProblemsResponse defines model for GetOpenProblemsResponse.
+type GetOpenProblemsResponse struct {
+	Data  *OpenProblemList `json:"data,omitempty"`
+	Error *Error           `json:"error,omitempty"`
+}
+
+// GetScheduledMessagesResponse defines model for GetScheduledMessagesResponse.
+type GetScheduledMessagesResponse struct {
+	Data  *ScheduledMessageList `json:"data,omitempty"`
+	Error *Error                `json:"error,omitempty"`
+}
+
 // ManagerAvailability defines model for ManagerAvailability.
 type ManagerAvailability struct {
 	Available bool `json:"available"`
 }
 
+// ManagerStatus Offline status is derived from the manager connections and cannot be set.
+type ManagerStatus string
+
+// ManagerStatusData defines model for ManagerStatusData.
+type ManagerStatusData struct {
+	// Status Offline status is derived from the manager connections and cannot be set.
+	Status ManagerStatus `json:"status"`
+}
+
+// ManagerStatusInfo defines model for ManagerStatusInfo.
+type ManagerStatusInfo struct {
+	ManagerId types.UserID `json:"managerId"`
+
+	// Since Time when the manager took the status.
+	Since time.Time `json:"since"`
+
+	// Status Offline status is derived from the manager connections and cannot be set.
+	Status ManagerStatus `json:"status"`
+}
+
+// ManagerStatusList defines model for ManagerStatusList.
+type ManagerStatusList struct {
+	Managers []ManagerStatusInfo `json:"managers"`
+}
+
 // Message defines model for Message.
 type Message struct {
 	AuthorId  types.UserID    `json:"authorId"`
 	Body      string          `json:"body"`
 	CreatedAt time.Time       `json:"createdAt"`
 	Id        types.MessageID `json:"id"`
+
+	// IsInternalNote The note is visible to the staff only.
+	IsInternalNote bool `json:"isInternalNote"`
 }
 
 // MessageWithoutBody defines model for MessageWithoutBody.
@@ -125,6 +199,102 @@ type MessagesPage struct {
 	Next     string    `json:"next"`
 }
 
+// OpenProblem defines model for OpenProblem.
+type OpenProblem struct {
+	ChatId    types.ChatID `json:"chatId"`
+	ClientId  types.UserID `json:"clientId"`
+	CreatedAt time.Time    `json:"createdAt"`
+
+	// ManagerId Absent if the problem is waiting for a free manager.
+	ManagerId *types.UserID   `json:"managerId,omitempty"`
+	ProblemId types.ProblemID `json:"problemId"`
+
+	// ReassignRequestedAt Present if the manager of the problem has been offline for too long.
+	ReassignRequestedAt *time.Time `json:"reassignRequestedAt,omitempty"`
+
+	// WaitTimeSeconds Time since the problem was opened.
+	WaitTimeSeconds int64 `json:"waitTimeSeconds"`
+}
+
+// OpenProblemList defines model for OpenProblemList.
+type OpenProblemList struct {
+	Problems []OpenProblem `json:"problems"`
+}
+
+// ReviewDecisionRequest defines model for ReviewDecisionRequest.
+type ReviewDecisionRequest struct {
+	MessageId types.MessageID `json:"messageId"`
+}
+
+// ReviewDecisionResponse defines model for ReviewDecisionResponse.
+type ReviewDecisionResponse struct {
+	Data  *map[string]interface{} `json:"data"`
+	Error *Error                  `json:"error,omitempty"`
+}
+
+// ReviewMessage defines model for ReviewMessage.
+type ReviewMessage struct {
+	AuthorId  types.UserID    `json:"authorId"`
+	Body      string          `json:"body"`
+	ChatId    types.ChatID    `json:"chatId"`
+	CreatedAt time.Time       `json:"createdAt"`
+	Id        types.MessageID `json:"id"`
+
+	// ReviewRequestedAt Time when AFC found the message suspicious.
+	ReviewRequestedAt time.Time `json:"reviewRequestedAt"`
+}
+
+// ReviewMessageList defines model for ReviewMessageList.
+type ReviewMessageList struct {
+	Messages []ReviewMessage `json:"messages"`
+}
+
+// ScheduleMessageRequest defines model for ScheduleMessageRequest.
+type ScheduleMessageRequest struct {
+	ChatId      types.ChatID `json:"chatId"`
+	DeliverAt   time.Time    `json:"deliverAt"`
+	MessageBody string       `json:"messageBody"`
+}
+
+// ScheduleMessageResponse defines model for ScheduleMessageResponse.
+type ScheduleMessageResponse struct {
+	Data  *ScheduledMessageWithoutBody `json:"data,omitempty"`
+	Error *Error                       `json:"error,omitempty"`
+}
+
+// ScheduledMessage defines model for ScheduledMessage.
+type ScheduledMessage struct {
+	Body      string                   `json:"body"`
+	ChatId    types.ChatID             `json:"chatId"`
+	CreatedAt time.Time                `json:"createdAt"`
+	DeliverAt time.Time                `json:"deliverAt"`
+	Id        types.ScheduledMessageID `json:"id"`
+}
+
+// ScheduledMessageList defines model for ScheduledMessageList.
+type ScheduledMessageList struct {
+	Messages []ScheduledMessage `json:"messages"`
+}
+
+// ScheduledMessageWithoutBody defines model for ScheduledMessageWithoutBody.
+type ScheduledMessageWithoutBody struct {
+	CreatedAt time.Time                `json:"createdAt"`
+	DeliverAt time.Time                `json:"deliverAt"`
+	Id        types.ScheduledMessageID `json:"id"`
+}
+
+// SendInternalNoteRequest defines model for SendInternalNoteRequest.
+type SendInternalNoteRequest struct {
+	ChatId   types.ChatID `json:"chatId"`
+	NoteBody string       `json:"noteBody"`
+}
+
+// SendInternalNoteResponse defines model for SendInternalNoteResponse.
+type SendInternalNoteResponse struct {
+	Data  *MessageWithoutBody `json:"data,omitempty"`
+	Error *Error              `json:"error,omitempty"`
+}
+
 // SendMessageRequest defines model for SendMessageRequest.
 type SendMessageRequest struct {
 	ChatId      types.ChatID `json:"chatId"`
@@ -137,9 +307,26 @@ type SendMessageResponse struct {
 	Error *Error              `json:"error,omitempty"`
 }
 
+// SetStatusRequest defines model for SetStatusRequest.
+type SetStatusRequest struct {
+	// Status Offline status is derived from the manager connections and cannot be set.
+	Status ManagerStatus `json:"status"`
+}
+
+// SetStatusResponse defines model for SetStatusResponse.
+type SetStatusResponse struct {
+	Data  *ManagerStatusData `json:"data,omitempty"`
+	Error *Error             `json:"error,omitempty"`
+}
+
 // XRequestIDHeader defines model for XRequestIDHeader.
 type XRequestIDHeader = types.RequestID
 
+// PostCancelScheduledMessageParams defines parameters for PostCancelScheduledMessage.
+type PostCancelScheduledMessageParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
 // PostCloseChatParams defines parameters for PostCloseChat.
 type PostCloseChatParams struct {
 	XRequestID XRequestIDHeader `json:"X-Request-ID"`
@@ -165,23 +352,105 @@ type PostGetFreeHandsBtnAvailabilityParams struct {
 	XRequestID XRequestIDHeader `json:"X-Request-ID"`
 }
 
+// PostGetScheduledMessagesParams defines parameters for PostGetScheduledMessages.
+type PostGetScheduledMessagesParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostScheduleMessageParams defines parameters for PostScheduleMessage.
+type PostScheduleMessageParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostSendInternalNoteParams defines parameters for PostSendInternalNote.
+type PostSendInternalNoteParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
 // PostSendMessageParams defines parameters for PostSendMessage.
 type PostSendMessageParams struct {
 	XRequestID XRequestIDHeader `json:"X-Request-ID"`
 }
 
+// PostSetStatusParams defines parameters for PostSetStatus.
+type PostSetStatusParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostSupervisorApproveMessageParams defines parameters for PostSupervisorApproveMessage.
+type PostSupervisorApproveMessageParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostSupervisorGetChatHistoryParams defines parameters for PostSupervisorGetChatHistory.
+type PostSupervisorGetChatHistoryParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostSupervisorGetManagerStatusesParams defines parameters for PostSupervisorGetManagerStatuses.
+type PostSupervisorGetManagerStatusesParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostSupervisorGetMessagesForReviewParams defines parameters for PostSupervisorGetMessagesForReview.
+type PostSupervisorGetMessagesForReviewParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostSupervisorGetOpenProblemsParams defines parameters for PostSupervisorGetOpenProblems.
+type PostSupervisorGetOpenProblemsParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostSupervisorRejectMessageParams defines parameters for PostSupervisorRejectMessage.
+type PostSupervisorRejectMessageParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostSupervisorSendInternalNoteParams defines parameters for PostSupervisorSendInternalNote.
+type PostSupervisorSendInternalNoteParams struct {
+	XRequestID XRequestIDHeader `json:"X-Request-ID"`
+}
+
+// PostCancelScheduledMessageJSONRequestBody defines body for PostCancelScheduledMessage for application/json ContentType.
+type PostCancelScheduledMessageJSONRequestBody = CancelScheduledMessageRequest
+
 // PostCloseChatJSONRequestBody defines body for PostCloseChat for application/json ContentType.
 type PostCloseChatJSONRequestBody = CloseChatRequest
 
 // PostGetChatHistoryJSONRequestBody defines body for PostGetChatHistory for application/json ContentType.
 type PostGetChatHistoryJSONRequestBody = GetHistoryRequest
 
+// PostScheduleMessageJSONRequestBody defines body for PostScheduleMessage for application/json ContentType.
+type PostScheduleMessageJSONRequestBody = ScheduleMessageRequest
+
+// PostSendInternalNoteJSONRequestBody defines body for PostSendInternalNote for application/json ContentType.
+type PostSendInternalNoteJSONRequestBody = SendInternalNoteRequest
+
 // PostSendMessageJSONRequestBody defines body for PostSendMessage for application/json ContentType.
 type PostSendMessageJSONRequestBody = SendMessageRequest
 
+// PostSetStatusJSONRequestBody defines body for PostSetStatus for application/json ContentType.
+type PostSetStatusJSONRequestBody = SetStatusRequest
+
+// PostSupervisorApproveMessageJSONRequestBody defines body for PostSupervisorApproveMessage for application/json ContentType.
+type PostSupervisorApproveMessageJSONRequestBody = ReviewDecisionRequest
+
+// PostSupervisorGetChatHistoryJSONRequestBody defines body for PostSupervisorGetChatHistory for application/json ContentType.
+type PostSupervisorGetChatHistoryJSONRequestBody = GetHistoryRequest
+
+// PostSupervisorRejectMessageJSONRequestBody defines body for PostSupervisorRejectMessage for application/json ContentType.
+type PostSupervisorRejectMessageJSONRequestBody = ReviewDecisionRequest
+
+// PostSupervisorSendInternalNoteJSONRequestBody defines body for PostSupervisorSendInternalNote for application/json ContentType.
+type PostSupervisorSendInternalNoteJSONRequestBody = SendInternalNoteRequest
+
 // ServerInterface represents all server handlers.
 type ServerInterface interface {
 
+	// (POST /cancelScheduledMessage)
+	PostCancelScheduledMessage(ctx echo.Context, params PostCancelScheduledMessageParams) error
+
 	// (POST /closeChat)
 	PostCloseChat(ctx echo.Context, params PostCloseChatParams) error
 
@@ -197,8 +466,41 @@ type ServerInterface interface {
 	// (POST /getFreeHandsBtnAvailability)
 	PostGetFreeHandsBtnAvailability(ctx echo.Context, params PostGetFreeHandsBtnAvailabilityParams) error
 
+	// (POST /getScheduledMessages)
+	PostGetScheduledMessages(ctx echo.Context, params PostGetScheduledMessagesParams) error
+
+	// (POST /scheduleMessage)
+	PostScheduleMessage(ctx echo.Context, params PostScheduleMessageParams) error
+
+	// (POST /sendInternalNote)
+	PostSendInternalNote(ctx echo.Context, params PostSendInternalNoteParams) error
+
 	// (POST /sendMessage)
 	PostSendMessage(ctx echo.Context, params PostSendMessageParams) error
+
+	// (POST /setStatus)
+	PostSetStatus(ctx echo.Context, params PostSetStatusParams) error
+
+	// (POST /supervisor/approveMessage)
+	PostSupervisorApproveMessage(ctx echo.Context, params PostSupervisorApproveMessageParams) error
+
+	// (POST /supervisor/getChatHistory)
+	PostSupervisorGetChatHistory(ctx echo.Context, params PostSupervisorGetChatHistoryParams) error
+
+	// (POST /supervisor/getManagerStatuses)
+	PostSupervisorGetManagerStatuses(ctx echo.Context, params PostSupervisorGetManagerStatusesParams) error
+
+	// (POST /supervisor/getMessagesForReview)
+	PostSupervisorGetMessagesForReview(ctx echo.Context, params PostSupervisorGetMessagesForReviewParams) error
+
+	// (POST /supervisor/getOpenProblems)
+	PostSupervisorGetOpenProblems(ctx echo.Context, params PostSupervisorGetOpenProblemsParams) error
+
+	// (POST /supervisor/rejectMessage)
+	PostSupervisorRejectMessage(ctx echo.Context, params PostSupervisorRejectMessageParams) error
+
+	// (POST /supervisor/sendInternalNote)
+	PostSupervisorSendInternalNote(ctx echo.Context, params PostSupervisorSendInternalNoteParams) error
 }
 
 // ServerInterfaceWrapper converts echo contexts to parameters.
@@ -206,6 +508,39 @@ type ServerInterfaceWrapper struct {
 	Handler ServerInterface
 }
 
+// PostCancelScheduledMessage converts echo context to params.
+func (w *ServerInterfaceWrapper) PostCancelScheduledMessage(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostCancelScheduledMessageParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, valueList[0], &XRequestID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostCancelScheduledMessage(ctx, params)
+	return err
+}
+
 // PostCloseChat converts echo context to params.
 func (w *ServerInterfaceWrapper) PostCloseChat(ctx echo.Context) error {
 	var err error
@@ -371,6 +706,105 @@ func (w *ServerInterfaceWrapper) PostGetFreeHandsBtnAvailability(ctx echo.Contex
 	return err
 }
 
+// PostGetScheduledMessages converts echo context to params.
+func (w *ServerInterfaceWrapper) PostGetScheduledMessages(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostGetScheduledMessagesParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, valueList[0], &XRequestID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostGetScheduledMessages(ctx, params)
+	return err
+}
+
+// PostScheduleMessage converts echo context to params.
+func (w *ServerInterfaceWrapper) PostScheduleMessage(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostScheduleMessageParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, valueList[0], &XRequestID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostScheduleMessage(ctx, params)
+	return err
+}
+
+// PostSendInternalNote converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSendInternalNote(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostSendInternalNoteParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, valueList[0], &XRequestID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSendInternalNote(ctx, params)
+	return err
+}
+
 // PostSendMessage converts echo context to params.
 func (w *ServerInterfaceWrapper) PostSendMessage(ctx echo.Context) error {
 	var err error
@@ -404,6 +838,270 @@ func (w *ServerInterfaceWrapper) PostSendMessage(ctx echo.Context) error {
 	return err
 }
 
+// PostSetStatus converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSetStatus(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(BearerAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostSetStatusParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, valueList[0], &XRequestID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSetStatus(ctx, params)
+	return err
+}
+
+// PostSupervisorApproveMessage converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSupervisorApproveMessage(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(SupervisorAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostSupervisorApproveMessageParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, valueList[0], &XRequestID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSupervisorApproveMessage(ctx, params)
+	return err
+}
+
+// PostSupervisorGetChatHistory converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSupervisorGetChatHistory(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(SupervisorAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostSupervisorGetChatHistoryParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, valueList[0], &XRequestID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSupervisorGetChatHistory(ctx, params)
+	return err
+}
+
+// PostSupervisorGetManagerStatuses converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSupervisorGetManagerStatuses(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(SupervisorAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostSupervisorGetManagerStatusesParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, valueList[0], &XRequestID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSupervisorGetManagerStatuses(ctx, params)
+	return err
+}
+
+// PostSupervisorGetMessagesForReview converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSupervisorGetMessagesForReview(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(SupervisorAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostSupervisorGetMessagesForReviewParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, valueList[0], &XRequestID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSupervisorGetMessagesForReview(ctx, params)
+	return err
+}
+
+// PostSupervisorGetOpenProblems converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSupervisorGetOpenProblems(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(SupervisorAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostSupervisorGetOpenProblemsParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, valueList[0], &XRequestID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSupervisorGetOpenProblems(ctx, params)
+	return err
+}
+
+// PostSupervisorRejectMessage converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSupervisorRejectMessage(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(SupervisorAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostSupervisorRejectMessageParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, valueList[0], &XRequestID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSupervisorRejectMessage(ctx, params)
+	return err
+}
+
+// PostSupervisorSendInternalNote converts echo context to params.
+func (w *ServerInterfaceWrapper) PostSupervisorSendInternalNote(ctx echo.Context) error {
+	var err error
+
+	ctx.Set(SupervisorAuthScopes, []string{})
+
+	// Parameter object where we will unmarshal all parameters from the context
+	var params PostSupervisorSendInternalNoteParams
+
+	headers := ctx.Request().Header
+	// ------------- Required header parameter "X-Request-ID" -------------
+	if valueList, found := headers[http.CanonicalHeaderKey("X-Request-ID")]; found {
+		var XRequestID XRequestIDHeader
+		n := len(valueList)
+		if n != 1 {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Expected one value for X-Request-ID, got %d", n))
+		}
+
+		err = runtime.BindStyledParameterWithLocation("simple", false, "X-Request-ID", runtime.ParamLocationHeader, valueList[0], &XRequestID)
+		if err != nil {
+			return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Invalid format for parameter X-Request-ID: %s", err))
+		}
+
+		params.XRequestID = XRequestID
+	} else {
+		return echo.NewHTTPError(http.StatusBadRequest, fmt.Sprintf("Header parameter X-Request-ID is required, but not found"))
+	}
+
+	// Invoke the callback with all the unmarshaled arguments
+	err = w.Handler.PostSupervisorSendInternalNote(ctx, params)
+	return err
+}
+
 // This is a simple interface which specifies echo.Route addition functions which
 // are present on both echo.Echo and echo.Group, since we want to allow using
 // either of them for path registration
@@ -432,41 +1130,74 @@ func RegisterHandlersWithBaseURL(router EchoRouter, si ServerInterface, baseURL
 		Handler: si,
 	}
 
+	router.POST(baseURL+"/cancelScheduledMessage", wrapper.PostCancelScheduledMessage)
 	router.POST(baseURL+"/closeChat", wrapper.PostCloseChat)
 	router.POST(baseURL+"/freeHands", wrapper.PostFreeHands)
 	router.POST(baseURL+"/getChatHistory", wrapper.PostGetChatHistory)
 	router.POST(baseURL+"/getChats", wrapper.PostGetChats)
 	router.POST(baseURL+"/getFreeHandsBtnAvailability", wrapper.PostGetFreeHandsBtnAvailability)
+	router.POST(baseURL+"/getScheduledMessages", wrapper.PostGetScheduledMessages)
+	router.POST(baseURL+"/scheduleMessage", wrapper.PostScheduleMessage)
+	router.POST(baseURL+"/sendInternalNote", wrapper.PostSendInternalNote)
 	router.POST(baseURL+"/sendMessage", wrapper.PostSendMessage)
+	router.POST(baseURL+"/setStatus", wrapper.PostSetStatus)
+	router.POST(baseURL+"/supervisor/approveMessage", wrapper.PostSupervisorApproveMessage)
+	router.POST(baseURL+"/supervisor/getChatHistory", wrapper.PostSupervisorGetChatHistory)
+	router.POST(baseURL+"/supervisor/getManagerStatuses", wrapper.PostSupervisorGetManagerStatuses)
+	router.POST(baseURL+"/supervisor/getMessagesForReview", wrapper.PostSupervisorGetMessagesForReview)
+	router.POST(baseURL+"/supervisor/getOpenProblems", wrapper.PostSupervisorGetOpenProblems)
+	router.POST(baseURL+"/supervisor/rejectMessage", wrapper.PostSupervisorRejectMessage)
+	router.POST(baseURL+"/supervisor/sendInternalNote", wrapper.PostSupervisorSendInternalNote)
 
 }
 
 // Base64 encoded, gzipped, json marshaled Swagger object
 var swaggerSpec = []string{
 
-	"H4sIAAAAAAAC/+RYW2/bRhP9K4v5vocWoEyqboBAQB9s52IXSWPUKRLA1cOKHIkbk7vM7lCxa+i/F3sh",
-	"RYmU7TiJ67RvEvc2c87Z4RleQ6rKSkmUZGByDRXXvERC7f69/x0/1mjo5Nkx8gy1fSYkTCD3fyOQvESY",
-	"wPtRmDk6eQYRaPxYC40ZTEjXGIFJcyy5XT1XuuQEE6hrkUEEdFXZ9Ya0kAuI4HK0UCNRVkqTD4dymMBC",
-	"UF7P9lJVxhdcc1NLdcFLEac5p5FBvRQpxkISasmL2O5pYBU2Cye4h3ttPrBarZq4XKpHOXcn8qJ4M4fJ",
-	"+TX8X+McJvC/eI1QHBbEdvZJBqvoGiqtKtQk0G2TFgKlHbprrhvh/WFQOwDboa+EhU12Tcr5OsxpG5aa",
-	"fcCUYDVdRRDSm/Sya59/fm5uzwfJzQfZ5PFKGBrOxP0QhKX7cRvdVlAhIa41v4Khc40/tlAG7Zogt38B",
-	"kOuMTKWkwX5KGSd3w2VdFHxWYHP3t+QVAWqt9G2IP3eTXEjPm/lbEKoM77TLkZ24iiBD4qJwazdBXkVQ",
-	"ojF8gQNjW5g0EyN//rSJ7yhEk6FJtahIKFsmUyWJC2nY8du3p8wlzuw6w7jMmKkwFXORsllthERjWKEW",
-	"It2Y9wPlyApuiJW1ITZD9medJPv4CxsnSfLjHkSAsi5hcv4kSZLoSZKMpxGUQorSPv05SVoGLN0LV7Qv",
-	"R3bNaMm1Ld/G5tUm8UIjHnOZmddc8gXqN0vUheKZZ6GT7alWswLL3xS9ULUM4xaPdod/QCsvkaxK73D0",
-	"bdfdlY37RdACcEjyYMlFwWeiEHT1ZUEFProb3jO+Y2FI6avvrDhFkNba+Fx797fiCzwTfzloS37pxT+2",
-	"4m+vwrh/E24oeF2Yvog1Xy/MqS0a96BriPVeINyPFt36NVOqQC57Oa7n2jRfr+ve1pY15Uo/Ph8TwUxl",
-	"V4HmVygXdq/9pFPm1qJINXLC7IA2ksg44YhEiTCwRNwz4YDjg7yWXUgtPwGQbrYdYt8JylVNhwGz74Xj",
-	"/whzg5T5UtEjKxiPu3vW5mr3bGsEEi/pdqvjZkXrg22MZyizsHHn9fGFPVM44XDXxS6FbB6Mo7v5M7fX",
-	"cG+zkcJXKO3dK/bZBd72oJjWWtDVmR3zp8+Qa9QHtc24+feiUfav795C6FxdnXeja6nnRJVXnpBz5VgW",
-	"ZN8McMjlBTurK6tsZslg4eXCDk5PIIIlauNt63JsM1EVSl4JmMD+XrK3D5G7Cy7AOG36AQed8jLY9L6v",
-	"ub5g9nowbphGo4olZs73usVMkDWvFnRuV9gyBKfKUNtquAPXXyN2qGs9Je59rVhNvTjQtBXQOnKUXrVV",
-	"VYjUHR5/MDbm686HihuVvN3fbd10a2bdAy8vB9lPSfItzg8CdgFs4u8YdlBne0Fq8bxxprtpO8gyVgZd",
-	"kGJ2RfPfsEqpYpi11vN+Lda+EXT95mQAuuZe8I7rajFc+BYjuMPdQL5E8vLP/cxh3F5u7vZoJd9vGx5Y",
-	"8wOGfIi58LJihTC0TZm5mSzXaQtDTM0dcYZ9EpQzWwZZ5ZtdcyOJj137veZ4R9Xoo7ero90N6FGO6QUT",
-	"87aWpFyyBRKT+In5r4+7wdx53KPH99bW/3OrjVm7ld1gW0vjgA3uxxZuq2ar4mGQOybo8dacAbP5wEVn",
-	"yCvurjos+HlPXsfaOVS7pu58ajGzbUOD+eaGz3CJhapKlMT8LIig1kXwd5M4LlTKi1wZmjxNno5j69im",
-	"q78DAAD//3zgsYfNGQAA",
+	"H4sIAAAAAAAC/+xb3W/bOBL/VwjePdwBSqxsu4eFgXtwm22bQ9sETRddoOcHWhpb3FCkSlJOc4X/9wM/",
+	"9C3Zru14nUVfisaiyJn5zTdH33Ak0kxw4Frh8TecEUlS0CDtX79/gC85KH11+QZIDNL8Rjke48T9GWBO",
+	"UsBj/PuZX3l2dYkDLOFLTiXEeKxlDgFWUQIpMW/PhUyJxmOc5zTGAdYPmXlfaUn5Agf469lCnNE0E1I7",
+	"cnSCx3hBdZLPziORju6IJCrn4o6kdBQlRJ8pkEsawYhyDZITNjJ7Krzym/kT7I/nJT94tVoVdFlWXxIe",
+	"AbuNEohzBvE7UIoswK+3pEiRgdQU7HIab81Ng4D2AVeX9WUH4ny1qkPw2RA7XQWDLKpMcAVdHmOiLWY8",
+	"Z4zMGBRoeobE7A+ItJEzSCmsbvxdwhyP8d9GlVKNvIxHv9pFlraXCbE8Esau53j8ef2LZvVVjFdBm76I",
+	"UeD6akckflMgjyL9ksxpR3RTLwzHQ4u7hOzMm93zKLw5Igs+3tI+WzGL7H+ohlRt0hOzj1EqzxCRkjzg",
+	"vnOVO5YJBeadQVN9coKsODq6Zf5arG+JUMSw1S4vzcJVgGPQhDL7blPIqwCnzu/0PGvJpFgYuPOnBX0v",
+	"PTUxqEjSTFPB8RhHgmtCuUJvPn68QZZxZN5TiPAYqQwiOqcRmuWKclAKMbGgUWPdP3QCiBGlUZorjWaA",
+	"/puH4TP4N7oIw/Cf5zjAwPMUjz//HIZh8HMYXph/fjL/PDP/PJ8GOKWcpmbR8zAsATHoL2y8/Hpmtjhb",
+	"EmkipzJsljy9kgBvCI/VO8LJAuT1EiQTJLYLcI35GylmDNL3Qr8SOe8+b7v4wYXV8994DPIDLCncd1a1",
+	"6Xov9DVnlINbaXApl/wJOvsatLGWLY7e5Has+9qNglIALzSfLAllZEYZ1Q/7EeUFXt9wR/reUKWFfHhi",
+	"TjLAUS6V47XjRzKygFv6PyvalHx1VncRhjUbvOia4BrHWxfTXqg5q1I3ZAE7wuWBv9VE5wrUQbTIbbaH",
+	"jhd8vRLeU+xHltvDb7oHWdcZcO8R9xRUbac96Gl73z2Jam+3K2V9vqRDEHFPWT06z4RgQHjHcqq102p7",
+	"p2Td6Hw9n5uIgZR9jqhCMUi6hBjNpUiRibyp2wFFgnOIzHsudEeEc2HDsQJdC8JY2CCEA0zuyQMOsHBn",
+	"1PLsmtPqhN0GwdfFVo1fJ27f5srikDbPlx7LpkBVKY+tLbQjaL9H58QrPhfdE70YT68sCrCiPOrJ3D7S",
+	"FNB9AryhBlqIO/uDY98AX3ITEw1nmqbQYcmccgCRV0Is9yvI78DQX/T4Hbave7rQbiqCyiMsSVVW3TLp",
+	"XCfiJNVhJuIHH7zfAl+YvZ6FYdgmy2QBEoiGeKIbTKzVgl0bNI/alwkwVVf+x/dC99lCAogLDcZDLqmi",
+	"MwZIi8IO5nMkOHs4x8Em52y5LaH3sq4LskNKTYk+UZ2IXL/w+DwVffqraMkGKCs2a5C5ZLPrhfzT7b2Q",
+	"e6HrewLM4aveXLTbVUF1sKGxllc9ocrjRLuLO+l5IyloepzJTAHXiM6tk8kcTMb93BOqKV+guZCIoLmE",
+	"MjY3YvFJiMSTvStaXjkfizoJRCm64L76LqBr4nAjoQ5EkQaJJi4JUWgGwJFPdi06WgjEBF9snyMZbE3a",
+	"dQuR4LEayMlswtM4/p4oJDLgEDfOolz/6zneWGtXKAWF2dfsrBmd2hS2/Eh/0uUP2N7d1XbcmG6VmxtS",
+	"XPF6CRFVVPDBtop3g1dPIMxUpPbxd/SuXqM78BSz2m4Se6qB7i+TXEvflVrjZatac/LqJZqbrrhzt44y",
+	"pHKV0YiK7QvOvmytdG7rM/AuvdO25g8Ul9+b1jX23FxY1pO3ov/UvRHf8/o2BkaXIL8rjXE0vBgqHFPK",
+	"ix8ugu1ul144WCpi+m9pO1I4ZEuvXm/t4Crb2+2Eze70tiWwbVnfwsO+tl748WGNoiO3newi3qZo3sHH",
+	"7mAeT20opc5ku669BR7X+xOH8ztc6MN4kHKjAa3tcHCAS6V9XQXw+OCO/LG88rBYD+KFDyVR7Vq2gzXA",
+	"ga8Aagce7FLQ3l18N/Om1w5RLql+MB4k9e4fiAQ5yXVS/fWqcEn/+fQR+7E72720TysflWidGTpUnhkv",
+	"osT6fbqtUy3ugKN7qhPXMi33QVIwON98tmGK+nsVTTUzT14Qfodu88x4QWSsAHnhocnNFQ7wEqRyJCwv",
+	"DPUiA04yisf42Xl4/gwH1m9a4Yyi3hk8C6FQPfmqm9lDqnihTFS957S9YIM9MS+Y8gLfCKX7R/1w0Jjv",
+	"HLD3asmoM/+5mjq9BFUGukhwDdz5kSxjNLKUjP5Qhv5vtdHPtb5l7fRlK3ZomYP9wem/FexPYfjoxLjj",
+	"HDVNlG478DicTZvEGcooKqa6hrF+R+QdMjEUEYUkKMHM/ai9AjUvI6oHwC63Pl1821N6x4a0M1PXg6I1",
+	"bSvqCrZ5MdczDNskjmuXlo1GqUKZEKwftXJi6FCoPZLouqNdPaIrHCKpTReUMly4AS0/WzMsyNegnfon",
+	"bmW/3F43dztZle8OXR1Z53vGmfqQ8zUNYlTpNmRqPVh2XpIqbZrUBjjlIq+Jf0XHWK0F8dR1vzNaOOA1",
+	"utIbmgdcE+kTiO5M97+cgyEcLUAjDvfINcmHhTl43MnLd+Pg5A7epjOBtb0aF8LvJFzDsu+edvJCHx5R",
+	"2ya5aWm7arbGhmVdbGQ1ukiU/HCD9fvmLosRDXJDdttqxp1uDBjonR45EAz1LoejQaX+FcqtbsYwzG+B",
+	"LN2tYdEC8hMtvET6HG0959KDfpuS04V/oId1bPyHGlE9CmCeI9+Ja2C/2bqBx0OWPQzk6Ztwt2P2J8D3",
+	"HabbBU9XQ7kD0N1THSVl7JuBvrcjBnYo1hagZsL2HJl52HIVVUhCKsoR3m3rnrJ/dcqYt3p6R0e83eLr",
+	"wXsS6ZywKmHxU7IF6mXna0SyTIrl5vA8cesa16HlcLa7qkRfcsidRvgIjaguDL0YDkKTYjDcPKkIUet8",
+	"etXwa1J7sjrSPwByZEUZmNJY4x28Mlj3ULVvrWTbTdfP09W0rUvfU8r7Kt5k1YQ/+Li/l278KP0ft/Tf",
+	"TSFa3yqtVwrlV9VqLVV88QFxbeLMXyOaF+QB9KZN5MmXaENfgA2Xw6oS7p6Atr/z2gBpOTLj2xRVnVgf",
+	"IW3dx8TeaQX2gWAxKI3mVKpDgN1h4PThHvy2rgfwD7VgvDvM9e/m1iNMGGs29Vyfzw21Qtn9Vvsj16Dp",
+	"5EHr/fKwB6/rhuyMcTqtNzO7Ru1LM7HqvxugEswV+cYk74NdtmWON2PCtCT3tcgPDdJ+ZHSHzegc8Dtn",
+	"dNv3dT4lVGUgezo7zQKgGFk/QML3o9VzxFbPVsrTXlWf9jArzGO5LNBpHngJS2AiS02K4FbhAOeS+eGL",
+	"8WjERERYIpQe/xL+cjEy4xTT1f8HAJQiHK2jSgAA",
 }
 
 // GetSwagger returns the content of the embedded swagger specification file
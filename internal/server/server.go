@@ -10,6 +10,7 @@ import (
 	"github.com/karasunokami/chat-service/internal/middlewares"
 	eventstream "github.com/karasunokami/chat-service/internal/services/event-stream"
 	tokenexpiration "github.com/karasunokami/chat-service/internal/services/token-expiration"
+	"github.com/karasunokami/chat-service/internal/types"
 	websocketstream "github.com/karasunokami/chat-service/internal/websocket-stream"
 
 	"github.com/labstack/echo/v4"
@@ -25,9 +26,19 @@ const (
 	shutdownTimeout   = 3 * time.Second
 )
 
+type readinessChecker interface {
+	IsReady() bool
+}
+
+type presenceTracker interface {
+	Connected(ctx context.Context, userID types.UserID)
+	Disconnected(ctx context.Context, userID types.UserID)
+}
+
 //go:generate options-gen -out-filename=server_options.gen.go -from-struct=Options
 type Options struct {
 	logger            *zap.Logger                  `option:"mandatory" validate:"required"`
+	serverName        string                       `option:"mandatory" validate:"required"`
 	addr              string                       `option:"mandatory" validate:"required,hostname_port"`
 	allowOrigins      []string                     `option:"mandatory" validate:"min=1"`
 	wsSecProtocol     string                       `option:"mandatory" validate:"required"`
@@ -37,6 +48,21 @@ type Options struct {
 	introspector      middlewares.Introspector     `option:"mandatory" validate:"required"`
 	eventStream       eventstream.EventStream      `option:"mandatory" validate:"required"`
 	eventsAdapter     websocketstream.EventAdapter `option:"mandatory" validate:"required"`
+	readiness         readinessChecker             `option:"mandatory" validate:"required"`
+
+	// supervisorRole allows to authenticate supervisors and enables the chat watching for them.
+	supervisorRole string
+
+	// keySet enables "passive" authentication by the realm public keys.
+	keySet middlewares.KeySet
+	// introspectionFallback allows to introspect tokens in "passive" mode if the keys are unavailable.
+	introspectionFallback bool
+	// sessionRevalidationPeriod enables periodic introspection of websocket sessions tokens. Zero disables it.
+	sessionRevalidationPeriod time.Duration
+	// maxWsConnectionsPerUser limits the concurrent websocket connections of the user. Zero means no limit.
+	maxWsConnectionsPerUser int `validate:"min=0"`
+	// presence tracks the users online status by their websocket connections.
+	presence presenceTracker
 }
 
 type Server struct {
@@ -49,9 +75,24 @@ func New(opts Options) (*Server, error) {
 		return nil, fmt.Errorf("validate options, err=%v", err)
 	}
 
+	roles := []string{opts.requiredRole}
+	if opts.supervisorRole != "" {
+		roles = append(roles, opts.supervisorRole)
+	}
+
+	verifier := middlewares.NewKeyCloakTokenVerifier(opts.introspector, opts.requiredResource, roles...)
+	if opts.keySet != nil {
+		var fallback middlewares.Introspector
+		if opts.introspectionFallback {
+			fallback = opts.introspector
+		}
+		verifier = middlewares.NewKeyCloakPassiveTokenVerifier(opts.keySet, fallback, opts.requiredResource, roles...)
+	}
+
 	e := echo.New()
 
 	e.Use(
+		middlewares.NewTracingMiddleware(opts.serverName),
 		middlewares.NewLoggerMiddleware(opts.logger),
 		middleware.RecoverWithConfig(middleware.RecoverConfig{
 			DisableStackAll: true,
@@ -65,7 +106,7 @@ func New(opts Options) (*Server, error) {
 			AllowOrigins: opts.allowOrigins,
 			AllowMethods: []string{echo.POST},
 		}),
-		middlewares.NewKeyCloakTokenAuth(opts.introspector, opts.requiredResource, opts.requiredRole),
+		middlewares.NewTokenAuth(verifier),
 
 		// max length of message is 3000 utf-8 symbols 3000. 4 bytes each = 12000 bytes / 1024 = 11.78 kB ~= 12 kB
 		middleware.BodyLimit(bodyLimit),
@@ -84,6 +125,29 @@ func New(opts Options) (*Server, error) {
 		close(shutdownCh)
 	})
 
+	wsOpts := []websocketstream.OptOptionsSetter{
+		// The same verifier is used for the in-band token refresh.
+		websocketstream.WithTokenVerifier(verifier),
+		websocketstream.WithMaxConnectionsPerUser(opts.maxWsConnectionsPerUser),
+	}
+	if opts.supervisorRole != "" {
+		wsOpts = append(wsOpts,
+			websocketstream.WithChatWatcher(opts.eventStream),
+			websocketstream.WithCanWatchChats(func(eCtx echo.Context) bool {
+				return middlewares.HasResourceRole(eCtx, opts.requiredResource, opts.supervisorRole)
+			}),
+		)
+	}
+	if opts.presence != nil {
+		wsOpts = append(wsOpts, websocketstream.WithPresence(opts.presence))
+	}
+	if opts.sessionRevalidationPeriod > 0 {
+		wsOpts = append(wsOpts,
+			websocketstream.WithTokenIntrospector(opts.introspector),
+			websocketstream.WithRevalidationPeriod(opts.sessionRevalidationPeriod),
+		)
+	}
+
 	wsHandler, err := websocketstream.NewHTTPHandler(websocketstream.NewOptions(
 		opts.logger,
 		opts.eventStream,
@@ -92,12 +156,19 @@ func New(opts Options) (*Server, error) {
 		websocketstream.NewUpgrader(opts.allowOrigins, opts.wsSecProtocol),
 		shutdownCh,
 		tokenexpiration.New(),
+		wsOpts...,
 	))
 	if err != nil {
 		return nil, fmt.Errorf("create ws handler, err=%v", err)
 	}
 
-	e.GET("/ws", wsHandler.Serve)
+	e.GET("/ws", func(c echo.Context) error {
+		// Do not take new long-living connections while dependencies are not available.
+		if !opts.readiness.IsReady() {
+			return echo.NewHTTPError(http.StatusServiceUnavailable, "service is not ready")
+		}
+		return wsHandler.Serve(c)
+	})
 
 	return &Server{
 		lg:  opts.logger,
@@ -3,6 +3,7 @@ package server
 
 import (
 	fmt461e464ebed9 "fmt"
+	"time"
 
 	"github.com/karasunokami/chat-service/internal/middlewares"
 	eventstream "github.com/karasunokami/chat-service/internal/services/event-stream"
@@ -17,6 +18,7 @@ type OptOptionsSetter func(o *Options)
 
 func NewOptions(
 	logger *zap.Logger,
+	serverName string,
 	addr string,
 	allowOrigins []string,
 	wsSecProtocol string,
@@ -26,6 +28,7 @@ func NewOptions(
 	introspector middlewares.Introspector,
 	eventStream eventstream.EventStream,
 	eventsAdapter websocketstream.EventAdapter,
+	readiness readinessChecker,
 	options ...OptOptionsSetter,
 ) Options {
 	o := Options{}
@@ -33,6 +36,7 @@ func NewOptions(
 	// Setting defaults from field tag (if present)
 
 	o.logger = logger
+	o.serverName = serverName
 	o.addr = addr
 	o.allowOrigins = allowOrigins
 	o.wsSecProtocol = wsSecProtocol
@@ -42,6 +46,7 @@ func NewOptions(
 	o.introspector = introspector
 	o.eventStream = eventStream
 	o.eventsAdapter = eventsAdapter
+	o.readiness = readiness
 
 	for _, opt := range options {
 		opt(&o)
@@ -49,9 +54,46 @@ func NewOptions(
 	return o
 }
 
+func WithSupervisorRole(opt string) OptOptionsSetter {
+	return func(o *Options) {
+		o.supervisorRole = opt
+	}
+}
+
+func WithKeySet(opt middlewares.KeySet) OptOptionsSetter {
+	return func(o *Options) {
+		o.keySet = opt
+	}
+}
+
+func WithIntrospectionFallback(opt bool) OptOptionsSetter {
+	return func(o *Options) {
+		o.introspectionFallback = opt
+	}
+}
+
+func WithSessionRevalidationPeriod(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) {
+		o.sessionRevalidationPeriod = opt
+	}
+}
+
+func WithMaxWsConnectionsPerUser(opt int) OptOptionsSetter {
+	return func(o *Options) {
+		o.maxWsConnectionsPerUser = opt
+	}
+}
+
+func WithPresence(opt presenceTracker) OptOptionsSetter {
+	return func(o *Options) {
+		o.presence = opt
+	}
+}
+
 func (o *Options) Validate() error {
 	errs := new(errors461e464ebed9.ValidationErrors)
 	errs.Add(errors461e464ebed9.NewValidationError("logger", _validate_Options_logger(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("serverName", _validate_Options_serverName(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("addr", _validate_Options_addr(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("allowOrigins", _validate_Options_allowOrigins(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("wsSecProtocol", _validate_Options_wsSecProtocol(o)))
@@ -61,6 +103,8 @@ func (o *Options) Validate() error {
 	errs.Add(errors461e464ebed9.NewValidationError("introspector", _validate_Options_introspector(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("eventStream", _validate_Options_eventStream(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("eventsAdapter", _validate_Options_eventsAdapter(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("readiness", _validate_Options_readiness(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("maxWsConnectionsPerUser", _validate_Options_maxWsConnectionsPerUser(o)))
 	return errs.AsError()
 }
 
@@ -71,6 +115,13 @@ func _validate_Options_logger(o *Options) error {
 	return nil
 }
 
+func _validate_Options_serverName(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.serverName, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `serverName` did not pass the test: %w", err)
+	}
+	return nil
+}
+
 func _validate_Options_addr(o *Options) error {
 	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.addr, "required,hostname_port"); err != nil {
 		return fmt461e464ebed9.Errorf("field `addr` did not pass the test: %w", err)
@@ -133,3 +184,17 @@ func _validate_Options_eventsAdapter(o *Options) error {
 	}
 	return nil
 }
+
+func _validate_Options_readiness(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.readiness, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `readiness` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_maxWsConnectionsPerUser(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.maxWsConnectionsPerUser, "min=0"); err != nil {
+		return fmt461e464ebed9.Errorf("field `maxWsConnectionsPerUser` did not pass the test: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: scanner.go
+
+// Package afcdlqmocks is a generated GoMock package.
+package afcdlqmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	msgbus "github.com/karasunokami/chat-service/internal/services/msg-bus"
+)
+
+// MockTopicScanner is a mock of TopicScanner interface.
+type MockTopicScanner struct {
+	ctrl     *gomock.Controller
+	recorder *MockTopicScannerMockRecorder
+}
+
+// MockTopicScannerMockRecorder is the mock recorder for MockTopicScanner.
+type MockTopicScannerMockRecorder struct {
+	mock *MockTopicScanner
+}
+
+// NewMockTopicScanner creates a new mock instance.
+func NewMockTopicScanner(ctrl *gomock.Controller) *MockTopicScanner {
+	mock := &MockTopicScanner{ctrl: ctrl}
+	mock.recorder = &MockTopicScannerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockTopicScanner) EXPECT() *MockTopicScannerMockRecorder {
+	return m.recorder
+}
+
+// Scan mocks base method.
+func (m *MockTopicScanner) Scan(ctx context.Context, f func(msgbus.Message) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Scan", ctx, f)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Scan indicates an expected call of Scan.
+func (mr *MockTopicScannerMockRecorder) Scan(ctx, f interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Scan", reflect.TypeOf((*MockTopicScanner)(nil).Scan), ctx, f)
+}
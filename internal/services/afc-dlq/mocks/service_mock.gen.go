@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package afcdlqmocks is a generated GoMock package.
+package afcdlqmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	msgbus "github.com/karasunokami/chat-service/internal/services/msg-bus"
+)
+
+// MockverdictsProcessor is a mock of verdictsProcessor interface.
+type MockverdictsProcessor struct {
+	ctrl     *gomock.Controller
+	recorder *MockverdictsProcessorMockRecorder
+}
+
+// MockverdictsProcessorMockRecorder is the mock recorder for MockverdictsProcessor.
+type MockverdictsProcessorMockRecorder struct {
+	mock *MockverdictsProcessor
+}
+
+// NewMockverdictsProcessor creates a new mock instance.
+func NewMockverdictsProcessor(ctrl *gomock.Controller) *MockverdictsProcessor {
+	mock := &MockverdictsProcessor{ctrl: ctrl}
+	mock.recorder = &MockverdictsProcessorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockverdictsProcessor) EXPECT() *MockverdictsProcessorMockRecorder {
+	return m.recorder
+}
+
+// ProcessVerdict mocks base method.
+func (m_2 *MockverdictsProcessor) ProcessVerdict(ctx context.Context, m msgbus.Message) error {
+	m_2.ctrl.T.Helper()
+	ret := m_2.ctrl.Call(m_2, "ProcessVerdict", ctx, m)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProcessVerdict indicates an expected call of ProcessVerdict.
+func (mr *MockverdictsProcessorMockRecorder) ProcessVerdict(ctx, m interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProcessVerdict", reflect.TypeOf((*MockverdictsProcessor)(nil).ProcessVerdict), ctx, m)
+}
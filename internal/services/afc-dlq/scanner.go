@@ -0,0 +1,101 @@
+package afcdlq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/karasunokami/chat-service/internal/logger"
+	msgbus "github.com/karasunokami/chat-service/internal/services/msg-bus"
+
+	"github.com/segmentio/kafka-go"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/scanner_mock.gen.go -package=afcdlqmocks
+
+// TopicScanner reads all the messages of the topic available at the moment of the call.
+// It never commits offsets, so the scanning is repeatable.
+type TopicScanner interface {
+	Scan(ctx context.Context, f func(m msgbus.Message) error) error
+}
+
+var errNoBrokers = errors.New("no brokers")
+
+func NewKafkaScanner(brokers []string, topic string) TopicScanner {
+	return &kafkaScanner{brokers: brokers, topic: topic}
+}
+
+type kafkaScanner struct {
+	brokers []string
+	topic   string
+}
+
+func (s *kafkaScanner) Scan(ctx context.Context, f func(m msgbus.Message) error) error {
+	if len(s.brokers) == 0 {
+		return errNoBrokers
+	}
+
+	conn, err := kafka.DialContext(ctx, "tcp", s.brokers[0])
+	if err != nil {
+		return fmt.Errorf("dial kafka, err=%v", err)
+	}
+
+	partitions, err := conn.ReadPartitions(s.topic)
+	_ = conn.Close()
+	if err != nil {
+		return fmt.Errorf("read partitions, err=%v", err)
+	}
+
+	for _, p := range partitions {
+		if err := s.scanPartition(ctx, p.ID, f); err != nil {
+			return fmt.Errorf("scan partition %d, err=%w", p.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func (s *kafkaScanner) scanPartition(ctx context.Context, partition int, f func(m msgbus.Message) error) error {
+	leader, err := kafka.DialLeader(ctx, "tcp", s.brokers[0], s.topic, partition)
+	if err != nil {
+		return fmt.Errorf("dial partition leader, err=%v", err)
+	}
+
+	first, last, err := leader.ReadOffsets()
+	_ = leader.Close()
+	if err != nil {
+		return fmt.Errorf("read offsets, err=%v", err)
+	}
+
+	if first >= last {
+		return nil
+	}
+
+	r := kafka.NewReader(kafka.ReaderConfig{
+		Brokers:     s.brokers,
+		Topic:       s.topic,
+		Partition:   partition,
+		Logger:      logger.NewKafkaAdapted().WithServiceName(serviceName),
+		ErrorLogger: logger.NewKafkaAdapted().WithServiceName(serviceName).ForErrors(),
+	})
+	defer r.Close()
+
+	if err := r.SetOffset(first); err != nil {
+		return fmt.Errorf("set offset, err=%v", err)
+	}
+
+	for {
+		m, err := r.ReadMessage(ctx)
+		if err != nil {
+			return fmt.Errorf("read message, err=%v", err)
+		}
+
+		if err := f(msgbus.FromKafkaMessage(m)); err != nil {
+			return err
+		}
+
+		if m.Offset >= last-1 {
+			return nil
+		}
+	}
+}
@@ -0,0 +1,62 @@
+//go:build integration
+
+package afcdlq_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	afcdlq "github.com/karasunokami/chat-service/internal/services/afc-dlq"
+	msgbus "github.com/karasunokami/chat-service/internal/services/msg-bus"
+	"github.com/karasunokami/chat-service/internal/testingh"
+
+	"github.com/segmentio/kafka-go"
+	"github.com/stretchr/testify/suite"
+)
+
+type ScannerIntegrationSuite struct {
+	testingh.KafkaSuite
+}
+
+func TestScannerIntegrationSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ScannerIntegrationSuite))
+}
+
+func (s *ScannerIntegrationSuite) TestScanIsRepeatable() {
+	// Arrange.
+	const n = 20
+
+	topic := fmt.Sprintf("%s.%d", "afc.msg-verdicts.dlq", time.Now().UnixMilli())
+	s.RecreateTopics(topic)
+
+	w := &kafka.Writer{
+		Addr:         kafka.TCP(s.KafkaBrokers()...),
+		Topic:        topic,
+		Balancer:     &kafka.RoundRobin{},
+		RequiredAcks: kafka.RequireOne,
+	}
+	defer func() { s.NoError(w.Close()) }()
+
+	msgs := make([]kafka.Message, n)
+	for i := range msgs {
+		msgs[i] = kafka.Message{Value: []byte(fmt.Sprintf("verdict %d", i))}
+	}
+	s.Require().NoError(w.WriteMessages(s.Ctx, msgs...))
+
+	scanner := afcdlq.NewKafkaScanner(s.KafkaBrokers(), topic)
+
+	for i := 0; i < 2; i++ {
+		// Action.
+		values := make(map[string]struct{})
+		err := scanner.Scan(s.Ctx, func(m msgbus.Message) error {
+			values[string(m.Value)] = struct{}{}
+			return nil
+		})
+
+		// Assert.
+		s.Require().NoError(err)
+		s.Len(values, n)
+	}
+}
@@ -0,0 +1,210 @@
+package afcdlq
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	afcverdictsprocessor "github.com/karasunokami/chat-service/internal/services/afc-verdicts-processor"
+	msgbus "github.com/karasunokami/chat-service/internal/services/msg-bus"
+
+	"go.uber.org/zap"
+)
+
+const serviceName = "afc-dlq"
+
+var errNoReplayTarget = errors.New("either verdicts writer or verdicts processor is required")
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/service_mock.gen.go -package=afcdlqmocks
+
+type verdictsProcessor interface {
+	ProcessVerdict(ctx context.Context, m msgbus.Message) error
+}
+
+//go:generate options-gen -out-filename=service_options.gen.go -from-struct=Options
+type Options struct {
+	scanner TopicScanner `option:"mandatory" validate:"required"`
+
+	// writer re-publishes the replayed verdicts to the verdicts topic.
+	writer msgbus.Writer
+	// processor applies the replayed verdicts directly. It takes precedence over the writer.
+	processor verdictsProcessor
+	// dryRun only selects the verdicts to replay.
+	dryRun bool
+}
+
+// Entry is the verdict from the DLQ.
+type Entry struct {
+	Partition         int
+	Offset            int64
+	Time              time.Time
+	OriginalPartition int
+	LastError         string
+
+	msg msgbus.Message
+}
+
+// Position identifies the DLQ message.
+type Position struct {
+	Partition int
+	Offset    int64
+}
+
+// Filter selects the DLQ entries. Zero fields match any entry.
+type Filter struct {
+	// Reason is a case-insensitive substring of the entry last error.
+	Reason string
+	// From and To limit the time the entry got into the DLQ, [From, To).
+	From time.Time
+	To   time.Time
+	// Positions select the exact entries.
+	Positions []Position
+	Limit     int
+}
+
+// ReplayResult is the outcome of the single entry replay, Err is nil on success.
+type ReplayResult struct {
+	Entry
+	Err error
+}
+
+// Service lists and replays the verdicts, which failed to be processed and got into the DLQ.
+type Service struct {
+	Options
+	logger *zap.Logger
+}
+
+func New(opts Options) (*Service, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate options, err=%v", err)
+	}
+
+	return &Service{
+		Options: opts,
+		logger:  zap.L().Named(serviceName),
+	}, nil
+}
+
+// List returns the DLQ entries matching the filter.
+func (s *Service) List(ctx context.Context, f Filter) ([]Entry, error) {
+	var entries []Entry
+
+	errLimitReached := errors.New("limit reached")
+
+	err := s.scanner.Scan(ctx, func(m msgbus.Message) error {
+		e := newEntry(m)
+		if !f.matches(e) {
+			return nil
+		}
+
+		entries = append(entries, e)
+		if f.Limit > 0 && len(entries) >= f.Limit {
+			return errLimitReached
+		}
+
+		return nil
+	})
+	if err != nil && !errors.Is(err, errLimitReached) {
+		return nil, fmt.Errorf("scan dlq, err=%v", err)
+	}
+
+	return entries, nil
+}
+
+// Replay re-publishes or processes the DLQ entries matching the filter.
+// The failure of the single entry doesn't stop the replay.
+func (s *Service) Replay(ctx context.Context, f Filter) ([]ReplayResult, error) {
+	if !s.dryRun && s.writer == nil && s.processor == nil {
+		return nil, errNoReplayTarget
+	}
+
+	entries, err := s.List(ctx, f)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]ReplayResult, 0, len(entries))
+	for _, e := range entries {
+		if s.dryRun {
+			results = append(results, ReplayResult{Entry: e})
+			continue
+		}
+
+		err := s.replay(ctx, e)
+		if err != nil {
+			s.logger.Warn("Replay dlq entry",
+				zap.Int("partition", e.Partition), zap.Int64("offset", e.Offset), zap.Error(err))
+		}
+
+		results = append(results, ReplayResult{Entry: e, Err: err})
+	}
+
+	return results, nil
+}
+
+func (s *Service) replay(ctx context.Context, e Entry) error {
+	m := msgbus.Message{Key: e.msg.Key, Value: e.msg.Value}
+
+	if s.processor != nil {
+		if err := s.processor.ProcessVerdict(ctx, m); err != nil {
+			return fmt.Errorf("process verdict, err=%w", err)
+		}
+		return nil
+	}
+
+	if err := s.writer.WriteMessages(ctx, m); err != nil {
+		return fmt.Errorf("write verdict, err=%w", err)
+	}
+
+	return nil
+}
+
+func newEntry(m msgbus.Message) Entry {
+	e := Entry{
+		Partition: m.Partition,
+		Offset:    m.Offset,
+		Time:      m.Time,
+		msg:       m,
+	}
+
+	for _, h := range m.Headers {
+		switch h.Key {
+		case afcverdictsprocessor.DLQHeaderLastError:
+			e.LastError = string(h.Value)
+		case afcverdictsprocessor.DLQHeaderOriginalPartition:
+			if len(h.Value) == 1 {
+				e.OriginalPartition = int(h.Value[0])
+			}
+		}
+	}
+
+	return e
+}
+
+func (f Filter) matches(e Entry) bool {
+	if f.Reason != "" && !strings.Contains(strings.ToLower(e.LastError), strings.ToLower(f.Reason)) {
+		return false
+	}
+
+	if !f.From.IsZero() && e.Time.Before(f.From) {
+		return false
+	}
+
+	if !f.To.IsZero() && !e.Time.Before(f.To) {
+		return false
+	}
+
+	if len(f.Positions) == 0 {
+		return true
+	}
+
+	for _, p := range f.Positions {
+		if p.Partition == e.Partition && p.Offset == e.Offset {
+			return true
+		}
+	}
+
+	return false
+}
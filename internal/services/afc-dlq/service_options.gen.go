@@ -0,0 +1,59 @@
+// Code generated by options-gen. DO NOT EDIT.
+package afcdlq
+
+import (
+	fmt461e464ebed9 "fmt"
+
+	msgbus "github.com/karasunokami/chat-service/internal/services/msg-bus"
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	scanner TopicScanner,
+	options ...OptOptionsSetter,
+) Options {
+	o := Options{}
+
+	// Setting defaults from field tag (if present)
+
+	o.scanner = scanner
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func WithWriter(opt msgbus.Writer) OptOptionsSetter {
+	return func(o *Options) {
+		o.writer = opt
+	}
+}
+
+func WithProcessor(opt verdictsProcessor) OptOptionsSetter {
+	return func(o *Options) {
+		o.processor = opt
+	}
+}
+
+func WithDryRun(opt bool) OptOptionsSetter {
+	return func(o *Options) {
+		o.dryRun = opt
+	}
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("scanner", _validate_Options_scanner(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_scanner(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.scanner, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `scanner` did not pass the test: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,185 @@
+package afcdlq_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	afcdlq "github.com/karasunokami/chat-service/internal/services/afc-dlq"
+	afcdlqmocks "github.com/karasunokami/chat-service/internal/services/afc-dlq/mocks"
+	afcverdictsprocessor "github.com/karasunokami/chat-service/internal/services/afc-verdicts-processor"
+	msgbus "github.com/karasunokami/chat-service/internal/services/msg-bus"
+	msgbusmocks "github.com/karasunokami/chat-service/internal/services/msg-bus/mocks"
+	"github.com/karasunokami/chat-service/internal/testingh"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ServiceSuite struct {
+	testingh.ContextSuite
+
+	ctrl      *gomock.Controller
+	scanner   *afcdlqmocks.MockTopicScanner
+	writer    *msgbusmocks.MockWriter
+	processor *afcdlqmocks.MockverdictsProcessor
+
+	now  time.Time
+	msgs []msgbus.Message
+}
+
+func TestServiceSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ServiceSuite))
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.ContextSuite.SetupTest()
+
+	s.ctrl = gomock.NewController(s.T())
+	s.scanner = afcdlqmocks.NewMockTopicScanner(s.ctrl)
+	s.writer = msgbusmocks.NewMockWriter(s.ctrl)
+	s.processor = afcdlqmocks.NewMockverdictsProcessor(s.ctrl)
+
+	s.now = time.Now()
+	s.msgs = []msgbus.Message{
+		dlqMsg(0, 10, s.now.Add(-time.Hour), "parse verdict: unknown verdict signing key: kid=\"old\""),
+		dlqMsg(1, 3, s.now.Add(-time.Minute), "msg repo block messages: message not found"),
+		dlqMsg(1, 4, s.now, "parse verdict: unknown verdict signing key: kid=\"old\""),
+	}
+	s.scanner.EXPECT().Scan(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, f func(m msgbus.Message) error) error {
+			for _, m := range s.msgs {
+				if err := f(m); err != nil {
+					return err
+				}
+			}
+			return nil
+		}).AnyTimes()
+}
+
+func (s *ServiceSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *ServiceSuite) TestList() {
+	svc := s.newService()
+
+	s.Run("all", func() {
+		entries, err := svc.List(s.Ctx, afcdlq.Filter{})
+		s.Require().NoError(err)
+		s.Require().Len(entries, 3)
+
+		s.Equal(0, entries[0].Partition)
+		s.Equal(int64(10), entries[0].Offset)
+		s.Equal(5, entries[0].OriginalPartition)
+		s.Contains(entries[0].LastError, "unknown verdict signing key")
+	})
+
+	s.Run("by reason", func() {
+		entries, err := svc.List(s.Ctx, afcdlq.Filter{Reason: "NOT FOUND"})
+		s.Require().NoError(err)
+		s.Require().Len(entries, 1)
+		s.Equal(int64(3), entries[0].Offset)
+	})
+
+	s.Run("by time", func() {
+		entries, err := svc.List(s.Ctx, afcdlq.Filter{From: s.now.Add(-30 * time.Minute), To: s.now})
+		s.Require().NoError(err)
+		s.Require().Len(entries, 1)
+		s.Equal(int64(3), entries[0].Offset)
+	})
+
+	s.Run("by position", func() {
+		entries, err := svc.List(s.Ctx, afcdlq.Filter{Positions: []afcdlq.Position{{Partition: 1, Offset: 4}}})
+		s.Require().NoError(err)
+		s.Require().Len(entries, 1)
+		s.Equal(1, entries[0].Partition)
+		s.Equal(int64(4), entries[0].Offset)
+	})
+
+	s.Run("limit", func() {
+		entries, err := svc.List(s.Ctx, afcdlq.Filter{Limit: 2})
+		s.Require().NoError(err)
+		s.Len(entries, 2)
+	})
+}
+
+func (s *ServiceSuite) TestReplay_Republish() {
+	// Arrange.
+	svc := s.newService(afcdlq.WithWriter(s.writer))
+
+	s.writer.EXPECT().WriteMessages(gomock.Any(), msgbus.Message{Key: s.msgs[0].Key, Value: s.msgs[0].Value}).Return(nil)
+	s.writer.EXPECT().WriteMessages(gomock.Any(), msgbus.Message{Key: s.msgs[2].Key, Value: s.msgs[2].Value}).
+		Return(errors.New("unexpected"))
+
+	// Action.
+	results, err := svc.Replay(s.Ctx, afcdlq.Filter{Reason: "signing key"})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Require().Len(results, 2)
+	s.NoError(results[0].Err)
+	s.Error(results[1].Err)
+}
+
+func (s *ServiceSuite) TestReplay_ProcessDirectly() {
+	// Arrange.
+	svc := s.newService(afcdlq.WithWriter(s.writer), afcdlq.WithProcessor(s.processor))
+
+	s.processor.EXPECT().ProcessVerdict(gomock.Any(), msgbus.Message{Key: s.msgs[1].Key, Value: s.msgs[1].Value}).
+		Return(nil)
+
+	// Action.
+	results, err := svc.Replay(s.Ctx, afcdlq.Filter{Reason: "not found"})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Require().Len(results, 1)
+	s.NoError(results[0].Err)
+}
+
+func (s *ServiceSuite) TestReplay_DryRun() {
+	// Arrange.
+	svc := s.newService(afcdlq.WithWriter(s.writer), afcdlq.WithDryRun(true))
+
+	// Action.
+	results, err := svc.Replay(s.Ctx, afcdlq.Filter{})
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Len(results, 3)
+}
+
+func (s *ServiceSuite) TestReplay_NoTarget() {
+	svc := s.newService()
+
+	_, err := svc.Replay(s.Ctx, afcdlq.Filter{})
+	s.Require().Error(err)
+}
+
+func (s *ServiceSuite) newService(opts ...afcdlq.OptOptionsSetter) *afcdlq.Service {
+	s.T().Helper()
+
+	svc, err := afcdlq.New(afcdlq.NewOptions(s.scanner, opts...))
+	s.Require().NoError(err)
+
+	return svc
+}
+
+func dlqMsg(partition int, offset int64, t time.Time, lastErr string) msgbus.Message {
+	return msgbus.Message{
+		Partition: partition,
+		Offset:    offset,
+		Time:      t,
+		Key:       []byte("chat-id"),
+		Value:     []byte("verdict"),
+		Headers: []msgbus.Header{
+			{Key: afcverdictsprocessor.DLQHeaderLastError, Value: []byte(lastErr)},
+			{Key: afcverdictsprocessor.DLQHeaderOriginalPartition, Value: []byte{5}},
+		},
+	}
+}
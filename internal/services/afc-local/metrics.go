@@ -0,0 +1,24 @@
+package afclocal
+
+import (
+	"github.com/karasunokami/chat-service/internal/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	verdictsCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "afc_local",
+		Name:      "verdicts_total",
+		Help:      "Number of verdicts made by the local AFC, the rule is empty for the ok verdicts.",
+	}, []string{"status", "rule"})
+
+	decodeErrorsCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "afc_local",
+		Name:      "decode_errors_total",
+		Help:      "Number of messages skipped because they cannot be decoded.",
+	})
+)
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package afclocalmocks is a generated GoMock package.
+package afclocalmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	types "github.com/karasunokami/chat-service/internal/types"
+)
+
+// MockverdictsApplier is a mock of verdictsApplier interface.
+type MockverdictsApplier struct {
+	ctrl     *gomock.Controller
+	recorder *MockverdictsApplierMockRecorder
+}
+
+// MockverdictsApplierMockRecorder is the mock recorder for MockverdictsApplier.
+type MockverdictsApplierMockRecorder struct {
+	mock *MockverdictsApplier
+}
+
+// NewMockverdictsApplier creates a new mock instance.
+func NewMockverdictsApplier(ctrl *gomock.Controller) *MockverdictsApplier {
+	mock := &MockverdictsApplier{ctrl: ctrl}
+	mock.recorder = &MockverdictsApplierMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockverdictsApplier) EXPECT() *MockverdictsApplierMockRecorder {
+	return m.recorder
+}
+
+// ApplyVerdict mocks base method.
+func (m *MockverdictsApplier) ApplyVerdict(ctx context.Context, chatID types.ChatID, msgID types.MessageID, suspicious bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ApplyVerdict", ctx, chatID, msgID, suspicious)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ApplyVerdict indicates an expected call of ApplyVerdict.
+func (mr *MockverdictsApplierMockRecorder) ApplyVerdict(ctx, chatID, msgID, suspicious interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ApplyVerdict", reflect.TypeOf((*MockverdictsApplier)(nil).ApplyVerdict), ctx, chatID, msgID, suspicious)
+}
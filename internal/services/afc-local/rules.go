@@ -0,0 +1,142 @@
+package afclocal
+
+import (
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+	"unicode"
+
+	"github.com/karasunokami/chat-service/internal/types"
+)
+
+// The rules, their names are the reasons of the suspicious verdicts.
+const (
+	RulePattern     = "pattern"
+	RuleBlockedWord = "blocked_word"
+	RuleLink        = "link"
+	RuleRate        = "rate"
+)
+
+var linkRegexp = regexp.MustCompile(`(?i)(?:https?://|www\.)([^\s/?#:]+)`)
+
+type rules struct {
+	patterns           []*regexp.Regexp
+	blockedWords       map[string]struct{}
+	blockLinks         bool
+	allowedLinkDomains []string
+}
+
+// match returns the first rule the body violates or the empty string.
+func (r rules) match(body string) string {
+	for _, p := range r.patterns {
+		if p.MatchString(body) {
+			return RulePattern
+		}
+	}
+
+	if len(r.blockedWords) > 0 {
+		words := strings.FieldsFunc(strings.ToLower(body), func(c rune) bool {
+			return !unicode.IsLetter(c) && !unicode.IsDigit(c)
+		})
+		for _, w := range words {
+			if _, ok := r.blockedWords[w]; ok {
+				return RuleBlockedWord
+			}
+		}
+	}
+
+	if r.blockLinks {
+		for _, m := range linkRegexp.FindAllStringSubmatch(body, -1) {
+			if !r.linkAllowed(strings.ToLower(m[1])) {
+				return RuleLink
+			}
+		}
+	}
+
+	return ""
+}
+
+func (r rules) linkAllowed(host string) bool {
+	for _, d := range r.allowedLinkDomains {
+		if host == d || strings.HasSuffix(host, "."+d) {
+			return true
+		}
+	}
+	return false
+}
+
+// rateLimiter counts the messages of the chats within the sliding window.
+// Every message is counted once, so the resends of the afc watchdog do not exhaust the limit.
+type rateLimiter struct {
+	limit  int
+	period time.Duration
+
+	mu          sync.Mutex
+	sent        map[types.ChatID][]sentMessage
+	lastCleanup time.Time
+}
+
+type sentMessage struct {
+	id types.MessageID
+	at time.Time
+}
+
+func newRateLimiter(limit int, period time.Duration) *rateLimiter {
+	return &rateLimiter{
+		limit:  limit,
+		period: period,
+		sent:   make(map[types.ChatID][]sentMessage),
+	}
+}
+
+// allow registers the message and reports whether the chat is within the limit.
+// The zero limit allows everything.
+func (l *rateLimiter) allow(chatID types.ChatID, msgID types.MessageID, now time.Time) bool {
+	if l.limit == 0 {
+		return true
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	if now.Sub(l.lastCleanup) > l.period {
+		l.cleanup(now)
+	}
+
+	sent := l.actual(l.sent[chatID], now)
+	for i, m := range sent {
+		if m.id == msgID {
+			l.sent[chatID] = sent
+			return i < l.limit
+		}
+	}
+
+	sent = append(sent, sentMessage{id: msgID, at: now})
+	l.sent[chatID] = sent
+
+	return len(sent) <= l.limit
+}
+
+// cleanup forgets the chats without the messages within the window.
+func (l *rateLimiter) cleanup(now time.Time) {
+	for chatID, sent := range l.sent {
+		if sent = l.actual(sent, now); len(sent) == 0 {
+			delete(l.sent, chatID)
+		} else {
+			l.sent[chatID] = sent
+		}
+	}
+	l.lastCleanup = now
+}
+
+func (l *rateLimiter) actual(sent []sentMessage, now time.Time) []sentMessage {
+	from := now.Add(-l.period)
+
+	i := 0
+	for i < len(sent) && !sent[i].at.After(from) {
+		i++
+	}
+
+	return sent[i:]
+}
@@ -0,0 +1,159 @@
+package afclocal
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"strings"
+	"time"
+
+	msgbus "github.com/karasunokami/chat-service/internal/services/msg-bus"
+	msgproducer "github.com/karasunokami/chat-service/internal/services/msg-producer"
+	"github.com/karasunokami/chat-service/internal/tracing"
+	"github.com/karasunokami/chat-service/internal/types"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+const serviceName = "afc-local"
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/service_mock.gen.go -package=afclocalmocks
+
+type verdictsApplier interface {
+	ApplyVerdict(ctx context.Context, chatID types.ChatID, msgID types.MessageID, suspicious bool) error
+}
+
+//go:generate options-gen -out-filename=service_options.gen.go -from-struct=Options
+type Options struct {
+	reader   msgbus.Reader                                       `option:"mandatory" validate:"required"`
+	decode   func(m msgbus.Message) (msgproducer.Message, error) `option:"mandatory" validate:"required"`
+	verdicts verdictsApplier                                     `option:"mandatory" validate:"required"`
+
+	// patterns are the regular expressions of the forbidden content, e.g. card numbers.
+	patterns     []string
+	blockedWords []string
+	// blockLinks makes the messages with the links suspicious, except the links to allowedLinkDomains and their subdomains.
+	blockLinks         bool
+	allowedLinkDomains []string
+	// rateLimit is the max number of messages of the chat within ratePeriod, zero disables the rule.
+	rateLimit  int           `validate:"min=0,max=10000"`
+	ratePeriod time.Duration `default:"1m" validate:"min=1s,max=24h"`
+}
+
+// Service is the in-process AFC. It checks the produced client messages against the rules
+// and applies the verdicts the same way as the verdicts of the external AFC.
+type Service struct {
+	Options
+
+	rules  rules
+	rate   *rateLimiter
+	logger *zap.Logger
+}
+
+func New(opts Options) (*Service, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate options, err=%v", err)
+	}
+
+	r := rules{
+		patterns:     make([]*regexp.Regexp, 0, len(opts.patterns)),
+		blockedWords: make(map[string]struct{}, len(opts.blockedWords)),
+		blockLinks:   opts.blockLinks,
+	}
+	for _, p := range opts.patterns {
+		re, err := regexp.Compile(p)
+		if err != nil {
+			return nil, fmt.Errorf("compile pattern %q, err=%v", p, err)
+		}
+		r.patterns = append(r.patterns, re)
+	}
+	for _, w := range opts.blockedWords {
+		r.blockedWords[strings.ToLower(w)] = struct{}{}
+	}
+	for _, d := range opts.allowedLinkDomains {
+		r.allowedLinkDomains = append(r.allowedLinkDomains, strings.ToLower(d))
+	}
+
+	return &Service{
+		Options: opts,
+		rules:   r,
+		rate:    newRateLimiter(opts.rateLimit, opts.ratePeriod),
+		logger:  zap.L().Named(serviceName),
+	}, nil
+}
+
+func (s *Service) Run(ctx context.Context) error {
+	defer func() {
+		if err := s.reader.Close(); err != nil {
+			s.logger.Error("Close messages reader", zap.Error(err))
+		}
+	}()
+
+	for {
+		m, err := s.reader.FetchMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil || errors.Is(err, io.EOF) {
+				return nil
+			}
+			return fmt.Errorf("fetch message, err=%v", err)
+		}
+
+		s.handle(ctx, m)
+
+		// The messages without the applied verdict are resent by the AFC watchdog.
+		if err := s.reader.CommitMessages(ctx, m); err != nil {
+			if ctx.Err() != nil {
+				return nil
+			}
+			s.logger.Error("Commit message", zap.Error(err))
+		}
+	}
+}
+
+func (s *Service) handle(ctx context.Context, m msgbus.Message) {
+	ctx, span := tracing.Start(tracing.ExtractMessageHeaders(ctx, &m), "afclocal.HandleMessage",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+	)
+
+	err := s.check(ctx, m)
+	if err != nil {
+		s.logger.Error("Check message", zap.Error(err))
+	}
+
+	tracing.End(span, err)
+}
+
+func (s *Service) check(ctx context.Context, m msgbus.Message) error {
+	msg, err := s.decode(m)
+	if err != nil {
+		decodeErrorsCounter.Inc()
+		return fmt.Errorf("decode message, err=%v", err)
+	}
+
+	if !msg.FromClient {
+		return nil
+	}
+
+	rule := s.rules.match(msg.Body)
+	if !s.rate.allow(msg.ChatID, msg.ID, time.Now()) && rule == "" {
+		rule = RuleRate
+	}
+	suspicious := rule != ""
+
+	err = s.verdicts.ApplyVerdict(ctx, msg.ChatID, msg.ID, suspicious)
+	if err != nil {
+		return fmt.Errorf("apply verdict, msg_id=%v, err=%v", msg.ID, err)
+	}
+
+	status := "ok"
+	if suspicious {
+		status = "suspicious"
+		s.logger.Info("Suspicious message", zap.Stringer("msg_id", msg.ID), zap.String("rule", rule))
+	}
+	verdictsCounter.WithLabelValues(status, rule).Inc()
+
+	return nil
+}
@@ -0,0 +1,116 @@
+// Code generated by options-gen. DO NOT EDIT.
+package afclocal
+
+import (
+	fmt461e464ebed9 "fmt"
+	"time"
+
+	msgbus "github.com/karasunokami/chat-service/internal/services/msg-bus"
+	msgproducer "github.com/karasunokami/chat-service/internal/services/msg-producer"
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	reader msgbus.Reader,
+	decode func(m msgbus.Message) (msgproducer.Message, error),
+	verdicts verdictsApplier,
+	options ...OptOptionsSetter,
+) Options {
+	o := Options{}
+
+	// Setting defaults from field tag (if present)
+	o.ratePeriod, _ = time.ParseDuration("1m")
+
+	o.reader = reader
+	o.decode = decode
+	o.verdicts = verdicts
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func WithPatterns(opt []string) OptOptionsSetter {
+	return func(o *Options) {
+		o.patterns = opt
+	}
+}
+
+func WithBlockedWords(opt []string) OptOptionsSetter {
+	return func(o *Options) {
+		o.blockedWords = opt
+	}
+}
+
+func WithBlockLinks(opt bool) OptOptionsSetter {
+	return func(o *Options) {
+		o.blockLinks = opt
+	}
+}
+
+func WithAllowedLinkDomains(opt []string) OptOptionsSetter {
+	return func(o *Options) {
+		o.allowedLinkDomains = opt
+	}
+}
+
+func WithRateLimit(opt int) OptOptionsSetter {
+	return func(o *Options) {
+		o.rateLimit = opt
+	}
+}
+
+func WithRatePeriod(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) {
+		o.ratePeriod = opt
+	}
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("reader", _validate_Options_reader(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("decode", _validate_Options_decode(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("verdicts", _validate_Options_verdicts(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("rateLimit", _validate_Options_rateLimit(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("ratePeriod", _validate_Options_ratePeriod(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_reader(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.reader, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `reader` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_decode(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.decode, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `decode` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_verdicts(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.verdicts, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `verdicts` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_rateLimit(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.rateLimit, "min=0,max=10000"); err != nil {
+		return fmt461e464ebed9.Errorf("field `rateLimit` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_ratePeriod(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.ratePeriod, "min=1s,max=24h"); err != nil {
+		return fmt461e464ebed9.Errorf("field `ratePeriod` did not pass the test: %w", err)
+	}
+	return nil
+}
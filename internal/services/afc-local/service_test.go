@@ -0,0 +1,213 @@
+package afclocal_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	afclocal "github.com/karasunokami/chat-service/internal/services/afc-local"
+	afclocalmocks "github.com/karasunokami/chat-service/internal/services/afc-local/mocks"
+	msgbus "github.com/karasunokami/chat-service/internal/services/msg-bus"
+	msgbusmocks "github.com/karasunokami/chat-service/internal/services/msg-bus/mocks"
+	msgproducer "github.com/karasunokami/chat-service/internal/services/msg-producer"
+	"github.com/karasunokami/chat-service/internal/types"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	topic         = "chat.messages"
+	consumerGroup = "afc-local"
+	encryptKey    = "24432646294A404E635266546A576E5A"
+)
+
+func TestService_Rules(t *testing.T) {
+	cases := []struct {
+		name       string
+		body       string
+		suspicious bool
+	}{
+		{name: "ok", body: "Hello, how can I change my tariff?"},
+		{name: "card number", body: "My card is 4276 3800 1234 5678", suspicious: true},
+		{name: "blocked word", body: "Send me the PASSWORD, please", suspicious: true},
+		{name: "blocked word in another case", body: "Назови свой пароль!", suspicious: true},
+		{name: "word containing blocked word", body: "Passwordless login does not work"},
+		{name: "link", body: "Look at https://evil.example.com/login", suspicious: true},
+		{name: "www link", body: "Look at www.evil.example.com", suspicious: true},
+		{name: "allowed link", body: "I read https://bank.ru/tariffs"},
+		{name: "allowed link subdomain", body: "I read https://help.Bank.ru/faq"},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			msg := msgproducer.Message{
+				ID:         types.NewMessageID(),
+				ChatID:     types.NewChatID(),
+				Body:       tt.body,
+				FromClient: true,
+			}
+
+			s := newTestEnv(t)
+			s.verdicts.EXPECT().ApplyVerdict(gomock.Any(), msg.ChatID, msg.ID, tt.suspicious).
+				DoAndReturn(s.done(1))
+
+			s.produce(msg)
+			s.run()
+		})
+	}
+}
+
+func TestService_RateLimit(t *testing.T) {
+	s := newTestEnv(t, afclocal.WithRateLimit(2), afclocal.WithRatePeriod(time.Minute))
+
+	chatID, otherChatID := types.NewChatID(), types.NewChatID()
+
+	var msgs []msgproducer.Message
+	for _, id := range []types.ChatID{chatID, chatID, otherChatID, chatID} {
+		msgs = append(msgs, msgproducer.Message{ID: types.NewMessageID(), ChatID: id, Body: "Hello", FromClient: true})
+	}
+
+	done := s.done(len(msgs))
+	gomock.InOrder(
+		s.verdicts.EXPECT().ApplyVerdict(gomock.Any(), chatID, msgs[0].ID, false).DoAndReturn(done),
+		s.verdicts.EXPECT().ApplyVerdict(gomock.Any(), chatID, msgs[1].ID, false).DoAndReturn(done),
+		s.verdicts.EXPECT().ApplyVerdict(gomock.Any(), otherChatID, msgs[2].ID, false).DoAndReturn(done),
+		s.verdicts.EXPECT().ApplyVerdict(gomock.Any(), chatID, msgs[3].ID, true).DoAndReturn(done),
+	)
+
+	s.produce(msgs...)
+	s.run()
+}
+
+func TestService_RateLimitIgnoresResends(t *testing.T) {
+	s := newTestEnv(t, afclocal.WithRateLimit(2), afclocal.WithRatePeriod(time.Minute))
+
+	chatID := types.NewChatID()
+	first := msgproducer.Message{ID: types.NewMessageID(), ChatID: chatID, Body: "Hello", FromClient: true}
+	second := msgproducer.Message{ID: types.NewMessageID(), ChatID: chatID, Body: "Are you there?", FromClient: true}
+
+	done := s.done(4)
+	gomock.InOrder(
+		s.verdicts.EXPECT().ApplyVerdict(gomock.Any(), chatID, first.ID, false).DoAndReturn(done),
+		s.verdicts.EXPECT().ApplyVerdict(gomock.Any(), chatID, first.ID, false).DoAndReturn(done),
+		s.verdicts.EXPECT().ApplyVerdict(gomock.Any(), chatID, first.ID, false).DoAndReturn(done),
+		s.verdicts.EXPECT().ApplyVerdict(gomock.Any(), chatID, second.ID, false).DoAndReturn(done),
+	)
+
+	// The afc watchdog resends the message without the verdict.
+	s.produce(first, first, first, second)
+	s.run()
+}
+
+func TestService_ManagerMessagesSkipped(t *testing.T) {
+	s := newTestEnv(t)
+
+	managerMsg := msgproducer.Message{ID: types.NewMessageID(), ChatID: types.NewChatID(), Body: "password"}
+	clientMsg := msgproducer.Message{ID: types.NewMessageID(), ChatID: types.NewChatID(), Body: "Hi", FromClient: true}
+
+	s.verdicts.EXPECT().ApplyVerdict(gomock.Any(), clientMsg.ChatID, clientMsg.ID, false).DoAndReturn(s.done(1))
+
+	s.produce(managerMsg, clientMsg)
+	s.run()
+}
+
+func TestService_InvalidPattern(t *testing.T) {
+	ctrl := gomock.NewController(t)
+
+	_, err := afclocal.New(afclocal.NewOptions(
+		msgbusmocks.NewMockReader(ctrl),
+		msgproducer.DecodePlain,
+		afclocalmocks.NewMockverdictsApplier(ctrl),
+		afclocal.WithPatterns([]string{"("}),
+	))
+	require.Error(t, err)
+}
+
+type testEnv struct {
+	t        *testing.T
+	ctx      context.Context
+	bus      *msgbus.Memory
+	verdicts *afclocalmocks.MockverdictsApplier
+	svc      *afclocal.Service
+
+	pending int
+	doneCh  chan struct{}
+}
+
+func newTestEnv(t *testing.T, opts ...afclocal.OptOptionsSetter) *testEnv {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	t.Cleanup(cancel)
+
+	bus, err := msgbus.NewMemory(msgbus.NewMemoryOptions())
+	require.NoError(t, err)
+
+	keyring, err := msgproducer.NewKeyring(map[string]string{"": encryptKey})
+	require.NoError(t, err)
+
+	verdicts := afclocalmocks.NewMockverdictsApplier(gomock.NewController(t))
+
+	opts = append([]afclocal.OptOptionsSetter{
+		afclocal.WithPatterns([]string{`\b(?:\d[ -]?){13,19}\b`}),
+		afclocal.WithBlockedWords([]string{"Password", "пароль"}),
+		afclocal.WithBlockLinks(true),
+		afclocal.WithAllowedLinkDomains([]string{"bank.ru"}),
+	}, opts...)
+
+	svc, err := afclocal.New(afclocal.NewOptions(bus.Reader(consumerGroup, topic), keyring.Decode, verdicts, opts...))
+	require.NoError(t, err)
+
+	return &testEnv{
+		t:        t,
+		ctx:      ctx,
+		bus:      bus,
+		verdicts: verdicts,
+		svc:      svc,
+		doneCh:   make(chan struct{}),
+	}
+}
+
+func (s *testEnv) produce(msgs ...msgproducer.Message) {
+	s.t.Helper()
+
+	producer, err := msgproducer.New(msgproducer.NewOptions(s.bus.Writer(topic), msgproducer.WithEncryptKey(encryptKey)))
+	require.NoError(s.t, err)
+	defer producer.Close()
+
+	for _, m := range msgs {
+		require.NoError(s.t, producer.ProduceMessage(s.ctx, m))
+	}
+}
+
+// done returns the ApplyVerdict stub, which stops the service after n calls.
+func (s *testEnv) done(n int) func(context.Context, types.ChatID, types.MessageID, bool) error {
+	s.pending = n
+
+	return func(context.Context, types.ChatID, types.MessageID, bool) error {
+		if s.pending--; s.pending == 0 {
+			close(s.doneCh)
+		}
+		return nil
+	}
+}
+
+func (s *testEnv) run() {
+	s.t.Helper()
+
+	ctx, cancel := context.WithCancel(s.ctx)
+	defer cancel()
+
+	errCh := make(chan error)
+	go func() { errCh <- s.svc.Run(ctx) }()
+
+	select {
+	case <-s.doneCh:
+	case <-ctx.Done():
+		s.t.Fatal("messages were not checked")
+	}
+
+	cancel()
+	require.NoError(s.t, <-errCh)
+}
@@ -0,0 +1,145 @@
+package afcverdictsprocessor
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	msgbus "github.com/karasunokami/chat-service/internal/services/msg-bus"
+	"github.com/karasunokami/chat-service/internal/tracing"
+	"github.com/karasunokami/chat-service/internal/types"
+
+	"go.opentelemetry.io/otel/trace"
+	"go.uber.org/zap"
+)
+
+type verdict struct {
+	msg     msgbus.Message
+	payload messagePayload
+}
+
+// fetchBatch blocks until the first message is fetched and then accumulates
+// up to processBatchSize messages, waiting no longer than processBatchMaxWait.
+// Messages fetched before an error are returned along with it.
+func (s *Service) fetchBatch(ctx context.Context, r msgbus.Reader) ([]msgbus.Message, error) {
+	m, err := r.FetchMessage(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	batch := make([]msgbus.Message, 0, s.processBatchSize)
+	batch = append(batch, m)
+
+	if s.processBatchSize == 1 {
+		return batch, nil
+	}
+
+	waitCtx, cancel := context.WithTimeout(ctx, s.processBatchMaxWait)
+	defer cancel()
+
+	for len(batch) < s.processBatchSize {
+		m, err := r.FetchMessage(waitCtx)
+		if err != nil {
+			if errors.Is(err, context.DeadlineExceeded) && ctx.Err() == nil {
+				break
+			}
+			return batch, err
+		}
+
+		batch = append(batch, m)
+	}
+
+	return batch, nil
+}
+
+// handleBatch applies the verdicts of the batch in a single transaction.
+// Malformed verdicts are routed to the DLQ right away. If the batch cannot be
+// applied as a whole, its verdicts are handled one by one, so only the failed
+// ones get to the DLQ.
+func (s *Service) handleBatch(ctx context.Context, batch []msgbus.Message) {
+	verdicts := make([]verdict, 0, len(batch))
+	for _, m := range batch {
+		mp, err := s.parseVerdict(m)
+		if err != nil {
+			s.logger.Debug("Parse verdict error", zap.Error(err))
+
+			s.writeMessageToDlq(ctx, m, err.Error())
+			continue
+		}
+
+		verdicts = append(verdicts, verdict{msg: m, payload: mp})
+	}
+
+	if len(verdicts) == 0 {
+		return
+	}
+
+	err := s.applyBatch(ctx, verdicts)
+	if err == nil {
+		return
+	}
+
+	s.logger.Warn("Apply verdicts batch, fallback to handling one by one",
+		zap.Error(err), zap.Int("size", len(verdicts)))
+	batchFallbacksCounter.Inc()
+
+	for _, v := range verdicts {
+		if err := s.handleMessage(ctx, v); err != nil {
+			s.logger.Debug("Handle message error", zap.Error(err))
+
+			s.writeMessageToDlq(ctx, v.msg, err.Error())
+		}
+	}
+}
+
+func (s *Service) parseVerdict(m msgbus.Message) (messagePayload, error) {
+	mp, err := s.parseMessage(m.Value)
+	if err != nil {
+		return messagePayload{}, fmt.Errorf("parse message, err=%w", err)
+	}
+
+	err = mp.Validate()
+	if err != nil {
+		return messagePayload{}, fmt.Errorf("validate message payload, err=%w", err)
+	}
+
+	if mp.Status != statusOk && mp.Status != statusSuspicious {
+		return messagePayload{}, fmt.Errorf("unknown message status, status=%v", mp.Status)
+	}
+
+	return mp, nil
+}
+
+func (s *Service) applyBatch(ctx context.Context, verdicts []verdict) (err error) {
+	links := make([]trace.Link, 0, len(verdicts))
+	for i := range verdicts {
+		links = append(links, trace.LinkFromContext(tracing.ExtractMessageHeaders(ctx, &verdicts[i].msg)))
+	}
+
+	ctx, span := tracing.Start(ctx, "afcverdictsprocessor.HandleBatch",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+		trace.WithLinks(links...),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	var visible, suspicious []types.MessageID
+	seen := make(map[types.MessageID]struct{}, len(verdicts))
+
+	for _, v := range verdicts {
+		id := v.payload.MessageID
+		if _, ok := seen[id]; ok {
+			continue
+		}
+		seen[id] = struct{}{}
+
+		if v.payload.Status == statusOk {
+			visible = append(visible, id)
+		} else {
+			suspicious = append(suspicious, id)
+		}
+	}
+
+	return s.txtor.RunInTx(ctx, func(ctx context.Context) error {
+		return s.applyVerdicts(ctx, visible, suspicious)
+	})
+}
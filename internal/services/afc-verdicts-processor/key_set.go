@@ -0,0 +1,157 @@
+package afcverdictsprocessor
+
+import (
+	"context"
+	"crypto"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"strings"
+	"sync"
+	"time"
+
+	keycloakclient "github.com/karasunokami/chat-service/internal/clients/keycloak"
+
+	"go.uber.org/zap"
+)
+
+var ErrUnknownSigningKey = errors.New("unknown verdict signing key")
+
+//go:generate options-gen -out-filename=key_set_options.gen.go -from-struct=KeySetOptions
+type KeySetOptions struct {
+	// source is the JWKS location: a http(s) URL or a local file path.
+	source       string        `option:"mandatory" validate:"required"`
+	reloadPeriod time.Duration `default:"1m" validate:"min=10ms,max=24h"`
+	// fetchTimeout limits every load of the keys, so the hung source does not stop the reloads.
+	fetchTimeout time.Duration `default:"10s" validate:"min=10ms,max=1m"`
+	httpClient   *http.Client
+}
+
+// KeySet keeps the active verdict signing keys by their IDs.
+// The keys are reloaded from the source periodically, so the AFC can rotate them without restart.
+type KeySet struct {
+	KeySetOptions
+
+	lg *zap.Logger
+
+	mu   sync.RWMutex
+	keys map[string]crypto.PublicKey
+}
+
+// NewKeySet creates the key set and loads the keys for the first time.
+func NewKeySet(ctx context.Context, opts KeySetOptions) (*KeySet, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate options, err=%v", err)
+	}
+
+	if opts.httpClient == nil {
+		opts.httpClient = &http.Client{Timeout: opts.fetchTimeout}
+	}
+
+	ks := &KeySet{
+		KeySetOptions: opts,
+		lg:            zap.L().Named("afc-verdicts-key-set"),
+	}
+
+	if err := ks.reload(ctx); err != nil {
+		return nil, fmt.Errorf("load keys, err=%v", err)
+	}
+
+	return ks, nil
+}
+
+// PublicKey returns the signing key by its ID or ErrUnknownSigningKey.
+func (ks *KeySet) PublicKey(kid string) (crypto.PublicKey, error) {
+	ks.mu.RLock()
+	defer ks.mu.RUnlock()
+
+	if k, ok := ks.keys[kid]; ok {
+		return k, nil
+	}
+
+	return nil, fmt.Errorf("%w: kid=%q", ErrUnknownSigningKey, kid)
+}
+
+// Run reloads the keys until the context is canceled. The previous keys stay active if the reload fails.
+func (ks *KeySet) Run(ctx context.Context) error {
+	ticker := time.NewTicker(ks.reloadPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case <-ticker.C:
+			if err := ks.reload(ctx); err != nil {
+				ks.lg.Error("Reload verdict signing keys", zap.Error(err))
+				keysReloadErrorsCounter.Inc()
+			}
+		}
+	}
+}
+
+func (ks *KeySet) reload(ctx context.Context) error {
+	ctx, cancel := context.WithTimeout(ctx, ks.fetchTimeout)
+	defer cancel()
+
+	data, err := ks.fetch(ctx)
+	if err != nil {
+		return fmt.Errorf("fetch jwks, err=%v", err)
+	}
+
+	var jwks keycloakclient.JWKS
+	if err := json.Unmarshal(data, &jwks); err != nil {
+		return fmt.Errorf("json unmarshal jwks, err=%v", err)
+	}
+
+	keys := make(map[string]crypto.PublicKey, len(jwks.Keys))
+	for _, k := range jwks.Keys {
+		if k.Kid == "" || !k.IsSigningKey() {
+			continue
+		}
+
+		pk, err := k.RSAPublicKey()
+		if err != nil {
+			ks.lg.Warn("Skip verdict signing key", zap.String("kid", k.Kid), zap.Error(err))
+			continue
+		}
+		keys[k.Kid] = pk
+	}
+
+	if len(keys) == 0 {
+		return errors.New("no signing keys in jwks")
+	}
+
+	ks.mu.Lock()
+	ks.keys = keys
+	ks.mu.Unlock()
+
+	return nil
+}
+
+func (ks *KeySet) fetch(ctx context.Context) ([]byte, error) {
+	if !strings.HasPrefix(ks.source, "http://") && !strings.HasPrefix(ks.source, "https://") {
+		return os.ReadFile(ks.source)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, ks.source, http.NoBody)
+	if err != nil {
+		return nil, fmt.Errorf("create request, err=%v", err)
+	}
+
+	resp, err := ks.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("send request, err=%v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("unexpected response status: %v", resp.Status)
+	}
+
+	return io.ReadAll(resp.Body)
+}
@@ -0,0 +1,78 @@
+// Code generated by options-gen. DO NOT EDIT.
+package afcverdictsprocessor
+
+import (
+	fmt461e464ebed9 "fmt"
+	"net/http"
+	"time"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptKeySetOptionsSetter func(o *KeySetOptions)
+
+func NewKeySetOptions(
+	source string,
+	options ...OptKeySetOptionsSetter,
+) KeySetOptions {
+	o := KeySetOptions{}
+
+	// Setting defaults from field tag (if present)
+	o.reloadPeriod, _ = time.ParseDuration("1m")
+	o.fetchTimeout, _ = time.ParseDuration("10s")
+
+	o.source = source
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func WithReloadPeriod(opt time.Duration) OptKeySetOptionsSetter {
+	return func(o *KeySetOptions) {
+		o.reloadPeriod = opt
+	}
+}
+
+func WithFetchTimeout(opt time.Duration) OptKeySetOptionsSetter {
+	return func(o *KeySetOptions) {
+		o.fetchTimeout = opt
+	}
+}
+
+func WithHttpClient(opt *http.Client) OptKeySetOptionsSetter {
+	return func(o *KeySetOptions) {
+		o.httpClient = opt
+	}
+}
+
+func (o *KeySetOptions) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("source", _validate_KeySetOptions_source(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("reloadPeriod", _validate_KeySetOptions_reloadPeriod(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("fetchTimeout", _validate_KeySetOptions_fetchTimeout(o)))
+	return errs.AsError()
+}
+
+func _validate_KeySetOptions_source(o *KeySetOptions) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.source, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `source` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_KeySetOptions_reloadPeriod(o *KeySetOptions) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.reloadPeriod, "min=10ms,max=24h"); err != nil {
+		return fmt461e464ebed9.Errorf("field `reloadPeriod` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_KeySetOptions_fetchTimeout(o *KeySetOptions) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.fetchTimeout, "min=10ms,max=1m"); err != nil {
+		return fmt461e464ebed9.Errorf("field `fetchTimeout` did not pass the test: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,148 @@
+package afcverdictsprocessor_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	keycloakclient "github.com/karasunokami/chat-service/internal/clients/keycloak"
+	afcverdictsprocessor "github.com/karasunokami/chat-service/internal/services/afc-verdicts-processor"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestKeySet_File(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	key1, key2 := newRSAKey(t), newRSAKey(t)
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	writeJWKS(t, path, map[string]*rsa.PublicKey{"kid-1": &key1.PublicKey})
+
+	ks, err := afcverdictsprocessor.NewKeySet(ctx, afcverdictsprocessor.NewKeySetOptions(path,
+		afcverdictsprocessor.WithReloadPeriod(10*time.Millisecond)))
+	require.NoError(t, err)
+
+	go func() { _ = ks.Run(ctx) }()
+
+	pk, err := ks.PublicKey("kid-1")
+	require.NoError(t, err)
+	assert.True(t, key1.PublicKey.Equal(pk))
+
+	_, err = ks.PublicKey("kid-2")
+	require.ErrorIs(t, err, afcverdictsprocessor.ErrUnknownSigningKey)
+
+	// Rotate the keys.
+	writeJWKS(t, path, map[string]*rsa.PublicKey{"kid-2": &key2.PublicKey})
+
+	assert.Eventually(t, func() bool {
+		_, err := ks.PublicKey("kid-1")
+		return err != nil
+	}, time.Second, 10*time.Millisecond)
+
+	pk, err = ks.PublicKey("kid-2")
+	require.NoError(t, err)
+	assert.True(t, key2.PublicKey.Equal(pk))
+
+	// Broken source keeps the previous keys.
+	require.NoError(t, os.WriteFile(path, []byte("{"), 0o600))
+	time.Sleep(50 * time.Millisecond)
+
+	_, err = ks.PublicKey("kid-2")
+	require.NoError(t, err)
+}
+
+func TestKeySet_URL(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	key := newRSAKey(t)
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, _ *http.Request) {
+		err := json.NewEncoder(w).Encode(newJWKS(map[string]*rsa.PublicKey{"kid-1": &key.PublicKey}))
+		assert.NoError(t, err)
+	}))
+	defer srv.Close()
+
+	ks, err := afcverdictsprocessor.NewKeySet(ctx, afcverdictsprocessor.NewKeySetOptions(srv.URL))
+	require.NoError(t, err)
+
+	pk, err := ks.PublicKey("kid-1")
+	require.NoError(t, err)
+	assert.True(t, key.PublicKey.Equal(pk))
+}
+
+func TestKeySet_URLTimeout(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	release := make(chan struct{})
+	srv := httptest.NewServer(http.HandlerFunc(func(http.ResponseWriter, *http.Request) {
+		<-release
+	}))
+	defer srv.Close()
+	defer close(release)
+
+	start := time.Now()
+	_, err := afcverdictsprocessor.NewKeySet(ctx, afcverdictsprocessor.NewKeySetOptions(srv.URL,
+		afcverdictsprocessor.WithFetchTimeout(50*time.Millisecond)))
+	require.ErrorContains(t, err, context.DeadlineExceeded.Error())
+	assert.Less(t, time.Since(start), time.Second)
+}
+
+func TestKeySet_InvalidSource(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	_, err := afcverdictsprocessor.NewKeySet(ctx, afcverdictsprocessor.NewKeySetOptions(
+		filepath.Join(t.TempDir(), "unknown.json")))
+	require.Error(t, err)
+
+	path := filepath.Join(t.TempDir(), "jwks.json")
+	writeJWKS(t, path, nil)
+
+	_, err = afcverdictsprocessor.NewKeySet(ctx, afcverdictsprocessor.NewKeySetOptions(path))
+	require.Error(t, err)
+}
+
+func newRSAKey(t *testing.T) *rsa.PrivateKey {
+	t.Helper()
+
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	require.NoError(t, err)
+
+	return key
+}
+
+func newJWKS(keys map[string]*rsa.PublicKey) keycloakclient.JWKS {
+	jwks := keycloakclient.JWKS{Keys: []keycloakclient.JWK{}}
+	for kid, pk := range keys {
+		jwks.Keys = append(jwks.Keys, keycloakclient.JWK{
+			Kid: kid,
+			Kty: "RSA",
+			Alg: "RS256",
+			Use: "sig",
+			N:   base64.RawURLEncoding.EncodeToString(pk.N.Bytes()),
+			E:   base64.RawURLEncoding.EncodeToString(big.NewInt(int64(pk.E)).Bytes()),
+		})
+	}
+
+	return jwks
+}
+
+func writeJWKS(t *testing.T, path string, keys map[string]*rsa.PublicKey) {
+	t.Helper()
+
+	data, err := json.Marshal(newJWKS(keys))
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(path, data, 0o600))
+}
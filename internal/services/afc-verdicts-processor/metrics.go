@@ -0,0 +1,59 @@
+package afcverdictsprocessor
+
+import (
+	"github.com/karasunokami/chat-service/internal/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	consumedCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "afc_verdicts_processor",
+		Name:      "consumed_total",
+		Help:      "Number of verdicts fetched from the topic.",
+	})
+
+	dlqCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "afc_verdicts_processor",
+		Name:      "dlq_total",
+		Help:      "Number of verdicts moved to the DLQ.",
+	})
+
+	retriesCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "afc_verdicts_processor",
+		Name:      "retries_total",
+		Help:      "Number of verdict handling retries.",
+	})
+
+	batchFallbacksCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "afc_verdicts_processor",
+		Name:      "batch_fallbacks_total",
+		Help:      "Number of verdict batches handled one by one after the batch transaction failure.",
+	})
+
+	reviewRequestedCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "afc_verdicts_processor",
+		Name:      "review_requested_total",
+		Help:      "Number of suspicious messages put into the manual review queue.",
+	})
+
+	keysReloadErrorsCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "afc_verdicts_processor",
+		Name:      "keys_reload_errors_total",
+		Help:      "Number of failed verdict signing keys reloads.",
+	})
+
+	unknownKeyCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "afc_verdicts_processor",
+		Name:      "unknown_key_total",
+		Help:      "Number of verdicts signed by an unknown key.",
+	})
+)
@@ -6,6 +6,7 @@ package afcverdictsprocessormocks
 
 import (
 	context "context"
+	crypto "crypto"
 	reflect "reflect"
 	time "time"
 
@@ -36,32 +37,75 @@ func (m *MockmessagesRepository) EXPECT() *MockmessagesRepositoryMockRecorder {
 	return m.recorder
 }
 
-// BlockMessage mocks base method.
-func (m *MockmessagesRepository) BlockMessage(ctx context.Context, msgID types.MessageID) error {
+// BlockMessages mocks base method.
+func (m *MockmessagesRepository) BlockMessages(ctx context.Context, msgIDs []types.MessageID) error {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "BlockMessage", ctx, msgID)
+	ret := m.ctrl.Call(m, "BlockMessages", ctx, msgIDs)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// BlockMessage indicates an expected call of BlockMessage.
-func (mr *MockmessagesRepositoryMockRecorder) BlockMessage(ctx, msgID interface{}) *gomock.Call {
+// BlockMessages indicates an expected call of BlockMessages.
+func (mr *MockmessagesRepositoryMockRecorder) BlockMessages(ctx, msgIDs interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockMessage", reflect.TypeOf((*MockmessagesRepository)(nil).BlockMessage), ctx, msgID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockMessages", reflect.TypeOf((*MockmessagesRepository)(nil).BlockMessages), ctx, msgIDs)
 }
 
-// MarkAsVisibleForManager mocks base method.
-func (m *MockmessagesRepository) MarkAsVisibleForManager(ctx context.Context, msgID types.MessageID) error {
+// GetHeldManagerMessageIDs mocks base method.
+func (m *MockmessagesRepository) GetHeldManagerMessageIDs(ctx context.Context, msgIDs []types.MessageID) ([]types.MessageID, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "MarkAsVisibleForManager", ctx, msgID)
+	ret := m.ctrl.Call(m, "GetHeldManagerMessageIDs", ctx, msgIDs)
+	ret0, _ := ret[0].([]types.MessageID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHeldManagerMessageIDs indicates an expected call of GetHeldManagerMessageIDs.
+func (mr *MockmessagesRepositoryMockRecorder) GetHeldManagerMessageIDs(ctx, msgIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHeldManagerMessageIDs", reflect.TypeOf((*MockmessagesRepository)(nil).GetHeldManagerMessageIDs), ctx, msgIDs)
+}
+
+// MarkManyAsVisibleForManager mocks base method.
+func (m *MockmessagesRepository) MarkManyAsVisibleForManager(ctx context.Context, msgIDs []types.MessageID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkManyAsVisibleForManager", ctx, msgIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkManyAsVisibleForManager indicates an expected call of MarkManyAsVisibleForManager.
+func (mr *MockmessagesRepositoryMockRecorder) MarkManyAsVisibleForManager(ctx, msgIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkManyAsVisibleForManager", reflect.TypeOf((*MockmessagesRepository)(nil).MarkManyAsVisibleForManager), ctx, msgIDs)
+}
+
+// ReleaseManagerMessages mocks base method.
+func (m *MockmessagesRepository) ReleaseManagerMessages(ctx context.Context, msgIDs []types.MessageID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseManagerMessages", ctx, msgIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseManagerMessages indicates an expected call of ReleaseManagerMessages.
+func (mr *MockmessagesRepositoryMockRecorder) ReleaseManagerMessages(ctx, msgIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseManagerMessages", reflect.TypeOf((*MockmessagesRepository)(nil).ReleaseManagerMessages), ctx, msgIDs)
+}
+
+// RequestReview mocks base method.
+func (m *MockmessagesRepository) RequestReview(ctx context.Context, msgIDs []types.MessageID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestReview", ctx, msgIDs)
 	ret0, _ := ret[0].(error)
 	return ret0
 }
 
-// MarkAsVisibleForManager indicates an expected call of MarkAsVisibleForManager.
-func (mr *MockmessagesRepositoryMockRecorder) MarkAsVisibleForManager(ctx, msgID interface{}) *gomock.Call {
+// RequestReview indicates an expected call of RequestReview.
+func (mr *MockmessagesRepositoryMockRecorder) RequestReview(ctx, msgIDs interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAsVisibleForManager", reflect.TypeOf((*MockmessagesRepository)(nil).MarkAsVisibleForManager), ctx, msgID)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestReview", reflect.TypeOf((*MockmessagesRepository)(nil).RequestReview), ctx, msgIDs)
 }
 
 // MockoutboxService is a mock of outboxService interface.
@@ -87,19 +131,57 @@ func (m *MockoutboxService) EXPECT() *MockoutboxServiceMockRecorder {
 	return m.recorder
 }
 
-// Put mocks base method.
-func (m *MockoutboxService) Put(ctx context.Context, name, payload string, availableAt time.Time) (types.JobID, error) {
+// PutMany mocks base method.
+func (m *MockoutboxService) PutMany(ctx context.Context, name string, payloads []string, availableAt time.Time) ([]types.JobID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "PutMany", ctx, name, payloads, availableAt)
+	ret0, _ := ret[0].([]types.JobID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// PutMany indicates an expected call of PutMany.
+func (mr *MockoutboxServiceMockRecorder) PutMany(ctx, name, payloads, availableAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PutMany", reflect.TypeOf((*MockoutboxService)(nil).PutMany), ctx, name, payloads, availableAt)
+}
+
+// MockverdictsKeySet is a mock of verdictsKeySet interface.
+type MockverdictsKeySet struct {
+	ctrl     *gomock.Controller
+	recorder *MockverdictsKeySetMockRecorder
+}
+
+// MockverdictsKeySetMockRecorder is the mock recorder for MockverdictsKeySet.
+type MockverdictsKeySetMockRecorder struct {
+	mock *MockverdictsKeySet
+}
+
+// NewMockverdictsKeySet creates a new mock instance.
+func NewMockverdictsKeySet(ctrl *gomock.Controller) *MockverdictsKeySet {
+	mock := &MockverdictsKeySet{ctrl: ctrl}
+	mock.recorder = &MockverdictsKeySetMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockverdictsKeySet) EXPECT() *MockverdictsKeySetMockRecorder {
+	return m.recorder
+}
+
+// PublicKey mocks base method.
+func (m *MockverdictsKeySet) PublicKey(kid string) (crypto.PublicKey, error) {
 	m.ctrl.T.Helper()
-	ret := m.ctrl.Call(m, "Put", ctx, name, payload, availableAt)
-	ret0, _ := ret[0].(types.JobID)
+	ret := m.ctrl.Call(m, "PublicKey", kid)
+	ret0, _ := ret[0].(crypto.PublicKey)
 	ret1, _ := ret[1].(error)
 	return ret0, ret1
 }
 
-// Put indicates an expected call of Put.
-func (mr *MockoutboxServiceMockRecorder) Put(ctx, name, payload, availableAt interface{}) *gomock.Call {
+// PublicKey indicates an expected call of PublicKey.
+func (mr *MockverdictsKeySetMockRecorder) PublicKey(kid interface{}) *gomock.Call {
 	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockoutboxService)(nil).Put), ctx, name, payload, availableAt)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "PublicKey", reflect.TypeOf((*MockverdictsKeySet)(nil).PublicKey), kid)
 }
 
 // Mocktransactor is a mock of transactor interface.
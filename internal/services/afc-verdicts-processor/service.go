@@ -2,20 +2,23 @@ package afcverdictsprocessor
 
 import (
 	"context"
+	"crypto"
 	"crypto/rsa"
 	"errors"
 	"fmt"
 	"io"
 	"time"
 
+	msgbus "github.com/karasunokami/chat-service/internal/services/msg-bus"
 	"github.com/karasunokami/chat-service/internal/services/outbox"
 	clientmessageblockedjob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/client-message-blocked"
 	clientmessagesentjob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/client-message-sent"
+	managermessagesentjob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/manager-message-sent"
+	"github.com/karasunokami/chat-service/internal/tracing"
 	"github.com/karasunokami/chat-service/internal/types"
 
 	"github.com/golang-jwt/jwt"
-	"github.com/segmentio/kafka-go"
-	"github.com/segmentio/kafka-go/protocol"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/multierr"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
@@ -28,12 +31,19 @@ const (
 //go:generate mockgen -source=$GOFILE -destination=mocks/service_mocks.gen.go -package=afcverdictsprocessormocks
 
 type messagesRepository interface {
-	MarkAsVisibleForManager(ctx context.Context, msgID types.MessageID) error
-	BlockMessage(ctx context.Context, msgID types.MessageID) error
+	MarkManyAsVisibleForManager(ctx context.Context, msgIDs []types.MessageID) error
+	BlockMessages(ctx context.Context, msgIDs []types.MessageID) error
+	RequestReview(ctx context.Context, msgIDs []types.MessageID) error
+	GetHeldManagerMessageIDs(ctx context.Context, msgIDs []types.MessageID) ([]types.MessageID, error)
+	ReleaseManagerMessages(ctx context.Context, msgIDs []types.MessageID) error
 }
 
 type outboxService interface {
-	Put(ctx context.Context, name, payload string, availableAt time.Time) (types.JobID, error)
+	PutMany(ctx context.Context, name string, payloads []string, availableAt time.Time) ([]types.JobID, error)
+}
+
+type verdictsKeySet interface {
+	PublicKey(kid string) (crypto.PublicKey, error)
 }
 
 type transactor interface {
@@ -46,15 +56,26 @@ type Options struct {
 	backoffMaxElapsedTime  time.Duration `default:"5s" validate:"min=500ms,max=1m"`
 	backoffExpFactor       float64       `default:"2" validate:"min=1.1,max=5"`
 
-	brokers          []string `option:"mandatory" validate:"min=1"`
-	consumers        int      `option:"mandatory" validate:"min=1,max=16"`
-	consumerGroup    string   `option:"mandatory" validate:"required"`
-	verdictsTopic    string   `option:"mandatory" validate:"required"`
-	verdictsSignKey  string
-	processBatchSize int
-
-	readerFactory KafkaReaderFactory `option:"mandatory" validate:"required"`
-	dlqWriter     KafkaDLQWriter     `option:"mandatory" validate:"required"`
+	consumers       int    `option:"mandatory" validate:"min=1,max=16"`
+	consumerGroup   string `option:"mandatory" validate:"required"`
+	verdictsTopic   string `option:"mandatory" validate:"required"`
+	verdictsSignKey string
+	// verdictsKeySet selects the verdict signing key by the JWT kid header.
+	// The verdicts without kid are verified with verdictsSignKey.
+	verdictsKeySet verdictsKeySet
+	// reviewSuspicious puts suspicious messages into the manual review queue instead of blocking them.
+	reviewSuspicious bool
+	// checkManagerMessages applies the verdicts to the manager messages held for AFC as well:
+	// the ok ones are delivered to the client, the suspicious ones are blocked.
+	checkManagerMessages bool
+
+	// processBatchSize is the max number of verdicts applied in a single transaction.
+	processBatchSize int `default:"1" validate:"min=1,max=1000"`
+	// processBatchMaxWait is the max time to wait for the batch to fill up after the first verdict is fetched.
+	processBatchMaxWait time.Duration `default:"1s" validate:"min=1ms,max=1m"`
+
+	readerFactory msgbus.ReaderFactory `option:"mandatory" validate:"required"`
+	dlqWriter     msgbus.Writer        `option:"mandatory" validate:"required"`
 
 	txtor   transactor         `option:"mandatory" validate:"required"`
 	msgRepo messagesRepository `option:"mandatory" validate:"required"`
@@ -126,7 +147,7 @@ func (s *Service) init() error {
 }
 
 func (s *Service) consumerLoop(ctx context.Context) error {
-	r := s.readerFactory(s.brokers, s.consumerGroup, s.verdictsTopic)
+	r := s.readerFactory(s.consumerGroup, s.verdictsTopic)
 	defer func() {
 		err := r.Close()
 		if err != nil {
@@ -139,44 +160,71 @@ func (s *Service) consumerLoop(ctx context.Context) error {
 		case <-ctx.Done():
 			return nil
 		default:
-			m, err := r.FetchMessage(ctx)
-			if err != nil {
-				return fmt.Errorf("fetch message, err=%w", err)
+			batch, fetchErr := s.fetchBatch(ctx, r)
+			if ctx.Err() != nil {
+				// Uncommitted messages will be fetched again after restart.
+				return nil
 			}
 
-			s.logger.Debug("Message fetched", zap.Any("message", m))
+			if len(batch) > 0 {
+				s.logger.Debug("Messages batch fetched", zap.Int("size", len(batch)))
+				consumedCounter.Add(float64(len(batch)))
 
-			err = s.handleMessage(ctx, m)
-			if err != nil {
-				s.logger.Debug("Handle message error", zap.Error(err))
+				s.handleBatch(ctx, batch)
 
-				s.writeMessageToDlq(ctx, m, err.Error())
+				err := r.CommitMessages(ctx, batch...)
+				if err != nil {
+					s.logger.Error("Commit messages", zap.Error(err))
+				}
 			}
 
-			err = r.CommitMessages(ctx, m)
-			if err != nil {
-				s.logger.Error("Commit message", zap.Error(err))
+			if fetchErr != nil {
+				return fmt.Errorf("fetch message, err=%w", fetchErr)
 			}
 		}
 	}
 }
 
-func (s *Service) getDelay(lastDelay time.Duration) time.Duration {
-	return lastDelay * time.Duration(s.backoffExpFactor)
+// ProcessVerdict applies the single verdict bypassing the topic, e.g. replayed from the DLQ.
+// Unlike the consumer loop, it returns the error instead of writing the verdict to the DLQ.
+func (s *Service) ProcessVerdict(ctx context.Context, m msgbus.Message) error {
+	mp, err := s.parseVerdict(m)
+	if err != nil {
+		return fmt.Errorf("parse verdict, err=%w", err)
+	}
+
+	return s.handleMessage(ctx, verdict{msg: m, payload: mp})
 }
 
-func (s *Service) handleMessage(ctx context.Context, m kafka.Message) error {
-	mp, err := s.parseMessage(m.Value)
-	if err != nil {
-		return fmt.Errorf("parse message, err=%w", err)
+// ApplyVerdict applies the verdict made within the process, e.g. by the local AFC.
+func (s *Service) ApplyVerdict(ctx context.Context, chatID types.ChatID, msgID types.MessageID, suspicious bool) (err error) {
+	ctx, span := tracing.Start(ctx, "afcverdictsprocessor.ApplyVerdict")
+	defer func() { tracing.End(span, err) }()
+
+	mp := messagePayload{ChatID: chatID, MessageID: msgID, Status: statusOk}
+	if suspicious {
+		mp.Status = statusSuspicious
 	}
 
-	err = mp.Validate()
+	err = s.handleWithRetries(ctx, mp)
 	if err != nil {
-		return fmt.Errorf("validate message payload, err=%w", err)
+		return fmt.Errorf("handle with retries, err=%w", err)
 	}
 
-	err = s.handleWithRetries(ctx, mp)
+	return nil
+}
+
+func (s *Service) getDelay(lastDelay time.Duration) time.Duration {
+	return lastDelay * time.Duration(s.backoffExpFactor)
+}
+
+func (s *Service) handleMessage(ctx context.Context, v verdict) (err error) {
+	ctx, span := tracing.Start(tracing.ExtractMessageHeaders(ctx, &v.msg), "afcverdictsprocessor.HandleMessage",
+		trace.WithSpanKind(trace.SpanKindConsumer),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	err = s.handleWithRetries(ctx, v.payload)
 	if err != nil {
 		return fmt.Errorf("handle with retries, err=%w", err)
 	}
@@ -206,6 +254,7 @@ func (s *Service) handleWithRetries(ctx context.Context, mp messagePayload) erro
 				s.logger.Debug("Sleep before next handle retry", zap.Duration("duration", delay))
 
 				lastDelay = delay
+				retriesCounter.Inc()
 
 				select {
 				case <-ctx.Done():
@@ -251,57 +300,183 @@ func (s *Service) handleMessageByStatus(ctx context.Context, mp messagePayload)
 
 func (s *Service) handleMessageOk(ctx context.Context, msgID types.MessageID) error {
 	return s.txtor.RunInTx(ctx, func(ctx context.Context) error {
-		err := s.msgRepo.MarkAsVisibleForManager(ctx, msgID)
+		return s.applyVerdicts(ctx, []types.MessageID{msgID}, nil)
+	})
+}
+
+func (s *Service) handleMessageSuspicious(ctx context.Context, msgID types.MessageID) error {
+	return s.txtor.RunInTx(ctx, func(ctx context.Context) error {
+		return s.applyVerdicts(ctx, nil, []types.MessageID{msgID})
+	})
+}
+
+// applyVerdicts must be called within the transaction.
+func (s *Service) applyVerdicts(ctx context.Context, visible, suspicious []types.MessageID) error {
+	if s.checkManagerMessages {
+		msgIDs := make([]types.MessageID, 0, len(visible)+len(suspicious))
+		msgIDs = append(append(msgIDs, visible...), suspicious...)
+
+		held, err := s.msgRepo.GetHeldManagerMessageIDs(ctx, msgIDs)
 		if err != nil {
-			return fmt.Errorf("msg repo mark as visible for manager, err=%v", err)
+			return fmt.Errorf("msg repo get held manager message ids, err=%v", err)
 		}
 
-		payload, err := outbox.MarshalMessageIDPayload(msgID)
-		if err != nil {
-			return fmt.Errorf("marshal client message sent job payload, err=%v", err)
+		var heldVisible, heldSuspicious []types.MessageID
+		heldVisible, visible = splitHeld(held, visible)
+		heldSuspicious, suspicious = splitHeld(held, suspicious)
+
+		if err := s.releaseManagerMessages(ctx, heldVisible); err != nil {
+			return err
 		}
+		// The manual review queue is for the client messages, the manager ones are blocked straight away.
+		if err := s.blockMessages(ctx, heldSuspicious); err != nil {
+			return err
+		}
+	}
 
-		_, err = s.outBox.Put(ctx, clientmessagesentjob.Name, payload, time.Now())
-		if err != nil {
-			return fmt.Errorf("outbox svc put, err=%v", err)
+	if err := s.markAsVisibleForManager(ctx, visible); err != nil {
+		return err
+	}
+	return s.handleSuspiciousMessages(ctx, suspicious)
+}
+
+// splitHeld separates the held message ids from the rest keeping the order.
+func splitHeld(held, msgIDs []types.MessageID) (heldIDs, rest []types.MessageID) {
+	if len(held) == 0 {
+		return nil, msgIDs
+	}
+
+	set := make(map[types.MessageID]struct{}, len(held))
+	for _, id := range held {
+		set[id] = struct{}{}
+	}
+
+	for _, id := range msgIDs {
+		if _, ok := set[id]; ok {
+			heldIDs = append(heldIDs, id)
+		} else {
+			rest = append(rest, id)
 		}
+	}
+
+	return heldIDs, rest
+}
+
+func (s *Service) handleSuspiciousMessages(ctx context.Context, msgIDs []types.MessageID) error {
+	if s.reviewSuspicious {
+		return s.requestReview(ctx, msgIDs)
+	}
+	return s.blockMessages(ctx, msgIDs)
+}
 
+func (s *Service) markAsVisibleForManager(ctx context.Context, msgIDs []types.MessageID) error {
+	if len(msgIDs) == 0 {
 		return nil
-	})
+	}
+
+	err := s.msgRepo.MarkManyAsVisibleForManager(ctx, msgIDs)
+	if err != nil {
+		return fmt.Errorf("msg repo mark as visible for manager, err=%v", err)
+	}
+
+	payloads, err := marshalMessageIDPayloads(msgIDs)
+	if err != nil {
+		return fmt.Errorf("marshal client message sent job payloads, err=%v", err)
+	}
+
+	_, err = s.outBox.PutMany(ctx, clientmessagesentjob.Name, payloads, time.Now())
+	if err != nil {
+		return fmt.Errorf("outbox svc put many, err=%v", err)
+	}
+
+	return nil
 }
 
-func (s *Service) handleMessageSuspicious(ctx context.Context, msgID types.MessageID) error {
-	return s.txtor.RunInTx(ctx, func(ctx context.Context) error {
-		err := s.msgRepo.BlockMessage(ctx, msgID)
-		if err != nil {
-			return fmt.Errorf("msg repo block message, err=%v", err)
-		}
+func (s *Service) releaseManagerMessages(ctx context.Context, msgIDs []types.MessageID) error {
+	if len(msgIDs) == 0 {
+		return nil
+	}
 
-		payload, err := outbox.MarshalMessageIDPayload(msgID)
-		if err != nil {
-			return fmt.Errorf("marshal client message blocked job payload, err=%v", err)
-		}
+	err := s.msgRepo.ReleaseManagerMessages(ctx, msgIDs)
+	if err != nil {
+		return fmt.Errorf("msg repo release manager messages, err=%v", err)
+	}
 
-		_, err = s.outBox.Put(ctx, clientmessageblockedjob.Name, payload, time.Now())
+	payloads, err := marshalMessageIDPayloads(msgIDs)
+	if err != nil {
+		return fmt.Errorf("marshal manager message sent job payloads, err=%v", err)
+	}
+
+	_, err = s.outBox.PutMany(ctx, managermessagesentjob.Name, payloads, time.Now())
+	if err != nil {
+		return fmt.Errorf("outbox svc put many, err=%v", err)
+	}
+
+	return nil
+}
+
+func (s *Service) blockMessages(ctx context.Context, msgIDs []types.MessageID) error {
+	if len(msgIDs) == 0 {
+		return nil
+	}
+
+	err := s.msgRepo.BlockMessages(ctx, msgIDs)
+	if err != nil {
+		return fmt.Errorf("msg repo block messages, err=%v", err)
+	}
+
+	payloads, err := marshalMessageIDPayloads(msgIDs)
+	if err != nil {
+		return fmt.Errorf("marshal client message blocked job payloads, err=%v", err)
+	}
+
+	_, err = s.outBox.PutMany(ctx, clientmessageblockedjob.Name, payloads, time.Now())
+	if err != nil {
+		return fmt.Errorf("outbox svc put many, err=%v", err)
+	}
+
+	return nil
+}
+
+func (s *Service) requestReview(ctx context.Context, msgIDs []types.MessageID) error {
+	if len(msgIDs) == 0 {
+		return nil
+	}
+
+	err := s.msgRepo.RequestReview(ctx, msgIDs)
+	if err != nil {
+		return fmt.Errorf("msg repo request review, err=%v", err)
+	}
+
+	reviewRequestedCounter.Add(float64(len(msgIDs)))
+
+	return nil
+}
+
+func marshalMessageIDPayloads(msgIDs []types.MessageID) ([]string, error) {
+	payloads := make([]string, 0, len(msgIDs))
+	for _, id := range msgIDs {
+		p, err := outbox.MarshalMessageIDPayload(id)
 		if err != nil {
-			return fmt.Errorf("outbox svc put, err=%v", err)
+			return nil, err
 		}
+		payloads = append(payloads, p)
+	}
 
-		return nil
-	})
+	return payloads, nil
 }
 
-func (s *Service) writeMessageToDlq(ctx context.Context, m kafka.Message, lastErrorText string) {
-	dlqMessage := kafka.Message{
+func (s *Service) writeMessageToDlq(ctx context.Context, m msgbus.Message, lastErrorText string) {
+	dlqMessage := msgbus.Message{
 		Key:   m.Key,
 		Value: m.Value,
-		Headers: []protocol.Header{
+		Headers: []msgbus.Header{
 			{
-				Key:   "LAST_ERROR",
+				Key:   DLQHeaderLastError,
 				Value: []byte(lastErrorText),
 			},
 			{
-				Key:   "ORIGINAL_PARTITION",
+				Key:   DLQHeaderOriginalPartition,
 				Value: []byte{byte(m.Partition)},
 			},
 		},
@@ -310,15 +485,22 @@ func (s *Service) writeMessageToDlq(ctx context.Context, m kafka.Message, lastEr
 	err := s.dlqWriter.WriteMessages(ctx, dlqMessage)
 	if err != nil {
 		s.logger.Error("Write message to DLQ", zap.Error(err), zap.Any("message", dlqMessage))
+
+		return
 	}
+
+	dlqCounter.Inc()
 }
 
 func (s *Service) parseMessage(data []byte) (messagePayload, error) {
-	if s.signKey != nil {
-		token, err := jwt.ParseWithClaims(string(data), &messagePayload{}, func(token *jwt.Token) (interface{}, error) {
-			return s.signKey, nil
-		})
+	if s.signKey != nil || s.verdictsKeySet != nil {
+		token, err := jwt.ParseWithClaims(string(data), &messagePayload{}, s.verdictSignKey)
 		if err != nil {
+			var ve *jwt.ValidationError
+			if errors.As(err, &ve) && errors.Is(ve.Inner, ErrUnknownSigningKey) {
+				unknownKeyCounter.Inc()
+				return messagePayload{}, fmt.Errorf("jwt parse with claims, err=%w", ve.Inner)
+			}
 			return messagePayload{}, fmt.Errorf("jwt parse with claims, err=%v", err)
 		}
 
@@ -332,3 +514,16 @@ func (s *Service) parseMessage(data []byte) (messagePayload, error) {
 
 	return mp, nil
 }
+
+func (s *Service) verdictSignKey(token *jwt.Token) (interface{}, error) {
+	kid, _ := token.Header["kid"].(string)
+	if kid != "" && s.verdictsKeySet != nil {
+		return s.verdictsKeySet.PublicKey(kid)
+	}
+
+	if s.signKey == nil {
+		return nil, fmt.Errorf("%w: verdict has no kid", ErrUnknownSigningKey)
+	}
+
+	return s.signKey, nil
+}
@@ -0,0 +1,403 @@
+package afcverdictsprocessor_test
+
+import (
+	"context"
+	"crypto/rsa"
+	"encoding/json"
+	"io"
+	"path/filepath"
+	"testing"
+	"time"
+
+	messagesrepo "github.com/karasunokami/chat-service/internal/repositories/messages"
+	afcverdictsprocessor "github.com/karasunokami/chat-service/internal/services/afc-verdicts-processor"
+	afcverdictsprocessormocks "github.com/karasunokami/chat-service/internal/services/afc-verdicts-processor/mocks"
+	msgbus "github.com/karasunokami/chat-service/internal/services/msg-bus"
+	msgbusmocks "github.com/karasunokami/chat-service/internal/services/msg-bus/mocks"
+	clientmessageblockedjob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/client-message-blocked"
+	clientmessagesentjob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/client-message-sent"
+	managermessagesentjob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/manager-message-sent"
+	"github.com/karasunokami/chat-service/internal/testingh"
+	"github.com/karasunokami/chat-service/internal/types"
+
+	"github.com/golang-jwt/jwt"
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	batchSize    = 5
+	batchMaxWait = 50 * time.Millisecond
+)
+
+type BatchServiceSuite struct {
+	testingh.ContextSuite
+
+	ctrl        *gomock.Controller
+	outboxSvc   *afcverdictsprocessormocks.MockoutboxService
+	msgRepo     *afcverdictsprocessormocks.MockmessagesRepository
+	transactor  *afcverdictsprocessormocks.Mocktransactor
+	consumer    *msgbusmocks.MockReader
+	dlqProducer *msgbusmocks.MockWriter
+
+	svc *afcverdictsprocessor.Service
+}
+
+func TestBatchServiceSuite(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, new(BatchServiceSuite))
+}
+
+func (s *BatchServiceSuite) SetupTest() {
+	s.ContextSuite.SetupTest()
+
+	s.ctrl = gomock.NewController(s.T())
+	s.outboxSvc = afcverdictsprocessormocks.NewMockoutboxService(s.ctrl)
+	s.msgRepo = afcverdictsprocessormocks.NewMockmessagesRepository(s.ctrl)
+	s.transactor = afcverdictsprocessormocks.NewMocktransactor(s.ctrl)
+	s.consumer = msgbusmocks.NewMockReader(s.ctrl)
+	s.dlqProducer = msgbusmocks.NewMockWriter(s.ctrl)
+
+	s.svc = s.newService()
+
+	// Always.
+	s.consumer.EXPECT().Close().Return(nil)
+	s.dlqProducer.EXPECT().Close().Return(nil)
+}
+
+func (s *BatchServiceSuite) newService(opts ...afcverdictsprocessor.OptOptionsSetter) *afcverdictsprocessor.Service {
+	s.T().Helper()
+
+	opts = append([]afcverdictsprocessor.OptOptionsSetter{
+		afcverdictsprocessor.WithBackoffInitialInterval(backoffInitialInterval),
+		afcverdictsprocessor.WithBackoffMaxElapsedTime(backoffMaxElapsedTime),
+		afcverdictsprocessor.WithProcessBatchSize(batchSize),
+		afcverdictsprocessor.WithProcessBatchMaxWait(batchMaxWait),
+	}, opts...)
+
+	svc, err := afcverdictsprocessor.New(afcverdictsprocessor.NewOptions(
+		1,
+		"afcverdictsprocessor_test.BatchServiceSuite",
+		"afc.unit-test.verdicts",
+		func(string, string) msgbus.Reader { return s.consumer },
+		s.dlqProducer,
+		s.transactor,
+		s.msgRepo,
+		s.outboxSvc,
+		opts...,
+	))
+	s.Require().NoError(err)
+
+	return svc
+}
+
+func (s *BatchServiceSuite) TearDownTest() {
+	s.ContextSuite.TearDownTest()
+	s.ctrl.Finish()
+}
+
+func (s *BatchServiceSuite) TestBatchAppliedInSingleTx() {
+	// Arrange.
+	okIDs := []types.MessageID{types.NewMessageID(), types.NewMessageID()}
+	suspiciousIDs := []types.MessageID{types.NewMessageID(), types.NewMessageID()}
+
+	invalid := msgbus.Message{Value: []byte(`{"chatId": "2d1bb2b4-1e11-11ed-9c9f-461e464ebed9"`)}
+	msgs := []msgbus.Message{
+		s.verdictMsg(okIDs[0], "ok"),
+		s.verdictMsg(suspiciousIDs[0], "suspicious"),
+		invalid,
+		s.verdictMsg(okIDs[1], "ok"),
+		s.verdictMsg(suspiciousIDs[1], "suspicious"),
+	}
+	s.expectFetch(msgs...)
+
+	s.dlqProducer.EXPECT().WriteMessages(gomock.Any(), kafkaMsgValueMatcher{invalid.Value})
+	s.expectTx(1)
+	s.msgRepo.EXPECT().MarkManyAsVisibleForManager(gomock.Any(), okIDs).Return(nil)
+	s.outboxSvc.EXPECT().PutMany(gomock.Any(), clientmessagesentjob.Name, gomock.Len(len(okIDs)), gomock.Any())
+	s.msgRepo.EXPECT().BlockMessages(gomock.Any(), suspiciousIDs).Return(nil)
+	s.outboxSvc.EXPECT().PutMany(gomock.Any(), clientmessageblockedjob.Name, gomock.Len(len(suspiciousIDs)), gomock.Any())
+	s.consumer.EXPECT().CommitMessages(gomock.Any(), msgs).Return(nil)
+
+	// Action & assert.
+	s.runProcessorFor(100 * time.Millisecond)
+}
+
+func (s *BatchServiceSuite) TestBatchFlushedAfterMaxWait() {
+	// Arrange.
+	msgID := types.NewMessageID()
+	msg := s.verdictMsg(msgID, "ok")
+
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(msg, nil)
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).DoAndReturn(func(ctx context.Context) (msgbus.Message, error) {
+		<-ctx.Done()
+		return msgbus.Message{}, ctx.Err()
+	})
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(msgbus.Message{}, io.EOF).MaxTimes(1)
+
+	s.expectTx(1)
+	s.msgRepo.EXPECT().MarkManyAsVisibleForManager(gomock.Any(), []types.MessageID{msgID}).Return(nil)
+	s.outboxSvc.EXPECT().PutMany(gomock.Any(), clientmessagesentjob.Name, gomock.Len(1), gomock.Any())
+	s.consumer.EXPECT().CommitMessages(gomock.Any(), []msgbus.Message{msg}).Return(nil)
+
+	// Action & assert.
+	s.runProcessorFor(2 * batchMaxWait)
+}
+
+func (s *BatchServiceSuite) TestBatchPartialFailure_FailedVerdictsRoutedToDLQ() {
+	// Arrange.
+	okID := types.NewMessageID()
+	suspiciousID := types.NewMessageID()
+	unknownID := types.NewMessageID()
+
+	okMsg := s.verdictMsg(okID, "ok")
+	suspiciousMsg := s.verdictMsg(suspiciousID, "suspicious")
+	unknownMsg := s.verdictMsg(unknownID, "suspicious")
+	msgs := []msgbus.Message{okMsg, suspiciousMsg, unknownMsg}
+	s.expectFetch(msgs...)
+
+	s.expectTx(-1)
+
+	// Batch attempt.
+	s.msgRepo.EXPECT().MarkManyAsVisibleForManager(gomock.Any(), []types.MessageID{okID}).Return(nil)
+	s.outboxSvc.EXPECT().PutMany(gomock.Any(), clientmessagesentjob.Name, gomock.Len(1), gomock.Any())
+	s.msgRepo.EXPECT().BlockMessages(gomock.Any(), []types.MessageID{suspiciousID, unknownID}).
+		Return(messagesrepo.ErrMsgNotFound)
+
+	// One by one fallback.
+	s.msgRepo.EXPECT().MarkManyAsVisibleForManager(gomock.Any(), []types.MessageID{okID}).Return(nil)
+	s.outboxSvc.EXPECT().PutMany(gomock.Any(), clientmessagesentjob.Name, gomock.Len(1), gomock.Any())
+	s.msgRepo.EXPECT().BlockMessages(gomock.Any(), []types.MessageID{suspiciousID}).Return(nil)
+	s.outboxSvc.EXPECT().PutMany(gomock.Any(), clientmessageblockedjob.Name, gomock.Len(1), gomock.Any())
+	s.msgRepo.EXPECT().BlockMessages(gomock.Any(), []types.MessageID{unknownID}).
+		Return(messagesrepo.ErrMsgNotFound).AnyTimes()
+
+	s.dlqProducer.EXPECT().WriteMessages(gomock.Any(), kafkaMsgValueMatcher{unknownMsg.Value})
+	s.consumer.EXPECT().CommitMessages(gomock.Any(), msgs).Return(nil)
+
+	// Action & assert.
+	s.runProcessorFor(2 * backoffMaxElapsedTime)
+}
+
+func (s *BatchServiceSuite) TestReviewMode_SuspiciousMessagesQueuedForReview() {
+	// Arrange.
+	s.svc = s.newService(afcverdictsprocessor.WithReviewSuspicious(true))
+
+	okID := types.NewMessageID()
+	suspiciousIDs := []types.MessageID{types.NewMessageID(), types.NewMessageID()}
+	msgs := []msgbus.Message{
+		s.verdictMsg(suspiciousIDs[0], "suspicious"),
+		s.verdictMsg(okID, "ok"),
+		s.verdictMsg(suspiciousIDs[1], "suspicious"),
+	}
+	s.expectFetch(msgs...)
+
+	s.expectTx(1)
+	s.msgRepo.EXPECT().MarkManyAsVisibleForManager(gomock.Any(), []types.MessageID{okID}).Return(nil)
+	s.outboxSvc.EXPECT().PutMany(gomock.Any(), clientmessagesentjob.Name, gomock.Len(1), gomock.Any())
+	s.msgRepo.EXPECT().RequestReview(gomock.Any(), suspiciousIDs).Return(nil)
+	s.consumer.EXPECT().CommitMessages(gomock.Any(), msgs).Return(nil)
+
+	// Action & assert.
+	s.runProcessorFor(100 * time.Millisecond)
+}
+
+func (s *BatchServiceSuite) TestManagerMessages_HeldMessagesReleasedOrBlocked() {
+	// Arrange.
+	s.svc = s.newService(
+		afcverdictsprocessor.WithCheckManagerMessages(true),
+		afcverdictsprocessor.WithReviewSuspicious(true),
+	)
+
+	clientOkID, clientSuspiciousID := types.NewMessageID(), types.NewMessageID()
+	managerOkID, managerSuspiciousID := types.NewMessageID(), types.NewMessageID()
+	msgs := []msgbus.Message{
+		s.verdictMsg(clientOkID, "ok"),
+		s.verdictMsg(managerOkID, "ok"),
+		s.verdictMsg(managerSuspiciousID, "suspicious"),
+		s.verdictMsg(clientSuspiciousID, "suspicious"),
+	}
+	s.expectFetch(msgs...)
+
+	s.expectTx(1)
+	s.msgRepo.EXPECT().GetHeldManagerMessageIDs(gomock.Any(),
+		[]types.MessageID{clientOkID, managerOkID, managerSuspiciousID, clientSuspiciousID},
+	).Return([]types.MessageID{managerOkID, managerSuspiciousID}, nil)
+
+	// The held manager messages are delivered to the client or blocked regardless of the review mode.
+	s.msgRepo.EXPECT().ReleaseManagerMessages(gomock.Any(), []types.MessageID{managerOkID}).Return(nil)
+	s.outboxSvc.EXPECT().PutMany(gomock.Any(), managermessagesentjob.Name, gomock.Len(1), gomock.Any())
+	s.msgRepo.EXPECT().BlockMessages(gomock.Any(), []types.MessageID{managerSuspiciousID}).Return(nil)
+	s.outboxSvc.EXPECT().PutMany(gomock.Any(), clientmessageblockedjob.Name, gomock.Len(1), gomock.Any())
+
+	// The client messages go the usual way.
+	s.msgRepo.EXPECT().MarkManyAsVisibleForManager(gomock.Any(), []types.MessageID{clientOkID}).Return(nil)
+	s.outboxSvc.EXPECT().PutMany(gomock.Any(), clientmessagesentjob.Name, gomock.Len(1), gomock.Any())
+	s.msgRepo.EXPECT().RequestReview(gomock.Any(), []types.MessageID{clientSuspiciousID}).Return(nil)
+	s.consumer.EXPECT().CommitMessages(gomock.Any(), msgs).Return(nil)
+
+	// Action & assert.
+	s.runProcessorFor(100 * time.Millisecond)
+}
+
+func (s *BatchServiceSuite) TestManagerMessages_ApplyVerdict() {
+	// Arrange.
+	s.svc = s.newService(afcverdictsprocessor.WithCheckManagerMessages(true))
+
+	msgID := types.NewMessageID()
+
+	s.expectTx(1)
+	s.msgRepo.EXPECT().GetHeldManagerMessageIDs(gomock.Any(), []types.MessageID{msgID}).
+		Return([]types.MessageID{msgID}, nil)
+	s.msgRepo.EXPECT().ReleaseManagerMessages(gomock.Any(), []types.MessageID{msgID}).Return(nil)
+	s.outboxSvc.EXPECT().PutMany(gomock.Any(), managermessagesentjob.Name, gomock.Len(1), gomock.Any())
+
+	// Action & assert.
+	s.Require().NoError(s.svc.ApplyVerdict(s.Ctx, types.NewChatID(), msgID, false))
+
+	// The service is not run, so satisfy the Close expectations of SetupTest.
+	s.Require().NoError(s.consumer.Close())
+	s.Require().NoError(s.dlqProducer.Close())
+}
+
+func (s *BatchServiceSuite) TestUnknownSigningKey_RoutedToDLQ() {
+	// Arrange.
+	key := newRSAKey(s.T())
+	path := filepath.Join(s.T().TempDir(), "jwks.json")
+	writeJWKS(s.T(), path, map[string]*rsa.PublicKey{"active": &key.PublicKey})
+
+	ks, err := afcverdictsprocessor.NewKeySet(s.Ctx, afcverdictsprocessor.NewKeySetOptions(path))
+	s.Require().NoError(err)
+	s.svc = s.newService(afcverdictsprocessor.WithVerdictsKeySet(ks))
+
+	okID := types.NewMessageID()
+	okMsg := s.signedVerdictMsg(key, "active", okID)
+	unknownKeyMsg := s.signedVerdictMsg(key, "revoked", types.NewMessageID())
+	msgs := []msgbus.Message{okMsg, unknownKeyMsg}
+	s.expectFetch(msgs...)
+
+	s.dlqProducer.EXPECT().WriteMessages(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, msgs ...msgbus.Message) error {
+			s.Require().Len(msgs, 1)
+			s.Equal(unknownKeyMsg.Value, msgs[0].Value)
+			s.Contains(string(msgs[0].Headers[0].Value), afcverdictsprocessor.ErrUnknownSigningKey.Error())
+			return nil
+		})
+	s.expectTx(1)
+	s.msgRepo.EXPECT().MarkManyAsVisibleForManager(gomock.Any(), []types.MessageID{okID}).Return(nil)
+	s.outboxSvc.EXPECT().PutMany(gomock.Any(), clientmessagesentjob.Name, gomock.Len(1), gomock.Any())
+	s.consumer.EXPECT().CommitMessages(gomock.Any(), msgs).Return(nil)
+
+	// Action & assert.
+	s.runProcessorFor(100 * time.Millisecond)
+}
+
+func (s *BatchServiceSuite) TestProcessVerdict() {
+	// Arrange.
+	msgID := types.NewMessageID()
+
+	s.expectTx(1)
+	s.msgRepo.EXPECT().BlockMessages(gomock.Any(), []types.MessageID{msgID}).Return(nil)
+	s.outboxSvc.EXPECT().PutMany(gomock.Any(), clientmessageblockedjob.Name, gomock.Len(1), gomock.Any())
+
+	// Action.
+	err := s.svc.ProcessVerdict(s.Ctx, s.verdictMsg(msgID, "suspicious"))
+	s.Require().NoError(err)
+
+	err = s.svc.ProcessVerdict(s.Ctx, msgbus.Message{Value: []byte("{")})
+
+	// Assert.
+	s.Require().Error(err)
+
+	// The service is not run, so satisfy the Close expectations of SetupTest.
+	s.Require().NoError(s.consumer.Close())
+	s.Require().NoError(s.dlqProducer.Close())
+}
+
+func (s *BatchServiceSuite) TestApplyVerdict() {
+	// Arrange.
+	okMsgID, suspiciousMsgID := types.NewMessageID(), types.NewMessageID()
+
+	s.expectTx(2)
+	s.msgRepo.EXPECT().MarkManyAsVisibleForManager(gomock.Any(), []types.MessageID{okMsgID}).Return(nil)
+	s.outboxSvc.EXPECT().PutMany(gomock.Any(), clientmessagesentjob.Name, gomock.Len(1), gomock.Any())
+	s.msgRepo.EXPECT().BlockMessages(gomock.Any(), []types.MessageID{suspiciousMsgID}).Return(nil)
+	s.outboxSvc.EXPECT().PutMany(gomock.Any(), clientmessageblockedjob.Name, gomock.Len(1), gomock.Any())
+
+	// Action & assert.
+	s.Require().NoError(s.svc.ApplyVerdict(s.Ctx, types.NewChatID(), okMsgID, false))
+	s.Require().NoError(s.svc.ApplyVerdict(s.Ctx, types.NewChatID(), suspiciousMsgID, true))
+
+	// The service is not run, so satisfy the Close expectations of SetupTest.
+	s.Require().NoError(s.consumer.Close())
+	s.Require().NoError(s.dlqProducer.Close())
+}
+
+func (s *BatchServiceSuite) signedVerdictMsg(key *rsa.PrivateKey, kid string, msgID types.MessageID) msgbus.Message {
+	s.T().Helper()
+
+	token := jwt.NewWithClaims(jwt.SigningMethodRS256, verdict{
+		ChatID:    types.NewChatID().String(),
+		MessageID: msgID.String(),
+		Status:    "ok",
+	})
+	token.Header["kid"] = kid
+
+	data, err := token.SignedString(key)
+	s.Require().NoError(err)
+
+	return msgbus.Message{Value: []byte(data)}
+}
+
+func (s *BatchServiceSuite) expectFetch(msgs ...msgbus.Message) {
+	s.T().Helper()
+
+	for _, m := range msgs {
+		s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(m, nil)
+	}
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(msgbus.Message{}, io.EOF).MaxTimes(1)
+}
+
+// expectTx expects the exact number of transactions or any number if n is negative.
+func (s *BatchServiceSuite) expectTx(n int) {
+	s.T().Helper()
+
+	call := s.transactor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+
+	if n < 0 {
+		call.AnyTimes()
+	} else {
+		call.Times(n)
+	}
+}
+
+func (s *BatchServiceSuite) verdictMsg(msgID types.MessageID, status string) msgbus.Message {
+	s.T().Helper()
+
+	data, err := json.Marshal(verdict{
+		ChatID:    types.NewChatID().String(),
+		MessageID: msgID.String(),
+		Status:    status,
+	})
+	s.Require().NoError(err)
+
+	return msgbus.Message{Value: data}
+}
+
+func (s *BatchServiceSuite) runProcessorFor(timeout time.Duration) {
+	s.T().Helper()
+
+	ctx, cancel := context.WithCancel(s.Ctx)
+	defer cancel()
+
+	errCh := make(chan error)
+	go func() { errCh <- s.svc.Run(ctx) }()
+
+	time.Sleep(timeout)
+	cancel()
+	s.NoError(<-errCh) // No error expected because of graceful shutdown via cancel ctx.
+}
@@ -1,25 +1,7 @@
 package afcverdictsprocessor
 
-import (
-	"context"
-	"io"
-
-	"github.com/segmentio/kafka-go"
+// The headers of the DLQ messages.
+const (
+	DLQHeaderLastError         = "LAST_ERROR"
+	DLQHeaderOriginalPartition = "ORIGINAL_PARTITION"
 )
-
-//go:generate mockgen -source=$GOFILE -destination=mocks/dlq_writer_mock.gen.go -package=afcverdictsprocessormocks
-
-type KafkaDLQWriter interface {
-	io.Closer
-	WriteMessages(ctx context.Context, msgs ...kafka.Message) error
-}
-
-func NewKafkaDLQWriter(brokers []string, topic string) KafkaDLQWriter {
-	return &kafka.Writer{
-		Addr:         kafka.TCP(brokers...),
-		Topic:        topic,
-		BatchSize:    1,
-		Async:        false,
-		RequiredAcks: kafka.RequireOne,
-	}
-}
@@ -14,6 +14,7 @@ import (
 	jobsrepo "github.com/karasunokami/chat-service/internal/repositories/jobs"
 	messagesrepo "github.com/karasunokami/chat-service/internal/repositories/messages"
 	afcverdictsprocessor "github.com/karasunokami/chat-service/internal/services/afc-verdicts-processor"
+	msgbus "github.com/karasunokami/chat-service/internal/services/msg-bus"
 	"github.com/karasunokami/chat-service/internal/services/outbox"
 	"github.com/karasunokami/chat-service/internal/store/message"
 	"github.com/karasunokami/chat-service/internal/testingh"
@@ -117,12 +118,11 @@ func (s *ServiceIntegrationSuite) SetupTest() {
 	s.Require().NoError(err)
 
 	s.svc, err = afcverdictsprocessor.New(afcverdictsprocessor.NewOptions(
-		s.ks.KafkaBrokers(),
 		4,
 		s.ConsumerGroup,
 		s.verdictsTopic,
-		afcverdictsprocessor.NewKafkaReader,
-		afcverdictsprocessor.NewKafkaDLQWriter(s.ks.KafkaBrokers(), s.verdictsDLQTopic),
+		msgbus.KafkaReaderFactory(s.ks.KafkaBrokers()),
+		msgbus.NewKafkaDLQWriter(s.ks.KafkaBrokers(), s.verdictsDLQTopic),
 		s.Database,
 		msgRepo,
 		outboxSvc,
@@ -0,0 +1,91 @@
+package afcverdictsprocessor_test
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	afcverdictsprocessor "github.com/karasunokami/chat-service/internal/services/afc-verdicts-processor"
+	afcverdictsprocessormocks "github.com/karasunokami/chat-service/internal/services/afc-verdicts-processor/mocks"
+	msgbus "github.com/karasunokami/chat-service/internal/services/msg-bus"
+	clientmessagesentjob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/client-message-sent"
+	"github.com/karasunokami/chat-service/internal/types"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+func TestService_MemoryBus(t *testing.T) {
+	const (
+		verdictsTopic = "afc.msg-verdicts"
+		dlqTopic      = "afc.msg-verdicts.dlq"
+	)
+
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	msgRepo := afcverdictsprocessormocks.NewMockmessagesRepository(ctrl)
+	outboxSvc := afcverdictsprocessormocks.NewMockoutboxService(ctrl)
+	transactor := afcverdictsprocessormocks.NewMocktransactor(ctrl)
+	transactor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		}).AnyTimes()
+
+	bus, err := msgbus.NewMemory(msgbus.NewMemoryOptions())
+	require.NoError(t, err)
+
+	svc, err := afcverdictsprocessor.New(afcverdictsprocessor.NewOptions(
+		2,
+		"afcverdictsprocessor_test.MemoryBus",
+		verdictsTopic,
+		bus.Reader,
+		bus.Writer(dlqTopic),
+		transactor,
+		msgRepo,
+		outboxSvc,
+	))
+	require.NoError(t, err)
+
+	msgID := types.NewMessageID()
+	processed := make(chan struct{})
+	msgRepo.EXPECT().MarkManyAsVisibleForManager(gomock.Any(), []types.MessageID{msgID}).Return(nil)
+	outboxSvc.EXPECT().PutMany(gomock.Any(), clientmessagesentjob.Name, gomock.Len(1), gomock.Any()).
+		DoAndReturn(func(context.Context, string, []string, time.Time) ([]types.JobID, error) {
+			close(processed)
+			return []types.JobID{types.NewJobID()}, nil
+		})
+
+	data, err := json.Marshal(verdict{ChatID: types.NewChatID().String(), MessageID: msgID.String(), Status: "ok"})
+	require.NoError(t, err)
+
+	w := bus.Writer(verdictsTopic)
+	require.NoError(t, w.WriteMessages(ctx, msgbus.Message{Value: data}, msgbus.Message{Value: []byte("{")}))
+
+	runCtx, stop := context.WithCancel(ctx)
+	errCh := make(chan error)
+	go func() { errCh <- svc.Run(runCtx) }()
+
+	// The malformed verdict gets into the DLQ topic of the same bus.
+	dlq := bus.Reader("afcverdictsprocessor_test.DLQ", dlqTopic)
+	defer dlq.Close()
+
+	m, err := dlq.FetchMessage(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "{", string(m.Value))
+	assert.Equal(t, afcverdictsprocessor.DLQHeaderLastError, m.Headers[0].Key)
+
+	select {
+	case <-processed:
+	case <-ctx.Done():
+		t.Fatal("verdict was not processed")
+	}
+
+	stop()
+	require.NoError(t, <-errCh)
+}
@@ -5,6 +5,7 @@ import (
 	fmt461e464ebed9 "fmt"
 	"time"
 
+	msgbus "github.com/karasunokami/chat-service/internal/services/msg-bus"
 	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
 	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
 )
@@ -12,12 +13,11 @@ import (
 type OptOptionsSetter func(o *Options)
 
 func NewOptions(
-	brokers []string,
 	consumers int,
 	consumerGroup string,
 	verdictsTopic string,
-	readerFactory KafkaReaderFactory,
-	dlqWriter KafkaDLQWriter,
+	readerFactory msgbus.ReaderFactory,
+	dlqWriter msgbus.Writer,
 	txtor transactor,
 	msgRepo messagesRepository,
 	outBox outboxService,
@@ -29,8 +29,9 @@ func NewOptions(
 	o.backoffInitialInterval, _ = time.ParseDuration("100ms")
 	o.backoffMaxElapsedTime, _ = time.ParseDuration("5s")
 	o.backoffExpFactor = 2
+	o.processBatchSize = 1
+	o.processBatchMaxWait, _ = time.ParseDuration("1s")
 
-	o.brokers = brokers
 	o.consumers = consumers
 	o.consumerGroup = consumerGroup
 	o.verdictsTopic = verdictsTopic
@@ -70,21 +71,46 @@ func WithVerdictsSignKey(opt string) OptOptionsSetter {
 	}
 }
 
+func WithVerdictsKeySet(opt verdictsKeySet) OptOptionsSetter {
+	return func(o *Options) {
+		o.verdictsKeySet = opt
+	}
+}
+
+func WithReviewSuspicious(opt bool) OptOptionsSetter {
+	return func(o *Options) {
+		o.reviewSuspicious = opt
+	}
+}
+
+func WithCheckManagerMessages(opt bool) OptOptionsSetter {
+	return func(o *Options) {
+		o.checkManagerMessages = opt
+	}
+}
+
 func WithProcessBatchSize(opt int) OptOptionsSetter {
 	return func(o *Options) {
 		o.processBatchSize = opt
 	}
 }
 
+func WithProcessBatchMaxWait(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) {
+		o.processBatchMaxWait = opt
+	}
+}
+
 func (o *Options) Validate() error {
 	errs := new(errors461e464ebed9.ValidationErrors)
 	errs.Add(errors461e464ebed9.NewValidationError("backoffInitialInterval", _validate_Options_backoffInitialInterval(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("backoffMaxElapsedTime", _validate_Options_backoffMaxElapsedTime(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("backoffExpFactor", _validate_Options_backoffExpFactor(o)))
-	errs.Add(errors461e464ebed9.NewValidationError("brokers", _validate_Options_brokers(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("consumers", _validate_Options_consumers(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("consumerGroup", _validate_Options_consumerGroup(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("verdictsTopic", _validate_Options_verdictsTopic(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("processBatchSize", _validate_Options_processBatchSize(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("processBatchMaxWait", _validate_Options_processBatchMaxWait(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("readerFactory", _validate_Options_readerFactory(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("dlqWriter", _validate_Options_dlqWriter(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("txtor", _validate_Options_txtor(o)))
@@ -114,13 +140,6 @@ func _validate_Options_backoffExpFactor(o *Options) error {
 	return nil
 }
 
-func _validate_Options_brokers(o *Options) error {
-	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.brokers, "min=1"); err != nil {
-		return fmt461e464ebed9.Errorf("field `brokers` did not pass the test: %w", err)
-	}
-	return nil
-}
-
 func _validate_Options_consumers(o *Options) error {
 	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.consumers, "min=1,max=16"); err != nil {
 		return fmt461e464ebed9.Errorf("field `consumers` did not pass the test: %w", err)
@@ -142,6 +161,20 @@ func _validate_Options_verdictsTopic(o *Options) error {
 	return nil
 }
 
+func _validate_Options_processBatchSize(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.processBatchSize, "min=1,max=1000"); err != nil {
+		return fmt461e464ebed9.Errorf("field `processBatchSize` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_processBatchMaxWait(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.processBatchMaxWait, "min=1ms,max=1m"); err != nil {
+		return fmt461e464ebed9.Errorf("field `processBatchMaxWait` did not pass the test: %w", err)
+	}
+	return nil
+}
+
 func _validate_Options_readerFactory(o *Options) error {
 	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.readerFactory, "required"); err != nil {
 		return fmt461e464ebed9.Errorf("field `readerFactory` did not pass the test: %w", err)
@@ -5,11 +5,14 @@ import (
 	"crypto/rsa"
 	"encoding/json"
 	"io"
+	"path/filepath"
 	"testing"
 	"time"
 
 	afcverdictsprocessor "github.com/karasunokami/chat-service/internal/services/afc-verdicts-processor"
 	afcverdictsprocessormocks "github.com/karasunokami/chat-service/internal/services/afc-verdicts-processor/mocks"
+	msgbus "github.com/karasunokami/chat-service/internal/services/msg-bus"
+	msgbusmocks "github.com/karasunokami/chat-service/internal/services/msg-bus/mocks"
 	clientmessageblockedjob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/client-message-blocked"
 	clientmessagesentjob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/client-message-sent"
 	"github.com/karasunokami/chat-service/internal/testingh"
@@ -17,7 +20,6 @@ import (
 
 	"github.com/golang-jwt/jwt"
 	"github.com/golang/mock/gomock"
-	"github.com/segmentio/kafka-go"
 	"github.com/stretchr/testify/suite"
 )
 
@@ -31,13 +33,15 @@ type ServiceSuite struct {
 
 	SignPrivateKey string
 	SignPubKey     string
+	// SignKeyID makes the suite verify verdicts with the JWKS key set instead of SignPubKey.
+	SignKeyID string
 
 	ctrl        *gomock.Controller
 	outboxSvc   *afcverdictsprocessormocks.MockoutboxService
 	msgRepo     *afcverdictsprocessormocks.MockmessagesRepository
 	transactor  *afcverdictsprocessormocks.Mocktransactor
-	consumer    *afcverdictsprocessormocks.MockKafkaReader
-	dlqProducer *afcverdictsprocessormocks.MockKafkaDLQWriter
+	consumer    *msgbusmocks.MockReader
+	dlqProducer *msgbusmocks.MockWriter
 
 	signPrivateKey *rsa.PrivateKey
 	svc            *afcverdictsprocessor.Service
@@ -58,6 +62,15 @@ func TestServiceSuite_SignedVerdicts(t *testing.T) {
 	})
 }
 
+func TestServiceSuite_SignedVerdictsWithKeyID(t *testing.T) {
+	t.Parallel()
+
+	suite.Run(t, &ServiceSuite{
+		SignPrivateKey: privateKey,
+		SignKeyID:      "afc-key-1",
+	})
+}
+
 func (s *ServiceSuite) SetupTest() {
 	s.ContextSuite.SetupTest()
 
@@ -71,8 +84,8 @@ func (s *ServiceSuite) SetupTest() {
 			return f(ctx)
 		}).AnyTimes()
 
-	s.consumer = afcverdictsprocessormocks.NewMockKafkaReader(s.ctrl)
-	s.dlqProducer = afcverdictsprocessormocks.NewMockKafkaDLQWriter(s.ctrl)
+	s.consumer = msgbusmocks.NewMockReader(s.ctrl)
+	s.dlqProducer = msgbusmocks.NewMockWriter(s.ctrl)
 
 	if k := s.SignPrivateKey; k != "" {
 		var err error
@@ -80,14 +93,26 @@ func (s *ServiceSuite) SetupTest() {
 		s.Require().NoError(err)
 	}
 
+	opts := []afcverdictsprocessor.OptOptionsSetter{
+		afcverdictsprocessor.WithVerdictsSignKey(s.SignPubKey),
+		afcverdictsprocessor.WithBackoffInitialInterval(backoffInitialInterval),
+		afcverdictsprocessor.WithBackoffMaxElapsedTime(backoffMaxElapsedTime),
+	}
+	if s.SignKeyID != "" {
+		path := filepath.Join(s.T().TempDir(), "jwks.json")
+		writeJWKS(s.T(), path, map[string]*rsa.PublicKey{s.SignKeyID: &s.signPrivateKey.PublicKey})
+
+		ks, err := afcverdictsprocessor.NewKeySet(s.Ctx, afcverdictsprocessor.NewKeySetOptions(path))
+		s.Require().NoError(err)
+		opts = append(opts, afcverdictsprocessor.WithVerdictsKeySet(ks))
+	}
+
 	var err error
 	s.svc, err = afcverdictsprocessor.New(afcverdictsprocessor.NewOptions(
-		[]string{"test:9092"},
 		1,
 		"afcverdictsprocessor_test.ServiceSuite",
 		"afc.unit-test.verdicts",
-		func(brokers []string, groupID string, topic string) afcverdictsprocessor.KafkaReader {
-			s.Equal([]string{"test:9092"}, brokers)
+		func(groupID, topic string) msgbus.Reader {
 			s.Equal("afcverdictsprocessor_test.ServiceSuite", groupID)
 			s.Equal("afc.unit-test.verdicts", topic)
 			return s.consumer
@@ -96,9 +121,7 @@ func (s *ServiceSuite) SetupTest() {
 		s.transactor,
 		s.msgRepo,
 		s.outboxSvc,
-		afcverdictsprocessor.WithVerdictsSignKey(s.SignPubKey),
-		afcverdictsprocessor.WithBackoffInitialInterval(backoffInitialInterval),
-		afcverdictsprocessor.WithBackoffMaxElapsedTime(backoffMaxElapsedTime),
+		opts...,
 	))
 	s.Require().NoError(err)
 
@@ -118,9 +141,9 @@ func (s *ServiceSuite) TestNotRetriableError_InvalidEncoding() {
   "chatId": "2d1bb2b4-1e11-11ed-9c9f-461e464ebed9",
   "messageId": "b611c338-1e11-11ed-b5ce-461e464ebed9",
   "status": "ok"`)
-	msg := kafka.Message{Value: v}
+	msg := msgbus.Message{Value: v}
 	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(msg, nil)
-	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(kafka.Message{}, io.EOF).MaxTimes(1)
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(msgbus.Message{}, io.EOF).MaxTimes(1)
 	s.consumer.EXPECT().CommitMessages(gomock.Any(), msg)
 	s.dlqProducer.EXPECT().WriteMessages(gomock.Any(), kafkaMsgValueMatcher{v})
 
@@ -137,9 +160,9 @@ func (s *ServiceSuite) TestNotRetriableError_InvalidVerdict() {
 	}
 	data := []byte(s.encode(v))
 
-	msg := kafka.Message{Value: data}
+	msg := msgbus.Message{Value: data}
 	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(msg, nil)
-	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(kafka.Message{}, io.EOF).MaxTimes(1)
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(msgbus.Message{}, io.EOF).MaxTimes(1)
 	s.consumer.EXPECT().CommitMessages(gomock.Any(), msg)
 	s.dlqProducer.EXPECT().WriteMessages(gomock.Any(), kafkaMsgValueMatcher{data})
 
@@ -157,13 +180,13 @@ func (s *ServiceSuite) TestOperationRetriedWithSuccess() {
 	}
 	data := []byte(s.encode(v))
 
-	msg := kafka.Message{Value: data}
+	msg := msgbus.Message{Value: data}
 	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(msg, nil)
-	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(kafka.Message{}, io.EOF).MaxTimes(1)
-	s.msgRepo.EXPECT().MarkAsVisibleForManager(gomock.Any(), msgID).Return(context.Canceled)
-	s.msgRepo.EXPECT().MarkAsVisibleForManager(gomock.Any(), msgID).Return(context.Canceled)
-	s.msgRepo.EXPECT().MarkAsVisibleForManager(gomock.Any(), msgID).Return(nil)
-	s.outboxSvc.EXPECT().Put(gomock.Any(), clientmessagesentjob.Name, gomock.Any(), gomock.Any())
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(msgbus.Message{}, io.EOF).MaxTimes(1)
+	s.msgRepo.EXPECT().MarkManyAsVisibleForManager(gomock.Any(), []types.MessageID{msgID}).Return(context.Canceled)
+	s.msgRepo.EXPECT().MarkManyAsVisibleForManager(gomock.Any(), []types.MessageID{msgID}).Return(context.Canceled)
+	s.msgRepo.EXPECT().MarkManyAsVisibleForManager(gomock.Any(), []types.MessageID{msgID}).Return(nil)
+	s.outboxSvc.EXPECT().PutMany(gomock.Any(), clientmessagesentjob.Name, gomock.Any(), gomock.Any())
 	s.consumer.EXPECT().CommitMessages(gomock.Any(), msg)
 
 	// Action & assert.
@@ -180,10 +203,10 @@ func (s *ServiceSuite) TestOperationBackoffExceeded() {
 	}
 	data := []byte(s.encode(v))
 
-	msg := kafka.Message{Value: data}
+	msg := msgbus.Message{Value: data}
 	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(msg, nil)
-	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(kafka.Message{}, io.EOF).MaxTimes(1)
-	s.msgRepo.EXPECT().MarkAsVisibleForManager(gomock.Any(), msgID).Return(context.Canceled).AnyTimes()
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(msgbus.Message{}, io.EOF).MaxTimes(1)
+	s.msgRepo.EXPECT().MarkManyAsVisibleForManager(gomock.Any(), []types.MessageID{msgID}).Return(context.Canceled).AnyTimes()
 	s.consumer.EXPECT().CommitMessages(gomock.Any(), msg)
 	s.dlqProducer.EXPECT().WriteMessages(gomock.Any(), kafkaMsgValueMatcher{data})
 
@@ -210,18 +233,18 @@ func (s *ServiceSuite) TestProcessMessagesWithoutErrors() {
 	for _, v := range verdicts {
 		data := []byte(s.encode(v))
 
-		msg := kafka.Message{Value: data}
+		msg := msgbus.Message{Value: data}
 		s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(msg, nil)
 		if v.Status == "ok" {
-			s.msgRepo.EXPECT().MarkAsVisibleForManager(gomock.Any(), types.MustParse[types.MessageID](v.MessageID)).Return(nil)
-			s.outboxSvc.EXPECT().Put(gomock.Any(), clientmessagesentjob.Name, gomock.Any(), gomock.Any())
+			s.msgRepo.EXPECT().MarkManyAsVisibleForManager(gomock.Any(), []types.MessageID{types.MustParse[types.MessageID](v.MessageID)}).Return(nil)
+			s.outboxSvc.EXPECT().PutMany(gomock.Any(), clientmessagesentjob.Name, gomock.Any(), gomock.Any())
 		} else {
-			s.msgRepo.EXPECT().BlockMessage(gomock.Any(), types.MustParse[types.MessageID](v.MessageID))
-			s.outboxSvc.EXPECT().Put(gomock.Any(), clientmessageblockedjob.Name, gomock.Any(), gomock.Any())
+			s.msgRepo.EXPECT().BlockMessages(gomock.Any(), []types.MessageID{types.MustParse[types.MessageID](v.MessageID)})
+			s.outboxSvc.EXPECT().PutMany(gomock.Any(), clientmessageblockedjob.Name, gomock.Any(), gomock.Any())
 		}
 		s.consumer.EXPECT().CommitMessages(gomock.Any(), msg)
 	}
-	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(kafka.Message{}, io.EOF).MaxTimes(1)
+	s.consumer.EXPECT().FetchMessage(gomock.Any()).Return(msgbus.Message{}, io.EOF).MaxTimes(1)
 
 	// Action & assert.
 	s.runProcessorFor(100 * time.Millisecond)
@@ -253,7 +276,12 @@ func (s *ServiceSuite) encode(v verdict) string {
 	s.T().Helper()
 
 	if s.signPrivateKey != nil {
-		result, err := jwt.NewWithClaims(jwt.SigningMethodRS256, v).SignedString(s.signPrivateKey)
+		token := jwt.NewWithClaims(jwt.SigningMethodRS256, v)
+		if s.SignKeyID != "" {
+			token.Header["kid"] = s.SignKeyID
+		}
+
+		result, err := token.SignedString(s.signPrivateKey)
 		s.Require().NoError(err)
 		return result
 	}
@@ -278,7 +306,7 @@ type kafkaMsgValueMatcher struct {
 }
 
 func (km kafkaMsgValueMatcher) Matches(x interface{}) bool {
-	v, ok := x.(kafka.Message)
+	v, ok := x.(msgbus.Message)
 	if !ok {
 		return false
 	}
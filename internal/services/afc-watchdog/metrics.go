@@ -0,0 +1,24 @@
+package afcwatchdog
+
+import (
+	"github.com/karasunokami/chat-service/internal/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	resentCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "afc_watchdog",
+		Name:      "resent_total",
+		Help:      "Number of messages resent to AFC because of the missing verdict.",
+	})
+
+	fallbackCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "afc_watchdog",
+		Name:      "fallback_total",
+		Help:      "Number of messages resolved with the fallback policy.",
+	}, []string{"policy"})
+)
@@ -0,0 +1,289 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package afcwatchdogmocks is a generated GoMock package.
+package afcwatchdogmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	auditrepo "github.com/karasunokami/chat-service/internal/repositories/audit"
+	messagesrepo "github.com/karasunokami/chat-service/internal/repositories/messages"
+	msgproducer "github.com/karasunokami/chat-service/internal/services/msg-producer"
+	types "github.com/karasunokami/chat-service/internal/types"
+)
+
+// MockmessagesRepository is a mock of messagesRepository interface.
+type MockmessagesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockmessagesRepositoryMockRecorder
+}
+
+// MockmessagesRepositoryMockRecorder is the mock recorder for MockmessagesRepository.
+type MockmessagesRepositoryMockRecorder struct {
+	mock *MockmessagesRepository
+}
+
+// NewMockmessagesRepository creates a new mock instance.
+func NewMockmessagesRepository(ctrl *gomock.Controller) *MockmessagesRepository {
+	mock := &MockmessagesRepository{ctrl: ctrl}
+	mock.recorder = &MockmessagesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmessagesRepository) EXPECT() *MockmessagesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// BlockMessages mocks base method.
+func (m *MockmessagesRepository) BlockMessages(ctx context.Context, msgIDs []types.MessageID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockMessages", ctx, msgIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockMessages indicates an expected call of BlockMessages.
+func (mr *MockmessagesRepositoryMockRecorder) BlockMessages(ctx, msgIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockMessages", reflect.TypeOf((*MockmessagesRepository)(nil).BlockMessages), ctx, msgIDs)
+}
+
+// BlockUnchecked mocks base method.
+func (m *MockmessagesRepository) BlockUnchecked(ctx context.Context, msgID types.MessageID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "BlockUnchecked", ctx, msgID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// BlockUnchecked indicates an expected call of BlockUnchecked.
+func (mr *MockmessagesRepositoryMockRecorder) BlockUnchecked(ctx, msgID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "BlockUnchecked", reflect.TypeOf((*MockmessagesRepository)(nil).BlockUnchecked), ctx, msgID)
+}
+
+// DeliverUnchecked mocks base method.
+func (m *MockmessagesRepository) DeliverUnchecked(ctx context.Context, msgID types.MessageID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeliverUnchecked", ctx, msgID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeliverUnchecked indicates an expected call of DeliverUnchecked.
+func (mr *MockmessagesRepositoryMockRecorder) DeliverUnchecked(ctx, msgID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeliverUnchecked", reflect.TypeOf((*MockmessagesRepository)(nil).DeliverUnchecked), ctx, msgID)
+}
+
+// GetHeldManagerMessageIDs mocks base method.
+func (m *MockmessagesRepository) GetHeldManagerMessageIDs(ctx context.Context, msgIDs []types.MessageID) ([]types.MessageID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetHeldManagerMessageIDs", ctx, msgIDs)
+	ret0, _ := ret[0].([]types.MessageID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetHeldManagerMessageIDs indicates an expected call of GetHeldManagerMessageIDs.
+func (mr *MockmessagesRepositoryMockRecorder) GetHeldManagerMessageIDs(ctx, msgIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetHeldManagerMessageIDs", reflect.TypeOf((*MockmessagesRepository)(nil).GetHeldManagerMessageIDs), ctx, msgIDs)
+}
+
+// GetUncheckedMessages mocks base method.
+func (m *MockmessagesRepository) GetUncheckedMessages(ctx context.Context, before time.Time, limit int) ([]messagesrepo.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetUncheckedMessages", ctx, before, limit)
+	ret0, _ := ret[0].([]messagesrepo.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetUncheckedMessages indicates an expected call of GetUncheckedMessages.
+func (mr *MockmessagesRepositoryMockRecorder) GetUncheckedMessages(ctx, before, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetUncheckedMessages", reflect.TypeOf((*MockmessagesRepository)(nil).GetUncheckedMessages), ctx, before, limit)
+}
+
+// MarkAFCResent mocks base method.
+func (m *MockmessagesRepository) MarkAFCResent(ctx context.Context, msgID types.MessageID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "MarkAFCResent", ctx, msgID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// MarkAFCResent indicates an expected call of MarkAFCResent.
+func (mr *MockmessagesRepositoryMockRecorder) MarkAFCResent(ctx, msgID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "MarkAFCResent", reflect.TypeOf((*MockmessagesRepository)(nil).MarkAFCResent), ctx, msgID)
+}
+
+// ReleaseManagerMessages mocks base method.
+func (m *MockmessagesRepository) ReleaseManagerMessages(ctx context.Context, msgIDs []types.MessageID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ReleaseManagerMessages", ctx, msgIDs)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ReleaseManagerMessages indicates an expected call of ReleaseManagerMessages.
+func (mr *MockmessagesRepositoryMockRecorder) ReleaseManagerMessages(ctx, msgIDs interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseManagerMessages", reflect.TypeOf((*MockmessagesRepository)(nil).ReleaseManagerMessages), ctx, msgIDs)
+}
+
+// MockmessageProducer is a mock of messageProducer interface.
+type MockmessageProducer struct {
+	ctrl     *gomock.Controller
+	recorder *MockmessageProducerMockRecorder
+}
+
+// MockmessageProducerMockRecorder is the mock recorder for MockmessageProducer.
+type MockmessageProducerMockRecorder struct {
+	mock *MockmessageProducer
+}
+
+// NewMockmessageProducer creates a new mock instance.
+func NewMockmessageProducer(ctrl *gomock.Controller) *MockmessageProducer {
+	mock := &MockmessageProducer{ctrl: ctrl}
+	mock.recorder = &MockmessageProducerMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmessageProducer) EXPECT() *MockmessageProducerMockRecorder {
+	return m.recorder
+}
+
+// ProduceMessage mocks base method.
+func (m *MockmessageProducer) ProduceMessage(ctx context.Context, message msgproducer.Message) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ProduceMessage", ctx, message)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ProduceMessage indicates an expected call of ProduceMessage.
+func (mr *MockmessageProducerMockRecorder) ProduceMessage(ctx, message interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ProduceMessage", reflect.TypeOf((*MockmessageProducer)(nil).ProduceMessage), ctx, message)
+}
+
+// MockoutboxService is a mock of outboxService interface.
+type MockoutboxService struct {
+	ctrl     *gomock.Controller
+	recorder *MockoutboxServiceMockRecorder
+}
+
+// MockoutboxServiceMockRecorder is the mock recorder for MockoutboxService.
+type MockoutboxServiceMockRecorder struct {
+	mock *MockoutboxService
+}
+
+// NewMockoutboxService creates a new mock instance.
+func NewMockoutboxService(ctrl *gomock.Controller) *MockoutboxService {
+	mock := &MockoutboxService{ctrl: ctrl}
+	mock.recorder = &MockoutboxServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockoutboxService) EXPECT() *MockoutboxServiceMockRecorder {
+	return m.recorder
+}
+
+// Put mocks base method.
+func (m *MockoutboxService) Put(ctx context.Context, name, payload string, availableAt time.Time) (types.JobID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", ctx, name, payload, availableAt)
+	ret0, _ := ret[0].(types.JobID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockoutboxServiceMockRecorder) Put(ctx, name, payload, availableAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockoutboxService)(nil).Put), ctx, name, payload, availableAt)
+}
+
+// MockauditRepository is a mock of auditRepository interface.
+type MockauditRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockauditRepositoryMockRecorder
+}
+
+// MockauditRepositoryMockRecorder is the mock recorder for MockauditRepository.
+type MockauditRepositoryMockRecorder struct {
+	mock *MockauditRepository
+}
+
+// NewMockauditRepository creates a new mock instance.
+func NewMockauditRepository(ctrl *gomock.Controller) *MockauditRepository {
+	mock := &MockauditRepository{ctrl: ctrl}
+	mock.recorder = &MockauditRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauditRepository) EXPECT() *MockauditRepositoryMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockauditRepository) Create(ctx context.Context, rec auditrepo.Record) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, rec)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockauditRepositoryMockRecorder) Create(ctx, rec interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockauditRepository)(nil).Create), ctx, rec)
+}
+
+// Mocktransactor is a mock of transactor interface.
+type Mocktransactor struct {
+	ctrl     *gomock.Controller
+	recorder *MocktransactorMockRecorder
+}
+
+// MocktransactorMockRecorder is the mock recorder for Mocktransactor.
+type MocktransactorMockRecorder struct {
+	mock *Mocktransactor
+}
+
+// NewMocktransactor creates a new mock instance.
+func NewMocktransactor(ctrl *gomock.Controller) *Mocktransactor {
+	mock := &Mocktransactor{ctrl: ctrl}
+	mock.recorder = &MocktransactorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mocktransactor) EXPECT() *MocktransactorMockRecorder {
+	return m.recorder
+}
+
+// RunInTx mocks base method.
+func (m *Mocktransactor) RunInTx(ctx context.Context, f func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTx", ctx, f)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTx indicates an expected call of RunInTx.
+func (mr *MocktransactorMockRecorder) RunInTx(ctx, f interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTx", reflect.TypeOf((*Mocktransactor)(nil).RunInTx), ctx, f)
+}
@@ -0,0 +1,244 @@
+package afcwatchdog
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	auditrepo "github.com/karasunokami/chat-service/internal/repositories/audit"
+	messagesrepo "github.com/karasunokami/chat-service/internal/repositories/messages"
+	msgproducer "github.com/karasunokami/chat-service/internal/services/msg-producer"
+	"github.com/karasunokami/chat-service/internal/services/outbox"
+	clientmessageblockedjob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/client-message-blocked"
+	clientmessagesentjob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/client-message-sent"
+	managermessagesentjob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/manager-message-sent"
+	"github.com/karasunokami/chat-service/internal/types"
+
+	"go.uber.org/zap"
+)
+
+const (
+	serviceName = "afc-watchdog"
+
+	// uncheckedLimit is the max number of messages handled in one tick.
+	uncheckedLimit = 100
+)
+
+type FallbackPolicy string
+
+const (
+	FallbackPolicyDeliver FallbackPolicy = "deliver"
+	FallbackPolicyBlock   FallbackPolicy = "block"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/service_mock.gen.go -package=afcwatchdogmocks
+
+type messagesRepository interface {
+	GetUncheckedMessages(ctx context.Context, before time.Time, limit int) ([]messagesrepo.Message, error)
+	MarkAFCResent(ctx context.Context, msgID types.MessageID) error
+	DeliverUnchecked(ctx context.Context, msgID types.MessageID) error
+	BlockUnchecked(ctx context.Context, msgID types.MessageID) error
+	GetHeldManagerMessageIDs(ctx context.Context, msgIDs []types.MessageID) ([]types.MessageID, error)
+	ReleaseManagerMessages(ctx context.Context, msgIDs []types.MessageID) error
+	BlockMessages(ctx context.Context, msgIDs []types.MessageID) error
+}
+
+type messageProducer interface {
+	ProduceMessage(ctx context.Context, message msgproducer.Message) error
+}
+
+type outboxService interface {
+	Put(ctx context.Context, name, payload string, availableAt time.Time) (types.JobID, error)
+}
+
+type auditRepository interface {
+	Create(ctx context.Context, rec auditrepo.Record) error
+}
+
+type transactor interface {
+	RunInTx(ctx context.Context, f func(context.Context) error) error
+}
+
+//go:generate options-gen -out-filename=service_options.gen.go -from-struct=Options
+type Options struct {
+	verdictTimeout time.Duration  `option:"mandatory" validate:"min=10ms,max=24h"`
+	fallbackPolicy FallbackPolicy `option:"mandatory" validate:"oneof=deliver block"`
+	maxResends     int            `default:"3" validate:"min=0,max=100"`
+	checkPeriod    time.Duration  `default:"30s" validate:"min=10ms,max=10m"`
+
+	msgRepo       messagesRepository `option:"mandatory" validate:"required"`
+	msgProducer   messageProducer    `option:"mandatory" validate:"required"`
+	outboxService outboxService      `option:"mandatory" validate:"required"`
+	auditRepo     auditRepository    `option:"mandatory" validate:"required"`
+	transactor    transactor         `option:"mandatory" validate:"required"`
+}
+
+// Service looks after the client messages and the held manager messages, which have not got the AFC verdict in time.
+// Such messages are resent to AFC up to maxResends times and then the fallback policy is applied.
+type Service struct {
+	Options
+	logger *zap.Logger
+}
+
+func New(opts Options) (*Service, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate options, err=%v", err)
+	}
+
+	return &Service{
+		Options: opts,
+		logger:  zap.L().Named(serviceName),
+	}, nil
+}
+
+func (s *Service) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.checkPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case <-ticker.C:
+			msgs, err := s.msgRepo.GetUncheckedMessages(ctx, time.Now().Add(-s.verdictTimeout), uncheckedLimit)
+			if err != nil {
+				s.logger.Error("Fetch unchecked messages", zap.Error(err))
+
+				continue
+			}
+
+			for _, msg := range msgs {
+				if err := s.handle(ctx, msg); err != nil {
+					s.logger.Error("Handle unchecked message", zap.Error(err), zap.Stringer("msg_id", msg.ID))
+				}
+			}
+		}
+	}
+}
+
+func (s *Service) handle(ctx context.Context, msg messagesrepo.Message) error {
+	if msg.AFCResends < s.maxResends {
+		if err := s.resend(ctx, msg); err != nil {
+			return fmt.Errorf("resend message, err=%v", err)
+		}
+
+		resentCounter.Inc()
+
+		return nil
+	}
+
+	err := s.applyFallback(ctx, msg)
+	if err != nil {
+		if errors.Is(err, messagesrepo.ErrMsgAlreadyChecked) {
+			// The verdict has just arrived.
+			return nil
+		}
+		return fmt.Errorf("apply fallback policy, err=%v", err)
+	}
+
+	fallbackCounter.WithLabelValues(string(s.fallbackPolicy)).Inc()
+
+	return nil
+}
+
+func (s *Service) resend(ctx context.Context, msg messagesrepo.Message) error {
+	err := s.msgProducer.ProduceMessage(ctx, msgproducer.Message{
+		ID:         msg.ID,
+		ChatID:     msg.ChatID,
+		Body:       msg.Body,
+		FromClient: !isHeldManagerMessage(msg),
+	})
+	if err != nil {
+		return fmt.Errorf("produce message, err=%v", err)
+	}
+
+	if err := s.msgRepo.MarkAFCResent(ctx, msg.ID); err != nil {
+		return fmt.Errorf("messages repo, mark afc resent, err=%v", err)
+	}
+
+	return nil
+}
+
+func (s *Service) applyFallback(ctx context.Context, msg messagesrepo.Message) error {
+	apply, jobName, action := s.fallback(msg)
+
+	return s.transactor.RunInTx(ctx, func(ctx context.Context) error {
+		if err := apply(ctx, msg.ID); err != nil {
+			return fmt.Errorf("messages repo, apply fallback, err=%w", err)
+		}
+
+		payload, err := outbox.MarshalMessageIDPayload(msg.ID)
+		if err != nil {
+			return fmt.Errorf("marshal message id payload, err=%v", err)
+		}
+
+		if _, err := s.outboxService.Put(ctx, jobName, payload, time.Now()); err != nil {
+			return fmt.Errorf("put job to outbox service, err=%v", err)
+		}
+
+		err = s.auditRepo.Create(ctx, auditrepo.Record{
+			Action:    action,
+			ChatID:    msg.ChatID,
+			ProblemID: msg.ProblemID,
+			MessageID: msg.ID,
+			RequestID: types.NewRequestID(),
+		})
+		if err != nil {
+			return fmt.Errorf("audit repo, create record, err=%v", err)
+		}
+
+		return nil
+	})
+}
+
+// fallback returns the fallback policy of the message along with the job to notify about it and the audit action.
+// The held manager messages are released or blocked the same way as by the AFC verdict.
+func (s *Service) fallback(
+	msg messagesrepo.Message,
+) (apply func(ctx context.Context, msgID types.MessageID) error, jobName string, action auditrepo.Action) {
+	deliver := s.fallbackPolicy == FallbackPolicyDeliver
+
+	switch {
+	case isHeldManagerMessage(msg) && deliver:
+		return s.releaseHeld, managermessagesentjob.Name, auditrepo.ActionAFCTimeoutDeliver
+	case isHeldManagerMessage(msg):
+		return s.blockHeld, clientmessageblockedjob.Name, auditrepo.ActionAFCTimeoutBlock
+	case deliver:
+		return s.msgRepo.DeliverUnchecked, clientmessagesentjob.Name, auditrepo.ActionAFCTimeoutDeliver
+	}
+	return s.msgRepo.BlockUnchecked, clientmessageblockedjob.Name, auditrepo.ActionAFCTimeoutBlock
+}
+
+func (s *Service) releaseHeld(ctx context.Context, msgID types.MessageID) error {
+	if err := s.checkHeld(ctx, msgID); err != nil {
+		return err
+	}
+	return s.msgRepo.ReleaseManagerMessages(ctx, []types.MessageID{msgID})
+}
+
+func (s *Service) blockHeld(ctx context.Context, msgID types.MessageID) error {
+	if err := s.checkHeld(ctx, msgID); err != nil {
+		return err
+	}
+	return s.msgRepo.BlockMessages(ctx, []types.MessageID{msgID})
+}
+
+// checkHeld returns messagesrepo.ErrMsgAlreadyChecked if the verdict has been applied to the manager message.
+func (s *Service) checkHeld(ctx context.Context, msgID types.MessageID) error {
+	held, err := s.msgRepo.GetHeldManagerMessageIDs(ctx, []types.MessageID{msgID})
+	if err != nil {
+		return fmt.Errorf("get held manager message ids, err=%v", err)
+	}
+
+	if len(held) == 0 {
+		return messagesrepo.ErrMsgAlreadyChecked
+	}
+	return nil
+}
+
+// isHeldManagerMessage tells the held manager message from the client one, see GetUncheckedMessages.
+func isHeldManagerMessage(msg messagesrepo.Message) bool {
+	return msg.IsVisibleForManager
+}
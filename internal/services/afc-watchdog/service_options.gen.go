@@ -0,0 +1,131 @@
+// Code generated by options-gen. DO NOT EDIT.
+package afcwatchdog
+
+import (
+	fmt461e464ebed9 "fmt"
+	"time"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	verdictTimeout time.Duration,
+	fallbackPolicy FallbackPolicy,
+	msgRepo messagesRepository,
+	msgProducer messageProducer,
+	outboxService outboxService,
+	auditRepo auditRepository,
+	transactor transactor,
+	options ...OptOptionsSetter,
+) Options {
+	o := Options{}
+
+	// Setting defaults from field tag (if present)
+	o.maxResends = 3
+	o.checkPeriod, _ = time.ParseDuration("30s")
+
+	o.verdictTimeout = verdictTimeout
+	o.fallbackPolicy = fallbackPolicy
+	o.msgRepo = msgRepo
+	o.msgProducer = msgProducer
+	o.outboxService = outboxService
+	o.auditRepo = auditRepo
+	o.transactor = transactor
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func WithMaxResends(opt int) OptOptionsSetter {
+	return func(o *Options) {
+		o.maxResends = opt
+	}
+}
+
+func WithCheckPeriod(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) {
+		o.checkPeriod = opt
+	}
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("verdictTimeout", _validate_Options_verdictTimeout(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("fallbackPolicy", _validate_Options_fallbackPolicy(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("maxResends", _validate_Options_maxResends(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("checkPeriod", _validate_Options_checkPeriod(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("msgRepo", _validate_Options_msgRepo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("msgProducer", _validate_Options_msgProducer(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("outboxService", _validate_Options_outboxService(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("auditRepo", _validate_Options_auditRepo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("transactor", _validate_Options_transactor(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_verdictTimeout(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.verdictTimeout, "min=10ms,max=24h"); err != nil {
+		return fmt461e464ebed9.Errorf("field `verdictTimeout` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_fallbackPolicy(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.fallbackPolicy, "oneof=deliver block"); err != nil {
+		return fmt461e464ebed9.Errorf("field `fallbackPolicy` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_maxResends(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.maxResends, "min=0,max=100"); err != nil {
+		return fmt461e464ebed9.Errorf("field `maxResends` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_checkPeriod(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.checkPeriod, "min=10ms,max=10m"); err != nil {
+		return fmt461e464ebed9.Errorf("field `checkPeriod` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_msgRepo(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.msgRepo, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `msgRepo` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_msgProducer(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.msgProducer, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `msgProducer` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_outboxService(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.outboxService, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `outboxService` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_auditRepo(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.auditRepo, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `auditRepo` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_transactor(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.transactor, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `transactor` did not pass the test: %w", err)
+	}
+	return nil
+}
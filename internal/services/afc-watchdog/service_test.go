@@ -0,0 +1,259 @@
+package afcwatchdog_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	auditrepo "github.com/karasunokami/chat-service/internal/repositories/audit"
+	messagesrepo "github.com/karasunokami/chat-service/internal/repositories/messages"
+	afcwatchdog "github.com/karasunokami/chat-service/internal/services/afc-watchdog"
+	afcwatchdogmocks "github.com/karasunokami/chat-service/internal/services/afc-watchdog/mocks"
+	msgproducer "github.com/karasunokami/chat-service/internal/services/msg-producer"
+	clientmessageblockedjob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/client-message-blocked"
+	clientmessagesentjob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/client-message-sent"
+	managermessagesentjob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/manager-message-sent"
+	"github.com/karasunokami/chat-service/internal/testingh"
+	"github.com/karasunokami/chat-service/internal/types"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	verdictTimeout = time.Minute
+	maxResends     = 2
+	checkPeriod    = 10 * time.Millisecond
+)
+
+type ServiceSuite struct {
+	testingh.ContextSuite
+
+	ctrl        *gomock.Controller
+	msgRepo     *afcwatchdogmocks.MockmessagesRepository
+	msgProducer *afcwatchdogmocks.MockmessageProducer
+	outboxSvc   *afcwatchdogmocks.MockoutboxService
+	auditRepo   *afcwatchdogmocks.MockauditRepository
+	txtor       *afcwatchdogmocks.Mocktransactor
+}
+
+func TestServiceSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ServiceSuite))
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.ContextSuite.SetupTest()
+
+	s.ctrl = gomock.NewController(s.T())
+	s.msgRepo = afcwatchdogmocks.NewMockmessagesRepository(s.ctrl)
+	s.msgProducer = afcwatchdogmocks.NewMockmessageProducer(s.ctrl)
+	s.outboxSvc = afcwatchdogmocks.NewMockoutboxService(s.ctrl)
+	s.auditRepo = afcwatchdogmocks.NewMockauditRepository(s.ctrl)
+	s.txtor = afcwatchdogmocks.NewMocktransactor(s.ctrl)
+	s.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		}).AnyTimes()
+}
+
+func (s *ServiceSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *ServiceSuite) TestInvalidOptions() {
+	_, err := afcwatchdog.New(afcwatchdog.NewOptions(
+		verdictTimeout, "ignore", s.msgRepo, s.msgProducer, s.outboxSvc, s.auditRepo, s.txtor))
+	s.Require().Error(err)
+}
+
+func (s *ServiceSuite) TestResendToAFC() {
+	// Arrange.
+	msg := s.uncheckedMessage(maxResends - 1)
+
+	s.expectUnchecked(msg)
+	s.msgProducer.EXPECT().ProduceMessage(gomock.Any(), msgproducer.Message{
+		ID:         msg.ID,
+		ChatID:     msg.ChatID,
+		Body:       msg.Body,
+		FromClient: true,
+	}).Return(nil)
+	s.msgRepo.EXPECT().MarkAFCResent(gomock.Any(), msg.ID).Return(nil)
+
+	// Action & assert.
+	s.runFor(afcwatchdog.FallbackPolicyBlock, 5*checkPeriod)
+}
+
+func (s *ServiceSuite) TestFallbackDeliver() {
+	// Arrange.
+	msg := s.uncheckedMessage(maxResends)
+
+	s.expectUnchecked(msg)
+	s.msgRepo.EXPECT().DeliverUnchecked(gomock.Any(), msg.ID).Return(nil)
+	s.outboxSvc.EXPECT().Put(gomock.Any(), clientmessagesentjob.Name, gomock.Any(), gomock.Any()).
+		Return(types.NewJobID(), nil)
+	s.expectAudit(msg, auditrepo.ActionAFCTimeoutDeliver)
+
+	// Action & assert.
+	s.runFor(afcwatchdog.FallbackPolicyDeliver, 5*checkPeriod)
+}
+
+func (s *ServiceSuite) TestFallbackBlock() {
+	// Arrange.
+	msg := s.uncheckedMessage(maxResends)
+
+	s.expectUnchecked(msg)
+	s.msgRepo.EXPECT().BlockUnchecked(gomock.Any(), msg.ID).Return(nil)
+	s.outboxSvc.EXPECT().Put(gomock.Any(), clientmessageblockedjob.Name, gomock.Any(), gomock.Any()).
+		Return(types.NewJobID(), nil)
+	s.expectAudit(msg, auditrepo.ActionAFCTimeoutBlock)
+
+	// Action & assert.
+	s.runFor(afcwatchdog.FallbackPolicyBlock, 5*checkPeriod)
+}
+
+func (s *ServiceSuite) TestResendHeldManagerMessageToAFC() {
+	// Arrange.
+	msg := s.heldManagerMessage(maxResends - 1)
+
+	s.expectUnchecked(msg)
+	s.msgProducer.EXPECT().ProduceMessage(gomock.Any(), msgproducer.Message{
+		ID:         msg.ID,
+		ChatID:     msg.ChatID,
+		Body:       msg.Body,
+		FromClient: false,
+	}).Return(nil)
+	s.msgRepo.EXPECT().MarkAFCResent(gomock.Any(), msg.ID).Return(nil)
+
+	// Action & assert.
+	s.runFor(afcwatchdog.FallbackPolicyBlock, 5*checkPeriod)
+}
+
+func (s *ServiceSuite) TestHeldManagerMessageFallbackDeliver() {
+	// Arrange.
+	msg := s.heldManagerMessage(maxResends)
+
+	s.expectUnchecked(msg)
+	s.msgRepo.EXPECT().GetHeldManagerMessageIDs(gomock.Any(), []types.MessageID{msg.ID}).
+		Return([]types.MessageID{msg.ID}, nil)
+	s.msgRepo.EXPECT().ReleaseManagerMessages(gomock.Any(), []types.MessageID{msg.ID}).Return(nil)
+	s.outboxSvc.EXPECT().Put(gomock.Any(), managermessagesentjob.Name, gomock.Any(), gomock.Any()).
+		Return(types.NewJobID(), nil)
+	s.expectAudit(msg, auditrepo.ActionAFCTimeoutDeliver)
+
+	// Action & assert.
+	s.runFor(afcwatchdog.FallbackPolicyDeliver, 5*checkPeriod)
+}
+
+func (s *ServiceSuite) TestHeldManagerMessageFallbackBlock() {
+	// Arrange.
+	msg := s.heldManagerMessage(maxResends)
+
+	s.expectUnchecked(msg)
+	s.msgRepo.EXPECT().GetHeldManagerMessageIDs(gomock.Any(), []types.MessageID{msg.ID}).
+		Return([]types.MessageID{msg.ID}, nil)
+	s.msgRepo.EXPECT().BlockMessages(gomock.Any(), []types.MessageID{msg.ID}).Return(nil)
+	s.outboxSvc.EXPECT().Put(gomock.Any(), clientmessageblockedjob.Name, gomock.Any(), gomock.Any()).
+		Return(types.NewJobID(), nil)
+	s.expectAudit(msg, auditrepo.ActionAFCTimeoutBlock)
+
+	// Action & assert.
+	s.runFor(afcwatchdog.FallbackPolicyBlock, 5*checkPeriod)
+}
+
+func (s *ServiceSuite) TestHeldManagerMessageCheckedMeanwhile() {
+	// Arrange.
+	msg := s.heldManagerMessage(maxResends)
+
+	s.expectUnchecked(msg)
+	s.msgRepo.EXPECT().GetHeldManagerMessageIDs(gomock.Any(), []types.MessageID{msg.ID}).Return(nil, nil)
+
+	// Action & assert.
+	s.runFor(afcwatchdog.FallbackPolicyBlock, 5*checkPeriod)
+}
+
+func (s *ServiceSuite) TestErrorsDoNotStopService() {
+	// Arrange.
+	notResent, checkedMeanwhile, blocked := s.uncheckedMessage(0), s.uncheckedMessage(maxResends), s.uncheckedMessage(maxResends)
+
+	s.msgRepo.EXPECT().GetUncheckedMessages(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("unexpected"))
+	s.expectUnchecked(notResent, checkedMeanwhile, blocked)
+	s.msgProducer.EXPECT().ProduceMessage(gomock.Any(), gomock.Any()).Return(errors.New("unexpected"))
+	s.msgRepo.EXPECT().BlockUnchecked(gomock.Any(), checkedMeanwhile.ID).Return(messagesrepo.ErrMsgAlreadyChecked)
+	s.msgRepo.EXPECT().BlockUnchecked(gomock.Any(), blocked.ID).Return(nil)
+	s.outboxSvc.EXPECT().Put(gomock.Any(), clientmessageblockedjob.Name, gomock.Any(), gomock.Any()).
+		Return(types.NewJobID(), nil)
+	s.expectAudit(blocked, auditrepo.ActionAFCTimeoutBlock)
+
+	// Action & assert.
+	s.runFor(afcwatchdog.FallbackPolicyBlock, 5*checkPeriod)
+}
+
+func (s *ServiceSuite) uncheckedMessage(resends int) messagesrepo.Message {
+	return messagesrepo.Message{
+		ID:         types.NewMessageID(),
+		ChatID:     types.NewChatID(),
+		ProblemID:  types.NewProblemID(),
+		Body:       "Hello!",
+		AFCResends: resends,
+	}
+}
+
+func (s *ServiceSuite) heldManagerMessage(resends int) messagesrepo.Message {
+	msg := s.uncheckedMessage(resends)
+	msg.IsVisibleForManager = true
+	return msg
+}
+
+func (s *ServiceSuite) expectUnchecked(msgs ...messagesrepo.Message) {
+	s.T().Helper()
+
+	s.msgRepo.EXPECT().GetUncheckedMessages(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, before time.Time, _ int) ([]messagesrepo.Message, error) {
+			s.InDelta(time.Now().Add(-verdictTimeout).Unix(), before.Unix(), 1)
+			return msgs, nil
+		})
+	s.msgRepo.EXPECT().GetUncheckedMessages(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, nil).AnyTimes()
+}
+
+func (s *ServiceSuite) expectAudit(msg messagesrepo.Message, action auditrepo.Action) {
+	s.T().Helper()
+
+	s.auditRepo.EXPECT().Create(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(_ context.Context, rec auditrepo.Record) error {
+			s.Equal(action, rec.Action)
+			s.True(rec.ManagerID.IsZero())
+			s.Equal(msg.ChatID, rec.ChatID)
+			s.Equal(msg.ProblemID, rec.ProblemID)
+			s.Equal(msg.ID, rec.MessageID)
+			s.False(rec.RequestID.IsZero())
+			return nil
+		})
+}
+
+func (s *ServiceSuite) runFor(policy afcwatchdog.FallbackPolicy, timeout time.Duration) {
+	s.T().Helper()
+
+	svc, err := afcwatchdog.New(afcwatchdog.NewOptions(
+		verdictTimeout,
+		policy,
+		s.msgRepo,
+		s.msgProducer,
+		s.outboxSvc,
+		s.auditRepo,
+		s.txtor,
+		afcwatchdog.WithMaxResends(maxResends),
+		afcwatchdog.WithCheckPeriod(checkPeriod),
+	))
+	s.Require().NoError(err)
+
+	ctx, cancel := context.WithTimeout(s.Ctx, timeout)
+	defer cancel()
+
+	s.NoError(svc.Run(ctx))
+}
@@ -11,4 +11,7 @@ type EventStream interface {
 	io.Closer
 	Subscribe(ctx context.Context, userID types.UserID) (<-chan Event, error)
 	Publish(ctx context.Context, userID types.UserID, event Event) error
+
+	// SubscribeChat subscribes to the ChatEvent-s of the chat regardless of their recipient.
+	SubscribeChat(ctx context.Context, chatID types.ChatID) (<-chan Event, error)
 }
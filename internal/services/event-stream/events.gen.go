@@ -1,4 +1,4 @@
-// Code generated by gonstructor --output=events.gen.go --type=NewMessageEvent --type=MessageSentEvent --type=MessageBlockedEvent --type=NewChatEvent --type=NewManagerMessageEvent --type=ChatClosedEvent; DO NOT EDIT.
+// Code generated by gonstructor --output=events.gen.go --type=NewMessageEvent --type=MessageSentEvent --type=MessageBlockedEvent --type=NewChatEvent --type=NewManagerMessageEvent --type=ChatClosedEvent --type=NewInternalNoteEvent; DO NOT EDIT.
 
 package eventstream
 
@@ -103,3 +103,23 @@ func NewChatClosedEvent(
 		RequestID:           requestID,
 	}
 }
+
+func NewNewInternalNoteEvent(
+	eventID types.EventID,
+	requestID types.RequestID,
+	chatID types.ChatID,
+	messageID types.MessageID,
+	createdAt time.Time,
+	noteBody string,
+	authorID types.UserID,
+) *NewInternalNoteEvent {
+	return &NewInternalNoteEvent{
+		EventID:   eventID,
+		RequestID: requestID,
+		ChatID:    chatID,
+		MessageID: messageID,
+		CreatedAt: createdAt,
+		NoteBody:  noteBody,
+		AuthorID:  authorID,
+	}
+}
@@ -8,7 +8,7 @@ import (
 	"github.com/karasunokami/chat-service/internal/validator"
 )
 
-//go:generate gonstructor --output=events.gen.go --type=NewMessageEvent --type=MessageSentEvent --type=MessageBlockedEvent --type=NewChatEvent --type=NewManagerMessageEvent --type=ChatClosedEvent
+//go:generate gonstructor --output=events.gen.go --type=NewMessageEvent --type=MessageSentEvent --type=MessageBlockedEvent --type=NewChatEvent --type=NewManagerMessageEvent --type=ChatClosedEvent --type=NewInternalNoteEvent
 
 type Event interface {
 	eventMarker()
@@ -18,6 +18,12 @@ type Event interface {
 type event struct{}         //
 func (*event) eventMarker() {}
 
+// ChatEvent is a manager event of the particular chat. Such events could be watched by supervisors.
+type ChatEvent interface {
+	Event
+	EventChatID() types.ChatID
+}
+
 // MessageSentEvent indicates that the message was checked by AFC
 // and was sent to the manager. Two gray ticks.
 type MessageSentEvent struct {
@@ -138,6 +144,10 @@ func (e *NewChatEvent) String() string {
 	return fmt.Sprintf("%v", *e)
 }
 
+func (e *NewChatEvent) EventChatID() types.ChatID {
+	return e.ChatID
+}
+
 // NewManagerMessageEvent is a signal about the appearance of a new manager message in the chat.
 type NewManagerMessageEvent struct {
 	event       `gonstructor:"-"`
@@ -172,6 +182,10 @@ func (e *NewManagerMessageEvent) String() string {
 	return fmt.Sprintf("%v", *e)
 }
 
+func (e *NewManagerMessageEvent) EventChatID() types.ChatID {
+	return e.ChatID
+}
+
 // ChatClosedEvent is a signal about chat closing.
 type ChatClosedEvent struct {
 	event               `gonstructor:"-"`
@@ -199,3 +213,46 @@ func (e *ChatClosedEvent) Matches(x interface{}) bool {
 func (e *ChatClosedEvent) String() string {
 	return fmt.Sprintf("%v", *e)
 }
+
+func (e *ChatClosedEvent) EventChatID() types.ChatID {
+	return e.ChatID
+}
+
+// NewInternalNoteEvent is a signal about the appearance of a new staff note in the chat.
+// It is never sent to the client.
+type NewInternalNoteEvent struct {
+	event     `gonstructor:"-"`
+	EventID   types.EventID   `validate:"required"`
+	RequestID types.RequestID `validate:"required"`
+	ChatID    types.ChatID    `validate:"required"`
+	MessageID types.MessageID `validate:"required"`
+	CreatedAt time.Time       `validate:"required"`
+	NoteBody  string          `validate:"required"`
+	AuthorID  types.UserID    `validate:"required"`
+}
+
+func (e *NewInternalNoteEvent) Validate() error {
+	return validator.Validator.Struct(e)
+}
+
+func (e *NewInternalNoteEvent) Matches(x interface{}) bool {
+	ev, ok := x.(*NewInternalNoteEvent)
+	if !ok {
+		return false
+	}
+
+	return ev.RequestID == e.RequestID &&
+		ev.ChatID == e.ChatID &&
+		ev.MessageID == e.MessageID &&
+		ev.CreatedAt == e.CreatedAt &&
+		ev.NoteBody == e.NoteBody &&
+		ev.AuthorID == e.AuthorID
+}
+
+func (e *NewInternalNoteEvent) String() string {
+	return fmt.Sprintf("%v", *e)
+}
+
+func (e *NewInternalNoteEvent) EventChatID() types.ChatID {
+	return e.ChatID
+}
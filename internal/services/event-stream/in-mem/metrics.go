@@ -0,0 +1,32 @@
+package inmemeventstream
+
+import (
+	"github.com/karasunokami/chat-service/internal/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	subscribersGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "event_stream",
+		Name:      "subscribers",
+		Help:      "Number of active event stream subscriptions.",
+	})
+
+	publishDurationHistogram = promauto.NewHistogram(prometheus.HistogramOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "event_stream",
+		Name:      "publish_duration_seconds",
+		Help:      "Event publishing latency.",
+		Buckets:   prometheus.DefBuckets,
+	})
+
+	droppedEventsCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "event_stream",
+		Name:      "dropped_events_total",
+		Help:      "Number of events published for users without subscribers.",
+	})
+)
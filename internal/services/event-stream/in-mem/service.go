@@ -9,6 +9,7 @@ import (
 	"github.com/karasunokami/chat-service/internal/types"
 
 	"github.com/imkira/go-observer"
+	"github.com/prometheus/client_golang/prometheus"
 	"go.uber.org/zap"
 )
 
@@ -19,16 +20,21 @@ type Service struct {
 	mu        sync.RWMutex
 	subs      map[types.UserID]observer.Property
 	subsCount map[types.UserID]int
-	logger    *zap.Logger
+	// chatSubs are the watchers of the chat events, see eventstream.ChatEvent.
+	chatSubs      map[types.ChatID]observer.Property
+	chatSubsCount map[types.ChatID]int
+	logger        *zap.Logger
 }
 
 func New() *Service {
 	return &Service{
-		wg:        sync.WaitGroup{},
-		mu:        sync.RWMutex{},
-		subs:      map[types.UserID]observer.Property{},
-		subsCount: map[types.UserID]int{},
-		logger:    zap.L().Named(serviceName),
+		wg:            sync.WaitGroup{},
+		mu:            sync.RWMutex{},
+		subs:          map[types.UserID]observer.Property{},
+		subsCount:     map[types.UserID]int{},
+		chatSubs:      map[types.ChatID]observer.Property{},
+		chatSubsCount: map[types.ChatID]int{},
+		logger:        zap.L().Named(serviceName),
 	}
 }
 
@@ -43,15 +49,43 @@ func (s *Service) Subscribe(ctx context.Context, userID types.UserID) (<-chan ev
 	s.subsCount[userID]++
 	s.mu.Unlock()
 
+	return s.observe(ctx, p, func() {
+		s.mu.Lock()
+		s.subsCount[userID]--
+		s.mu.Unlock()
+	}), nil
+}
+
+func (s *Service) SubscribeChat(ctx context.Context, chatID types.ChatID) (<-chan eventstream.Event, error) {
+	s.mu.Lock()
+	p, ok := s.chatSubs[chatID]
+	if !ok {
+		p = observer.NewProperty(nil)
+		s.chatSubs[chatID] = p
+	}
+
+	s.chatSubsCount[chatID]++
+	s.mu.Unlock()
+
+	return s.observe(ctx, p, func() {
+		s.mu.Lock()
+		s.chatSubsCount[chatID]--
+		s.mu.Unlock()
+	}), nil
+}
+
+// observe streams the property changes until the context is done, then calls release.
+func (s *Service) observe(ctx context.Context, p observer.Property, release func()) <-chan eventstream.Event {
+	subscribersGauge.Inc()
+
 	stream := p.Observe()
 	events := make(chan eventstream.Event)
 
 	s.wg.Add(1)
 	go func() {
 		defer func() {
-			s.mu.Lock()
-			s.subsCount[userID]--
-			s.mu.Unlock()
+			release()
+			subscribersGauge.Dec()
 
 			close(events)
 			s.wg.Done()
@@ -72,7 +106,7 @@ func (s *Service) Subscribe(ctx context.Context, userID types.UserID) (<-chan ev
 		}
 	}()
 
-	return events, nil
+	return events
 }
 
 func (s *Service) Publish(_ context.Context, userID types.UserID, event eventstream.Event) error {
@@ -80,8 +114,17 @@ func (s *Service) Publish(_ context.Context, userID types.UserID, event eventstr
 		return fmt.Errorf("invalid event, err=%v", err)
 	}
 
+	timer := prometheus.NewTimer(publishDurationHistogram)
+	defer timer.ObserveDuration()
+
+	// Chat watchers get the event regardless of the recipient subscriptions.
+	if chatEvent, ok := event.(eventstream.ChatEvent); ok {
+		s.publishChatEvent(chatEvent)
+	}
+
 	if v := s.getSubsCount(userID); v == 0 {
 		s.logger.With(zap.Stringer("user_id", userID)).Debug("no subscribers")
+		droppedEventsCounter.Inc()
 
 		return nil
 	}
@@ -103,6 +146,20 @@ func (s *Service) Close() error {
 	return nil
 }
 
+func (s *Service) publishChatEvent(event eventstream.ChatEvent) {
+	chatID := event.EventChatID()
+
+	s.mu.RLock()
+	p, count := s.chatSubs[chatID], s.chatSubsCount[chatID]
+	s.mu.RUnlock()
+
+	if count == 0 {
+		return
+	}
+
+	p.Update(event)
+}
+
 func (s *Service) getSubsCount(uid types.UserID) int {
 	s.mu.RLock()
 	defer s.mu.RUnlock()
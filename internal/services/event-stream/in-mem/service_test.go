@@ -242,6 +242,40 @@ func (s *ServiceSuite) TestPublishInDifferentUserStreams() {
 	s.Equal(expectedMsgs, receivedMsgs)
 }
 
+func (s *ServiceSuite) TestChatSubscription() {
+	// Arrange.
+	chatID := types.NewChatID()
+	managerID := types.NewUserID() // Offline.
+
+	ctx, cancel := context.WithCancel(s.Ctx)
+	defer cancel()
+
+	events, err := s.stream.SubscribeChat(ctx, chatID)
+	s.Require().NoError(err)
+
+	// Action.
+	// Not a chat event.
+	s.Require().NoError(s.stream.Publish(ctx, managerID, newMessageEvent("Client event")))
+	// Event of another chat.
+	s.Require().NoError(s.stream.Publish(ctx, managerID, newManagerMessageEvent(types.NewChatID(), "Another chat")))
+	s.Require().NoError(s.stream.Publish(ctx, managerID, newManagerMessageEvent(chatID, "Hello")))
+
+	// Assert.
+	select {
+	case ev := <-events:
+		s.Require().IsType(&eventstream.NewManagerMessageEvent{}, ev)
+		s.Equal("Hello", ev.(*eventstream.NewManagerMessageEvent).MessageBody)
+	case <-time.After(time.Second):
+		s.FailNow("lost event")
+	}
+
+	select {
+	case ev := <-events:
+		s.FailNow("unexpected event", ev)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
 // readNewMessageEvents reads n events from the stream.
 // If n is negative, then the function reads the stream until it is closed.
 func readNewMessageEvents(stream <-chan eventstream.Event, n int) <-chan []string {
@@ -272,3 +306,15 @@ func newMessageEvent(body string) eventstream.Event {
 		false,
 	)
 }
+
+func newManagerMessageEvent(chatID types.ChatID, body string) eventstream.Event {
+	return eventstream.NewNewManagerMessageEvent(
+		types.NewEventID(),
+		types.NewRequestID(),
+		chatID,
+		types.NewMessageID(),
+		time.Now(),
+		body,
+		types.NewUserID(),
+	)
+}
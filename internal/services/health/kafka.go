@@ -0,0 +1,33 @@
+package health
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/segmentio/kafka-go"
+)
+
+var errNoBrokers = errors.New("no brokers")
+
+// NewKafkaChecker checks that at least one of the brokers is reachable.
+func NewKafkaChecker(brokers []string) Checker {
+	return CheckerFunc(func(ctx context.Context) error {
+		if len(brokers) == 0 {
+			return errNoBrokers
+		}
+
+		var lastErr error
+		for _, b := range brokers {
+			conn, err := kafka.DialContext(ctx, "tcp", b)
+			if err != nil {
+				lastErr = err
+				continue
+			}
+
+			return conn.Close()
+		}
+
+		return fmt.Errorf("all brokers are unreachable, last err=%v", lastErr)
+	})
+}
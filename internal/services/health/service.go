@@ -0,0 +1,173 @@
+package health
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+)
+
+const serviceName = "health"
+
+const (
+	StatusOK   = "ok"
+	StatusFail = "fail"
+)
+
+// Checker checks a single dependency. The nil error means the dependency is healthy.
+type Checker interface {
+	Check(ctx context.Context) error
+}
+
+type CheckerFunc func(ctx context.Context) error
+
+func (f CheckerFunc) Check(ctx context.Context) error {
+	return f(ctx)
+}
+
+type ComponentStatus struct {
+	Status string `json:"status"`
+	Error  string `json:"error,omitempty"`
+}
+
+type Report struct {
+	Status     string                     `json:"status"`
+	Components map[string]ComponentStatus `json:"components"`
+}
+
+func (r Report) OK() bool {
+	return r.Status == StatusOK
+}
+
+//go:generate options-gen -out-filename=service_options.gen.go -from-struct=Options
+type Options struct {
+	period       time.Duration `default:"5s" validate:"min=100ms,max=1m"`
+	checkTimeout time.Duration `default:"3s" validate:"min=10ms,max=30s"`
+}
+
+type namedChecker struct {
+	name    string
+	checker Checker
+}
+
+// Service runs liveness checks on demand and readiness checks periodically,
+// so the readiness state is cheap to ask for on every incoming connection.
+type Service struct {
+	Options
+
+	logger *zap.Logger
+
+	liveness  []namedChecker
+	readiness []namedChecker
+
+	mu        sync.RWMutex
+	lastReady Report
+}
+
+func New(opts Options) (*Service, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate options, err=%v", err)
+	}
+
+	return &Service{
+		Options:   opts,
+		logger:    zap.L().Named(serviceName),
+		lastReady: Report{Status: StatusFail, Components: map[string]ComponentStatus{}},
+	}, nil
+}
+
+// AddLivenessChecker must be called before the service start.
+func (s *Service) AddLivenessChecker(name string, c Checker) {
+	s.liveness = append(s.liveness, namedChecker{name: name, checker: c})
+}
+
+// AddReadinessChecker must be called before the service start.
+func (s *Service) AddReadinessChecker(name string, c Checker) {
+	s.readiness = append(s.readiness, namedChecker{name: name, checker: c})
+}
+
+func (s *Service) Run(ctx context.Context) error {
+	t := time.NewTicker(s.period)
+	defer t.Stop()
+
+	for {
+		s.refreshReadiness(ctx)
+
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+		}
+	}
+}
+
+// Live runs liveness checks right now.
+func (s *Service) Live(ctx context.Context) Report {
+	return s.check(ctx, s.liveness)
+}
+
+// Ready returns the result of the last readiness checks.
+func (s *Service) Ready() Report {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+
+	return s.lastReady
+}
+
+func (s *Service) IsReady() bool {
+	return s.Ready().OK()
+}
+
+func (s *Service) refreshReadiness(ctx context.Context) {
+	r := s.check(ctx, s.readiness)
+	if ctx.Err() != nil {
+		return
+	}
+
+	s.mu.Lock()
+	wasReady := s.lastReady.OK()
+	s.lastReady = r
+	s.mu.Unlock()
+
+	if wasReady && !r.OK() {
+		s.logger.Warn("Service is not ready", zap.Any("components", r.Components))
+	}
+	if !wasReady && r.OK() {
+		s.logger.Info("Service is ready")
+	}
+}
+
+func (s *Service) check(ctx context.Context, checkers []namedChecker) Report {
+	ctx, cancel := context.WithTimeout(ctx, s.checkTimeout)
+	defer cancel()
+
+	statuses := make([]ComponentStatus, len(checkers))
+
+	var wg sync.WaitGroup
+	for i, c := range checkers {
+		i, c := i, c
+
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+
+			statuses[i] = ComponentStatus{Status: StatusOK}
+			if err := c.checker.Check(ctx); err != nil {
+				statuses[i] = ComponentStatus{Status: StatusFail, Error: err.Error()}
+			}
+		}()
+	}
+	wg.Wait()
+
+	r := Report{Status: StatusOK, Components: make(map[string]ComponentStatus, len(checkers))}
+	for i, c := range checkers {
+		r.Components[c.name] = statuses[i]
+		if statuses[i].Status != StatusOK {
+			r.Status = StatusFail
+		}
+	}
+
+	return r
+}
@@ -0,0 +1,60 @@
+// Code generated by options-gen. DO NOT EDIT.
+package health
+
+import (
+	fmt461e464ebed9 "fmt"
+	"time"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	options ...OptOptionsSetter,
+) Options {
+	o := Options{}
+
+	// Setting defaults from field tag (if present)
+	o.period, _ = time.ParseDuration("5s")
+	o.checkTimeout, _ = time.ParseDuration("3s")
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func WithPeriod(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) {
+		o.period = opt
+	}
+}
+
+func WithCheckTimeout(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) {
+		o.checkTimeout = opt
+	}
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("period", _validate_Options_period(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("checkTimeout", _validate_Options_checkTimeout(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_period(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.period, "min=100ms,max=1m"); err != nil {
+		return fmt461e464ebed9.Errorf("field `period` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_checkTimeout(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.checkTimeout, "min=10ms,max=30s"); err != nil {
+		return fmt461e464ebed9.Errorf("field `checkTimeout` did not pass the test: %w", err)
+	}
+	return nil
+}
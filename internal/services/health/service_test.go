@@ -0,0 +1,98 @@
+package health_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/karasunokami/chat-service/internal/services/health"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+var errDown = errors.New("down")
+
+func okChecker() health.Checker {
+	return health.CheckerFunc(func(ctx context.Context) error { return nil })
+}
+
+func failChecker() health.Checker {
+	return health.CheckerFunc(func(ctx context.Context) error { return errDown })
+}
+
+func TestService_Live(t *testing.T) {
+	t.Run("all ok", func(t *testing.T) {
+		s, err := health.New(health.NewOptions())
+		require.NoError(t, err)
+
+		s.AddLivenessChecker("a", okChecker())
+		s.AddLivenessChecker("b", okChecker())
+
+		r := s.Live(context.Background())
+		assert.True(t, r.OK())
+		assert.Equal(t, map[string]health.ComponentStatus{
+			"a": {Status: health.StatusOK},
+			"b": {Status: health.StatusOK},
+		}, r.Components)
+	})
+
+	t.Run("one failed", func(t *testing.T) {
+		s, err := health.New(health.NewOptions())
+		require.NoError(t, err)
+
+		s.AddLivenessChecker("a", okChecker())
+		s.AddLivenessChecker("b", failChecker())
+
+		r := s.Live(context.Background())
+		assert.False(t, r.OK())
+		assert.Equal(t, health.StatusFail, r.Status)
+		assert.Equal(t, health.ComponentStatus{Status: health.StatusFail, Error: errDown.Error()}, r.Components["b"])
+		assert.Equal(t, health.ComponentStatus{Status: health.StatusOK}, r.Components["a"])
+	})
+
+	t.Run("check timeout", func(t *testing.T) {
+		s, err := health.New(health.NewOptions(health.WithCheckTimeout(50 * time.Millisecond)))
+		require.NoError(t, err)
+
+		s.AddLivenessChecker("slow", health.CheckerFunc(func(ctx context.Context) error {
+			<-ctx.Done()
+			return ctx.Err()
+		}))
+
+		r := s.Live(context.Background())
+		assert.False(t, r.OK())
+		assert.Equal(t, context.DeadlineExceeded.Error(), r.Components["slow"].Error)
+	})
+}
+
+func TestService_Ready(t *testing.T) {
+	s, err := health.New(health.NewOptions(health.WithPeriod(100 * time.Millisecond)))
+	require.NoError(t, err)
+
+	ready := make(chan struct{}, 1)
+	s.AddReadinessChecker("dep", health.CheckerFunc(func(ctx context.Context) error {
+		select {
+		case ready <- struct{}{}:
+		default:
+		}
+		return nil
+	}))
+
+	// Not ready until the first check.
+	assert.False(t, s.IsReady())
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- s.Run(ctx) }()
+
+	<-ready
+	assert.Eventually(t, s.IsReady, time.Second, 10*time.Millisecond)
+	assert.Equal(t, health.ComponentStatus{Status: health.StatusOK}, s.Ready().Components["dep"])
+
+	cancel()
+	require.NoError(t, <-errCh)
+}
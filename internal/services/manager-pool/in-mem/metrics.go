@@ -0,0 +1,15 @@
+package inmemmanagerpool
+
+import (
+	"github.com/karasunokami/chat-service/internal/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var poolSizeGauge = promauto.NewGauge(prometheus.GaugeOpts{
+	Namespace: metrics.Namespace,
+	Subsystem: "manager_pool",
+	Name:      "size",
+	Help:      "Number of managers waiting for problems.",
+})
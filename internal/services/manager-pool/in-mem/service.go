@@ -43,6 +43,7 @@ func (s *Service) Get(_ context.Context) (types.UserID, error) {
 
 	m := s.managers[0]
 	s.managers = s.managers[1:]
+	poolSizeGauge.Set(float64(len(s.managers)))
 
 	return m, nil
 }
@@ -58,6 +59,24 @@ func (s *Service) Put(_ context.Context, managerID types.UserID) error {
 	}
 
 	s.managers = append(s.managers, managerID)
+	poolSizeGauge.Set(float64(len(s.managers)))
+
+	return nil
+}
+
+// Remove takes the manager out of the queue. It is a no-op if the manager is not in the pool.
+func (s *Service) Remove(_ context.Context, managerID types.UserID) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for i, manager := range s.managers {
+		if manager.Matches(managerID) {
+			s.managers = append(s.managers[:i], s.managers[i+1:]...)
+			poolSizeGauge.Set(float64(len(s.managers)))
+
+			return nil
+		}
+	}
 
 	return nil
 }
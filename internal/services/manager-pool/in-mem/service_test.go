@@ -102,6 +102,31 @@ func (s *ServiceSuite) TestPut_Idempotency() {
 	s.False(contains)
 }
 
+func (s *ServiceSuite) TestRemove() {
+	managers := []types.UserID{types.NewUserID(), types.NewUserID(), types.NewUserID()}
+	for _, m := range managers {
+		s.Require().NoError(s.pool.Put(s.Ctx, m))
+	}
+
+	// Removing of the absent manager is no-op.
+	s.Require().NoError(s.pool.Remove(s.Ctx, types.NewUserID()))
+	s.Equal(3, s.pool.Size())
+
+	s.Require().NoError(s.pool.Remove(s.Ctx, managers[1]))
+	s.Equal(2, s.pool.Size())
+
+	contains, err := s.pool.Contains(s.Ctx, managers[1])
+	s.Require().NoError(err)
+	s.False(contains)
+
+	// FIFO order of the rest is kept.
+	for _, m := range []types.UserID{managers[0], managers[2]} {
+		mm, err := s.pool.Get(s.Ctx)
+		s.Require().NoError(err)
+		s.Equal(m.String(), mm.String())
+	}
+}
+
 func (s *ServiceSuite) TestConcurrency() {
 	const (
 		managersNum = 100
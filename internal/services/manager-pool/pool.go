@@ -15,6 +15,7 @@ type Pool interface {
 	io.Closer
 	Get(ctx context.Context) (types.UserID, error)
 	Put(ctx context.Context, managerID types.UserID) error
+	Remove(ctx context.Context, managerID types.UserID) error
 	Contains(ctx context.Context, managerID types.UserID) (bool, error)
 	Size() int
 }
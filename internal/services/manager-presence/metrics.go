@@ -0,0 +1,24 @@
+package managerpresence
+
+import (
+	"github.com/karasunokami/chat-service/internal/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	managersGauge = promauto.NewGaugeVec(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "manager_presence",
+		Name:      "managers",
+		Help:      "Number of known managers by status.",
+	}, []string{"status"})
+
+	flaggedProblemsCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "manager_presence",
+		Name:      "flagged_problems_total",
+		Help:      "Number of problems flagged for reassignment because of offline managers.",
+	})
+)
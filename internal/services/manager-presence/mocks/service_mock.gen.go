@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package managerpresencemocks is a generated GoMock package.
+package managerpresencemocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	types "github.com/karasunokami/chat-service/internal/types"
+)
+
+// MockmanagersPool is a mock of managersPool interface.
+type MockmanagersPool struct {
+	ctrl     *gomock.Controller
+	recorder *MockmanagersPoolMockRecorder
+}
+
+// MockmanagersPoolMockRecorder is the mock recorder for MockmanagersPool.
+type MockmanagersPoolMockRecorder struct {
+	mock *MockmanagersPool
+}
+
+// NewMockmanagersPool creates a new mock instance.
+func NewMockmanagersPool(ctrl *gomock.Controller) *MockmanagersPool {
+	mock := &MockmanagersPool{ctrl: ctrl}
+	mock.recorder = &MockmanagersPoolMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmanagersPool) EXPECT() *MockmanagersPoolMockRecorder {
+	return m.recorder
+}
+
+// Remove mocks base method.
+func (m *MockmanagersPool) Remove(ctx context.Context, managerID types.UserID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Remove", ctx, managerID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Remove indicates an expected call of Remove.
+func (mr *MockmanagersPoolMockRecorder) Remove(ctx, managerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Remove", reflect.TypeOf((*MockmanagersPool)(nil).Remove), ctx, managerID)
+}
+
+// MockproblemsRepository is a mock of problemsRepository interface.
+type MockproblemsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockproblemsRepositoryMockRecorder
+}
+
+// MockproblemsRepositoryMockRecorder is the mock recorder for MockproblemsRepository.
+type MockproblemsRepositoryMockRecorder struct {
+	mock *MockproblemsRepository
+}
+
+// NewMockproblemsRepository creates a new mock instance.
+func NewMockproblemsRepository(ctrl *gomock.Controller) *MockproblemsRepository {
+	mock := &MockproblemsRepository{ctrl: ctrl}
+	mock.recorder = &MockproblemsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockproblemsRepository) EXPECT() *MockproblemsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// CancelManagerProblemsReassignment mocks base method.
+func (m *MockproblemsRepository) CancelManagerProblemsReassignment(ctx context.Context, managerID types.UserID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CancelManagerProblemsReassignment", ctx, managerID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CancelManagerProblemsReassignment indicates an expected call of CancelManagerProblemsReassignment.
+func (mr *MockproblemsRepositoryMockRecorder) CancelManagerProblemsReassignment(ctx, managerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CancelManagerProblemsReassignment", reflect.TypeOf((*MockproblemsRepository)(nil).CancelManagerProblemsReassignment), ctx, managerID)
+}
+
+// RequestManagerProblemsReassignment mocks base method.
+func (m *MockproblemsRepository) RequestManagerProblemsReassignment(ctx context.Context, managerID types.UserID) (int, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RequestManagerProblemsReassignment", ctx, managerID)
+	ret0, _ := ret[0].(int)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// RequestManagerProblemsReassignment indicates an expected call of RequestManagerProblemsReassignment.
+func (mr *MockproblemsRepositoryMockRecorder) RequestManagerProblemsReassignment(ctx, managerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RequestManagerProblemsReassignment", reflect.TypeOf((*MockproblemsRepository)(nil).RequestManagerProblemsReassignment), ctx, managerID)
+}
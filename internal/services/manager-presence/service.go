@@ -0,0 +1,279 @@
+package managerpresence
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"github.com/karasunokami/chat-service/internal/types"
+
+	"go.uber.org/zap"
+)
+
+const serviceName = "manager-presence"
+
+type Status string
+
+const (
+	StatusOnline  Status = "online"
+	StatusAway    Status = "away"
+	StatusOffline Status = "offline"
+)
+
+// ManagerStatus is the status of the manager and the time it was taken.
+type ManagerStatus struct {
+	ManagerID types.UserID
+	Status    Status
+	Since     time.Time
+}
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/service_mock.gen.go -package=managerpresencemocks
+
+type managersPool interface {
+	Remove(ctx context.Context, managerID types.UserID) error
+}
+
+type problemsRepository interface {
+	RequestManagerProblemsReassignment(ctx context.Context, managerID types.UserID) (int, error)
+	CancelManagerProblemsReassignment(ctx context.Context, managerID types.UserID) (int, error)
+}
+
+//go:generate options-gen -out-filename=service_options.gen.go -from-struct=Options
+type Options struct {
+	// offlineTimeout is the time after which the problems of the offline manager are flagged for reassignment.
+	offlineTimeout time.Duration `option:"mandatory" validate:"min=10ms,max=24h"`
+	checkPeriod    time.Duration `default:"10s" validate:"min=10ms,max=1m"`
+
+	managersPool managersPool       `option:"mandatory" validate:"required"`
+	problemsRepo problemsRepository `option:"mandatory" validate:"required"`
+}
+
+type managerState struct {
+	connections int
+	away        bool
+
+	status Status
+	since  time.Time
+
+	// reassignRequested is unknown after the restart, so it is assumed to be set for the new states.
+	reassignRequested bool
+}
+
+func (st *managerState) update(now time.Time) (changed bool) {
+	status := StatusOnline
+	switch {
+	case st.connections == 0:
+		status = StatusOffline
+	case st.away:
+		status = StatusAway
+	}
+
+	if status == st.status {
+		return false
+	}
+
+	st.status, st.since = status, now
+	return true
+}
+
+// Service tracks the manager statuses. The manager is online while there is at least one websocket
+// connection of the manager and the manager has not switched to away.
+// Away and offline managers are kept out of the managers pool.
+//
+// The statuses are kept in memory like the managers pool itself, so they are lost on restart.
+// After it the managers are offline until they reconnect and the away flag is reset, and the managers
+// that never reconnect are unknown, so their problems are not flagged for reassignment.
+type Service struct {
+	Options
+	logger *zap.Logger
+
+	mu       sync.Mutex
+	managers map[types.UserID]*managerState
+}
+
+func New(opts Options) (*Service, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate options, err=%v", err)
+	}
+
+	return &Service{
+		Options:  opts,
+		logger:   zap.L().Named(serviceName),
+		managers: make(map[types.UserID]*managerState),
+	}, nil
+}
+
+// Connected registers a new websocket connection of the manager.
+func (s *Service) Connected(_ context.Context, managerID types.UserID) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st := s.state(managerID)
+	st.connections++
+	st.update(time.Now())
+}
+
+// Disconnected unregisters the websocket connection of the manager.
+func (s *Service) Disconnected(ctx context.Context, managerID types.UserID) {
+	s.mu.Lock()
+	st := s.state(managerID)
+	if st.connections > 0 {
+		st.connections--
+	}
+	changed := st.update(time.Now())
+	status := st.status
+	s.mu.Unlock()
+
+	if changed && status == StatusOffline {
+		s.removeFromPool(ctx, managerID)
+	}
+}
+
+// SetAway switches the manager to away or back. The new status of the manager is returned,
+// it stays offline until the manager connects.
+func (s *Service) SetAway(ctx context.Context, managerID types.UserID, away bool) (Status, error) {
+	s.mu.Lock()
+	st := s.state(managerID)
+	st.away = away
+	st.update(time.Now())
+	status := st.status
+	s.mu.Unlock()
+
+	if status != StatusOnline {
+		if err := s.managersPool.Remove(ctx, managerID); err != nil {
+			return "", fmt.Errorf("remove manager from pool, err=%v", err)
+		}
+	}
+
+	return status, nil
+}
+
+// Status returns the current status of the manager.
+func (s *Service) Status(managerID types.UserID) Status {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	st, ok := s.managers[managerID]
+	if !ok {
+		return StatusOffline
+	}
+	return st.status
+}
+
+// Statuses returns the statuses of all known managers ordered by manager ID.
+func (s *Service) Statuses() []ManagerStatus {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	result := make([]ManagerStatus, 0, len(s.managers))
+	for id, st := range s.managers {
+		result = append(result, ManagerStatus{ManagerID: id, Status: st.status, Since: st.since})
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].ManagerID.String() < result[j].ManagerID.String()
+	})
+
+	return result
+}
+
+// Run periodically flags the problems of the managers offline for too long for reassignment
+// and removes the flags when the managers are back.
+func (s *Service) Run(ctx context.Context) error {
+	t := time.NewTicker(s.checkPeriod)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+			s.check(ctx)
+		}
+	}
+}
+
+func (s *Service) check(ctx context.Context) {
+	var notOnline, toRequest, toCancel []types.UserID
+	counts := make(map[Status]int, 3)
+
+	s.mu.Lock()
+	now := time.Now()
+	for id, st := range s.managers {
+		counts[st.status]++
+
+		if st.status != StatusOnline {
+			notOnline = append(notOnline, id)
+		}
+
+		switch {
+		case st.status == StatusOffline && !st.reassignRequested && now.Sub(st.since) >= s.offlineTimeout:
+			toRequest = append(toRequest, id)
+		case st.status != StatusOffline && st.reassignRequested:
+			toCancel = append(toCancel, id)
+		}
+	}
+	s.mu.Unlock()
+
+	for _, status := range []Status{StatusOnline, StatusAway, StatusOffline} {
+		managersGauge.WithLabelValues(string(status)).Set(float64(counts[status]))
+	}
+
+	// The managers could put themselves into the pool by the "free hands" while being away.
+	for _, id := range notOnline {
+		s.removeFromPool(ctx, id)
+	}
+
+	for _, id := range toRequest {
+		n, err := s.problemsRepo.RequestManagerProblemsReassignment(ctx, id)
+		if err != nil {
+			s.logger.Error("Request reassignment of manager problems", zap.Stringer("manager_id", id), zap.Error(err))
+			continue
+		}
+		if n > 0 {
+			s.logger.Info("Manager is offline for too long, problems flagged for reassignment",
+				zap.Stringer("manager_id", id), zap.Int("problems", n))
+			flaggedProblemsCounter.Add(float64(n))
+		}
+		s.setReassignRequested(id, true)
+	}
+
+	for _, id := range toCancel {
+		if _, err := s.problemsRepo.CancelManagerProblemsReassignment(ctx, id); err != nil {
+			s.logger.Error("Cancel reassignment of manager problems", zap.Stringer("manager_id", id), zap.Error(err))
+			continue
+		}
+		s.setReassignRequested(id, false)
+	}
+}
+
+func (s *Service) setReassignRequested(managerID types.UserID, requested bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if st, ok := s.managers[managerID]; ok {
+		st.reassignRequested = requested
+	}
+}
+
+func (s *Service) removeFromPool(ctx context.Context, managerID types.UserID) {
+	if err := s.managersPool.Remove(ctx, managerID); err != nil {
+		s.logger.Warn("Remove manager from pool", zap.Stringer("manager_id", managerID), zap.Error(err))
+	}
+}
+
+// state must be called under the lock.
+func (s *Service) state(managerID types.UserID) *managerState {
+	st, ok := s.managers[managerID]
+	if !ok {
+		st = &managerState{
+			status:            StatusOffline,
+			since:             time.Now(),
+			reassignRequested: true,
+		}
+		s.managers[managerID] = st
+	}
+	return st
+}
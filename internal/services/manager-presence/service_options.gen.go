@@ -0,0 +1,76 @@
+// Code generated by options-gen. DO NOT EDIT.
+package managerpresence
+
+import (
+	fmt461e464ebed9 "fmt"
+	"time"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	offlineTimeout time.Duration,
+	managersPool managersPool,
+	problemsRepo problemsRepository,
+	options ...OptOptionsSetter,
+) Options {
+	o := Options{}
+
+	// Setting defaults from field tag (if present)
+	o.checkPeriod, _ = time.ParseDuration("10s")
+
+	o.offlineTimeout = offlineTimeout
+	o.managersPool = managersPool
+	o.problemsRepo = problemsRepo
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func WithCheckPeriod(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) {
+		o.checkPeriod = opt
+	}
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("offlineTimeout", _validate_Options_offlineTimeout(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("checkPeriod", _validate_Options_checkPeriod(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("managersPool", _validate_Options_managersPool(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("problemsRepo", _validate_Options_problemsRepo(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_offlineTimeout(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.offlineTimeout, "min=10ms,max=24h"); err != nil {
+		return fmt461e464ebed9.Errorf("field `offlineTimeout` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_checkPeriod(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.checkPeriod, "min=10ms,max=1m"); err != nil {
+		return fmt461e464ebed9.Errorf("field `checkPeriod` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_managersPool(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.managersPool, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `managersPool` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_problemsRepo(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.problemsRepo, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `problemsRepo` did not pass the test: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,180 @@
+package managerpresence_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	managerpresence "github.com/karasunokami/chat-service/internal/services/manager-presence"
+	managerpresencemocks "github.com/karasunokami/chat-service/internal/services/manager-presence/mocks"
+	"github.com/karasunokami/chat-service/internal/testingh"
+	"github.com/karasunokami/chat-service/internal/types"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	offlineTimeout = 100 * time.Millisecond
+	checkPeriod    = 10 * time.Millisecond
+)
+
+type ServiceSuite struct {
+	testingh.ContextSuite
+
+	ctrl         *gomock.Controller
+	pool         *managerpresencemocks.MockmanagersPool
+	problemsRepo *managerpresencemocks.MockproblemsRepository
+	presence     *managerpresence.Service
+}
+
+func TestServiceSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ServiceSuite))
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.ContextSuite.SetupTest()
+
+	s.ctrl = gomock.NewController(s.T())
+	s.pool = managerpresencemocks.NewMockmanagersPool(s.ctrl)
+	s.problemsRepo = managerpresencemocks.NewMockproblemsRepository(s.ctrl)
+
+	var err error
+	s.presence, err = managerpresence.New(managerpresence.NewOptions(
+		offlineTimeout,
+		s.pool,
+		s.problemsRepo,
+		managerpresence.WithCheckPeriod(checkPeriod),
+	))
+	s.Require().NoError(err)
+}
+
+func (s *ServiceSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *ServiceSuite) TestStatusByConnections() {
+	managerID := types.NewUserID()
+	s.Equal(managerpresence.StatusOffline, s.presence.Status(managerID))
+
+	s.presence.Connected(s.Ctx, managerID)
+	s.presence.Connected(s.Ctx, managerID)
+	s.Equal(managerpresence.StatusOnline, s.presence.Status(managerID))
+
+	// The manager is online while at least one connection is open.
+	s.presence.Disconnected(s.Ctx, managerID)
+	s.Equal(managerpresence.StatusOnline, s.presence.Status(managerID))
+
+	s.pool.EXPECT().Remove(s.Ctx, managerID).Return(nil)
+	s.presence.Disconnected(s.Ctx, managerID)
+	s.Equal(managerpresence.StatusOffline, s.presence.Status(managerID))
+}
+
+func (s *ServiceSuite) TestSetAway() {
+	managerID := types.NewUserID()
+	s.presence.Connected(s.Ctx, managerID)
+
+	s.pool.EXPECT().Remove(s.Ctx, managerID).Return(nil)
+	status, err := s.presence.SetAway(s.Ctx, managerID, true)
+	s.Require().NoError(err)
+	s.Equal(managerpresence.StatusAway, status)
+
+	status, err = s.presence.SetAway(s.Ctx, managerID, false)
+	s.Require().NoError(err)
+	s.Equal(managerpresence.StatusOnline, status)
+}
+
+func (s *ServiceSuite) TestSetAway_KeptAfterReconnect() {
+	managerID := types.NewUserID()
+
+	s.pool.EXPECT().Remove(s.Ctx, managerID).Return(nil).Times(2)
+	status, err := s.presence.SetAway(s.Ctx, managerID, true)
+	s.Require().NoError(err)
+	s.Equal(managerpresence.StatusOffline, status)
+
+	s.presence.Connected(s.Ctx, managerID)
+	s.Equal(managerpresence.StatusAway, s.presence.Status(managerID))
+
+	s.presence.Disconnected(s.Ctx, managerID)
+	s.Equal(managerpresence.StatusOffline, s.presence.Status(managerID))
+}
+
+func (s *ServiceSuite) TestStatuses() {
+	online, away := types.NewUserID(), types.NewUserID()
+
+	s.pool.EXPECT().Remove(s.Ctx, away).Return(nil)
+	s.presence.Connected(s.Ctx, online)
+	s.presence.Connected(s.Ctx, away)
+	_, err := s.presence.SetAway(s.Ctx, away, true)
+	s.Require().NoError(err)
+
+	statuses := s.presence.Statuses()
+	s.Require().Len(statuses, 2)
+
+	byID := make(map[types.UserID]managerpresence.Status)
+	for _, st := range statuses {
+		byID[st.ManagerID] = st.Status
+		s.False(st.Since.IsZero())
+	}
+	s.Equal(managerpresence.StatusOnline, byID[online])
+	s.Equal(managerpresence.StatusAway, byID[away])
+}
+
+func (s *ServiceSuite) TestReassignment() {
+	managerID := types.NewUserID()
+
+	reassignRequested := make(chan struct{})
+	reassignCanceled := make(chan struct{}, 1)
+
+	s.pool.EXPECT().Remove(gomock.Any(), managerID).Return(nil).AnyTimes()
+	gomock.InOrder(
+		// The state after the restart is unknown.
+		s.problemsRepo.EXPECT().CancelManagerProblemsReassignment(gomock.Any(), managerID).Return(0, nil),
+		s.problemsRepo.EXPECT().RequestManagerProblemsReassignment(gomock.Any(), managerID).
+			DoAndReturn(func(_ context.Context, _ types.UserID) (int, error) {
+				close(reassignRequested)
+				return 2, nil
+			}),
+		s.problemsRepo.EXPECT().CancelManagerProblemsReassignment(gomock.Any(), managerID).
+			DoAndReturn(func(_ context.Context, _ types.UserID) (int, error) {
+				reassignCanceled <- struct{}{}
+				return 2, nil
+			}),
+	)
+
+	ctx, cancel := context.WithCancel(s.Ctx)
+	defer cancel()
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- s.presence.Run(ctx) }()
+
+	s.presence.Connected(s.Ctx, managerID)
+	time.Sleep(3 * checkPeriod)
+
+	disconnectedAt := time.Now()
+	s.presence.Disconnected(s.Ctx, managerID)
+
+	select {
+	case <-reassignRequested:
+		s.GreaterOrEqual(time.Since(disconnectedAt), offlineTimeout)
+	case <-s.Ctx.Done():
+		s.Fail("reassignment was not requested")
+	}
+
+	s.presence.Connected(s.Ctx, managerID)
+
+	select {
+	case <-reassignCanceled:
+	case <-s.Ctx.Done():
+		s.Fail("reassignment was not canceled")
+	}
+
+	// Check that the reassignment is not canceled twice.
+	time.Sleep(3 * checkPeriod)
+
+	cancel()
+	s.NoError(<-errCh)
+}
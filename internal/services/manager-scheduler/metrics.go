@@ -0,0 +1,15 @@
+package managerscheduler
+
+import (
+	"github.com/karasunokami/chat-service/internal/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var assignmentsCounter = promauto.NewCounter(prometheus.CounterOpts{
+	Namespace: metrics.Namespace,
+	Subsystem: "manager_scheduler",
+	Name:      "assignments_total",
+	Help:      "Number of managers assigned to problems.",
+})
@@ -98,6 +98,8 @@ func (s *Service) Run(ctx context.Context) error {
 
 					continue
 				}
+
+				assignmentsCounter.Inc()
 			}
 		}
 	}
@@ -0,0 +1,81 @@
+package messagereview
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/karasunokami/chat-service/internal/services/outbox"
+	clientmessageblockedjob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/client-message-blocked"
+	clientmessagesentjob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/client-message-sent"
+	"github.com/karasunokami/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/resolver_mock.gen.go -package=messagereviewmocks
+
+type messagesRepository interface {
+	ApproveReviewedMessage(ctx context.Context, msgID types.MessageID) error
+	RejectReviewedMessage(ctx context.Context, msgID types.MessageID) error
+}
+
+type outboxService interface {
+	Put(ctx context.Context, name, payload string, availableAt time.Time) (types.JobID, error)
+}
+
+type transactor interface {
+	RunInTx(ctx context.Context, f func(context.Context) error) error
+}
+
+//go:generate options-gen -out-filename=resolver_options.gen.go -from-struct=Options
+type Options struct {
+	msgRepo       messagesRepository `option:"mandatory" validate:"required"`
+	outboxService outboxService      `option:"mandatory" validate:"required"`
+	transactor    transactor         `option:"mandatory" validate:"required"`
+}
+
+// Resolver applies the decision to the message from the manual review queue, both the supervisor
+// and the default one. The approved message is delivered as if AFC found it ok, the rejected one is blocked.
+// The messagesrepo.ErrMsgNotUnderReview error means the message has already been resolved.
+type Resolver struct {
+	Options
+}
+
+func New(opts Options) (*Resolver, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate options, err=%v", err)
+	}
+
+	return &Resolver{Options: opts}, nil
+}
+
+func (r *Resolver) Approve(ctx context.Context, msgID types.MessageID) error {
+	return r.resolve(ctx, msgID, r.msgRepo.ApproveReviewedMessage, clientmessagesentjob.Name)
+}
+
+func (r *Resolver) Reject(ctx context.Context, msgID types.MessageID) error {
+	return r.resolve(ctx, msgID, r.msgRepo.RejectReviewedMessage, clientmessageblockedjob.Name)
+}
+
+func (r *Resolver) resolve(
+	ctx context.Context,
+	msgID types.MessageID,
+	resolve func(ctx context.Context, msgID types.MessageID) error,
+	jobName string,
+) error {
+	return r.transactor.RunInTx(ctx, func(ctx context.Context) error {
+		if err := resolve(ctx, msgID); err != nil {
+			return fmt.Errorf("messages repo, resolve review, err=%w", err)
+		}
+
+		payload, err := outbox.MarshalMessageIDPayload(msgID)
+		if err != nil {
+			return fmt.Errorf("marshal message id payload, err=%v", err)
+		}
+
+		if _, err := r.outboxService.Put(ctx, jobName, payload, time.Now()); err != nil {
+			return fmt.Errorf("put %s job to outbox service, err=%w", jobName, err)
+		}
+
+		return nil
+	})
+}
@@ -0,0 +1,141 @@
+package messagereview_test
+
+import (
+	"context"
+	"io"
+	"testing"
+
+	messagesrepo "github.com/karasunokami/chat-service/internal/repositories/messages"
+	messagereview "github.com/karasunokami/chat-service/internal/services/message-review"
+	messagereviewmocks "github.com/karasunokami/chat-service/internal/services/message-review/mocks"
+	"github.com/karasunokami/chat-service/internal/services/outbox"
+	clientmessageblockedjob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/client-message-blocked"
+	clientmessagesentjob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/client-message-sent"
+	"github.com/karasunokami/chat-service/internal/testingh"
+	"github.com/karasunokami/chat-service/internal/types"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type ResolverSuite struct {
+	testingh.ContextSuite
+
+	ctrl      *gomock.Controller
+	msgRepo   *messagereviewmocks.MockmessagesRepository
+	outBoxSvc *messagereviewmocks.MockoutboxService
+	txtor     *messagereviewmocks.Mocktransactor
+	resolver  *messagereview.Resolver
+}
+
+func TestResolverSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ResolverSuite))
+}
+
+func (s *ResolverSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.msgRepo = messagereviewmocks.NewMockmessagesRepository(s.ctrl)
+	s.outBoxSvc = messagereviewmocks.NewMockoutboxService(s.ctrl)
+	s.txtor = messagereviewmocks.NewMocktransactor(s.ctrl)
+
+	var err error
+	s.resolver, err = messagereview.New(messagereview.NewOptions(s.msgRepo, s.outBoxSvc, s.txtor))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *ResolverSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *ResolverSuite) TestMessageNotUnderReview() {
+	// Arrange.
+	msgID := types.NewMessageID()
+
+	s.expectTx()
+	s.msgRepo.EXPECT().ApproveReviewedMessage(s.Ctx, msgID).Return(messagesrepo.ErrMsgNotUnderReview)
+
+	// Action.
+	err := s.resolver.Approve(s.Ctx, msgID)
+
+	// Assert.
+	s.Require().ErrorIs(err, messagesrepo.ErrMsgNotUnderReview)
+}
+
+func (s *ResolverSuite) TestResolveError() {
+	// Arrange.
+	msgID := types.NewMessageID()
+
+	s.expectTx()
+	s.msgRepo.EXPECT().RejectReviewedMessage(s.Ctx, msgID).Return(io.EOF)
+
+	// Action.
+	err := s.resolver.Reject(s.Ctx, msgID)
+
+	// Assert.
+	s.Require().ErrorIs(err, io.EOF)
+}
+
+func (s *ResolverSuite) TestPutJobError() {
+	// Arrange.
+	msgID := types.NewMessageID()
+
+	s.expectTx()
+	s.msgRepo.EXPECT().ApproveReviewedMessage(s.Ctx, msgID).Return(nil)
+	s.outBoxSvc.EXPECT().Put(s.Ctx, clientmessagesentjob.Name, gomock.Any(), gomock.Any()).Return(types.JobIDNil, io.EOF)
+
+	// Action.
+	err := s.resolver.Approve(s.Ctx, msgID)
+
+	// Assert.
+	s.Require().ErrorIs(err, io.EOF)
+}
+
+func (s *ResolverSuite) TestApproved() {
+	// Arrange.
+	msgID := types.NewMessageID()
+
+	s.expectTx()
+	s.msgRepo.EXPECT().ApproveReviewedMessage(s.Ctx, msgID).Return(nil)
+	s.outBoxSvc.EXPECT().Put(s.Ctx, clientmessagesentjob.Name, s.payload(msgID), gomock.Any()).
+		Return(types.NewJobID(), nil)
+
+	// Action.
+	err := s.resolver.Approve(s.Ctx, msgID)
+
+	// Assert.
+	s.Require().NoError(err)
+}
+
+func (s *ResolverSuite) TestRejected() {
+	// Arrange.
+	msgID := types.NewMessageID()
+
+	s.expectTx()
+	s.msgRepo.EXPECT().RejectReviewedMessage(s.Ctx, msgID).Return(nil)
+	s.outBoxSvc.EXPECT().Put(s.Ctx, clientmessageblockedjob.Name, s.payload(msgID), gomock.Any()).
+		Return(types.NewJobID(), nil)
+
+	// Action.
+	err := s.resolver.Reject(s.Ctx, msgID)
+
+	// Assert.
+	s.Require().NoError(err)
+}
+
+func (s *ResolverSuite) payload(msgID types.MessageID) string {
+	p, err := outbox.MarshalMessageIDPayload(msgID)
+	s.Require().NoError(err)
+	return p
+}
+
+func (s *ResolverSuite) expectTx() {
+	s.txtor.EXPECT().RunInTx(s.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+}
@@ -0,0 +1,43 @@
+package msgbus
+
+import (
+	"context"
+	"io"
+	"time"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/bus_mock.gen.go -package=msgbusmocks
+
+// Message is the message of the topic, the same for all the bus implementations.
+type Message struct {
+	Topic     string
+	Partition int
+	Offset    int64
+	Key       []byte
+	Value     []byte
+	Headers   []Header
+	Time      time.Time
+}
+
+type Header struct {
+	Key   string
+	Value []byte
+}
+
+// Writer publishes the messages to the topic.
+// The Topic, Partition and Offset of the written messages are set by the bus.
+type Writer interface {
+	io.Closer
+	WriteMessages(ctx context.Context, msgs ...Message) error
+}
+
+// Reader consumes the messages of the topic as a member of the consumer group.
+// The committed messages are not delivered to the group again.
+type Reader interface {
+	io.Closer
+	FetchMessage(ctx context.Context) (Message, error)
+	CommitMessages(ctx context.Context, msgs ...Message) error
+}
+
+// ReaderFactory creates the reader of the topic within the consumer group.
+type ReaderFactory func(groupID, topic string) Reader
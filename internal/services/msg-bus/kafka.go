@@ -0,0 +1,135 @@
+package msgbus
+
+import (
+	"context"
+
+	"github.com/karasunokami/chat-service/internal/logger"
+
+	"github.com/segmentio/kafka-go"
+)
+
+// NewKafkaWriter creates the writer of the topic, the messages with the same key get into the same partition.
+func NewKafkaWriter(brokers []string, topic string, batchSize int) Writer {
+	return &kafkaWriter{w: &kafka.Writer{
+		Addr:         kafka.TCP(brokers...),
+		Topic:        topic,
+		Balancer:     &kafka.CRC32Balancer{},
+		BatchSize:    batchSize,
+		RequiredAcks: kafka.RequireOne,
+		Async:        false,
+		Logger:       logger.NewKafkaAdapted().WithServiceName(topic),
+		ErrorLogger:  logger.NewKafkaAdapted().WithServiceName(topic).ForErrors(),
+	}}
+}
+
+// NewKafkaDLQWriter creates the writer of the dead letter topic, every message is written right away.
+func NewKafkaDLQWriter(brokers []string, topic string) Writer {
+	return &kafkaWriter{w: &kafka.Writer{
+		Addr:         kafka.TCP(brokers...),
+		Topic:        topic,
+		BatchSize:    1,
+		Async:        false,
+		RequiredAcks: kafka.RequireOne,
+	}}
+}
+
+// NewKafkaReader creates the reader of the topic, the new consumer group starts from the oldest message.
+func NewKafkaReader(brokers []string, groupID, topic string) Reader {
+	return &kafkaReader{r: kafka.NewReader(kafka.ReaderConfig{
+		WatchPartitionChanges: true,
+		Brokers:               brokers,
+		GroupID:               groupID,
+		Topic:                 topic,
+		Logger:                logger.NewKafkaAdapted().WithServiceName(groupID),
+		ErrorLogger:           logger.NewKafkaAdapted().WithServiceName(groupID).ForErrors(),
+		StartOffset:           kafka.FirstOffset,
+	})}
+}
+
+// KafkaReaderFactory creates the readers of the brokers topics.
+func KafkaReaderFactory(brokers []string) ReaderFactory {
+	return func(groupID, topic string) Reader {
+		return NewKafkaReader(brokers, groupID, topic)
+	}
+}
+
+type kafkaWriter struct {
+	w *kafka.Writer
+}
+
+func (w *kafkaWriter) WriteMessages(ctx context.Context, msgs ...Message) error {
+	kMsgs := make([]kafka.Message, 0, len(msgs))
+	for _, m := range msgs {
+		// The writer chooses the partition of the own topic.
+		km := toKafkaMessage(m)
+		km.Topic, km.Partition, km.Offset = "", 0, 0
+		kMsgs = append(kMsgs, km)
+	}
+
+	return w.w.WriteMessages(ctx, kMsgs...)
+}
+
+func (w *kafkaWriter) Close() error {
+	return w.w.Close()
+}
+
+type kafkaReader struct {
+	r *kafka.Reader
+}
+
+func (r *kafkaReader) FetchMessage(ctx context.Context) (Message, error) {
+	m, err := r.r.FetchMessage(ctx)
+	if err != nil {
+		return Message{}, err
+	}
+
+	return FromKafkaMessage(m), nil
+}
+
+func (r *kafkaReader) CommitMessages(ctx context.Context, msgs ...Message) error {
+	kMsgs := make([]kafka.Message, 0, len(msgs))
+	for _, m := range msgs {
+		kMsgs = append(kMsgs, toKafkaMessage(m))
+	}
+
+	return r.r.CommitMessages(ctx, kMsgs...)
+}
+
+func (r *kafkaReader) Close() error {
+	return r.r.Close()
+}
+
+// FromKafkaMessage converts the message read by kafka-go directly, e.g. by the partition reader.
+func FromKafkaMessage(m kafka.Message) Message {
+	headers := make([]Header, 0, len(m.Headers))
+	for _, h := range m.Headers {
+		headers = append(headers, Header{Key: h.Key, Value: h.Value})
+	}
+
+	return Message{
+		Topic:     m.Topic,
+		Partition: m.Partition,
+		Offset:    m.Offset,
+		Key:       m.Key,
+		Value:     m.Value,
+		Headers:   headers,
+		Time:      m.Time,
+	}
+}
+
+func toKafkaMessage(m Message) kafka.Message {
+	headers := make([]kafka.Header, 0, len(m.Headers))
+	for _, h := range m.Headers {
+		headers = append(headers, kafka.Header{Key: h.Key, Value: h.Value})
+	}
+
+	return kafka.Message{
+		Topic:     m.Topic,
+		Partition: m.Partition,
+		Offset:    m.Offset,
+		Key:       m.Key,
+		Value:     m.Value,
+		Headers:   headers,
+		Time:      m.Time,
+	}
+}
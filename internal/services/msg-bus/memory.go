@@ -0,0 +1,294 @@
+package msgbus
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+)
+
+//go:generate options-gen -out-filename=memory_options.gen.go -from-struct=MemoryOptions
+type MemoryOptions struct {
+	// topicCapacity is the max number of messages kept in the topic, the oldest ones are dropped.
+	topicCapacity int `default:"10000" validate:"min=1,max=1000000"`
+}
+
+// Memory is the in-process bus with the single partition topics.
+// It allows running the service without the broker, the messages are lost on restart.
+type Memory struct {
+	capacity int
+
+	mu     sync.Mutex
+	topics map[string]*memoryTopic
+}
+
+func NewMemory(opts MemoryOptions) (*Memory, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate options, err=%v", err)
+	}
+
+	return &Memory{
+		capacity: opts.topicCapacity,
+		topics:   make(map[string]*memoryTopic),
+	}, nil
+}
+
+func (b *Memory) Writer(topic string) Writer {
+	return &memoryWriter{bus: b, topic: topic}
+}
+
+// Reader joins the consumer group of the topic. The new group starts from the oldest kept message.
+// Once the last reader of the group is closed, the uncommitted messages are delivered to the group again.
+func (b *Memory) Reader(groupID, topic string) Reader {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	t := b.topic(topic)
+
+	g, ok := t.groups[groupID]
+	if !ok {
+		g = &memoryGroup{next: t.base, committed: t.base, done: make(map[int64]struct{})}
+		t.groups[groupID] = g
+	}
+	g.readers++
+
+	return &memoryReader{bus: b, topic: t, group: g, closed: make(chan struct{})}
+}
+
+// topic must be called under the lock.
+func (b *Memory) topic(name string) *memoryTopic {
+	t, ok := b.topics[name]
+	if !ok {
+		t = &memoryTopic{
+			name:     name,
+			groups:   make(map[string]*memoryGroup),
+			appended: make(chan struct{}),
+		}
+		b.topics[name] = t
+	}
+
+	return t
+}
+
+type memoryTopic struct {
+	name     string
+	base     int64     // The offset of the oldest kept message.
+	end      int64     // The offset of the next appended message.
+	messages []Message // The ring buffer growing up to the capacity, see at.
+	groups   map[string]*memoryGroup
+	appended chan struct{} // Closed and replaced on every append.
+}
+
+func (t *memoryTopic) append(msgs []Message, capacity int) {
+	now := time.Now()
+
+	for _, m := range msgs {
+		m.Topic = t.name
+		m.Partition = 0
+		m.Offset = t.end
+		m.Key = clone(m.Key)
+		m.Value = clone(m.Value)
+		m.Headers = append([]Header(nil), m.Headers...)
+		if m.Time.IsZero() {
+			m.Time = now
+		}
+
+		if len(t.messages) < capacity {
+			t.messages = append(t.messages, m)
+		} else {
+			t.messages[t.end%int64(capacity)] = m
+		}
+		t.end++
+	}
+
+	if t.end-t.base > int64(capacity) {
+		t.base = t.end - int64(capacity)
+
+		for _, g := range t.groups {
+			g.skipTo(t.base)
+		}
+	}
+
+	close(t.appended)
+	t.appended = make(chan struct{})
+}
+
+// at returns the kept message by its offset. The buffer is not full until its offset reaches the capacity,
+// so the message is at the offset modulo the buffer length in both cases.
+func (t *memoryTopic) at(offset int64) Message {
+	return t.messages[offset%int64(len(t.messages))]
+}
+
+type memoryGroup struct {
+	next      int64              // The offset of the next message to fetch.
+	committed int64              // All the messages before the offset are committed.
+	done      map[int64]struct{} // The committed offsets after the committed one.
+	readers   int
+}
+
+func (g *memoryGroup) commit(offset int64) {
+	if offset < g.committed {
+		return
+	}
+
+	g.done[offset] = struct{}{}
+	g.advance()
+}
+
+// skipTo moves the group past the messages dropped from the topic.
+func (g *memoryGroup) skipTo(offset int64) {
+	if g.next < offset {
+		g.next = offset
+	}
+
+	if g.committed >= offset {
+		return
+	}
+
+	for o := range g.done {
+		if o < offset {
+			delete(g.done, o)
+		}
+	}
+	g.committed = offset
+	g.advance()
+}
+
+func (g *memoryGroup) advance() {
+	for {
+		if _, ok := g.done[g.committed]; !ok {
+			return
+		}
+		delete(g.done, g.committed)
+		g.committed++
+	}
+}
+
+type memoryWriter struct {
+	bus *Memory
+
+	topic  string
+	mu     sync.RWMutex
+	closed bool
+}
+
+func (w *memoryWriter) WriteMessages(ctx context.Context, msgs ...Message) error {
+	if err := ctx.Err(); err != nil {
+		return err
+	}
+
+	w.mu.RLock()
+	defer w.mu.RUnlock()
+
+	if w.closed {
+		return io.ErrClosedPipe
+	}
+
+	w.bus.mu.Lock()
+	defer w.bus.mu.Unlock()
+
+	w.bus.topic(w.topic).append(msgs, w.bus.capacity)
+
+	return nil
+}
+
+func (w *memoryWriter) Close() error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	w.closed = true
+
+	return nil
+}
+
+type memoryReader struct {
+	bus   *Memory
+	topic *memoryTopic
+	group *memoryGroup
+
+	closeOnce sync.Once
+	closed    chan struct{}
+}
+
+func (r *memoryReader) FetchMessage(ctx context.Context) (Message, error) {
+	for {
+		r.bus.mu.Lock()
+		if r.isClosed() {
+			r.bus.mu.Unlock()
+			return Message{}, io.EOF
+		}
+
+		t, g := r.topic, r.group
+		if g.next < t.end {
+			m := t.at(g.next)
+			g.next++
+			r.bus.mu.Unlock()
+
+			return m, nil
+		}
+
+		appended := t.appended
+		r.bus.mu.Unlock()
+
+		select {
+		case <-ctx.Done():
+			return Message{}, ctx.Err()
+		case <-r.closed:
+			return Message{}, io.EOF
+		case <-appended:
+		}
+	}
+}
+
+func (r *memoryReader) CommitMessages(_ context.Context, msgs ...Message) error {
+	r.bus.mu.Lock()
+	defer r.bus.mu.Unlock()
+
+	if r.isClosed() {
+		return io.ErrClosedPipe
+	}
+
+	for _, m := range msgs {
+		if m.Topic != r.topic.name {
+			return fmt.Errorf("commit message of another topic, topic=%v", m.Topic)
+		}
+		r.group.commit(m.Offset)
+	}
+
+	return nil
+}
+
+func (r *memoryReader) Close() error {
+	r.closeOnce.Do(func() {
+		r.bus.mu.Lock()
+		defer r.bus.mu.Unlock()
+
+		close(r.closed)
+
+		g := r.group
+		g.readers--
+		if g.readers == 0 {
+			g.next = g.committed
+			g.done = make(map[int64]struct{})
+		}
+	})
+
+	return nil
+}
+
+func (r *memoryReader) isClosed() bool {
+	select {
+	case <-r.closed:
+		return true
+	default:
+		return false
+	}
+}
+
+func clone(b []byte) []byte {
+	if b == nil {
+		return nil
+	}
+	return append([]byte(nil), b...)
+}
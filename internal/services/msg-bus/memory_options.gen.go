@@ -0,0 +1,44 @@
+// Code generated by options-gen. DO NOT EDIT.
+package msgbus
+
+import (
+	fmt461e464ebed9 "fmt"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptMemoryOptionsSetter func(o *MemoryOptions)
+
+func NewMemoryOptions(
+	options ...OptMemoryOptionsSetter,
+) MemoryOptions {
+	o := MemoryOptions{}
+
+	// Setting defaults from field tag (if present)
+	o.topicCapacity = 10000
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func WithTopicCapacity(opt int) OptMemoryOptionsSetter {
+	return func(o *MemoryOptions) {
+		o.topicCapacity = opt
+	}
+}
+
+func (o *MemoryOptions) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("topicCapacity", _validate_MemoryOptions_topicCapacity(o)))
+	return errs.AsError()
+}
+
+func _validate_MemoryOptions_topicCapacity(o *MemoryOptions) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.topicCapacity, "min=1,max=1000000"); err != nil {
+		return fmt461e464ebed9.Errorf("field `topicCapacity` did not pass the test: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,221 @@
+package msgbus_test
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"testing"
+	"time"
+
+	msgbus "github.com/karasunokami/chat-service/internal/services/msg-bus"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const topic = "chat.messages"
+
+func TestMemory_GroupsGetAllMessages(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	bus := newMemory(t)
+	write(ctx, t, bus, "1", "2")
+
+	for _, group := range []string{"group-1", "group-2"} {
+		r := bus.Reader(group, topic)
+		assert.Equal(t, []string{"1", "2"}, fetch(ctx, t, r, 2))
+		require.NoError(t, r.Close())
+	}
+}
+
+func TestMemory_GroupReadersShareMessages(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	bus := newMemory(t)
+	r1, r2 := bus.Reader("group", topic), bus.Reader("group", topic)
+	defer r1.Close()
+	defer r2.Close()
+
+	write(ctx, t, bus, "1", "2", "3")
+
+	got := append(fetch(ctx, t, r1, 2), fetch(ctx, t, r2, 1)...)
+	assert.Equal(t, []string{"1", "2", "3"}, got)
+
+	assertNoMessage(t, r1)
+	assertNoMessage(t, r2)
+}
+
+func TestMemory_FetchWaitsForMessage(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	bus := newMemory(t)
+	r := bus.Reader("group", topic)
+	defer r.Close()
+
+	go func() {
+		time.Sleep(50 * time.Millisecond)
+		write(ctx, t, bus, "1")
+	}()
+
+	m, err := r.FetchMessage(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "1", string(m.Value))
+	assert.Equal(t, topic, m.Topic)
+	assert.Equal(t, []byte("key-1"), m.Key)
+	assert.Equal(t, []msgbus.Header{{Key: "HEADER", Value: []byte("1")}}, m.Headers)
+	assert.False(t, m.Time.IsZero())
+}
+
+func TestMemory_UncommittedMessagesRedelivered(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	bus := newMemory(t)
+	write(ctx, t, bus, "1", "2", "3")
+
+	r := bus.Reader("group", topic)
+	m1, err := r.FetchMessage(ctx)
+	require.NoError(t, err)
+	_, err = r.FetchMessage(ctx)
+	require.NoError(t, err)
+	m3, err := r.FetchMessage(ctx)
+	require.NoError(t, err)
+
+	// The 3rd message is committed, but the 2nd one is not, so the group restarts from the 2nd one.
+	require.NoError(t, r.CommitMessages(ctx, m1, m3))
+	require.NoError(t, r.Close())
+
+	_, err = r.FetchMessage(ctx)
+	require.ErrorIs(t, err, io.EOF)
+
+	r = bus.Reader("group", topic)
+	defer r.Close()
+	assert.Equal(t, []string{"2", "3"}, fetch(ctx, t, r, 2))
+}
+
+func TestMemory_CommittedMessagesNotRedelivered(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	bus := newMemory(t)
+	write(ctx, t, bus, "1", "2")
+
+	r := bus.Reader("group", topic)
+	m, err := r.FetchMessage(ctx)
+	require.NoError(t, err)
+	require.NoError(t, r.CommitMessages(ctx, m))
+	require.NoError(t, r.Close())
+
+	r = bus.Reader("group", topic)
+	defer r.Close()
+	assert.Equal(t, []string{"2"}, fetch(ctx, t, r, 1))
+	assertNoMessage(t, r)
+}
+
+func TestMemory_TopicCapacity(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	bus, err := msgbus.NewMemory(msgbus.NewMemoryOptions(msgbus.WithTopicCapacity(2)))
+	require.NoError(t, err)
+
+	r := bus.Reader("group", topic)
+	defer r.Close()
+
+	write(ctx, t, bus, "1", "2", "3")
+
+	m, err := r.FetchMessage(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "2", string(m.Value))
+	assert.Equal(t, int64(1), m.Offset)
+}
+
+func TestMemory_TopicCapacityWrapsAround(t *testing.T) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+
+	bus, err := msgbus.NewMemory(msgbus.NewMemoryOptions(msgbus.WithTopicCapacity(3)))
+	require.NoError(t, err)
+
+	r := bus.Reader("group", topic)
+	defer r.Close()
+
+	write(ctx, t, bus, "1", "2")
+	assert.Equal(t, []string{"1"}, fetch(ctx, t, r, 1))
+
+	// The unread message is overwritten, the reader continues from the oldest kept one.
+	write(ctx, t, bus, "3", "4", "5", "6", "7")
+	assert.Equal(t, []string{"5", "6", "7"}, fetch(ctx, t, r, 3))
+	assertNoMessage(t, r)
+
+	late := bus.Reader("late-group", topic)
+	defer late.Close()
+
+	m, err := late.FetchMessage(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, "5", string(m.Value))
+	assert.Equal(t, int64(4), m.Offset)
+}
+
+func TestMemory_ClosedWriter(t *testing.T) {
+	bus := newMemory(t)
+
+	w := bus.Writer(topic)
+	require.NoError(t, w.Close())
+
+	err := w.WriteMessages(context.Background(), msgbus.Message{Value: []byte("1")})
+	require.ErrorIs(t, err, io.ErrClosedPipe)
+}
+
+func newMemory(t *testing.T) *msgbus.Memory {
+	t.Helper()
+
+	bus, err := msgbus.NewMemory(msgbus.NewMemoryOptions())
+	require.NoError(t, err)
+
+	return bus
+}
+
+func write(ctx context.Context, t *testing.T, bus *msgbus.Memory, values ...string) {
+	t.Helper()
+
+	msgs := make([]msgbus.Message, 0, len(values))
+	for _, v := range values {
+		msgs = append(msgs, msgbus.Message{
+			Key:     []byte(fmt.Sprintf("key-%s", v)),
+			Value:   []byte(v),
+			Headers: []msgbus.Header{{Key: "HEADER", Value: []byte(v)}},
+		})
+	}
+
+	w := bus.Writer(topic)
+	defer w.Close()
+
+	assert.NoError(t, w.WriteMessages(ctx, msgs...))
+}
+
+func fetch(ctx context.Context, t *testing.T, r msgbus.Reader, n int) []string {
+	t.Helper()
+
+	values := make([]string, 0, n)
+	for i := 0; i < n; i++ {
+		m, err := r.FetchMessage(ctx)
+		require.NoError(t, err)
+		values = append(values, string(m.Value))
+	}
+
+	return values
+}
+
+func assertNoMessage(t *testing.T, r msgbus.Reader) {
+	t.Helper()
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	_, err := r.FetchMessage(ctx)
+	assert.ErrorIs(t, err, context.DeadlineExceeded)
+}
@@ -4,10 +4,14 @@ import (
 	"context"
 	"encoding/json"
 	"fmt"
+	"time"
 
+	msgbus "github.com/karasunokami/chat-service/internal/services/msg-bus"
+	"github.com/karasunokami/chat-service/internal/tracing"
 	"github.com/karasunokami/chat-service/internal/types"
 
-	"github.com/segmentio/kafka-go"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -18,8 +22,16 @@ type Message struct {
 	FromClient bool
 }
 
-func (s *Service) ProduceMessage(ctx context.Context, msg Message) error {
-	data, err := msgToJSON(msg)
+func (s *Service) ProduceMessage(ctx context.Context, msg Message) (err error) {
+	ctx, span := tracing.Start(ctx, "msgproducer.ProduceMessage",
+		trace.WithSpanKind(trace.SpanKindProducer),
+		trace.WithAttributes(attribute.Stringer("message_id", msg.ID)),
+	)
+	defer func() { tracing.End(span, err) }()
+
+	eventTime := time.Now().UTC()
+
+	data, err := json.Marshal(newJSONMessage(msg, eventTime))
 	if err != nil {
 		return fmt.Errorf("marshal json, err=%v", err)
 	}
@@ -31,12 +43,19 @@ func (s *Service) ProduceMessage(ctx context.Context, msg Message) error {
 		}
 	}
 
-	err = s.wr.WriteMessages(ctx, kafka.Message{
-		Key:   []byte(msg.ChatID.String()),
-		Value: data,
-	})
+	busMsg := msgbus.Message{
+		Key:     []byte(msg.ChatID.String()),
+		Value:   data,
+		Headers: envelopeHeaders(eventTime),
+	}
+	tracing.InjectMessageHeaders(ctx, &busMsg)
+	if s.keyID != "" {
+		busMsg.Headers = append(busMsg.Headers, msgbus.Header{Key: EncryptionKeyIDHeader, Value: []byte(s.keyID)})
+	}
+
+	err = s.wr.WriteMessages(ctx, busMsg)
 	if err != nil {
-		return fmt.Errorf("write data to kafka writer, err=%v", err)
+		return fmt.Errorf("write data to bus writer, err=%v", err)
 	}
 
 	s.logger.Debug("Message produced", zap.Stringer("messageId", msg.ID), zap.String("body", msg.Body))
@@ -53,24 +72,10 @@ func (s *Service) encryptData(data []byte) ([]byte, error) {
 	return s.cipher.Seal(nonce, nonce, data, nil), nil
 }
 
-func msgToJSON(msg Message) ([]byte, error) {
-	return json.Marshal(struct {
-		ID         string `json:"id"`
-		ChatID     string `json:"chatId"`
-		Body       string `json:"body"`
-		FromClient bool   `json:"fromClient"`
-	}{
-		ID:         msg.ID.String(),
-		ChatID:     msg.ChatID.String(),
-		Body:       msg.Body,
-		FromClient: msg.FromClient,
-	})
-}
-
 func (s *Service) Close() error {
 	err := s.wr.Close()
 	if err != nil {
-		return fmt.Errorf("close kafka writer, err=%v", err)
+		return fmt.Errorf("close bus writer, err=%v", err)
 	}
 
 	return nil
@@ -0,0 +1,66 @@
+package msgproducer
+
+import (
+	"strconv"
+	"time"
+
+	"github.com/karasunokami/chat-service/internal/buildinfo"
+	msgbus "github.com/karasunokami/chat-service/internal/services/msg-bus"
+)
+
+const (
+	// SchemaVersion is the version of the produced message shape, described by api/chat.messages.schema.json.
+	// Bump it on any change of the shape.
+	SchemaVersion = 1
+
+	// ContentType is the type of the message value before the encryption.
+	ContentType = "application/vnd.chat-service.message+json"
+)
+
+// The envelope headers, they are never encrypted.
+const (
+	SchemaVersionHeader   = "SCHEMA_VERSION"
+	ContentTypeHeader     = "CONTENT_TYPE"
+	ProducerVersionHeader = "PRODUCER_VERSION"
+	EventTimeHeader       = "EVENT_TIME"
+)
+
+// jsonMessage is the message value. The envelope fields are on the same level as the message ones,
+// so the consumers of the unversioned shape keep working.
+type jsonMessage struct {
+	SchemaVersion   int       `json:"schemaVersion"`
+	ContentType     string    `json:"contentType"`
+	ProducerVersion string    `json:"producerVersion"`
+	EventTime       time.Time `json:"eventTime"`
+
+	ID         string `json:"id"`
+	ChatID     string `json:"chatId"`
+	Body       string `json:"body"`
+	FromClient bool   `json:"fromClient"`
+}
+
+func newJSONMessage(msg Message, eventTime time.Time) jsonMessage {
+	return jsonMessage{
+		SchemaVersion:   SchemaVersion,
+		ContentType:     ContentType,
+		ProducerVersion: producerVersion(),
+		EventTime:       eventTime,
+		ID:              msg.ID.String(),
+		ChatID:          msg.ChatID.String(),
+		Body:            msg.Body,
+		FromClient:      msg.FromClient,
+	}
+}
+
+func envelopeHeaders(eventTime time.Time) []msgbus.Header {
+	return []msgbus.Header{
+		{Key: SchemaVersionHeader, Value: []byte(strconv.Itoa(SchemaVersion))},
+		{Key: ContentTypeHeader, Value: []byte(ContentType)},
+		{Key: ProducerVersionHeader, Value: []byte(producerVersion())},
+		{Key: EventTimeHeader, Value: []byte(eventTime.Format(time.RFC3339Nano))},
+	}
+}
+
+func producerVersion() string {
+	return buildinfo.BuildInfo.Main.Version
+}
@@ -0,0 +1,143 @@
+package msgproducer_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"os"
+	"path/filepath"
+	"runtime"
+	"sort"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	msgproducer "github.com/karasunokami/chat-service/internal/services/msg-producer"
+	"github.com/karasunokami/chat-service/internal/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+// schemaFingerprints are the message shapes of the released schema versions.
+// Never change the existing ones, bump msgproducer.SchemaVersion and add the new shape instead.
+var schemaFingerprints = map[int]string{
+	1: "body:string,chatId:string,contentType:string,eventTime:string,fromClient:boolean,id:string," +
+		"producerVersion:string,schemaVersion:integer",
+}
+
+type jsonSchema struct {
+	Properties map[string]struct {
+		Type  string `json:"type"`
+		Const any    `json:"const"`
+	} `json:"properties"`
+	Required []string `json:"required"`
+}
+
+func TestEnvelope_SchemaCompatibility(t *testing.T) {
+	schema := readMessagesSchema(t)
+
+	t.Run("schema version", func(t *testing.T) {
+		assert.EqualValues(t, msgproducer.SchemaVersion, schema.Properties["schemaVersion"].Const)
+		assert.Equal(t, msgproducer.ContentType, schema.Properties["contentType"].Const)
+	})
+
+	t.Run("schema is not changed without version bump", func(t *testing.T) {
+		propTypes := make(map[string]string, len(schema.Properties))
+		for name, p := range schema.Properties {
+			propTypes[name] = p.Type
+		}
+
+		exp, ok := schemaFingerprints[msgproducer.SchemaVersion]
+		require.True(t, ok, "add the fingerprint of schema version %d", msgproducer.SchemaVersion)
+		assert.Equal(t, exp, fingerprint(propTypes), "the schema is changed, bump msgproducer.SchemaVersion")
+	})
+
+	t.Run("produced message matches schema", func(t *testing.T) {
+		writer := new(writerMock)
+		s, err := msgproducer.New(msgproducer.NewOptions(writer))
+		require.NoError(t, err)
+
+		err = s.ProduceMessage(context.Background(), msgproducer.Message{
+			ID:         types.NewMessageID(),
+			ChatID:     types.NewChatID(),
+			Body:       "Hello!",
+			FromClient: true,
+		})
+		require.NoError(t, err)
+		require.Len(t, writer.msgs, 1)
+
+		var produced map[string]any
+		require.NoError(t, json.Unmarshal(writer.msgs[0].Value, &produced))
+
+		producedTypes := make(map[string]string, len(produced))
+		for name, v := range produced {
+			producedTypes[name] = jsonType(v)
+		}
+
+		assert.Equal(t, schemaFingerprints[msgproducer.SchemaVersion], fingerprint(producedTypes),
+			"the produced message shape is changed, update the schema and bump msgproducer.SchemaVersion")
+		for _, name := range schema.Required {
+			assert.Contains(t, produced, name)
+		}
+
+		headers := make(map[string]string)
+		for _, h := range writer.msgs[0].Headers {
+			headers[h.Key] = string(h.Value)
+		}
+		assert.Equal(t, strconv.Itoa(msgproducer.SchemaVersion), headers[msgproducer.SchemaVersionHeader])
+		assert.Equal(t, msgproducer.ContentType, headers[msgproducer.ContentTypeHeader])
+		assert.Equal(t, produced["producerVersion"], headers[msgproducer.ProducerVersionHeader])
+
+		eventTime, err := time.Parse(time.RFC3339Nano, headers[msgproducer.EventTimeHeader])
+		require.NoError(t, err)
+		assert.WithinDuration(t, time.Now(), eventTime, time.Minute)
+	})
+}
+
+func readMessagesSchema(t *testing.T) jsonSchema {
+	t.Helper()
+
+	_, currentFile, _, ok := runtime.Caller(0)
+	require.True(t, ok)
+
+	data, err := os.ReadFile(filepath.Join(filepath.Dir(currentFile), "..", "..", "..", "api", "chat.messages.schema.json"))
+	require.NoError(t, err)
+
+	var schema jsonSchema
+	require.NoError(t, json.Unmarshal(data, &schema))
+
+	return schema
+}
+
+func fingerprint(fieldTypes map[string]string) string {
+	fields := make([]string, 0, len(fieldTypes))
+	for name, typ := range fieldTypes {
+		fields = append(fields, fmt.Sprintf("%s:%s", name, typ))
+	}
+	sort.Strings(fields)
+
+	return strings.Join(fields, ",")
+}
+
+func jsonType(v any) string {
+	switch v := v.(type) {
+	case string:
+		return "string"
+	case bool:
+		return "boolean"
+	case float64:
+		if v == math.Trunc(v) {
+			return "integer"
+		}
+		return "number"
+	case nil:
+		return "null"
+	case []any:
+		return "array"
+	default:
+		return "object"
+	}
+}
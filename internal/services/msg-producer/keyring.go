@@ -0,0 +1,128 @@
+package msgproducer
+
+import (
+	"crypto/cipher"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	msgbus "github.com/karasunokami/chat-service/internal/services/msg-bus"
+	"github.com/karasunokami/chat-service/internal/types"
+)
+
+// EncryptionKeyIDHeader is the header of the message with the ID of the key the message was encrypted with.
+const EncryptionKeyIDHeader = "ENCRYPTION_KEY_ID"
+
+var (
+	ErrUnknownEncryptionKey = errors.New("unknown encryption key")
+	ErrMalformedMessage     = errors.New("malformed message")
+)
+
+// Keyring holds the AES-GCM ciphers by the key IDs. It allows to decode the messages
+// encrypted with the previous keys during the keys rotation.
+type Keyring struct {
+	ciphers map[string]cipher.AEAD
+}
+
+// NewKeyring creates the keyring from the hex encoded keys by their IDs.
+// The key with the empty ID is used for the messages without EncryptionKeyIDHeader.
+func NewKeyring(keys map[string]string) (*Keyring, error) {
+	ciphers := make(map[string]cipher.AEAD, len(keys))
+	for id, key := range keys {
+		c, err := initializeCipher(key)
+		if err != nil {
+			return nil, fmt.Errorf("initialize cipher for key %q, err=%v", id, err)
+		}
+		ciphers[id] = c
+	}
+
+	return &Keyring{ciphers: ciphers}, nil
+}
+
+// Decode decrypts the message with the key from EncryptionKeyIDHeader and unmarshals it.
+func (k *Keyring) Decode(m msgbus.Message) (Message, error) {
+	data, err := k.Decrypt(m)
+	if err != nil {
+		return Message{}, err
+	}
+
+	msg, err := msgFromJSON(data)
+	if err != nil {
+		return Message{}, fmt.Errorf("%w: unmarshal json, err=%v", ErrMalformedMessage, err)
+	}
+
+	return msg, nil
+}
+
+// DecodePlain unmarshals the message produced without encryption.
+func DecodePlain(m msgbus.Message) (Message, error) {
+	msg, err := msgFromJSON(m.Value)
+	if err != nil {
+		return Message{}, fmt.Errorf("%w: unmarshal json, err=%v", ErrMalformedMessage, err)
+	}
+
+	return msg, nil
+}
+
+// Decrypt returns the decrypted message value.
+func (k *Keyring) Decrypt(m msgbus.Message) ([]byte, error) {
+	keyID := messageKeyID(m)
+
+	c, err := k.cipher(keyID)
+	if err != nil {
+		return nil, err
+	}
+
+	ns := c.NonceSize()
+	if len(m.Value) < ns {
+		return nil, fmt.Errorf("%w: value is shorter than nonce", ErrMalformedMessage)
+	}
+
+	data, err := c.Open(nil, m.Value[:ns], m.Value[ns:], nil)
+	if err != nil {
+		return nil, fmt.Errorf("%w: open, key=%q, err=%v", ErrMalformedMessage, keyID, err)
+	}
+
+	return data, nil
+}
+
+func (k *Keyring) cipher(keyID string) (cipher.AEAD, error) {
+	c, ok := k.ciphers[keyID]
+	if !ok {
+		return nil, fmt.Errorf("%w: key=%q", ErrUnknownEncryptionKey, keyID)
+	}
+	return c, nil
+}
+
+func messageKeyID(m msgbus.Message) string {
+	for _, h := range m.Headers {
+		if h.Key == EncryptionKeyIDHeader {
+			return string(h.Value)
+		}
+	}
+	return ""
+}
+
+func msgFromJSON(data []byte) (Message, error) {
+	var m jsonMessage
+	if err := json.Unmarshal(data, &m); err != nil {
+		return Message{}, err
+	}
+
+	id, err := types.Parse[types.MessageID](m.ID)
+	if err != nil {
+		return Message{}, fmt.Errorf("parse message id, err=%v", err)
+	}
+
+	chatID, err := types.Parse[types.ChatID](m.ChatID)
+	if err != nil {
+		return Message{}, fmt.Errorf("parse chat id, err=%v", err)
+	}
+
+	return Message{
+		ID:         id,
+		ChatID:     chatID,
+		Body:       m.Body,
+		FromClient: m.FromClient,
+	}, nil
+}
@@ -1,35 +1,38 @@
 package msgproducer
 
 import (
-	"context"
 	"crypto/aes"
 	"crypto/cipher"
 	"crypto/rand"
 	"encoding/hex"
+	"errors"
 	"fmt"
-	"io"
 
-	"github.com/segmentio/kafka-go"
+	msgbus "github.com/karasunokami/chat-service/internal/services/msg-bus"
+
 	"go.uber.org/zap"
 )
 
 const serviceName = "msg-producer"
 
-type KafkaWriter interface {
-	io.Closer
-	WriteMessages(ctx context.Context, msgs ...kafka.Message) error
-}
+var errNoActiveEncryptKey = errors.New("active encrypt key id is not in the keyring")
 
 //go:generate options-gen -out-filename=service_options.gen.go -from-struct=Options
 type Options struct {
-	wr           KafkaWriter `option:"mandatory" validate:"required"`
-	encryptKey   string      `validate:"omitempty,hexadecimal"`
+	wr           msgbus.Writer `option:"mandatory" validate:"required"`
+	encryptKey   string        `validate:"omitempty,hexadecimal"`
 	nonceFactory func(size int) ([]byte, error)
+
+	// encryptKeys is the keyring of the hex encoded keys by their IDs, it takes precedence over encryptKey.
+	// The messages are encrypted with the activeEncryptKeyID key, which is written to EncryptionKeyIDHeader.
+	encryptKeys        map[string]string
+	activeEncryptKeyID string
 }
 
 type Service struct {
-	wr           KafkaWriter
+	wr           msgbus.Writer
 	cipher       cipher.AEAD
+	keyID        string
 	nonceFactory func(size int) ([]byte, error)
 	logger       *zap.Logger
 }
@@ -49,7 +52,25 @@ func New(opts Options) (*Service, error) {
 		logger:       zap.L().Named(serviceName),
 	}
 
-	if opts.encryptKey != "" {
+	switch {
+	case len(opts.encryptKeys) > 0:
+		if opts.activeEncryptKeyID == "" {
+			return nil, errNoActiveEncryptKey
+		}
+
+		keyring, err := NewKeyring(opts.encryptKeys)
+		if err != nil {
+			return nil, fmt.Errorf("create keyring, err=%v", err)
+		}
+
+		c, err := keyring.cipher(opts.activeEncryptKeyID)
+		if err != nil {
+			return nil, fmt.Errorf("%w: %v", errNoActiveEncryptKey, err)
+		}
+
+		s.cipher, s.keyID = c, opts.activeEncryptKeyID
+
+	case opts.encryptKey != "":
 		c, err := initializeCipher(opts.encryptKey)
 		if err != nil {
 			return nil, fmt.Errorf("initialize aead cipher, err=%v", err)
@@ -5,12 +5,13 @@ package msgproducer_test
 import (
 	"bytes"
 	"context"
-	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"testing"
 	"time"
 
 	"github.com/karasunokami/chat-service/internal/logger"
+	msgbus "github.com/karasunokami/chat-service/internal/services/msg-bus"
 	msgproducer "github.com/karasunokami/chat-service/internal/services/msg-producer"
 	"github.com/karasunokami/chat-service/internal/testingh"
 	"github.com/karasunokami/chat-service/internal/types"
@@ -58,7 +59,7 @@ func (s *ServiceIntegrationSuite) TearDownTest() {
 func (s *ServiceIntegrationSuite) TestPlainMessages() {
 	// Arrange.
 	svc, err := msgproducer.New(msgproducer.NewOptions(
-		msgproducer.NewKafkaWriter(s.KafkaBrokers(), s.messagesTopic, 1),
+		msgbus.NewKafkaWriter(s.KafkaBrokers(), s.messagesTopic, 1),
 	))
 	s.Require().NoError(err)
 	defer func() { s.Require().NoError(svc.Close()) }()
@@ -95,25 +96,12 @@ func (s *ServiceIntegrationSuite) TestPlainMessages() {
 	})
 
 	s.Run("assert messages values and order", func() {
-		expectedChatMsgs := map[string][]string{
-			chat1: {
-				fmt.Sprintf(`{"id":%q,"chatId":%q,"body":"chat 1, message 1","fromClient":true}`, msg11, chat1),
-				fmt.Sprintf(`{"id":%q,"chatId":%q,"body":"chat 1, message 2","fromClient":false}`, msg12, chat1),
-				fmt.Sprintf(`{"id":%q,"chatId":%q,"body":"chat 1, message 3","fromClient":true}`, msg13, chat1),
-			},
-			chat2: {
-				fmt.Sprintf(`{"id":%q,"chatId":%q,"body":"chat 2, message 1","fromClient":true}`, msg21, chat2),
-				fmt.Sprintf(`{"id":%q,"chatId":%q,"body":"chat 2, message 2","fromClient":false}`, msg22, chat2),
-			},
-			chat3: {
-				fmt.Sprintf(`{"id":%q,"chatId":%q,"body":"chat 3, message 1","fromClient":true}`, msg31, chat3),
-			},
-		}
+		expectedChatMsgs := expectedChatMessages()
 
 		for chatID, chatMsgs := range producedMsgsByKey {
 			s.Require().Len(chatMsgs, len(expectedChatMsgs[chatID]))
 			for i, m := range chatMsgs {
-				s.JSONEq(expectedChatMsgs[chatID][i], string(m.Value), "chat = %s, msg #%d", chatID, i)
+				s.JSONEq(expectedChatMsgs[chatID][i], s.stripEnvelope(m.Value), "chat = %s, msg #%d", chatID, i)
 			}
 		}
 	})
@@ -122,8 +110,8 @@ func (s *ServiceIntegrationSuite) TestPlainMessages() {
 func (s *ServiceIntegrationSuite) TestEncryptedMessages() {
 	// Arrange.
 	svc, err := msgproducer.New(msgproducer.NewOptions(
-		msgproducer.NewKafkaWriter(s.KafkaBrokers(), s.messagesTopic, 1),
-		msgproducer.WithEncryptKey("68566D597133743677397A2443264629"),
+		msgbus.NewKafkaWriter(s.KafkaBrokers(), s.messagesTopic, 1),
+		msgproducer.WithEncryptKey(encryptKey),
 		msgproducer.WithNonceFactory(func(size int) ([]byte, error) {
 			return bytes.Repeat([]byte{'1'}, size), nil
 		}),
@@ -163,27 +151,19 @@ func (s *ServiceIntegrationSuite) TestEncryptedMessages() {
 	})
 
 	s.Run("assert messages values and order", func() {
-		expectedChatMsgs := map[string][]string{
-			chat1: {
-				"3131313131313131313131317234451261f7b6d088f83e40f4192f5e4390f73013204bb5d664f96485e5fa3647b6c5c45e6cdb8fa8c9b39a4806a3dddbd14950c1409a45584e7b615026525078aaae1f61fb513a5fb260a3abd4217f1e7d13ff6a84dfe5031e815b6f3584d1c2bd37f9c70ad4ee049e06b147861b71c74aa5029b328aa5e7adf6561e9853694a329012b35cb6a0edde299c9e9aaf1ad3dba5d88d5db228e35f",   //nolint:lll
-				"3131313131313131313131317234451261f7b6d786a13a47f14b785e4390f73113204bb5d664f93781e9fa3647b6c5c45e6cdb8fa8c9b39a4806a3dddbd14950c1409a45584e7b615026525078aaae1f61fb513a5fb260a3abd4217f1e7d13ff6a84dfe5031e815b6f3584d1c2bd37f9c70ad4ee049e06b147861b71c74aa5029b318aa5e7adf6561e9853694a329012b34ea5b9fbc6a77e8a986ccbd361fe78d806a2b5c8366a", //nolint:lll
-				"3131313131313131313131317234451261f7b6d586ff6e15a14b7a5e4390f73113204bb5d664a33987eafa3647b6c5c45e6cdb8fa8c9b39a4806a3dddbd14950c1409a45584e7b615026525078aaae1f61fb513a5fb260a3abd4217f1e7d13ff6a84dfe5031e815b6f3584d1c2bd37f9c70ad4ee049e06b147861b71c74aa5029b308aa5e7adf6561e9853694a329012b35cb6a0edde70173140eb48ecaef2207c27f1ac3553",   //nolint:lll
-			},
-			chat2: {
-				"3131313131313131313131317234451261f7b68687a83a15f54a7a5e4391a16713204bb5d664a260d2befa3647b6c5c45e6cdb8fa8c9b39a4806a3dddbd14950c1409a450d147c655377505078aaae1f61fb513a5fb238f3a8d0217f1e7d13ff6a84dfe5031e815b6f3584d1c2bd37f9c70ad4ee049e05b147861b71c74aa5029b328aa5e7adf6561e9853694a329012b35cb6a0edde4c98bcdfee9a02f37226affd75f50b84",   //nolint:lll
-				"3131313131313131313131317234451261f7b6d3d0fb6e10a94f7c5e4391a13613204bb5d664fa31d2e5fa3647b6c5c45e6cdb8fa8c9b39a4806a3dddbd14950c1409a450d147c655377505078aaae1f61fb513a5fb238f3a8d0217f1e7d13ff6a84dfe5031e815b6f3584d1c2bd37f9c70ad4ee049e05b147861b71c74aa5029b318aa5e7adf6561e9853694a329012b34ea5b9fbc6a7ffc511d84310df726032cda90e98a6ad", //nolint:lll
-			},
-			chat3: {
-				"3131313131313131313131317234451261f7b68585f86b12f3482f5e4391a16713204bb5d664f93181bafa3647b6c5c45e6cdb8fa8c9b39a4806a3dddbd14950c1409a445715796c007d075078aaae1f61fb513a5fb261abae84217f1e7d13ff6a84dfe5031e815b6f3584d1c2bd37f9c70ad4ee049e04b147861b71c74aa5029b328aa5e7adf6561e9853694a329012b35cb6a0eddea5dddf50c8cf2db87902fb33c56471a4", //nolint:lll
-			},
-		}
+		keyring, err := msgproducer.NewKeyring(map[string]string{"": encryptKey})
+		s.Require().NoError(err)
+
+		expectedChatMsgs := expectedChatMessages()
 
 		for chatID, chatMsgs := range producedMsgsByKey {
 			s.Require().Len(chatMsgs, len(expectedChatMsgs[chatID]))
 			for i, m := range chatMsgs {
-				s.Equal(expectedChatMsgs[chatID][i], hex.EncodeToString(m.Value),
-					"chat = %s, msg #%d", chatID, i,
-				)
+				s.True(bytes.HasPrefix(m.Value, bytes.Repeat([]byte{'1'}, 12)), "nonce is prepended")
+
+				data, err := keyring.Decrypt(msgbus.FromKafkaMessage(m))
+				s.Require().NoError(err)
+				s.JSONEq(expectedChatMsgs[chatID][i], s.stripEnvelope(data), "chat = %s, msg #%d", chatID, i)
 			}
 		}
 	})
@@ -206,6 +186,27 @@ func (s *ServiceIntegrationSuite) consumeMessages(n int) []kafka.Message {
 	return result
 }
 
+// stripEnvelope checks the envelope fields and returns the rest of the message.
+func (s *ServiceIntegrationSuite) stripEnvelope(data []byte) string {
+	s.T().Helper()
+
+	var m map[string]any
+	s.Require().NoError(json.Unmarshal(data, &m))
+
+	s.EqualValues(msgproducer.SchemaVersion, m["schemaVersion"])
+	s.Equal(msgproducer.ContentType, m["contentType"])
+	s.NotEmpty(m["producerVersion"])
+	s.NotEmpty(m["eventTime"])
+	for _, f := range []string{"schemaVersion", "contentType", "producerVersion", "eventTime"} {
+		delete(m, f)
+	}
+
+	result, err := json.Marshal(m)
+	s.Require().NoError(err)
+
+	return string(result)
+}
+
 func groupByKey(msgs []kafka.Message) map[string][]kafka.Message {
 	result := make(map[string][]kafka.Message)
 	for _, m := range msgs {
@@ -216,7 +217,27 @@ func groupByKey(msgs []kafka.Message) map[string][]kafka.Message {
 
 // Fixtures.
 
-const chatsNumber = 3
+const (
+	chatsNumber = 3
+	encryptKey  = "68566D597133743677397A2443264629"
+)
+
+func expectedChatMessages() map[string][]string {
+	return map[string][]string{
+		chat1: {
+			fmt.Sprintf(`{"id":%q,"chatId":%q,"body":"chat 1, message 1","fromClient":true}`, msg11, chat1),
+			fmt.Sprintf(`{"id":%q,"chatId":%q,"body":"chat 1, message 2","fromClient":false}`, msg12, chat1),
+			fmt.Sprintf(`{"id":%q,"chatId":%q,"body":"chat 1, message 3","fromClient":true}`, msg13, chat1),
+		},
+		chat2: {
+			fmt.Sprintf(`{"id":%q,"chatId":%q,"body":"chat 2, message 1","fromClient":true}`, msg21, chat2),
+			fmt.Sprintf(`{"id":%q,"chatId":%q,"body":"chat 2, message 2","fromClient":false}`, msg22, chat2),
+		},
+		chat3: {
+			fmt.Sprintf(`{"id":%q,"chatId":%q,"body":"chat 3, message 1","fromClient":true}`, msg31, chat3),
+		},
+	}
+}
 
 var (
 	chat1 = "86ba45bc-84fd-11ed-9104-461e464ebed8"
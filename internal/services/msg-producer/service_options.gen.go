@@ -4,6 +4,7 @@ package msgproducer
 import (
 	fmt461e464ebed9 "fmt"
 
+	msgbus "github.com/karasunokami/chat-service/internal/services/msg-bus"
 	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
 	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
 )
@@ -11,7 +12,7 @@ import (
 type OptOptionsSetter func(o *Options)
 
 func NewOptions(
-	wr KafkaWriter,
+	wr msgbus.Writer,
 	options ...OptOptionsSetter,
 ) Options {
 	o := Options{}
@@ -38,6 +39,18 @@ func WithNonceFactory(opt func(size int) ([]byte, error)) OptOptionsSetter {
 	}
 }
 
+func WithEncryptKeys(opt map[string]string) OptOptionsSetter {
+	return func(o *Options) {
+		o.encryptKeys = opt
+	}
+}
+
+func WithActiveEncryptKeyID(opt string) OptOptionsSetter {
+	return func(o *Options) {
+		o.activeEncryptKeyID = opt
+	}
+}
+
 func (o *Options) Validate() error {
 	errs := new(errors461e464ebed9.ValidationErrors)
 	errs.Add(errors461e464ebed9.NewValidationError("wr", _validate_Options_wr(o)))
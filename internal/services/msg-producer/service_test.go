@@ -9,10 +9,10 @@ import (
 	"fmt"
 	"testing"
 
+	msgbus "github.com/karasunokami/chat-service/internal/services/msg-bus"
 	msgproducer "github.com/karasunokami/chat-service/internal/services/msg-producer"
 	"github.com/karasunokami/chat-service/internal/types"
 
-	"github.com/segmentio/kafka-go"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 )
@@ -37,7 +37,7 @@ func TestService_ProduceMessage(t *testing.T) {
 	for _, tt := range cases {
 		t.Run(tt.name, func(t *testing.T) {
 			// Arrange.
-			writer := new(kafkaWriterMock)
+			writer := new(writerMock)
 			s, err := msgproducer.New(msgproducer.NewOptions(writer, msgproducer.WithEncryptKey(tt.key)))
 			require.NoError(t, err)
 			defer func() {
@@ -73,6 +73,12 @@ func TestService_ProduceMessage(t *testing.T) {
 				msg := requireMsgUnmarshal(t, data)
 				assert.Equal(t, []byte(msg.ChatID.String()), m.Key)
 
+				if tt.key == "" {
+					decoded, err := msgproducer.DecodePlain(m)
+					require.NoError(t, err)
+					assert.Equal(t, msg, decoded)
+				}
+
 				produced = append(produced, msg)
 			}
 			assert.Equal(t, msgs, produced)
@@ -80,6 +86,113 @@ func TestService_ProduceMessage(t *testing.T) {
 	}
 }
 
+func TestService_EncryptKeyRotation(t *testing.T) {
+	const (
+		oldKeyID = "2026-09"
+		oldKey   = "24432646294A404E635266546A576E5A"
+		newKeyID = "2026-10"
+		newKey   = "68566D597133743677397A2443264629"
+	)
+
+	produce := func(t *testing.T, opts ...msgproducer.OptOptionsSetter) (msgproducer.Message, msgbus.Message) {
+		t.Helper()
+
+		writer := new(writerMock)
+		s, err := msgproducer.New(msgproducer.NewOptions(writer, opts...))
+		require.NoError(t, err)
+
+		msg := msgproducer.Message{
+			ID:         types.NewMessageID(),
+			ChatID:     types.NewChatID(),
+			Body:       "Hello!",
+			FromClient: true,
+		}
+		require.NoError(t, s.ProduceMessage(context.Background(), msg))
+		require.Len(t, writer.msgs, 1)
+
+		return msg, writer.msgs[0]
+	}
+
+	// Legacy single key, no key ID header.
+	legacyMsg, legacyKafkaMsg := produce(t, msgproducer.WithEncryptKey(oldKey))
+
+	// Before the rotation.
+	beforeMsg, beforeKafkaMsg := produce(t,
+		msgproducer.WithEncryptKeys(map[string]string{oldKeyID: oldKey}),
+		msgproducer.WithActiveEncryptKeyID(oldKeyID),
+	)
+
+	// After the rotation, the old key is kept for the in-flight messages.
+	afterMsg, afterKafkaMsg := produce(t,
+		msgproducer.WithEncryptKeys(map[string]string{oldKeyID: oldKey, newKeyID: newKey}),
+		msgproducer.WithActiveEncryptKeyID(newKeyID),
+	)
+
+	for _, h := range legacyKafkaMsg.Headers {
+		assert.NotEqual(t, msgproducer.EncryptionKeyIDHeader, h.Key)
+	}
+	assert.Contains(t, afterKafkaMsg.Headers, msgbus.Header{Key: msgproducer.EncryptionKeyIDHeader, Value: []byte(newKeyID)})
+
+	t.Run("consumer with both keys", func(t *testing.T) {
+		keyring, err := msgproducer.NewKeyring(map[string]string{"": oldKey, oldKeyID: oldKey, newKeyID: newKey})
+		require.NoError(t, err)
+
+		for _, tt := range []struct {
+			exp msgproducer.Message
+			m   msgbus.Message
+		}{
+			{exp: legacyMsg, m: legacyKafkaMsg},
+			{exp: beforeMsg, m: beforeKafkaMsg},
+			{exp: afterMsg, m: afterKafkaMsg},
+		} {
+			msg, err := keyring.Decode(tt.m)
+			require.NoError(t, err)
+			assert.Equal(t, tt.exp, msg)
+		}
+	})
+
+	t.Run("consumer without old key", func(t *testing.T) {
+		keyring, err := msgproducer.NewKeyring(map[string]string{newKeyID: newKey})
+		require.NoError(t, err)
+
+		_, err = keyring.Decode(beforeKafkaMsg)
+		require.ErrorIs(t, err, msgproducer.ErrUnknownEncryptionKey)
+
+		msg, err := keyring.Decode(afterKafkaMsg)
+		require.NoError(t, err)
+		assert.Equal(t, afterMsg, msg)
+	})
+
+	t.Run("key id does not match key", func(t *testing.T) {
+		keyring, err := msgproducer.NewKeyring(map[string]string{newKeyID: oldKey})
+		require.NoError(t, err)
+
+		_, err = keyring.Decode(afterKafkaMsg)
+		require.ErrorIs(t, err, msgproducer.ErrMalformedMessage)
+	})
+}
+
+func TestService_InvalidKeyring(t *testing.T) {
+	for name, opts := range map[string][]msgproducer.OptOptionsSetter{
+		"no active key": {
+			msgproducer.WithEncryptKeys(map[string]string{"k1": "24432646294A404E635266546A576E5A"}),
+		},
+		"unknown active key": {
+			msgproducer.WithEncryptKeys(map[string]string{"k1": "24432646294A404E635266546A576E5A"}),
+			msgproducer.WithActiveEncryptKeyID("k2"),
+		},
+		"invalid key": {
+			msgproducer.WithEncryptKeys(map[string]string{"k1": "not hex"}),
+			msgproducer.WithActiveEncryptKeyID("k1"),
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			_, err := msgproducer.New(msgproducer.NewOptions(new(writerMock), opts...))
+			require.Error(t, err)
+		})
+	}
+}
+
 func requireMsgDecrypt(t *testing.T, keyStr string, data []byte) []byte {
 	t.Helper()
 
@@ -120,19 +233,19 @@ func requireMsgUnmarshal(t *testing.T, data []byte) msgproducer.Message {
 	}
 }
 
-var _ msgproducer.KafkaWriter = (*kafkaWriterMock)(nil)
+var _ msgbus.Writer = (*writerMock)(nil)
 
-type kafkaWriterMock struct {
-	msgs   []kafka.Message
+type writerMock struct {
+	msgs   []msgbus.Message
 	closed bool
 }
 
-func (m *kafkaWriterMock) Close() error {
+func (m *writerMock) Close() error {
 	m.closed = true
 	return nil
 }
 
-func (m *kafkaWriterMock) WriteMessages(_ context.Context, msgs ...kafka.Message) error {
+func (m *writerMock) WriteMessages(_ context.Context, msgs ...msgbus.Message) error {
 	m.msgs = append(m.msgs, msgs...)
 	return nil
 }
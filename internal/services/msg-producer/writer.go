@@ -1,20 +0,0 @@
-package msgproducer
-
-import (
-	"github.com/karasunokami/chat-service/internal/logger"
-
-	"github.com/segmentio/kafka-go"
-)
-
-func NewKafkaWriter(brokers []string, topic string, batchSize int) KafkaWriter {
-	return &kafka.Writer{
-		Addr:         kafka.TCP(brokers...),
-		Topic:        topic,
-		Balancer:     &kafka.CRC32Balancer{},
-		BatchSize:    batchSize,
-		RequiredAcks: kafka.RequireOne,
-		Async:        false,
-		Logger:       logger.NewKafkaAdapted().WithServiceName(serviceName),
-		ErrorLogger:  logger.NewKafkaAdapted().WithServiceName(serviceName).ForErrors(),
-	}
-}
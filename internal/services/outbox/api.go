@@ -9,6 +9,11 @@ import (
 )
 
 func (s *Service) Put(ctx context.Context, name, payload string, availableAt time.Time) (types.JobID, error) {
+	payload, err := s.wrapPayload(ctx, payload)
+	if err != nil {
+		return types.JobIDNil, fmt.Errorf("wrap payload with trace context, err=%v", err)
+	}
+
 	jobID, err := s.jobsRepo.CreateJob(ctx, name, payload, availableAt)
 	if err != nil {
 		return types.JobIDNil, fmt.Errorf("jobs repo create job, err=%v", err)
@@ -16,3 +21,22 @@ func (s *Service) Put(ctx context.Context, name, payload string, availableAt tim
 
 	return jobID, nil
 }
+
+// PutMany puts a job with the same name for every payload using a single insert.
+func (s *Service) PutMany(ctx context.Context, name string, payloads []string, availableAt time.Time) ([]types.JobID, error) {
+	wrapped := make([]string, 0, len(payloads))
+	for _, p := range payloads {
+		payload, err := s.wrapPayload(ctx, p)
+		if err != nil {
+			return nil, fmt.Errorf("wrap payload with trace context, err=%v", err)
+		}
+		wrapped = append(wrapped, payload)
+	}
+
+	jobIDs, err := s.jobsRepo.CreateJobs(ctx, name, wrapped, availableAt)
+	if err != nil {
+		return nil, fmt.Errorf("jobs repo create jobs, err=%v", err)
+	}
+
+	return jobIDs, nil
+}
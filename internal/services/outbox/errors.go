@@ -7,6 +7,11 @@ import (
 
 var ErrJobAlreadyExists = errors.New("job with provided name already registered")
 
+var (
+	errNotRunning       = errors.New("outbox is not running")
+	errHeartbeatTimeout = errors.New("outbox heartbeat timeout")
+)
+
 type jobFailedError struct {
 	reason string
 }
@@ -0,0 +1,89 @@
+package managermessagesentjob_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	messagesrepo "github.com/karasunokami/chat-service/internal/repositories/messages"
+	eventstream "github.com/karasunokami/chat-service/internal/services/event-stream"
+	"github.com/karasunokami/chat-service/internal/services/outbox"
+	managermessagesentjob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/manager-message-sent"
+	managermessagesentjobmocks "github.com/karasunokami/chat-service/internal/services/outbox/jobs/manager-message-sent/mocks"
+	"github.com/karasunokami/chat-service/internal/types"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJob_Handle(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	msgRepo := managermessagesentjobmocks.NewMockmessageRepository(ctrl)
+	eventStream := managermessagesentjobmocks.NewMockeventStream(ctrl)
+	job, err := managermessagesentjob.New(managermessagesentjob.NewOptions(eventStream, msgRepo))
+	require.NoError(t, err)
+
+	clientID := types.NewUserID()
+	managerID := types.NewUserID()
+	problemID := types.NewProblemID()
+
+	msg := messagesrepo.Message{
+		ID:                  types.NewMessageID(),
+		ChatID:              types.NewChatID(),
+		AuthorID:            managerID,
+		InitialRequestID:    types.NewRequestID(),
+		ProblemID:           problemID,
+		Body:                "Hello!",
+		CreatedAt:           time.Now(),
+		IsVisibleForClient:  true,
+		IsVisibleForManager: true,
+	}
+
+	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msg.ID).Return(&msg, nil)
+	msgRepo.EXPECT().GetFirstProblemMessage(gomock.Any(), problemID).
+		Return(&messagesrepo.Message{ID: types.NewMessageID(), AuthorID: clientID}, nil)
+
+	eventStream.EXPECT().Publish(ctx, clientID, &eventstream.NewMessageEvent{
+		RequestID:   msg.InitialRequestID,
+		ChatID:      msg.ChatID,
+		MessageID:   msg.ID,
+		CreatedAt:   msg.CreatedAt,
+		MessageBody: msg.Body,
+		AuthorID:    managerID,
+	}).Return(nil)
+
+	// Action & assert.
+	payload, err := outbox.MarshalMessageIDPayload(msg.ID)
+	require.NoError(t, err)
+
+	err = job.Handle(ctx, payload)
+	require.NoError(t, err)
+}
+
+func TestJob_Handle_MessageNotFound(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+
+	msgRepo := managermessagesentjobmocks.NewMockmessageRepository(ctrl)
+	eventStream := managermessagesentjobmocks.NewMockeventStream(ctrl)
+	job, err := managermessagesentjob.New(managermessagesentjob.NewOptions(eventStream, msgRepo))
+	require.NoError(t, err)
+
+	msgID := types.NewMessageID()
+	msgRepo.EXPECT().GetMessageByID(gomock.Any(), msgID).Return(nil, messagesrepo.ErrMsgNotFound)
+
+	// Action & assert.
+	payload, err := outbox.MarshalMessageIDPayload(msgID)
+	require.NoError(t, err)
+
+	err = job.Handle(ctx, payload)
+	require.Error(t, err)
+}
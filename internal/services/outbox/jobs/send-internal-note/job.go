@@ -0,0 +1,81 @@
+package sendinternalnotejob
+
+import (
+	"context"
+	"fmt"
+
+	messagesrepo "github.com/karasunokami/chat-service/internal/repositories/messages"
+	eventstream "github.com/karasunokami/chat-service/internal/services/event-stream"
+	"github.com/karasunokami/chat-service/internal/services/outbox"
+	"github.com/karasunokami/chat-service/internal/types"
+)
+
+const Name = "send-internal-note"
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/job_mock.gen.go -package=sendinternalnotejobmocks
+
+type eventStream interface {
+	Publish(ctx context.Context, userID types.UserID, event eventstream.Event) error
+}
+
+type messagesRepo interface {
+	GetMessageByID(ctx context.Context, id types.MessageID) (*messagesrepo.Message, error)
+}
+
+//go:generate options-gen -out-filename=job_options.gen.go -from-struct=Options
+type Options struct {
+	eventStream  eventStream  `option:"mandatory" validate:"required"`
+	messagesRepo messagesRepo `option:"mandatory" validate:"required"`
+}
+
+// Job delivers the internal note to the staff only. Unlike the manager messages,
+// the note is not produced to AFC and is not published to the client.
+type Job struct {
+	Options
+	outbox.DefaultJob
+}
+
+func New(opts Options) (*Job, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate options, err=%v", err)
+	}
+
+	return &Job{Options: opts}, nil
+}
+
+func (j *Job) Name() string {
+	return Name
+}
+
+func (j *Job) Handle(ctx context.Context, payload string) error {
+	pl, err := UnmarshalPayload(payload)
+	if err != nil {
+		return fmt.Errorf("unmarshal payload, err=%v", err)
+	}
+
+	msg, err := j.messagesRepo.GetMessageByID(ctx, pl.MessageID)
+	if err != nil {
+		return fmt.Errorf("messages repo, get message by id, err=%v", err)
+	}
+
+	// The note is published once, the supervisors watching the chat get it anyway.
+	recipientID := pl.ManagerID
+	if recipientID.IsZero() {
+		recipientID = msg.AuthorID
+	}
+
+	err = j.eventStream.Publish(ctx, recipientID, eventstream.NewNewInternalNoteEvent(
+		types.NewEventID(),
+		msg.InitialRequestID,
+		msg.ChatID,
+		msg.ID,
+		msg.CreatedAt,
+		msg.Body,
+		msg.AuthorID,
+	))
+	if err != nil {
+		return fmt.Errorf("publish internal note to event stream, err=%v", err)
+	}
+
+	return nil
+}
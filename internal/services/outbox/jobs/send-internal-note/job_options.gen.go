@@ -0,0 +1,50 @@
+// Code generated by options-gen. DO NOT EDIT.
+package sendinternalnotejob
+
+import (
+	fmt461e464ebed9 "fmt"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	eventStream eventStream,
+	messagesRepo messagesRepo,
+	options ...OptOptionsSetter,
+) Options {
+	o := Options{}
+
+	// Setting defaults from field tag (if present)
+
+	o.eventStream = eventStream
+	o.messagesRepo = messagesRepo
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("eventStream", _validate_Options_eventStream(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("messagesRepo", _validate_Options_messagesRepo(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_eventStream(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.eventStream, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `eventStream` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_messagesRepo(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.messagesRepo, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `messagesRepo` did not pass the test: %w", err)
+	}
+	return nil
+}
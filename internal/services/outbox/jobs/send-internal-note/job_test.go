@@ -0,0 +1,81 @@
+package sendinternalnotejob_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	messagesrepo "github.com/karasunokami/chat-service/internal/repositories/messages"
+	eventstream "github.com/karasunokami/chat-service/internal/services/event-stream"
+	sendinternalnotejob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/send-internal-note"
+	sendinternalnotejobmocks "github.com/karasunokami/chat-service/internal/services/outbox/jobs/send-internal-note/mocks"
+	"github.com/karasunokami/chat-service/internal/types"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJob_Handle(t *testing.T) {
+	cases := []struct {
+		name      string
+		authorID  types.UserID
+		managerID types.UserID
+		recipient func(authorID, managerID types.UserID) types.UserID
+	}{
+		{
+			name:      "note is published to the assigned manager",
+			authorID:  types.NewUserID(),
+			managerID: types.NewUserID(),
+			recipient: func(_, managerID types.UserID) types.UserID { return managerID },
+		},
+		{
+			name:      "note of the waiting problem is published to its author",
+			authorID:  types.NewUserID(),
+			managerID: types.UserIDNil,
+			recipient: func(authorID, _ types.UserID) types.UserID { return authorID },
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange.
+			ctx := context.Background()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			eventStream := sendinternalnotejobmocks.NewMockeventStream(ctrl)
+			msgRepo := sendinternalnotejobmocks.NewMockmessagesRepo(ctrl)
+			job, err := sendinternalnotejob.New(sendinternalnotejob.NewOptions(eventStream, msgRepo))
+			require.NoError(t, err)
+
+			msg := messagesrepo.Message{
+				ID:                  types.NewMessageID(),
+				ChatID:              types.NewChatID(),
+				AuthorID:            tt.authorID,
+				InitialRequestID:    types.NewRequestID(),
+				Body:                "client verified by phone",
+				CreatedAt:           time.Now(),
+				IsVisibleForManager: true,
+				IsInternalNote:      true,
+			}
+			msgRepo.EXPECT().GetMessageByID(ctx, msg.ID).Return(&msg, nil)
+
+			eventStream.EXPECT().Publish(ctx, tt.recipient(tt.authorID, tt.managerID), eventstream.NewNewInternalNoteEvent(
+				types.NewEventID(),
+				msg.InitialRequestID,
+				msg.ChatID,
+				msg.ID,
+				msg.CreatedAt,
+				msg.Body,
+				msg.AuthorID,
+			)).Return(nil)
+
+			payload, err := sendinternalnotejob.MarshalPayload(msg.ID, tt.managerID)
+			require.NoError(t, err)
+
+			// Action & assert.
+			require.NoError(t, job.Handle(ctx, payload))
+		})
+	}
+}
@@ -86,6 +86,11 @@ func (j *Job) Handle(ctx context.Context, payload string) error {
 		return fmt.Errorf("publish message to event stream, err=%v", err)
 	}
 
+	if !msg.IsVisibleForClient {
+		// The message is held for the AFC verdict, it is delivered to the client by the manager-message-sent job.
+		return nil
+	}
+
 	err = j.eventStream.Publish(ctx, firstMessage.AuthorID, eventstream.NewNewMessageEvent(
 		types.NewEventID(),
 		msg.InitialRequestID,
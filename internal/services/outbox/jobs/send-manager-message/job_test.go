@@ -0,0 +1,95 @@
+package sendmanagermessagejob_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	messagesrepo "github.com/karasunokami/chat-service/internal/repositories/messages"
+	eventstream "github.com/karasunokami/chat-service/internal/services/event-stream"
+	msgproducer "github.com/karasunokami/chat-service/internal/services/msg-producer"
+	sendmanagermessagejob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/send-manager-message"
+	sendmanagermessagejobmocks "github.com/karasunokami/chat-service/internal/services/outbox/jobs/send-manager-message/mocks"
+	"github.com/karasunokami/chat-service/internal/types"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+func TestJob_Handle(t *testing.T) {
+	cases := []struct {
+		name           string
+		heldForAFC     bool
+		expClientEvent bool
+	}{
+		{name: "full visible", expClientEvent: true},
+		{name: "held for afc", heldForAFC: true},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			// Arrange.
+			ctx := context.Background()
+
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+
+			eventStream := sendmanagermessagejobmocks.NewMockeventStream(ctrl)
+			msgProducer := sendmanagermessagejobmocks.NewMockmessageProducer(ctrl)
+			msgRepo := sendmanagermessagejobmocks.NewMockmessagesRepo(ctrl)
+
+			job, err := sendmanagermessagejob.New(sendmanagermessagejob.NewOptions(eventStream, msgProducer, msgRepo))
+			require.NoError(t, err)
+
+			clientID, managerID := types.NewUserID(), types.NewUserID()
+			msg := messagesrepo.Message{
+				ID:                  types.NewMessageID(),
+				ChatID:              types.NewChatID(),
+				AuthorID:            managerID,
+				InitialRequestID:    types.NewRequestID(),
+				ProblemID:           types.NewProblemID(),
+				Body:                "Hello!",
+				CreatedAt:           time.Now(),
+				IsVisibleForClient:  !tt.heldForAFC,
+				IsVisibleForManager: true,
+			}
+
+			msgRepo.EXPECT().GetMessageByID(gomock.Any(), msg.ID).Return(&msg, nil)
+			msgRepo.EXPECT().GetFirstProblemMessage(gomock.Any(), msg.ProblemID).
+				Return(&messagesrepo.Message{ID: types.NewMessageID(), AuthorID: clientID}, nil)
+
+			msgProducer.EXPECT().ProduceMessage(gomock.Any(), msgproducer.Message{
+				ID:     msg.ID,
+				ChatID: msg.ChatID,
+				Body:   msg.Body,
+			}).Return(nil)
+
+			eventStream.EXPECT().Publish(gomock.Any(), managerID, &eventstream.NewManagerMessageEvent{
+				RequestID:   msg.InitialRequestID,
+				ChatID:      msg.ChatID,
+				MessageID:   msg.ID,
+				CreatedAt:   msg.CreatedAt,
+				MessageBody: msg.Body,
+				AuthorID:    managerID,
+			}).Return(nil)
+
+			if tt.expClientEvent {
+				eventStream.EXPECT().Publish(gomock.Any(), clientID, &eventstream.NewMessageEvent{
+					RequestID:   msg.InitialRequestID,
+					ChatID:      msg.ChatID,
+					MessageID:   msg.ID,
+					CreatedAt:   msg.CreatedAt,
+					MessageBody: msg.Body,
+					AuthorID:    managerID,
+				}).Return(nil)
+			}
+
+			// Action & assert.
+			payload, err := sendmanagermessagejob.MarshalPayload(msg.ID, managerID)
+			require.NoError(t, err)
+
+			err = job.Handle(ctx, payload)
+			require.NoError(t, err)
+		})
+	}
+}
@@ -0,0 +1,171 @@
+package sendscheduledmessagejob
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	messagesrepo "github.com/karasunokami/chat-service/internal/repositories/messages"
+	problemsrepo "github.com/karasunokami/chat-service/internal/repositories/problems"
+	scheduledmessagesrepo "github.com/karasunokami/chat-service/internal/repositories/scheduledmessages"
+	"github.com/karasunokami/chat-service/internal/services/outbox"
+	sendmanagermessagejob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/send-manager-message"
+	"github.com/karasunokami/chat-service/internal/types"
+
+	"go.uber.org/zap"
+)
+
+const (
+	Name = "send-scheduled-message"
+
+	serviceName = "send-scheduled-message-job"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/job_mock.gen.go -package=sendscheduledmessagejobmocks
+
+type scheduledMessagesRepo interface {
+	GetByID(ctx context.Context, id types.ScheduledMessageID) (*scheduledmessagesrepo.Message, error)
+	Delete(ctx context.Context, id types.ScheduledMessageID) error
+}
+
+type messagesRepo interface {
+	CreateFullVisible(
+		ctx context.Context,
+		reqID types.RequestID,
+		problemID types.ProblemID,
+		chatID types.ChatID,
+		authorID types.UserID,
+		msgBody string,
+	) (*messagesrepo.Message, error)
+	CreateManagerVisible(
+		ctx context.Context,
+		reqID types.RequestID,
+		problemID types.ProblemID,
+		chatID types.ChatID,
+		authorID types.UserID,
+		msgBody string,
+	) (*messagesrepo.Message, error)
+}
+
+type problemsRepo interface {
+	GetAssignedProblemID(ctx context.Context, managerID types.UserID, chatID types.ChatID) (types.ProblemID, error)
+}
+
+type outboxService interface {
+	Put(ctx context.Context, name, payload string, availableAt time.Time) (types.JobID, error)
+}
+
+type transactor interface {
+	RunInTx(ctx context.Context, f func(context.Context) error) error
+}
+
+//go:generate options-gen -out-filename=job_options.gen.go -from-struct=Options
+type Options struct {
+	scheduledMessagesRepo scheduledMessagesRepo `option:"mandatory" validate:"required"`
+	messagesRepo          messagesRepo          `option:"mandatory" validate:"required"`
+	problemsRepo          problemsRepo          `option:"mandatory" validate:"required"`
+	outboxService         outboxService         `option:"mandatory" validate:"required"`
+	transactor            transactor            `option:"mandatory" validate:"required"`
+
+	// holdForAFC keeps the delivered messages invisible for the client until the AFC verdict.
+	holdForAFC bool
+}
+
+type Job struct {
+	Options
+	outbox.DefaultJob
+	logger *zap.Logger
+}
+
+func New(opts Options) (*Job, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate options, err=%v", err)
+	}
+
+	return &Job{
+		Options: opts,
+		logger:  zap.L().Named(serviceName),
+	}, nil
+}
+
+func (j *Job) Name() string {
+	return Name
+}
+
+// Handle turns the scheduled message into a regular manager message and passes it
+// to the send-manager-message job. The message is dropped if it was canceled or
+// the problem it was scheduled for is not open anymore.
+func (j *Job) Handle(ctx context.Context, payload string) error {
+	pl, err := UnmarshalPayload(payload)
+	if err != nil {
+		return fmt.Errorf("unmarshal payload, err=%v", err)
+	}
+
+	scheduled, err := j.scheduledMessagesRepo.GetByID(ctx, pl.ScheduledMessageID)
+	if err != nil {
+		if errors.Is(err, scheduledmessagesrepo.ErrMsgNotFound) {
+			j.logger.Info("Scheduled message was canceled", zap.Stringer("id", pl.ScheduledMessageID))
+			return nil
+		}
+
+		return fmt.Errorf("scheduled messages repo, get by id, err=%v", err)
+	}
+
+	return j.transactor.RunInTx(ctx, func(ctx context.Context) error {
+		problemID, err := j.problemsRepo.GetAssignedProblemID(ctx, scheduled.ManagerID, scheduled.ChatID)
+		if err != nil && !errors.Is(err, problemsrepo.ErrNotFound) {
+			return fmt.Errorf("problems repo, get assigned problem id, err=%v", err)
+		}
+
+		if problemID == scheduled.ProblemID {
+			msg, err := j.createMessage(ctx, scheduled)
+			if err != nil {
+				return err
+			}
+
+			pl, err := sendmanagermessagejob.MarshalPayload(msg.ID, scheduled.ManagerID)
+			if err != nil {
+				return fmt.Errorf("marshal send manager message payload, err=%v", err)
+			}
+
+			_, err = j.outboxService.Put(ctx, sendmanagermessagejob.Name, pl, time.Now())
+			if err != nil {
+				return fmt.Errorf("put send manager message job, err=%v", err)
+			}
+		} else {
+			j.logger.Info("Scheduled message problem is not open anymore, skip delivery",
+				zap.Stringer("id", scheduled.ID),
+				zap.Stringer("problem_id", scheduled.ProblemID),
+			)
+		}
+
+		err = j.scheduledMessagesRepo.Delete(ctx, scheduled.ID)
+		if err != nil {
+			return fmt.Errorf("scheduled messages repo, delete, err=%v", err)
+		}
+
+		return nil
+	})
+}
+
+func (j *Job) createMessage(ctx context.Context, scheduled *scheduledmessagesrepo.Message) (*messagesrepo.Message, error) {
+	create, name := j.messagesRepo.CreateFullVisible, "create full visible"
+	if j.holdForAFC {
+		create, name = j.messagesRepo.CreateManagerVisible, "create manager visible"
+	}
+
+	msg, err := create(
+		ctx,
+		scheduled.InitialRequestID,
+		scheduled.ProblemID,
+		scheduled.ChatID,
+		scheduled.ManagerID,
+		scheduled.Body,
+	)
+	if err != nil {
+		return nil, fmt.Errorf("messages repo, %s, err=%v", name, err)
+	}
+
+	return msg, nil
+}
@@ -0,0 +1,86 @@
+// Code generated by options-gen. DO NOT EDIT.
+package sendscheduledmessagejob
+
+import (
+	fmt461e464ebed9 "fmt"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	scheduledMessagesRepo scheduledMessagesRepo,
+	messagesRepo messagesRepo,
+	problemsRepo problemsRepo,
+	outboxService outboxService,
+	transactor transactor,
+	options ...OptOptionsSetter,
+) Options {
+	o := Options{}
+
+	// Setting defaults from field tag (if present)
+
+	o.scheduledMessagesRepo = scheduledMessagesRepo
+	o.messagesRepo = messagesRepo
+	o.problemsRepo = problemsRepo
+	o.outboxService = outboxService
+	o.transactor = transactor
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func WithHoldForAFC(opt bool) OptOptionsSetter {
+	return func(o *Options) {
+		o.holdForAFC = opt
+	}
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("scheduledMessagesRepo", _validate_Options_scheduledMessagesRepo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("messagesRepo", _validate_Options_messagesRepo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("problemsRepo", _validate_Options_problemsRepo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("outboxService", _validate_Options_outboxService(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("transactor", _validate_Options_transactor(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_scheduledMessagesRepo(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.scheduledMessagesRepo, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `scheduledMessagesRepo` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_messagesRepo(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.messagesRepo, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `messagesRepo` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_problemsRepo(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.problemsRepo, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `problemsRepo` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_outboxService(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.outboxService, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `outboxService` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_transactor(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.transactor, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `transactor` did not pass the test: %w", err)
+	}
+	return nil
+}
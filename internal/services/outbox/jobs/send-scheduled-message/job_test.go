@@ -0,0 +1,187 @@
+package sendscheduledmessagejob_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	messagesrepo "github.com/karasunokami/chat-service/internal/repositories/messages"
+	problemsrepo "github.com/karasunokami/chat-service/internal/repositories/problems"
+	scheduledmessagesrepo "github.com/karasunokami/chat-service/internal/repositories/scheduledmessages"
+	sendmanagermessagejob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/send-manager-message"
+	sendscheduledmessagejob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/send-scheduled-message"
+	sendscheduledmessagejobmocks "github.com/karasunokami/chat-service/internal/services/outbox/jobs/send-scheduled-message/mocks"
+	"github.com/karasunokami/chat-service/internal/types"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/require"
+)
+
+type jobDeps struct {
+	scheduledRepo *sendscheduledmessagejobmocks.MockscheduledMessagesRepo
+	msgRepo       *sendscheduledmessagejobmocks.MockmessagesRepo
+	problemsRepo  *sendscheduledmessagejobmocks.MockproblemsRepo
+	outboxSvc     *sendscheduledmessagejobmocks.MockoutboxService
+	txtor         *sendscheduledmessagejobmocks.Mocktransactor
+}
+
+func newJob(t *testing.T, opts ...sendscheduledmessagejob.OptOptionsSetter) (*sendscheduledmessagejob.Job, jobDeps) {
+	t.Helper()
+
+	ctrl := gomock.NewController(t)
+	t.Cleanup(ctrl.Finish)
+
+	d := jobDeps{
+		scheduledRepo: sendscheduledmessagejobmocks.NewMockscheduledMessagesRepo(ctrl),
+		msgRepo:       sendscheduledmessagejobmocks.NewMockmessagesRepo(ctrl),
+		problemsRepo:  sendscheduledmessagejobmocks.NewMockproblemsRepo(ctrl),
+		outboxSvc:     sendscheduledmessagejobmocks.NewMockoutboxService(ctrl),
+		txtor:         sendscheduledmessagejobmocks.NewMocktransactor(ctrl),
+	}
+	d.txtor.EXPECT().RunInTx(gomock.Any(), gomock.Any()).AnyTimes().DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+
+	job, err := sendscheduledmessagejob.New(sendscheduledmessagejob.NewOptions(
+		d.scheduledRepo,
+		d.msgRepo,
+		d.problemsRepo,
+		d.outboxSvc,
+		d.txtor,
+		opts...,
+	))
+	require.NoError(t, err)
+
+	return job, d
+}
+
+func newScheduledMessage() *scheduledmessagesrepo.Message {
+	return &scheduledmessagesrepo.Message{
+		ID:               types.NewScheduledMessageID(),
+		ChatID:           types.NewChatID(),
+		ProblemID:        types.NewProblemID(),
+		ManagerID:        types.NewUserID(),
+		InitialRequestID: types.NewRequestID(),
+		Body:             "Follow up",
+		DeliverAt:        time.Now(),
+		CreatedAt:        time.Now().Add(-time.Hour),
+	}
+}
+
+func TestJob_Handle_Delivered(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+	job, d := newJob(t)
+	scheduled := newScheduledMessage()
+	msgID := types.NewMessageID()
+
+	d.scheduledRepo.EXPECT().GetByID(ctx, scheduled.ID).Return(scheduled, nil)
+	d.problemsRepo.EXPECT().GetAssignedProblemID(ctx, scheduled.ManagerID, scheduled.ChatID).
+		Return(scheduled.ProblemID, nil)
+	d.msgRepo.EXPECT().CreateFullVisible(
+		ctx,
+		scheduled.InitialRequestID,
+		scheduled.ProblemID,
+		scheduled.ChatID,
+		scheduled.ManagerID,
+		scheduled.Body,
+	).Return(&messagesrepo.Message{ID: msgID}, nil)
+
+	expectedPayload, err := sendmanagermessagejob.MarshalPayload(msgID, scheduled.ManagerID)
+	require.NoError(t, err)
+	d.outboxSvc.EXPECT().Put(ctx, sendmanagermessagejob.Name, expectedPayload, gomock.Any()).
+		Return(types.NewJobID(), nil)
+	d.scheduledRepo.EXPECT().Delete(ctx, scheduled.ID).Return(nil)
+
+	// Action & assert.
+	payload, err := sendscheduledmessagejob.MarshalPayload(scheduled.ID)
+	require.NoError(t, err)
+
+	err = job.Handle(ctx, payload)
+	require.NoError(t, err)
+}
+
+func TestJob_Handle_DeliveredHeldForAFC(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+	job, d := newJob(t, sendscheduledmessagejob.WithHoldForAFC(true))
+	scheduled := newScheduledMessage()
+	msgID := types.NewMessageID()
+
+	d.scheduledRepo.EXPECT().GetByID(ctx, scheduled.ID).Return(scheduled, nil)
+	d.problemsRepo.EXPECT().GetAssignedProblemID(ctx, scheduled.ManagerID, scheduled.ChatID).
+		Return(scheduled.ProblemID, nil)
+	d.msgRepo.EXPECT().CreateManagerVisible(
+		ctx,
+		scheduled.InitialRequestID,
+		scheduled.ProblemID,
+		scheduled.ChatID,
+		scheduled.ManagerID,
+		scheduled.Body,
+	).Return(&messagesrepo.Message{ID: msgID}, nil)
+	d.outboxSvc.EXPECT().Put(ctx, sendmanagermessagejob.Name, gomock.Any(), gomock.Any()).
+		Return(types.NewJobID(), nil)
+	d.scheduledRepo.EXPECT().Delete(ctx, scheduled.ID).Return(nil)
+
+	// Action & assert.
+	payload, err := sendscheduledmessagejob.MarshalPayload(scheduled.ID)
+	require.NoError(t, err)
+
+	err = job.Handle(ctx, payload)
+	require.NoError(t, err)
+}
+
+func TestJob_Handle_Canceled(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+	job, d := newJob(t)
+	id := types.NewScheduledMessageID()
+
+	d.scheduledRepo.EXPECT().GetByID(ctx, id).Return(nil, scheduledmessagesrepo.ErrMsgNotFound)
+
+	// Action & assert.
+	payload, err := sendscheduledmessagejob.MarshalPayload(id)
+	require.NoError(t, err)
+
+	err = job.Handle(ctx, payload)
+	require.NoError(t, err)
+}
+
+func TestJob_Handle_ProblemResolved(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+	job, d := newJob(t)
+	scheduled := newScheduledMessage()
+
+	d.scheduledRepo.EXPECT().GetByID(ctx, scheduled.ID).Return(scheduled, nil)
+	d.problemsRepo.EXPECT().GetAssignedProblemID(ctx, scheduled.ManagerID, scheduled.ChatID).
+		Return(types.ProblemIDNil, problemsrepo.ErrNotFound)
+	d.scheduledRepo.EXPECT().Delete(ctx, scheduled.ID).Return(nil)
+
+	// Action & assert.
+	payload, err := sendscheduledmessagejob.MarshalPayload(scheduled.ID)
+	require.NoError(t, err)
+
+	err = job.Handle(ctx, payload)
+	require.NoError(t, err)
+}
+
+func TestJob_Handle_AnotherProblemOpened(t *testing.T) {
+	// Arrange.
+	ctx := context.Background()
+	job, d := newJob(t)
+	scheduled := newScheduledMessage()
+
+	d.scheduledRepo.EXPECT().GetByID(ctx, scheduled.ID).Return(scheduled, nil)
+	d.problemsRepo.EXPECT().GetAssignedProblemID(ctx, scheduled.ManagerID, scheduled.ChatID).
+		Return(types.NewProblemID(), nil)
+	d.scheduledRepo.EXPECT().Delete(ctx, scheduled.ID).Return(nil)
+
+	// Action & assert.
+	payload, err := sendscheduledmessagejob.MarshalPayload(scheduled.ID)
+	require.NoError(t, err)
+
+	err = job.Handle(ctx, payload)
+	require.NoError(t, err)
+}
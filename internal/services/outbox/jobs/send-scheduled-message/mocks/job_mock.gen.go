@@ -0,0 +1,234 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: job.go
+
+// Package sendscheduledmessagejobmocks is a generated GoMock package.
+package sendscheduledmessagejobmocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	messagesrepo "github.com/karasunokami/chat-service/internal/repositories/messages"
+	scheduledmessagesrepo "github.com/karasunokami/chat-service/internal/repositories/scheduledmessages"
+	types "github.com/karasunokami/chat-service/internal/types"
+)
+
+// MockscheduledMessagesRepo is a mock of scheduledMessagesRepo interface.
+type MockscheduledMessagesRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockscheduledMessagesRepoMockRecorder
+}
+
+// MockscheduledMessagesRepoMockRecorder is the mock recorder for MockscheduledMessagesRepo.
+type MockscheduledMessagesRepoMockRecorder struct {
+	mock *MockscheduledMessagesRepo
+}
+
+// NewMockscheduledMessagesRepo creates a new mock instance.
+func NewMockscheduledMessagesRepo(ctrl *gomock.Controller) *MockscheduledMessagesRepo {
+	mock := &MockscheduledMessagesRepo{ctrl: ctrl}
+	mock.recorder = &MockscheduledMessagesRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockscheduledMessagesRepo) EXPECT() *MockscheduledMessagesRepoMockRecorder {
+	return m.recorder
+}
+
+// Delete mocks base method.
+func (m *MockscheduledMessagesRepo) Delete(ctx context.Context, id types.ScheduledMessageID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Delete", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Delete indicates an expected call of Delete.
+func (mr *MockscheduledMessagesRepoMockRecorder) Delete(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockscheduledMessagesRepo)(nil).Delete), ctx, id)
+}
+
+// GetByID mocks base method.
+func (m *MockscheduledMessagesRepo) GetByID(ctx context.Context, id types.ScheduledMessageID) (*scheduledmessagesrepo.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetByID", ctx, id)
+	ret0, _ := ret[0].(*scheduledmessagesrepo.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetByID indicates an expected call of GetByID.
+func (mr *MockscheduledMessagesRepoMockRecorder) GetByID(ctx, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetByID", reflect.TypeOf((*MockscheduledMessagesRepo)(nil).GetByID), ctx, id)
+}
+
+// MockmessagesRepo is a mock of messagesRepo interface.
+type MockmessagesRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockmessagesRepoMockRecorder
+}
+
+// MockmessagesRepoMockRecorder is the mock recorder for MockmessagesRepo.
+type MockmessagesRepoMockRecorder struct {
+	mock *MockmessagesRepo
+}
+
+// NewMockmessagesRepo creates a new mock instance.
+func NewMockmessagesRepo(ctrl *gomock.Controller) *MockmessagesRepo {
+	mock := &MockmessagesRepo{ctrl: ctrl}
+	mock.recorder = &MockmessagesRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmessagesRepo) EXPECT() *MockmessagesRepoMockRecorder {
+	return m.recorder
+}
+
+// CreateFullVisible mocks base method.
+func (m *MockmessagesRepo) CreateFullVisible(ctx context.Context, reqID types.RequestID, problemID types.ProblemID, chatID types.ChatID, authorID types.UserID, msgBody string) (*messagesrepo.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFullVisible", ctx, reqID, problemID, chatID, authorID, msgBody)
+	ret0, _ := ret[0].(*messagesrepo.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFullVisible indicates an expected call of CreateFullVisible.
+func (mr *MockmessagesRepoMockRecorder) CreateFullVisible(ctx, reqID, problemID, chatID, authorID, msgBody interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFullVisible", reflect.TypeOf((*MockmessagesRepo)(nil).CreateFullVisible), ctx, reqID, problemID, chatID, authorID, msgBody)
+}
+
+// CreateManagerVisible mocks base method.
+func (m *MockmessagesRepo) CreateManagerVisible(ctx context.Context, reqID types.RequestID, problemID types.ProblemID, chatID types.ChatID, authorID types.UserID, msgBody string) (*messagesrepo.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateManagerVisible", ctx, reqID, problemID, chatID, authorID, msgBody)
+	ret0, _ := ret[0].(*messagesrepo.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateManagerVisible indicates an expected call of CreateManagerVisible.
+func (mr *MockmessagesRepoMockRecorder) CreateManagerVisible(ctx, reqID, problemID, chatID, authorID, msgBody interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateManagerVisible", reflect.TypeOf((*MockmessagesRepo)(nil).CreateManagerVisible), ctx, reqID, problemID, chatID, authorID, msgBody)
+}
+
+// MockproblemsRepo is a mock of problemsRepo interface.
+type MockproblemsRepo struct {
+	ctrl     *gomock.Controller
+	recorder *MockproblemsRepoMockRecorder
+}
+
+// MockproblemsRepoMockRecorder is the mock recorder for MockproblemsRepo.
+type MockproblemsRepoMockRecorder struct {
+	mock *MockproblemsRepo
+}
+
+// NewMockproblemsRepo creates a new mock instance.
+func NewMockproblemsRepo(ctrl *gomock.Controller) *MockproblemsRepo {
+	mock := &MockproblemsRepo{ctrl: ctrl}
+	mock.recorder = &MockproblemsRepoMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockproblemsRepo) EXPECT() *MockproblemsRepoMockRecorder {
+	return m.recorder
+}
+
+// GetAssignedProblemID mocks base method.
+func (m *MockproblemsRepo) GetAssignedProblemID(ctx context.Context, managerID types.UserID, chatID types.ChatID) (types.ProblemID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetAssignedProblemID", ctx, managerID, chatID)
+	ret0, _ := ret[0].(types.ProblemID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetAssignedProblemID indicates an expected call of GetAssignedProblemID.
+func (mr *MockproblemsRepoMockRecorder) GetAssignedProblemID(ctx, managerID, chatID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAssignedProblemID", reflect.TypeOf((*MockproblemsRepo)(nil).GetAssignedProblemID), ctx, managerID, chatID)
+}
+
+// MockoutboxService is a mock of outboxService interface.
+type MockoutboxService struct {
+	ctrl     *gomock.Controller
+	recorder *MockoutboxServiceMockRecorder
+}
+
+// MockoutboxServiceMockRecorder is the mock recorder for MockoutboxService.
+type MockoutboxServiceMockRecorder struct {
+	mock *MockoutboxService
+}
+
+// NewMockoutboxService creates a new mock instance.
+func NewMockoutboxService(ctrl *gomock.Controller) *MockoutboxService {
+	mock := &MockoutboxService{ctrl: ctrl}
+	mock.recorder = &MockoutboxServiceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockoutboxService) EXPECT() *MockoutboxServiceMockRecorder {
+	return m.recorder
+}
+
+// Put mocks base method.
+func (m *MockoutboxService) Put(ctx context.Context, name, payload string, availableAt time.Time) (types.JobID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Put", ctx, name, payload, availableAt)
+	ret0, _ := ret[0].(types.JobID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// Put indicates an expected call of Put.
+func (mr *MockoutboxServiceMockRecorder) Put(ctx, name, payload, availableAt interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockoutboxService)(nil).Put), ctx, name, payload, availableAt)
+}
+
+// Mocktransactor is a mock of transactor interface.
+type Mocktransactor struct {
+	ctrl     *gomock.Controller
+	recorder *MocktransactorMockRecorder
+}
+
+// MocktransactorMockRecorder is the mock recorder for Mocktransactor.
+type MocktransactorMockRecorder struct {
+	mock *Mocktransactor
+}
+
+// NewMocktransactor creates a new mock instance.
+func NewMocktransactor(ctrl *gomock.Controller) *Mocktransactor {
+	mock := &Mocktransactor{ctrl: ctrl}
+	mock.recorder = &MocktransactorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mocktransactor) EXPECT() *MocktransactorMockRecorder {
+	return m.recorder
+}
+
+// RunInTx mocks base method.
+func (m *Mocktransactor) RunInTx(ctx context.Context, f func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTx", ctx, f)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTx indicates an expected call of RunInTx.
+func (mr *MocktransactorMockRecorder) RunInTx(ctx, f interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTx", reflect.TypeOf((*Mocktransactor)(nil).RunInTx), ctx, f)
+}
@@ -0,0 +1,32 @@
+package outbox
+
+import (
+	"github.com/karasunokami/chat-service/internal/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	queueDepthGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "outbox",
+		Name:      "queue_depth",
+		Help:      "Number of jobs available for execution.",
+	})
+
+	jobDurationHistogram = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "outbox",
+		Name:      "job_duration_seconds",
+		Help:      "Job execution latency.",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"job"})
+
+	jobFailuresCounter = promauto.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "outbox",
+		Name:      "job_failures_total",
+		Help:      "Number of failed job executions.",
+	}, []string{"job"})
+)
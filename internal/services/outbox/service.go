@@ -4,22 +4,35 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"sync/atomic"
 	"time"
 
 	jobsrepo "github.com/karasunokami/chat-service/internal/repositories/jobs"
+	"github.com/karasunokami/chat-service/internal/tracing"
 	"github.com/karasunokami/chat-service/internal/types"
 
+	"github.com/prometheus/client_golang/prometheus"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 	"golang.org/x/sync/errgroup"
 )
 
-const serviceName = "outbox"
+const (
+	serviceName = "outbox"
+
+	releaseJobTimeout = 3 * time.Second
+
+	queueDepthReportPeriod = 5 * time.Second
+)
 
 type jobsRepository interface {
 	CreateJob(ctx context.Context, name, payload string, availableAt time.Time) (types.JobID, error)
+	CreateJobs(ctx context.Context, name string, payloads []string, availableAt time.Time) ([]types.JobID, error)
 	FindAndReserveJob(ctx context.Context, until time.Time) (jobsrepo.Job, error)
+	CountAvailableJobs(ctx context.Context) (int, error)
 	CreateFailedJob(ctx context.Context, name, payload, reason string) error
 	DeleteJob(ctx context.Context, jobID types.JobID) error
+	ReleaseJob(ctx context.Context, jobID types.JobID) error
 }
 
 type transactor interface {
@@ -33,6 +46,16 @@ type Options struct {
 	reserveFor time.Duration  `option:"mandatory" validate:"min=1s,max=10m"`
 	jobsRepo   jobsRepository `option:"mandatory"`
 	database   transactor     `option:"mandatory"`
+
+	// drainTimeout is a grace period given to in-flight jobs after the service stop.
+	drainTimeout time.Duration `default:"10s" validate:"min=0,max=5m"`
+
+	// heartbeatTimeout is the max allowed time between jobs reserving loop iterations.
+	heartbeatTimeout time.Duration `default:"1m" validate:"min=1s,max=10m"`
+
+	// propagateTraceContext wraps the job payloads with the trace context, see tracedPayload.
+	// The payloads are unwrapped regardless of it, so it is safe to enable once all replicas are updated.
+	propagateTraceContext bool
 }
 
 type Service struct {
@@ -43,6 +66,9 @@ type Service struct {
 	executeJobsCh chan jobsrepo.Job
 
 	jobs map[string]Job
+
+	// heartbeat is the unix nano time of the last jobs reserving loop iteration.
+	heartbeat atomic.Int64
 }
 
 func New(opts Options) (*Service, error) {
@@ -79,12 +105,43 @@ func (s *Service) MustRegisterJob(job Job) {
 	}
 }
 
+// Run processes jobs until ctx is canceled. After that the service drains:
+// it stops reserving new jobs and waits for in-flight jobs for drainTimeout.
+// Jobs that haven't finished in time are canceled and their reservations are released,
+// so another replica could pick them up immediately.
 func (s *Service) Run(ctx context.Context) error {
+	// Jobs context lives longer than ctx to let in-flight jobs finish during draining.
+	jobsCtx, cancelJobs := context.WithCancel(context.Background())
+	defer cancelJobs()
+
+	drained := make(chan struct{})
+	defer close(drained)
+
+	go func() {
+		select {
+		case <-ctx.Done():
+		case <-drained:
+			return
+		}
+
+		s.lg.Info("Draining started", zap.Duration("drain_timeout", s.drainTimeout))
+
+		t := time.NewTimer(s.drainTimeout)
+		defer t.Stop()
+
+		select {
+		case <-t.C:
+			s.lg.Warn("Drain timeout exceeded, cancel in-flight jobs")
+			cancelJobs()
+		case <-drained:
+		}
+	}()
+
 	eg, ctx := errgroup.WithContext(ctx)
 
 	for i := 0; i < s.workers; i++ {
 		eg.Go(func() error {
-			s.runWorker(ctx)
+			s.runWorker(ctx, jobsCtx)
 
 			return nil
 		})
@@ -96,9 +153,39 @@ func (s *Service) Run(ctx context.Context) error {
 		return nil
 	})
 
+	eg.Go(func() error {
+		s.reportQueueDepth(ctx)
+
+		return nil
+	})
+
 	return eg.Wait()
 }
 
+func (s *Service) reportQueueDepth(ctx context.Context) {
+	t := time.NewTicker(queueDepthReportPeriod)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case <-t.C:
+			n, err := s.jobsRepo.CountAvailableJobs(ctx)
+			if err != nil {
+				if ctx.Err() == nil {
+					s.lg.Warn("Count available jobs", zap.Error(err))
+				}
+
+				continue
+			}
+
+			queueDepthGauge.Set(float64(n))
+		}
+	}
+}
+
 func (s *Service) findAndReserveJobs(ctx context.Context) {
 	for {
 		select {
@@ -106,6 +193,8 @@ func (s *Service) findAndReserveJobs(ctx context.Context) {
 			return
 
 		default:
+			s.beat()
+
 			j, err := s.jobsRepo.FindAndReserveJob(ctx, time.Now().Add(s.reserveFor))
 			if err != nil {
 				if errors.Is(err, jobsrepo.ErrNoJobs) {
@@ -114,7 +203,9 @@ func (s *Service) findAndReserveJobs(ctx context.Context) {
 					continue
 				}
 
-				s.lg.Error("Find and reserve job", zap.Error(err))
+				if ctx.Err() == nil {
+					s.lg.Error("Find and reserve job", zap.Error(err))
+				}
 
 				continue
 			}
@@ -125,22 +216,62 @@ func (s *Service) findAndReserveJobs(ctx context.Context) {
 }
 
 func (s *Service) pushJob(ctx context.Context, j jobsrepo.Job) {
-	select {
-	case s.executeJobsCh <- j:
-	case <-ctx.Done():
-		return
+	t := time.NewTicker(s.idleTime)
+	defer t.Stop()
+
+	for {
+		select {
+		case s.executeJobsCh <- j:
+			return
+
+		case <-t.C:
+			// All workers are busy, but the loop is alive.
+			s.beat()
+
+		case <-ctx.Done():
+			// The job was reserved, but no worker will take it.
+			s.releaseJob(j)
+			return
+		}
+	}
+}
+
+func (s *Service) beat() {
+	s.heartbeat.Store(time.Now().UnixNano())
+}
+
+// CheckHeartbeat returns error if the jobs reserving loop is stuck or not running.
+func (s *Service) CheckHeartbeat(_ context.Context) error {
+	last := s.heartbeat.Load()
+	if last == 0 {
+		return errNotRunning
 	}
+
+	if since := time.Since(time.Unix(0, last)); since > s.heartbeatTimeout {
+		return fmt.Errorf("%w: last heartbeat %s ago", errHeartbeatTimeout, since.Round(time.Second))
+	}
+
+	return nil
 }
 
-func (s *Service) runWorker(ctx context.Context) {
+func (s *Service) runWorker(ctx, jobsCtx context.Context) {
 	for {
 		select {
 		case j := <-s.executeJobsCh:
-			err := s.handleJob(ctx, j)
+			err := s.handleJob(jobsCtx, j)
 			if err != nil {
+				// The job interrupted by the drain is not failed, it is released for the next run.
+				if jobsCtx.Err() != nil {
+					s.releaseJob(j)
+
+					continue
+				}
+
+				jobFailuresCounter.WithLabelValues(j.Name).Inc()
+
 				var jobFailedErr *jobFailedError
 				if ok := errors.As(err, &jobFailedErr); ok {
-					s.moveJobToDLQ(ctx, j, jobFailedErr.getReason())
+					s.moveJobToDLQ(jobsCtx, j, jobFailedErr.getReason())
 
 					continue
 				}
@@ -154,6 +285,20 @@ func (s *Service) runWorker(ctx context.Context) {
 	}
 }
 
+// releaseJob makes the unfinished job available for other workers.
+// It uses its own context, because the service contexts are canceled at this moment.
+func (s *Service) releaseJob(j jobsrepo.Job) {
+	ctx, cancel := context.WithTimeout(context.Background(), releaseJobTimeout)
+	defer cancel()
+
+	if err := s.jobsRepo.ReleaseJob(ctx, j.ID); err != nil {
+		s.lg.Error("Release job", zap.String("job", j.Name), zap.Error(err))
+		return
+	}
+
+	s.lg.Info("Job released", zap.String("job", j.Name), zap.Stringer("job_id", j.ID))
+}
+
 func (s *Service) handleJob(ctx context.Context, j jobsrepo.Job) error {
 	serviceJob, ex := s.getServiceJob(j.Name)
 	if !ex {
@@ -179,10 +324,18 @@ func (s *Service) handleJob(ctx context.Context, j jobsrepo.Job) error {
 	return nil
 }
 
-func (s *Service) executeJob(ctx context.Context, serviceJob Job, payload string) error {
+func (s *Service) executeJob(ctx context.Context, serviceJob Job, payload string) (err error) {
+	ctx, payload = unwrapPayload(ctx, payload)
+
+	ctx, span := tracing.Start(ctx, "outbox.job "+serviceJob.Name(), trace.WithSpanKind(trace.SpanKindConsumer))
+	defer func() { tracing.End(span, err) }()
+
 	ctx, cancel := context.WithTimeout(ctx, serviceJob.ExecutionTimeout())
 	defer cancel()
 
+	timer := prometheus.NewTimer(jobDurationHistogram.WithLabelValues(serviceJob.Name()))
+	defer timer.ObserveDuration()
+
 	return serviceJob.Handle(ctx, payload)
 }
 
@@ -22,6 +22,8 @@ func NewOptions(
 	o := Options{}
 
 	// Setting defaults from field tag (if present)
+	o.drainTimeout, _ = time.ParseDuration("10s")
+	o.heartbeatTimeout, _ = time.ParseDuration("1m")
 
 	o.workers = workers
 	o.idleTime = idleTime
@@ -35,11 +37,31 @@ func NewOptions(
 	return o
 }
 
+func WithDrainTimeout(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) {
+		o.drainTimeout = opt
+	}
+}
+
+func WithHeartbeatTimeout(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) {
+		o.heartbeatTimeout = opt
+	}
+}
+
+func WithPropagateTraceContext(opt bool) OptOptionsSetter {
+	return func(o *Options) {
+		o.propagateTraceContext = opt
+	}
+}
+
 func (o *Options) Validate() error {
 	errs := new(errors461e464ebed9.ValidationErrors)
 	errs.Add(errors461e464ebed9.NewValidationError("workers", _validate_Options_workers(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("idleTime", _validate_Options_idleTime(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("reserveFor", _validate_Options_reserveFor(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("drainTimeout", _validate_Options_drainTimeout(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("heartbeatTimeout", _validate_Options_heartbeatTimeout(o)))
 	return errs.AsError()
 }
 
@@ -63,3 +85,17 @@ func _validate_Options_reserveFor(o *Options) error {
 	}
 	return nil
 }
+
+func _validate_Options_drainTimeout(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.drainTimeout, "min=0,max=5m"); err != nil {
+		return fmt461e464ebed9.Errorf("field `drainTimeout` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_heartbeatTimeout(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.heartbeatTimeout, "min=1s,max=10m"); err != nil {
+		return fmt461e464ebed9.Errorf("field `heartbeatTimeout` did not pass the test: %w", err)
+	}
+	return nil
+}
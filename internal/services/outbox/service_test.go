@@ -6,6 +6,7 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"strings"
 	"sync/atomic"
 	"testing"
 	"time"
@@ -15,7 +16,11 @@ import (
 	"github.com/karasunokami/chat-service/internal/testingh"
 
 	"github.com/golang/mock/gomock"
+	"github.com/prometheus/client_golang/prometheus"
 	"github.com/stretchr/testify/suite"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/goleak"
 )
 
@@ -107,6 +112,68 @@ func (s *OutboxServiceSuite) TestPutJob() {
 	s.NotEmpty(j.CreatedAt)
 }
 
+func (s *OutboxServiceSuite) TestPutJob_TraceContextPropagation() {
+	// Arrange.
+	const jobName = "TestPutJob_TraceContextPropagation"
+	const jobPayload = "{}"
+
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	ctx := trace.ContextWithSpanContext(s.Ctx, trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1},
+		SpanID:     trace.SpanID{1},
+		TraceFlags: trace.FlagsSampled,
+	}))
+
+	jobsRepo, err := jobsrepo.New(jobsrepo.NewOptions(s.Database))
+	s.Require().NoError(err)
+	propagatingSvc, err := outbox.New(outbox.NewOptions(
+		workers,
+		idleTime,
+		reserveFor,
+		jobsRepo,
+		s.Database,
+		outbox.WithPropagateTraceContext(true),
+	))
+	s.Require().NoError(err)
+
+	// Action.
+	plainJobID, err := s.outboxSvc.Put(ctx, jobName, jobPayload, time.Now())
+	s.Require().NoError(err)
+	tracedJobID, err := propagatingSvc.Put(ctx, jobName, jobPayload, time.Now())
+	s.Require().NoError(err)
+
+	// Assert.
+	j, err := s.Store.Job.Get(s.Ctx, plainJobID)
+	s.Require().NoError(err)
+	s.Equal(jobPayload, j.Payload)
+
+	j, err = s.Store.Job.Get(s.Ctx, tracedJobID)
+	s.Require().NoError(err)
+	s.Contains(j.Payload, `"traceContext"`)
+	s.Contains(j.Payload, `"payload":"{}"`)
+}
+
+func (s *OutboxServiceSuite) TestPutManyJobs() {
+	// Arrange.
+	const jobName = "TestPutManyJobs"
+	payloads := []string{`{"n":1}`, `{"n":2}`}
+	availableAt := time.Now()
+
+	// Action.
+	jobIDs, err := s.outboxSvc.PutMany(s.Ctx, jobName, payloads, availableAt)
+	s.Require().NoError(err)
+
+	// Assert.
+	s.Require().Len(jobIDs, len(payloads))
+	for i, jobID := range jobIDs {
+		j, err := s.Store.Job.Get(s.Ctx, jobID)
+		s.Require().NoError(err)
+		s.Equal(jobName, j.Name)
+		s.Equal(payloads[i], j.Payload)
+		s.Equal(availableAt.Unix(), j.AvailableAt.Unix())
+	}
+}
+
 func (s *OutboxServiceSuite) TestAllJobsProcessed() {
 	// Arrange.
 	const jobName = "TestAllJobsProcessed"
@@ -227,6 +294,107 @@ func (s *OutboxServiceSuite) TestIfNoJobsThenWorkersSleepForIdleTime() {
 	s.NoError(<-errCh)
 }
 
+func (s *OutboxServiceSuite) TestDrain_InFlightJobFinished() {
+	// Arrange.
+	const jobName = "TestDrain_InFlightJobFinished"
+
+	started := make(chan struct{})
+	job := newJobMock(jobName, func(ctx context.Context, _ string) error {
+		close(started)
+		time.Sleep(300 * time.Millisecond)
+		return nil
+	}, time.Second, 1)
+	s.outboxSvc.MustRegisterJob(job)
+
+	_, err := s.outboxSvc.Put(s.Ctx, jobName, "{}", time.Now())
+	s.Require().NoError(err)
+
+	// Action.
+	cancel, errCh := s.runOutbox()
+	defer cancel()
+
+	<-started
+	cancel()
+	s.NoError(<-errCh)
+
+	// Assert.
+	s.Equal(1, job.ExecutedTimes())
+	s.Equal(0, s.Store.Job.Query().CountX(s.Ctx)) // Job was finished during draining.
+	s.Equal(0, s.Store.FailedJob.Query().CountX(s.Ctx))
+}
+
+func (s *OutboxServiceSuite) TestDrain_UnfinishedJobReleased() {
+	// Arrange.
+	const jobName = "TestDrain_UnfinishedJobReleased"
+
+	jobsRepo, err := jobsrepo.New(jobsrepo.NewOptions(s.Database))
+	s.Require().NoError(err)
+
+	outboxSvc, err := outbox.New(outbox.NewOptions(
+		workers,
+		idleTime,
+		time.Minute,
+		jobsRepo,
+		s.Database,
+		outbox.WithDrainTimeout(100*time.Millisecond),
+	))
+	s.Require().NoError(err)
+
+	started := make(chan struct{})
+	job := newJobMock(jobName, func(ctx context.Context, _ string) error {
+		close(started)
+		<-ctx.Done()
+		return ctx.Err()
+	}, time.Minute, 3)
+	outboxSvc.MustRegisterJob(job)
+
+	jobID, err := outboxSvc.Put(s.Ctx, jobName, "{}", time.Now())
+	s.Require().NoError(err)
+
+	// Action.
+	ctx, cancel := context.WithCancel(s.Ctx)
+	defer cancel()
+
+	errCh := make(chan error)
+	go func() { errCh <- outboxSvc.Run(ctx) }()
+
+	<-started
+	cancel()
+	s.NoError(<-errCh)
+
+	// Assert.
+	s.Equal(1, job.ExecutedTimes())
+	s.Equal(0, s.Store.FailedJob.Query().CountX(s.Ctx))
+
+	j, err := s.Store.Job.Get(s.Ctx, jobID)
+	s.Require().NoError(err)
+	s.False(j.ReservedUntil.After(time.Now())) // Reservation was released instead of waiting for reserve_for.
+	s.Zero(s.jobFailures(jobName))             // Released job is not failed.
+}
+
+// jobFailures returns the failures counter of the job from the default registry.
+func (s *OutboxServiceSuite) jobFailures(jobName string) float64 {
+	s.T().Helper()
+
+	mfs, err := prometheus.DefaultGatherer.Gather()
+	s.Require().NoError(err)
+
+	for _, mf := range mfs {
+		if !strings.HasSuffix(mf.GetName(), "outbox_job_failures_total") {
+			continue
+		}
+		for _, m := range mf.GetMetric() {
+			for _, l := range m.GetLabel() {
+				if l.GetName() == "job" && l.GetValue() == jobName {
+					return m.GetCounter().GetValue()
+				}
+			}
+		}
+	}
+
+	return 0
+}
+
 func (s *OutboxServiceSuite) runOutboxFor(timeout time.Duration) {
 	s.T().Helper()
 
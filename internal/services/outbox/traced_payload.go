@@ -0,0 +1,43 @@
+package outbox
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/karasunokami/chat-service/internal/tracing"
+)
+
+// tracedPayload wraps the job payload to pass the trace context from Put to the job execution.
+type tracedPayload struct {
+	TraceContext map[string]string `json:"traceContext"`
+	Payload      *string           `json:"payload"`
+}
+
+// wrapPayload returns payload as is if the propagation is disabled or ctx has no trace context.
+func (s *Service) wrapPayload(ctx context.Context, payload string) (string, error) {
+	if !s.propagateTraceContext {
+		return payload, nil
+	}
+
+	tc := tracing.InjectMap(ctx)
+	if len(tc) == 0 {
+		return payload, nil
+	}
+
+	data, err := json.Marshal(tracedPayload{TraceContext: tc, Payload: &payload})
+	if err != nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// unwrapPayload returns the original job payload and the context with restored trace context.
+func unwrapPayload(ctx context.Context, payload string) (context.Context, string) {
+	var p tracedPayload
+	if err := json.Unmarshal([]byte(payload), &p); err != nil || p.Payload == nil || p.TraceContext == nil {
+		return ctx, payload
+	}
+
+	return tracing.ExtractMap(ctx, p.TraceContext), *p.Payload
+}
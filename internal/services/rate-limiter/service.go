@@ -0,0 +1,113 @@
+package ratelimiter
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/karasunokami/chat-service/internal/types"
+
+	"golang.org/x/time/rate"
+)
+
+// Limit is the token bucket parameters: RPS tokens are added per second up to the Burst.
+type Limit struct {
+	RPS   float64 `validate:"gt=0"`
+	Burst int     `validate:"min=1"`
+}
+
+//go:generate options-gen -out-filename=service_options.gen.go -from-struct=Options
+type Options struct {
+	defaultLimit Limit `option:"mandatory" validate:"required"`
+
+	// operationsLimits overrides the default limit by operation ID.
+	operationsLimits map[string]Limit `validate:"dive"`
+
+	// idleTTL is the time after which the limiter of inactive user is removed.
+	idleTTL       time.Duration `default:"10m" validate:"min=10ms,max=24h"`
+	cleanupPeriod time.Duration `default:"1m" validate:"min=10ms,max=1h"`
+}
+
+type limiterKey struct {
+	userID    types.UserID
+	operation string
+}
+
+type limiterEntry struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+}
+
+// Service limits the rate of user requests by the token bucket per user and operation.
+type Service struct {
+	Options
+
+	mu       sync.Mutex
+	limiters map[limiterKey]*limiterEntry
+}
+
+func New(opts Options) (*Service, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate options, err=%v", err)
+	}
+
+	return &Service{
+		Options:  opts,
+		limiters: make(map[limiterKey]*limiterEntry),
+	}, nil
+}
+
+// Run periodically removes limiters of inactive users.
+func (s *Service) Run(ctx context.Context) error {
+	t := time.NewTicker(s.cleanupPeriod)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-t.C:
+			s.cleanup()
+		}
+	}
+}
+
+// Allow reports whether the user can perform the operation now.
+func (s *Service) Allow(userID types.UserID, operation string) bool {
+	now := time.Now()
+	key := limiterKey{userID: userID, operation: operation}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.limiters[key]
+	if !ok {
+		limit := s.limit(operation)
+		e = &limiterEntry{limiter: rate.NewLimiter(rate.Limit(limit.RPS), limit.Burst)}
+		s.limiters[key] = e
+	}
+	e.lastSeen = now
+
+	return e.limiter.AllowN(now, 1)
+}
+
+func (s *Service) limit(operation string) Limit {
+	if l, ok := s.operationsLimits[operation]; ok {
+		return l
+	}
+	return s.defaultLimit
+}
+
+func (s *Service) cleanup() {
+	deadline := time.Now().Add(-s.idleTTL)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	for k, e := range s.limiters {
+		if e.lastSeen.Before(deadline) {
+			delete(s.limiters, k)
+		}
+	}
+}
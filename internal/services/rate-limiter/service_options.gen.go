@@ -0,0 +1,85 @@
+// Code generated by options-gen. DO NOT EDIT.
+package ratelimiter
+
+import (
+	fmt461e464ebed9 "fmt"
+	"time"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	defaultLimit Limit,
+	options ...OptOptionsSetter,
+) Options {
+	o := Options{}
+
+	// Setting defaults from field tag (if present)
+	o.idleTTL, _ = time.ParseDuration("10m")
+	o.cleanupPeriod, _ = time.ParseDuration("1m")
+
+	o.defaultLimit = defaultLimit
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func WithOperationsLimits(opt map[string]Limit) OptOptionsSetter {
+	return func(o *Options) {
+		o.operationsLimits = opt
+	}
+}
+
+func WithIdleTTL(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) {
+		o.idleTTL = opt
+	}
+}
+
+func WithCleanupPeriod(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) {
+		o.cleanupPeriod = opt
+	}
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("defaultLimit", _validate_Options_defaultLimit(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("operationsLimits", _validate_Options_operationsLimits(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("idleTTL", _validate_Options_idleTTL(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("cleanupPeriod", _validate_Options_cleanupPeriod(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_defaultLimit(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.defaultLimit, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `defaultLimit` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_operationsLimits(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.operationsLimits, "dive"); err != nil {
+		return fmt461e464ebed9.Errorf("field `operationsLimits` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_idleTTL(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.idleTTL, "min=10ms,max=24h"); err != nil {
+		return fmt461e464ebed9.Errorf("field `idleTTL` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_cleanupPeriod(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.cleanupPeriod, "min=10ms,max=1h"); err != nil {
+		return fmt461e464ebed9.Errorf("field `cleanupPeriod` did not pass the test: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,78 @@
+package ratelimiter_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	ratelimiter "github.com/karasunokami/chat-service/internal/services/rate-limiter"
+	"github.com/karasunokami/chat-service/internal/types"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+)
+
+const (
+	opSendMessage = "PostSendMessage"
+	opGetHistory  = "PostGetHistory"
+)
+
+// slowLimit does not refill the bucket during the test.
+var slowLimit = ratelimiter.Limit{RPS: 0.001, Burst: 2}
+
+func TestNew_InvalidLimits(t *testing.T) {
+	_, err := ratelimiter.New(ratelimiter.NewOptions(ratelimiter.Limit{}))
+	require.Error(t, err)
+
+	_, err = ratelimiter.New(ratelimiter.NewOptions(slowLimit,
+		ratelimiter.WithOperationsLimits(map[string]ratelimiter.Limit{opSendMessage: {RPS: 1}})))
+	require.Error(t, err)
+}
+
+func TestService_Allow(t *testing.T) {
+	s, err := ratelimiter.New(ratelimiter.NewOptions(slowLimit,
+		ratelimiter.WithOperationsLimits(map[string]ratelimiter.Limit{opSendMessage: {RPS: 0.001, Burst: 1}})))
+	require.NoError(t, err)
+
+	user1, user2 := types.NewUserID(), types.NewUserID()
+
+	t.Run("operation limit", func(t *testing.T) {
+		assert.True(t, s.Allow(user1, opSendMessage))
+		assert.False(t, s.Allow(user1, opSendMessage))
+	})
+
+	t.Run("default limit", func(t *testing.T) {
+		assert.True(t, s.Allow(user1, opGetHistory))
+		assert.True(t, s.Allow(user1, opGetHistory))
+		assert.False(t, s.Allow(user1, opGetHistory))
+	})
+
+	t.Run("users are limited independently", func(t *testing.T) {
+		assert.True(t, s.Allow(user2, opSendMessage))
+		assert.False(t, s.Allow(user2, opSendMessage))
+	})
+}
+
+func TestService_Run(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	s, err := ratelimiter.New(ratelimiter.NewOptions(ratelimiter.Limit{RPS: 0.001, Burst: 1},
+		ratelimiter.WithIdleTTL(10*time.Millisecond),
+		ratelimiter.WithCleanupPeriod(10*time.Millisecond),
+	))
+	require.NoError(t, err)
+
+	uid := types.NewUserID()
+	require.True(t, s.Allow(uid, opSendMessage))
+	require.False(t, s.Allow(uid, opSendMessage))
+
+	errCh := make(chan error, 1)
+	go func() { errCh <- s.Run(ctx) }()
+
+	// The limiter of the idle user is removed, so the bucket is full again.
+	assert.Eventually(t, func() bool { return s.Allow(uid, opSendMessage) }, time.Second, 50*time.Millisecond)
+
+	cancel()
+	require.NoError(t, <-errCh)
+}
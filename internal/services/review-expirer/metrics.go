@@ -0,0 +1,15 @@
+package reviewexpirer
+
+import (
+	"github.com/karasunokami/chat-service/internal/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var expiredCounter = promauto.NewCounter(prometheus.CounterOpts{
+	Namespace: metrics.Namespace,
+	Subsystem: "review_expirer",
+	Name:      "expired_total",
+	Help:      "Number of reviewed messages resolved with the default decision.",
+})
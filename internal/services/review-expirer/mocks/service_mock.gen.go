@@ -0,0 +1,103 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: service.go
+
+// Package reviewexpirermocks is a generated GoMock package.
+package reviewexpirermocks
+
+import (
+	context "context"
+	reflect "reflect"
+	time "time"
+
+	gomock "github.com/golang/mock/gomock"
+	types "github.com/karasunokami/chat-service/internal/types"
+)
+
+// MockmessagesRepository is a mock of messagesRepository interface.
+type MockmessagesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockmessagesRepositoryMockRecorder
+}
+
+// MockmessagesRepositoryMockRecorder is the mock recorder for MockmessagesRepository.
+type MockmessagesRepositoryMockRecorder struct {
+	mock *MockmessagesRepository
+}
+
+// NewMockmessagesRepository creates a new mock instance.
+func NewMockmessagesRepository(ctrl *gomock.Controller) *MockmessagesRepository {
+	mock := &MockmessagesRepository{ctrl: ctrl}
+	mock.recorder = &MockmessagesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmessagesRepository) EXPECT() *MockmessagesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetReviewExpiredMessageIDs mocks base method.
+func (m *MockmessagesRepository) GetReviewExpiredMessageIDs(ctx context.Context, before time.Time, limit int) ([]types.MessageID, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetReviewExpiredMessageIDs", ctx, before, limit)
+	ret0, _ := ret[0].([]types.MessageID)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetReviewExpiredMessageIDs indicates an expected call of GetReviewExpiredMessageIDs.
+func (mr *MockmessagesRepositoryMockRecorder) GetReviewExpiredMessageIDs(ctx, before, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetReviewExpiredMessageIDs", reflect.TypeOf((*MockmessagesRepository)(nil).GetReviewExpiredMessageIDs), ctx, before, limit)
+}
+
+// MockreviewResolver is a mock of reviewResolver interface.
+type MockreviewResolver struct {
+	ctrl     *gomock.Controller
+	recorder *MockreviewResolverMockRecorder
+}
+
+// MockreviewResolverMockRecorder is the mock recorder for MockreviewResolver.
+type MockreviewResolverMockRecorder struct {
+	mock *MockreviewResolver
+}
+
+// NewMockreviewResolver creates a new mock instance.
+func NewMockreviewResolver(ctrl *gomock.Controller) *MockreviewResolver {
+	mock := &MockreviewResolver{ctrl: ctrl}
+	mock.recorder = &MockreviewResolverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockreviewResolver) EXPECT() *MockreviewResolverMockRecorder {
+	return m.recorder
+}
+
+// Approve mocks base method.
+func (m *MockreviewResolver) Approve(ctx context.Context, msgID types.MessageID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Approve", ctx, msgID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Approve indicates an expected call of Approve.
+func (mr *MockreviewResolverMockRecorder) Approve(ctx, msgID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Approve", reflect.TypeOf((*MockreviewResolver)(nil).Approve), ctx, msgID)
+}
+
+// Reject mocks base method.
+func (m *MockreviewResolver) Reject(ctx context.Context, msgID types.MessageID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reject", ctx, msgID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reject indicates an expected call of Reject.
+func (mr *MockreviewResolverMockRecorder) Reject(ctx, msgID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reject", reflect.TypeOf((*MockreviewResolver)(nil).Reject), ctx, msgID)
+}
@@ -0,0 +1,109 @@
+package reviewexpirer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	messagesrepo "github.com/karasunokami/chat-service/internal/repositories/messages"
+	"github.com/karasunokami/chat-service/internal/types"
+
+	"go.uber.org/zap"
+)
+
+const (
+	serviceName = "review-expirer"
+
+	// expiredLimit is the max number of messages resolved in one tick.
+	expiredLimit = 100
+)
+
+type Decision string
+
+const (
+	DecisionApprove Decision = "approve"
+	DecisionReject  Decision = "reject"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/service_mock.gen.go -package=reviewexpirermocks
+
+type messagesRepository interface {
+	GetReviewExpiredMessageIDs(ctx context.Context, before time.Time, limit int) ([]types.MessageID, error)
+}
+
+type reviewResolver interface {
+	Approve(ctx context.Context, msgID types.MessageID) error
+	Reject(ctx context.Context, msgID types.MessageID) error
+}
+
+//go:generate options-gen -out-filename=service_options.gen.go -from-struct=Options
+type Options struct {
+	reviewTimeout   time.Duration `option:"mandatory" validate:"min=10ms,max=168h"`
+	defaultDecision Decision      `option:"mandatory" validate:"oneof=approve reject"`
+	checkPeriod     time.Duration `default:"10s" validate:"min=10ms,max=10m"`
+
+	msgRepo  messagesRepository `option:"mandatory" validate:"required"`
+	resolver reviewResolver     `option:"mandatory" validate:"required"`
+}
+
+// Service applies the default decision to the suspicious messages,
+// which have been waiting in the manual review queue longer than the review timeout.
+type Service struct {
+	Options
+	logger *zap.Logger
+}
+
+func New(opts Options) (*Service, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate options, err=%v", err)
+	}
+
+	return &Service{
+		Options: opts,
+		logger:  zap.L().Named(serviceName),
+	}, nil
+}
+
+func (s *Service) Run(ctx context.Context) error {
+	ticker := time.NewTicker(s.checkPeriod)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case <-ticker.C:
+			ids, err := s.msgRepo.GetReviewExpiredMessageIDs(ctx, time.Now().Add(-s.reviewTimeout), expiredLimit)
+			if err != nil {
+				s.logger.Error("Fetch review expired messages", zap.Error(err))
+
+				continue
+			}
+
+			for _, id := range ids {
+				err := s.resolve(ctx, id)
+				if err != nil {
+					if errors.Is(err, messagesrepo.ErrMsgNotUnderReview) {
+						// The supervisor has just made the decision.
+						continue
+					}
+
+					s.logger.Error("Resolve review expired message", zap.Error(err), zap.Stringer("msg_id", id))
+
+					continue
+				}
+
+				expiredCounter.Inc()
+			}
+		}
+	}
+}
+
+func (s *Service) resolve(ctx context.Context, msgID types.MessageID) error {
+	if s.defaultDecision == DecisionApprove {
+		return s.resolver.Approve(ctx, msgID)
+	}
+	return s.resolver.Reject(ctx, msgID)
+}
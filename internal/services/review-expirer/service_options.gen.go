@@ -0,0 +1,86 @@
+// Code generated by options-gen. DO NOT EDIT.
+package reviewexpirer
+
+import (
+	fmt461e464ebed9 "fmt"
+	"time"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	reviewTimeout time.Duration,
+	defaultDecision Decision,
+	msgRepo messagesRepository,
+	resolver reviewResolver,
+	options ...OptOptionsSetter,
+) Options {
+	o := Options{}
+
+	// Setting defaults from field tag (if present)
+	o.checkPeriod, _ = time.ParseDuration("10s")
+
+	o.reviewTimeout = reviewTimeout
+	o.defaultDecision = defaultDecision
+	o.msgRepo = msgRepo
+	o.resolver = resolver
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func WithCheckPeriod(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) {
+		o.checkPeriod = opt
+	}
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("reviewTimeout", _validate_Options_reviewTimeout(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("defaultDecision", _validate_Options_defaultDecision(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("checkPeriod", _validate_Options_checkPeriod(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("msgRepo", _validate_Options_msgRepo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("resolver", _validate_Options_resolver(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_reviewTimeout(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.reviewTimeout, "min=10ms,max=168h"); err != nil {
+		return fmt461e464ebed9.Errorf("field `reviewTimeout` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_defaultDecision(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.defaultDecision, "oneof=approve reject"); err != nil {
+		return fmt461e464ebed9.Errorf("field `defaultDecision` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_checkPeriod(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.checkPeriod, "min=10ms,max=10m"); err != nil {
+		return fmt461e464ebed9.Errorf("field `checkPeriod` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_msgRepo(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.msgRepo, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `msgRepo` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_resolver(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.resolver, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `resolver` did not pass the test: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package reviewexpirer_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	messagesrepo "github.com/karasunokami/chat-service/internal/repositories/messages"
+	reviewexpirer "github.com/karasunokami/chat-service/internal/services/review-expirer"
+	reviewexpirermocks "github.com/karasunokami/chat-service/internal/services/review-expirer/mocks"
+	"github.com/karasunokami/chat-service/internal/testingh"
+	"github.com/karasunokami/chat-service/internal/types"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+const (
+	reviewTimeout = time.Minute
+	checkPeriod   = 10 * time.Millisecond
+)
+
+type ServiceSuite struct {
+	testingh.ContextSuite
+
+	ctrl     *gomock.Controller
+	msgRepo  *reviewexpirermocks.MockmessagesRepository
+	resolver *reviewexpirermocks.MockreviewResolver
+}
+
+func TestServiceSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(ServiceSuite))
+}
+
+func (s *ServiceSuite) SetupTest() {
+	s.ContextSuite.SetupTest()
+
+	s.ctrl = gomock.NewController(s.T())
+	s.msgRepo = reviewexpirermocks.NewMockmessagesRepository(s.ctrl)
+	s.resolver = reviewexpirermocks.NewMockreviewResolver(s.ctrl)
+}
+
+func (s *ServiceSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *ServiceSuite) TestInvalidOptions() {
+	_, err := reviewexpirer.New(reviewexpirer.NewOptions(
+		reviewTimeout, "postpone", s.msgRepo, s.resolver))
+	s.Require().Error(err)
+}
+
+func (s *ServiceSuite) TestDefaultDecisionReject() {
+	// Arrange.
+	msgID := types.NewMessageID()
+
+	s.expectExpired(msgID)
+	s.resolver.EXPECT().Reject(gomock.Any(), msgID).Return(nil)
+
+	// Action & assert.
+	s.runFor(reviewexpirer.DecisionReject, 5*checkPeriod)
+}
+
+func (s *ServiceSuite) TestDefaultDecisionApprove() {
+	// Arrange.
+	msgID := types.NewMessageID()
+
+	s.expectExpired(msgID)
+	s.resolver.EXPECT().Approve(gomock.Any(), msgID).Return(nil)
+
+	// Action & assert.
+	s.runFor(reviewexpirer.DecisionApprove, 5*checkPeriod)
+}
+
+func (s *ServiceSuite) TestErrorsDoNotStopService() {
+	// Arrange.
+	resolvedBySupervisor, failed, expired := types.NewMessageID(), types.NewMessageID(), types.NewMessageID()
+
+	s.msgRepo.EXPECT().GetReviewExpiredMessageIDs(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, errors.New("unexpected"))
+	s.expectExpired(resolvedBySupervisor, failed, expired)
+	s.resolver.EXPECT().Reject(gomock.Any(), resolvedBySupervisor).Return(messagesrepo.ErrMsgNotUnderReview)
+	s.resolver.EXPECT().Reject(gomock.Any(), failed).Return(errors.New("unexpected"))
+	s.resolver.EXPECT().Reject(gomock.Any(), expired).Return(nil)
+
+	// Action & assert.
+	s.runFor(reviewexpirer.DecisionReject, 5*checkPeriod)
+}
+
+func (s *ServiceSuite) expectExpired(ids ...types.MessageID) {
+	s.T().Helper()
+
+	s.msgRepo.EXPECT().GetReviewExpiredMessageIDs(gomock.Any(), gomock.Any(), gomock.Any()).
+		DoAndReturn(func(_ context.Context, before time.Time, _ int) ([]types.MessageID, error) {
+			s.InDelta(time.Now().Add(-reviewTimeout).Unix(), before.Unix(), 1)
+			return ids, nil
+		})
+	s.msgRepo.EXPECT().GetReviewExpiredMessageIDs(gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(nil, nil).AnyTimes()
+}
+
+func (s *ServiceSuite) runFor(d reviewexpirer.Decision, timeout time.Duration) {
+	s.T().Helper()
+
+	svc, err := reviewexpirer.New(reviewexpirer.NewOptions(
+		reviewTimeout,
+		d,
+		s.msgRepo,
+		s.resolver,
+		reviewexpirer.WithCheckPeriod(checkPeriod),
+	))
+	s.Require().NoError(err)
+
+	ctx, cancel := context.WithTimeout(s.Ctx, timeout)
+	defer cancel()
+
+	s.NoError(svc.Run(ctx))
+}
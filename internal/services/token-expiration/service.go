@@ -27,7 +27,11 @@ func (s *Service) NewExpireContext(ctx context.Context, id string, deadline time
 
 	ctx, cancel := context.WithCancel(ctx)
 
-	go s.cancelAfter(ctx, cancel, id, duration)
+	// The timer is registered before return to be extendable right away.
+	t := time.NewTimer(duration)
+	s.addTimer(id, t)
+
+	go s.cancelAfter(ctx, cancel, id, t)
 
 	return ctx, nil
 }
@@ -43,20 +47,19 @@ func (s *Service) Extend(id string, deadline time.Time) error {
 		return fmt.Errorf("timer with id %s not found", id)
 	}
 
+	if !t.Stop() {
+		return fmt.Errorf("timer with id %s already expired", id)
+	}
 	t.Reset(duration)
 
 	return nil
 }
 
-func (s *Service) cancelAfter(ctx context.Context, cancel context.CancelFunc, id string, duration time.Duration) {
+func (s *Service) cancelAfter(ctx context.Context, cancel context.CancelFunc, id string, t *time.Timer) {
 	defer cancel()
 	defer s.removeTimer(id)
-
-	t := time.NewTimer(duration)
 	defer t.Stop()
 
-	s.addTimer(id, t)
-
 	select {
 	case <-ctx.Done():
 	case <-t.C:
@@ -45,6 +45,31 @@ func TestExtendExpire(t *testing.T) {
 	waitContextClose(expireContext, t, time.Millisecond*301)
 }
 
+func TestExtendRightAfterCreation(t *testing.T) {
+	s := tokenexpiration.New()
+
+	expireContext, err := s.NewExpireContext(context.Background(), "1", time.Now().Add(time.Millisecond*20))
+	require.NoError(t, err)
+
+	err = s.Extend("1", time.Now().Add(time.Millisecond*100))
+	require.NoError(t, err)
+
+	waitContextNotClose(expireContext, t, time.Millisecond*50)
+	waitContextClose(expireContext, t, time.Millisecond*100)
+}
+
+func TestExtendExpired(t *testing.T) {
+	s := tokenexpiration.New()
+
+	expireContext, err := s.NewExpireContext(context.Background(), "1", time.Now().Add(time.Millisecond*10))
+	require.NoError(t, err)
+
+	waitContextClose(expireContext, t, time.Millisecond*30)
+
+	err = s.Extend("1", time.Now().Add(time.Millisecond*100))
+	require.Error(t, err)
+}
+
 func waitContextClose(ctx context.Context, t *testing.T, timeout time.Duration) {
 	t.Helper()
 
@@ -0,0 +1,173 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/karasunokami/chat-service/internal/store/auditrecord"
+	"github.com/karasunokami/chat-service/internal/types"
+)
+
+// AuditRecord is the model entity for the AuditRecord schema.
+type AuditRecord struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID types.AuditRecordID `json:"id,omitempty"`
+	// ManagerID holds the value of the "manager_id" field.
+	ManagerID types.UserID `json:"manager_id,omitempty"`
+	// Action holds the value of the "action" field.
+	Action auditrecord.Action `json:"action,omitempty"`
+	// ChatID holds the value of the "chat_id" field.
+	ChatID types.ChatID `json:"chat_id,omitempty"`
+	// ProblemID holds the value of the "problem_id" field.
+	ProblemID types.ProblemID `json:"problem_id,omitempty"`
+	// MessageID holds the value of the "message_id" field.
+	MessageID types.MessageID `json:"message_id,omitempty"`
+	// RequestID holds the value of the "request_id" field.
+	RequestID types.RequestID `json:"request_id,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*AuditRecord) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case auditrecord.FieldAction:
+			values[i] = new(sql.NullString)
+		case auditrecord.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case auditrecord.FieldID:
+			values[i] = new(types.AuditRecordID)
+		case auditrecord.FieldChatID:
+			values[i] = new(types.ChatID)
+		case auditrecord.FieldMessageID:
+			values[i] = new(types.MessageID)
+		case auditrecord.FieldProblemID:
+			values[i] = new(types.ProblemID)
+		case auditrecord.FieldRequestID:
+			values[i] = new(types.RequestID)
+		case auditrecord.FieldManagerID:
+			values[i] = new(types.UserID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type AuditRecord", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the AuditRecord fields.
+func (ar *AuditRecord) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case auditrecord.FieldID:
+			if value, ok := values[i].(*types.AuditRecordID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				ar.ID = *value
+			}
+		case auditrecord.FieldManagerID:
+			if value, ok := values[i].(*types.UserID); !ok {
+				return fmt.Errorf("unexpected type %T for field manager_id", values[i])
+			} else if value != nil {
+				ar.ManagerID = *value
+			}
+		case auditrecord.FieldAction:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field action", values[i])
+			} else if value.Valid {
+				ar.Action = auditrecord.Action(value.String)
+			}
+		case auditrecord.FieldChatID:
+			if value, ok := values[i].(*types.ChatID); !ok {
+				return fmt.Errorf("unexpected type %T for field chat_id", values[i])
+			} else if value != nil {
+				ar.ChatID = *value
+			}
+		case auditrecord.FieldProblemID:
+			if value, ok := values[i].(*types.ProblemID); !ok {
+				return fmt.Errorf("unexpected type %T for field problem_id", values[i])
+			} else if value != nil {
+				ar.ProblemID = *value
+			}
+		case auditrecord.FieldMessageID:
+			if value, ok := values[i].(*types.MessageID); !ok {
+				return fmt.Errorf("unexpected type %T for field message_id", values[i])
+			} else if value != nil {
+				ar.MessageID = *value
+			}
+		case auditrecord.FieldRequestID:
+			if value, ok := values[i].(*types.RequestID); !ok {
+				return fmt.Errorf("unexpected type %T for field request_id", values[i])
+			} else if value != nil {
+				ar.RequestID = *value
+			}
+		case auditrecord.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ar.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this AuditRecord.
+// Note that you need to call AuditRecord.Unwrap() before calling this method if this AuditRecord
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ar *AuditRecord) Update() *AuditRecordUpdateOne {
+	return NewAuditRecordClient(ar.config).UpdateOne(ar)
+}
+
+// Unwrap unwraps the AuditRecord entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ar *AuditRecord) Unwrap() *AuditRecord {
+	_tx, ok := ar.config.driver.(*txDriver)
+	if !ok {
+		panic("store: AuditRecord is not a transactional entity")
+	}
+	ar.config.driver = _tx.drv
+	return ar
+}
+
+// String implements the fmt.Stringer.
+func (ar *AuditRecord) String() string {
+	var builder strings.Builder
+	builder.WriteString("AuditRecord(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ar.ID))
+	builder.WriteString("manager_id=")
+	builder.WriteString(fmt.Sprintf("%v", ar.ManagerID))
+	builder.WriteString(", ")
+	builder.WriteString("action=")
+	builder.WriteString(fmt.Sprintf("%v", ar.Action))
+	builder.WriteString(", ")
+	builder.WriteString("chat_id=")
+	builder.WriteString(fmt.Sprintf("%v", ar.ChatID))
+	builder.WriteString(", ")
+	builder.WriteString("problem_id=")
+	builder.WriteString(fmt.Sprintf("%v", ar.ProblemID))
+	builder.WriteString(", ")
+	builder.WriteString("message_id=")
+	builder.WriteString(fmt.Sprintf("%v", ar.MessageID))
+	builder.WriteString(", ")
+	builder.WriteString("request_id=")
+	builder.WriteString(fmt.Sprintf("%v", ar.RequestID))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ar.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// AuditRecords is a parsable slice of AuditRecord.
+type AuditRecords []*AuditRecord
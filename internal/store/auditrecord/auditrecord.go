@@ -0,0 +1,91 @@
+// Code generated by ent, DO NOT EDIT.
+
+package auditrecord
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/karasunokami/chat-service/internal/types"
+)
+
+const (
+	// Label holds the string label denoting the auditrecord type in the database.
+	Label = "audit_record"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldManagerID holds the string denoting the manager_id field in the database.
+	FieldManagerID = "manager_id"
+	// FieldAction holds the string denoting the action field in the database.
+	FieldAction = "action"
+	// FieldChatID holds the string denoting the chat_id field in the database.
+	FieldChatID = "chat_id"
+	// FieldProblemID holds the string denoting the problem_id field in the database.
+	FieldProblemID = "problem_id"
+	// FieldMessageID holds the string denoting the message_id field in the database.
+	FieldMessageID = "message_id"
+	// FieldRequestID holds the string denoting the request_id field in the database.
+	FieldRequestID = "request_id"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the auditrecord in the database.
+	Table = "audit_records"
+)
+
+// Columns holds all SQL columns for auditrecord fields.
+var Columns = []string{
+	FieldID,
+	FieldManagerID,
+	FieldAction,
+	FieldChatID,
+	FieldProblemID,
+	FieldMessageID,
+	FieldRequestID,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() types.AuditRecordID
+)
+
+// Action defines the type for the "action" enum field.
+type Action string
+
+// Action values.
+const (
+	ActionGetChatHistory    Action = "get_chat_history"
+	ActionSendMessage       Action = "send_message"
+	ActionCloseChat         Action = "close_chat"
+	ActionFreeHands         Action = "free_hands"
+	ActionSendInternalNote  Action = "send_internal_note"
+	ActionSetStatus         Action = "set_status"
+	ActionAfcTimeoutDeliver Action = "afc_timeout_deliver"
+	ActionAfcTimeoutBlock   Action = "afc_timeout_block"
+)
+
+func (a Action) String() string {
+	return string(a)
+}
+
+// ActionValidator is a validator for the "action" field enum values. It is called by the builders before save.
+func ActionValidator(a Action) error {
+	switch a {
+	case ActionGetChatHistory, ActionSendMessage, ActionCloseChat, ActionFreeHands, ActionSendInternalNote, ActionSetStatus, ActionAfcTimeoutDeliver, ActionAfcTimeoutBlock:
+		return nil
+	default:
+		return fmt.Errorf("auditrecord: invalid enum value for action field: %q", a)
+	}
+}
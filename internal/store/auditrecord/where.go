@@ -0,0 +1,418 @@
+// Code generated by ent, DO NOT EDIT.
+
+package auditrecord
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/karasunokami/chat-service/internal/store/predicate"
+	"github.com/karasunokami/chat-service/internal/types"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id types.AuditRecordID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id types.AuditRecordID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id types.AuditRecordID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...types.AuditRecordID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...types.AuditRecordID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id types.AuditRecordID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id types.AuditRecordID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id types.AuditRecordID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id types.AuditRecordID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLTE(FieldID, id))
+}
+
+// ManagerID applies equality check predicate on the "manager_id" field. It's identical to ManagerIDEQ.
+func ManagerID(v types.UserID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldManagerID, v))
+}
+
+// ChatID applies equality check predicate on the "chat_id" field. It's identical to ChatIDEQ.
+func ChatID(v types.ChatID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldChatID, v))
+}
+
+// ProblemID applies equality check predicate on the "problem_id" field. It's identical to ProblemIDEQ.
+func ProblemID(v types.ProblemID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldProblemID, v))
+}
+
+// MessageID applies equality check predicate on the "message_id" field. It's identical to MessageIDEQ.
+func MessageID(v types.MessageID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldMessageID, v))
+}
+
+// RequestID applies equality check predicate on the "request_id" field. It's identical to RequestIDEQ.
+func RequestID(v types.RequestID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldRequestID, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ManagerIDEQ applies the EQ predicate on the "manager_id" field.
+func ManagerIDEQ(v types.UserID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldManagerID, v))
+}
+
+// ManagerIDNEQ applies the NEQ predicate on the "manager_id" field.
+func ManagerIDNEQ(v types.UserID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNEQ(FieldManagerID, v))
+}
+
+// ManagerIDIn applies the In predicate on the "manager_id" field.
+func ManagerIDIn(vs ...types.UserID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIn(FieldManagerID, vs...))
+}
+
+// ManagerIDNotIn applies the NotIn predicate on the "manager_id" field.
+func ManagerIDNotIn(vs ...types.UserID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotIn(FieldManagerID, vs...))
+}
+
+// ManagerIDGT applies the GT predicate on the "manager_id" field.
+func ManagerIDGT(v types.UserID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGT(FieldManagerID, v))
+}
+
+// ManagerIDGTE applies the GTE predicate on the "manager_id" field.
+func ManagerIDGTE(v types.UserID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGTE(FieldManagerID, v))
+}
+
+// ManagerIDLT applies the LT predicate on the "manager_id" field.
+func ManagerIDLT(v types.UserID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLT(FieldManagerID, v))
+}
+
+// ManagerIDLTE applies the LTE predicate on the "manager_id" field.
+func ManagerIDLTE(v types.UserID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLTE(FieldManagerID, v))
+}
+
+// ManagerIDIsNil applies the IsNil predicate on the "manager_id" field.
+func ManagerIDIsNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIsNull(FieldManagerID))
+}
+
+// ManagerIDNotNil applies the NotNil predicate on the "manager_id" field.
+func ManagerIDNotNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotNull(FieldManagerID))
+}
+
+// ActionEQ applies the EQ predicate on the "action" field.
+func ActionEQ(v Action) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldAction, v))
+}
+
+// ActionNEQ applies the NEQ predicate on the "action" field.
+func ActionNEQ(v Action) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNEQ(FieldAction, v))
+}
+
+// ActionIn applies the In predicate on the "action" field.
+func ActionIn(vs ...Action) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIn(FieldAction, vs...))
+}
+
+// ActionNotIn applies the NotIn predicate on the "action" field.
+func ActionNotIn(vs ...Action) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotIn(FieldAction, vs...))
+}
+
+// ChatIDEQ applies the EQ predicate on the "chat_id" field.
+func ChatIDEQ(v types.ChatID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldChatID, v))
+}
+
+// ChatIDNEQ applies the NEQ predicate on the "chat_id" field.
+func ChatIDNEQ(v types.ChatID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNEQ(FieldChatID, v))
+}
+
+// ChatIDIn applies the In predicate on the "chat_id" field.
+func ChatIDIn(vs ...types.ChatID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIn(FieldChatID, vs...))
+}
+
+// ChatIDNotIn applies the NotIn predicate on the "chat_id" field.
+func ChatIDNotIn(vs ...types.ChatID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotIn(FieldChatID, vs...))
+}
+
+// ChatIDGT applies the GT predicate on the "chat_id" field.
+func ChatIDGT(v types.ChatID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGT(FieldChatID, v))
+}
+
+// ChatIDGTE applies the GTE predicate on the "chat_id" field.
+func ChatIDGTE(v types.ChatID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGTE(FieldChatID, v))
+}
+
+// ChatIDLT applies the LT predicate on the "chat_id" field.
+func ChatIDLT(v types.ChatID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLT(FieldChatID, v))
+}
+
+// ChatIDLTE applies the LTE predicate on the "chat_id" field.
+func ChatIDLTE(v types.ChatID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLTE(FieldChatID, v))
+}
+
+// ChatIDIsNil applies the IsNil predicate on the "chat_id" field.
+func ChatIDIsNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIsNull(FieldChatID))
+}
+
+// ChatIDNotNil applies the NotNil predicate on the "chat_id" field.
+func ChatIDNotNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotNull(FieldChatID))
+}
+
+// ProblemIDEQ applies the EQ predicate on the "problem_id" field.
+func ProblemIDEQ(v types.ProblemID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldProblemID, v))
+}
+
+// ProblemIDNEQ applies the NEQ predicate on the "problem_id" field.
+func ProblemIDNEQ(v types.ProblemID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNEQ(FieldProblemID, v))
+}
+
+// ProblemIDIn applies the In predicate on the "problem_id" field.
+func ProblemIDIn(vs ...types.ProblemID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIn(FieldProblemID, vs...))
+}
+
+// ProblemIDNotIn applies the NotIn predicate on the "problem_id" field.
+func ProblemIDNotIn(vs ...types.ProblemID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotIn(FieldProblemID, vs...))
+}
+
+// ProblemIDGT applies the GT predicate on the "problem_id" field.
+func ProblemIDGT(v types.ProblemID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGT(FieldProblemID, v))
+}
+
+// ProblemIDGTE applies the GTE predicate on the "problem_id" field.
+func ProblemIDGTE(v types.ProblemID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGTE(FieldProblemID, v))
+}
+
+// ProblemIDLT applies the LT predicate on the "problem_id" field.
+func ProblemIDLT(v types.ProblemID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLT(FieldProblemID, v))
+}
+
+// ProblemIDLTE applies the LTE predicate on the "problem_id" field.
+func ProblemIDLTE(v types.ProblemID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLTE(FieldProblemID, v))
+}
+
+// ProblemIDIsNil applies the IsNil predicate on the "problem_id" field.
+func ProblemIDIsNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIsNull(FieldProblemID))
+}
+
+// ProblemIDNotNil applies the NotNil predicate on the "problem_id" field.
+func ProblemIDNotNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotNull(FieldProblemID))
+}
+
+// MessageIDEQ applies the EQ predicate on the "message_id" field.
+func MessageIDEQ(v types.MessageID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldMessageID, v))
+}
+
+// MessageIDNEQ applies the NEQ predicate on the "message_id" field.
+func MessageIDNEQ(v types.MessageID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNEQ(FieldMessageID, v))
+}
+
+// MessageIDIn applies the In predicate on the "message_id" field.
+func MessageIDIn(vs ...types.MessageID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIn(FieldMessageID, vs...))
+}
+
+// MessageIDNotIn applies the NotIn predicate on the "message_id" field.
+func MessageIDNotIn(vs ...types.MessageID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotIn(FieldMessageID, vs...))
+}
+
+// MessageIDGT applies the GT predicate on the "message_id" field.
+func MessageIDGT(v types.MessageID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGT(FieldMessageID, v))
+}
+
+// MessageIDGTE applies the GTE predicate on the "message_id" field.
+func MessageIDGTE(v types.MessageID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGTE(FieldMessageID, v))
+}
+
+// MessageIDLT applies the LT predicate on the "message_id" field.
+func MessageIDLT(v types.MessageID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLT(FieldMessageID, v))
+}
+
+// MessageIDLTE applies the LTE predicate on the "message_id" field.
+func MessageIDLTE(v types.MessageID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLTE(FieldMessageID, v))
+}
+
+// MessageIDIsNil applies the IsNil predicate on the "message_id" field.
+func MessageIDIsNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIsNull(FieldMessageID))
+}
+
+// MessageIDNotNil applies the NotNil predicate on the "message_id" field.
+func MessageIDNotNil() predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotNull(FieldMessageID))
+}
+
+// RequestIDEQ applies the EQ predicate on the "request_id" field.
+func RequestIDEQ(v types.RequestID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldRequestID, v))
+}
+
+// RequestIDNEQ applies the NEQ predicate on the "request_id" field.
+func RequestIDNEQ(v types.RequestID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNEQ(FieldRequestID, v))
+}
+
+// RequestIDIn applies the In predicate on the "request_id" field.
+func RequestIDIn(vs ...types.RequestID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIn(FieldRequestID, vs...))
+}
+
+// RequestIDNotIn applies the NotIn predicate on the "request_id" field.
+func RequestIDNotIn(vs ...types.RequestID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotIn(FieldRequestID, vs...))
+}
+
+// RequestIDGT applies the GT predicate on the "request_id" field.
+func RequestIDGT(v types.RequestID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGT(FieldRequestID, v))
+}
+
+// RequestIDGTE applies the GTE predicate on the "request_id" field.
+func RequestIDGTE(v types.RequestID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGTE(FieldRequestID, v))
+}
+
+// RequestIDLT applies the LT predicate on the "request_id" field.
+func RequestIDLT(v types.RequestID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLT(FieldRequestID, v))
+}
+
+// RequestIDLTE applies the LTE predicate on the "request_id" field.
+func RequestIDLTE(v types.RequestID) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLTE(FieldRequestID, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.AuditRecord {
+	return predicate.AuditRecord(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.AuditRecord) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.AuditRecord) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.AuditRecord) predicate.AuditRecord {
+	return predicate.AuditRecord(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
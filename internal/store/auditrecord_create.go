@@ -0,0 +1,649 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/karasunokami/chat-service/internal/store/auditrecord"
+	"github.com/karasunokami/chat-service/internal/types"
+)
+
+// AuditRecordCreate is the builder for creating a AuditRecord entity.
+type AuditRecordCreate struct {
+	config
+	mutation *AuditRecordMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetManagerID sets the "manager_id" field.
+func (arc *AuditRecordCreate) SetManagerID(ti types.UserID) *AuditRecordCreate {
+	arc.mutation.SetManagerID(ti)
+	return arc
+}
+
+// SetNillableManagerID sets the "manager_id" field if the given value is not nil.
+func (arc *AuditRecordCreate) SetNillableManagerID(ti *types.UserID) *AuditRecordCreate {
+	if ti != nil {
+		arc.SetManagerID(*ti)
+	}
+	return arc
+}
+
+// SetAction sets the "action" field.
+func (arc *AuditRecordCreate) SetAction(a auditrecord.Action) *AuditRecordCreate {
+	arc.mutation.SetAction(a)
+	return arc
+}
+
+// SetChatID sets the "chat_id" field.
+func (arc *AuditRecordCreate) SetChatID(ti types.ChatID) *AuditRecordCreate {
+	arc.mutation.SetChatID(ti)
+	return arc
+}
+
+// SetNillableChatID sets the "chat_id" field if the given value is not nil.
+func (arc *AuditRecordCreate) SetNillableChatID(ti *types.ChatID) *AuditRecordCreate {
+	if ti != nil {
+		arc.SetChatID(*ti)
+	}
+	return arc
+}
+
+// SetProblemID sets the "problem_id" field.
+func (arc *AuditRecordCreate) SetProblemID(ti types.ProblemID) *AuditRecordCreate {
+	arc.mutation.SetProblemID(ti)
+	return arc
+}
+
+// SetNillableProblemID sets the "problem_id" field if the given value is not nil.
+func (arc *AuditRecordCreate) SetNillableProblemID(ti *types.ProblemID) *AuditRecordCreate {
+	if ti != nil {
+		arc.SetProblemID(*ti)
+	}
+	return arc
+}
+
+// SetMessageID sets the "message_id" field.
+func (arc *AuditRecordCreate) SetMessageID(ti types.MessageID) *AuditRecordCreate {
+	arc.mutation.SetMessageID(ti)
+	return arc
+}
+
+// SetNillableMessageID sets the "message_id" field if the given value is not nil.
+func (arc *AuditRecordCreate) SetNillableMessageID(ti *types.MessageID) *AuditRecordCreate {
+	if ti != nil {
+		arc.SetMessageID(*ti)
+	}
+	return arc
+}
+
+// SetRequestID sets the "request_id" field.
+func (arc *AuditRecordCreate) SetRequestID(ti types.RequestID) *AuditRecordCreate {
+	arc.mutation.SetRequestID(ti)
+	return arc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (arc *AuditRecordCreate) SetCreatedAt(t time.Time) *AuditRecordCreate {
+	arc.mutation.SetCreatedAt(t)
+	return arc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (arc *AuditRecordCreate) SetNillableCreatedAt(t *time.Time) *AuditRecordCreate {
+	if t != nil {
+		arc.SetCreatedAt(*t)
+	}
+	return arc
+}
+
+// SetID sets the "id" field.
+func (arc *AuditRecordCreate) SetID(tri types.AuditRecordID) *AuditRecordCreate {
+	arc.mutation.SetID(tri)
+	return arc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (arc *AuditRecordCreate) SetNillableID(tri *types.AuditRecordID) *AuditRecordCreate {
+	if tri != nil {
+		arc.SetID(*tri)
+	}
+	return arc
+}
+
+// Mutation returns the AuditRecordMutation object of the builder.
+func (arc *AuditRecordCreate) Mutation() *AuditRecordMutation {
+	return arc.mutation
+}
+
+// Save creates the AuditRecord in the database.
+func (arc *AuditRecordCreate) Save(ctx context.Context) (*AuditRecord, error) {
+	arc.defaults()
+	return withHooks[*AuditRecord, AuditRecordMutation](ctx, arc.sqlSave, arc.mutation, arc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (arc *AuditRecordCreate) SaveX(ctx context.Context) *AuditRecord {
+	v, err := arc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (arc *AuditRecordCreate) Exec(ctx context.Context) error {
+	_, err := arc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (arc *AuditRecordCreate) ExecX(ctx context.Context) {
+	if err := arc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (arc *AuditRecordCreate) defaults() {
+	if _, ok := arc.mutation.CreatedAt(); !ok {
+		v := auditrecord.DefaultCreatedAt()
+		arc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := arc.mutation.ID(); !ok {
+		v := auditrecord.DefaultID()
+		arc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (arc *AuditRecordCreate) check() error {
+	if v, ok := arc.mutation.ManagerID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "manager_id", err: fmt.Errorf(`store: validator failed for field "AuditRecord.manager_id": %w`, err)}
+		}
+	}
+	if _, ok := arc.mutation.Action(); !ok {
+		return &ValidationError{Name: "action", err: errors.New(`store: missing required field "AuditRecord.action"`)}
+	}
+	if v, ok := arc.mutation.Action(); ok {
+		if err := auditrecord.ActionValidator(v); err != nil {
+			return &ValidationError{Name: "action", err: fmt.Errorf(`store: validator failed for field "AuditRecord.action": %w`, err)}
+		}
+	}
+	if v, ok := arc.mutation.ChatID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "chat_id", err: fmt.Errorf(`store: validator failed for field "AuditRecord.chat_id": %w`, err)}
+		}
+	}
+	if v, ok := arc.mutation.ProblemID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "problem_id", err: fmt.Errorf(`store: validator failed for field "AuditRecord.problem_id": %w`, err)}
+		}
+	}
+	if v, ok := arc.mutation.MessageID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "message_id", err: fmt.Errorf(`store: validator failed for field "AuditRecord.message_id": %w`, err)}
+		}
+	}
+	if _, ok := arc.mutation.RequestID(); !ok {
+		return &ValidationError{Name: "request_id", err: errors.New(`store: missing required field "AuditRecord.request_id"`)}
+	}
+	if v, ok := arc.mutation.RequestID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "request_id", err: fmt.Errorf(`store: validator failed for field "AuditRecord.request_id": %w`, err)}
+		}
+	}
+	if _, ok := arc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`store: missing required field "AuditRecord.created_at"`)}
+	}
+	if v, ok := arc.mutation.ID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`store: validator failed for field "AuditRecord.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (arc *AuditRecordCreate) sqlSave(ctx context.Context) (*AuditRecord, error) {
+	if err := arc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := arc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, arc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*types.AuditRecordID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	arc.mutation.id = &_node.ID
+	arc.mutation.done = true
+	return _node, nil
+}
+
+func (arc *AuditRecordCreate) createSpec() (*AuditRecord, *sqlgraph.CreateSpec) {
+	var (
+		_node = &AuditRecord{config: arc.config}
+		_spec = sqlgraph.NewCreateSpec(auditrecord.Table, sqlgraph.NewFieldSpec(auditrecord.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = arc.conflict
+	if id, ok := arc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := arc.mutation.ManagerID(); ok {
+		_spec.SetField(auditrecord.FieldManagerID, field.TypeUUID, value)
+		_node.ManagerID = value
+	}
+	if value, ok := arc.mutation.Action(); ok {
+		_spec.SetField(auditrecord.FieldAction, field.TypeEnum, value)
+		_node.Action = value
+	}
+	if value, ok := arc.mutation.ChatID(); ok {
+		_spec.SetField(auditrecord.FieldChatID, field.TypeUUID, value)
+		_node.ChatID = value
+	}
+	if value, ok := arc.mutation.ProblemID(); ok {
+		_spec.SetField(auditrecord.FieldProblemID, field.TypeUUID, value)
+		_node.ProblemID = value
+	}
+	if value, ok := arc.mutation.MessageID(); ok {
+		_spec.SetField(auditrecord.FieldMessageID, field.TypeUUID, value)
+		_node.MessageID = value
+	}
+	if value, ok := arc.mutation.RequestID(); ok {
+		_spec.SetField(auditrecord.FieldRequestID, field.TypeUUID, value)
+		_node.RequestID = value
+	}
+	if value, ok := arc.mutation.CreatedAt(); ok {
+		_spec.SetField(auditrecord.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AuditRecord.Create().
+//		SetManagerID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AuditRecordUpsert) {
+//			SetManagerID(v+v).
+//		}).
+//		Exec(ctx)
+func (arc *AuditRecordCreate) OnConflict(opts ...sql.ConflictOption) *AuditRecordUpsertOne {
+	arc.conflict = opts
+	return &AuditRecordUpsertOne{
+		create: arc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AuditRecord.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (arc *AuditRecordCreate) OnConflictColumns(columns ...string) *AuditRecordUpsertOne {
+	arc.conflict = append(arc.conflict, sql.ConflictColumns(columns...))
+	return &AuditRecordUpsertOne{
+		create: arc,
+	}
+}
+
+type (
+	// AuditRecordUpsertOne is the builder for "upsert"-ing
+	//  one AuditRecord node.
+	AuditRecordUpsertOne struct {
+		create *AuditRecordCreate
+	}
+
+	// AuditRecordUpsert is the "OnConflict" setter.
+	AuditRecordUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.AuditRecord.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(auditrecord.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AuditRecordUpsertOne) UpdateNewValues() *AuditRecordUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(auditrecord.FieldID)
+		}
+		if _, exists := u.create.mutation.ManagerID(); exists {
+			s.SetIgnore(auditrecord.FieldManagerID)
+		}
+		if _, exists := u.create.mutation.Action(); exists {
+			s.SetIgnore(auditrecord.FieldAction)
+		}
+		if _, exists := u.create.mutation.ChatID(); exists {
+			s.SetIgnore(auditrecord.FieldChatID)
+		}
+		if _, exists := u.create.mutation.ProblemID(); exists {
+			s.SetIgnore(auditrecord.FieldProblemID)
+		}
+		if _, exists := u.create.mutation.MessageID(); exists {
+			s.SetIgnore(auditrecord.FieldMessageID)
+		}
+		if _, exists := u.create.mutation.RequestID(); exists {
+			s.SetIgnore(auditrecord.FieldRequestID)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(auditrecord.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AuditRecord.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *AuditRecordUpsertOne) Ignore() *AuditRecordUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AuditRecordUpsertOne) DoNothing() *AuditRecordUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AuditRecordCreate.OnConflict
+// documentation for more info.
+func (u *AuditRecordUpsertOne) Update(set func(*AuditRecordUpsert)) *AuditRecordUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AuditRecordUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *AuditRecordUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("store: missing options for AuditRecordCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AuditRecordUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *AuditRecordUpsertOne) ID(ctx context.Context) (id types.AuditRecordID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("store: AuditRecordUpsertOne.ID is not supported by MySQL driver. Use AuditRecordUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *AuditRecordUpsertOne) IDX(ctx context.Context) types.AuditRecordID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// AuditRecordCreateBulk is the builder for creating many AuditRecord entities in bulk.
+type AuditRecordCreateBulk struct {
+	config
+	builders []*AuditRecordCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the AuditRecord entities in the database.
+func (arcb *AuditRecordCreateBulk) Save(ctx context.Context) ([]*AuditRecord, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(arcb.builders))
+	nodes := make([]*AuditRecord, len(arcb.builders))
+	mutators := make([]Mutator, len(arcb.builders))
+	for i := range arcb.builders {
+		func(i int, root context.Context) {
+			builder := arcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*AuditRecordMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, arcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = arcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, arcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, arcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (arcb *AuditRecordCreateBulk) SaveX(ctx context.Context) []*AuditRecord {
+	v, err := arcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (arcb *AuditRecordCreateBulk) Exec(ctx context.Context) error {
+	_, err := arcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (arcb *AuditRecordCreateBulk) ExecX(ctx context.Context) {
+	if err := arcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.AuditRecord.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.AuditRecordUpsert) {
+//			SetManagerID(v+v).
+//		}).
+//		Exec(ctx)
+func (arcb *AuditRecordCreateBulk) OnConflict(opts ...sql.ConflictOption) *AuditRecordUpsertBulk {
+	arcb.conflict = opts
+	return &AuditRecordUpsertBulk{
+		create: arcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.AuditRecord.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (arcb *AuditRecordCreateBulk) OnConflictColumns(columns ...string) *AuditRecordUpsertBulk {
+	arcb.conflict = append(arcb.conflict, sql.ConflictColumns(columns...))
+	return &AuditRecordUpsertBulk{
+		create: arcb,
+	}
+}
+
+// AuditRecordUpsertBulk is the builder for "upsert"-ing
+// a bulk of AuditRecord nodes.
+type AuditRecordUpsertBulk struct {
+	create *AuditRecordCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.AuditRecord.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(auditrecord.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *AuditRecordUpsertBulk) UpdateNewValues() *AuditRecordUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(auditrecord.FieldID)
+			}
+			if _, exists := b.mutation.ManagerID(); exists {
+				s.SetIgnore(auditrecord.FieldManagerID)
+			}
+			if _, exists := b.mutation.Action(); exists {
+				s.SetIgnore(auditrecord.FieldAction)
+			}
+			if _, exists := b.mutation.ChatID(); exists {
+				s.SetIgnore(auditrecord.FieldChatID)
+			}
+			if _, exists := b.mutation.ProblemID(); exists {
+				s.SetIgnore(auditrecord.FieldProblemID)
+			}
+			if _, exists := b.mutation.MessageID(); exists {
+				s.SetIgnore(auditrecord.FieldMessageID)
+			}
+			if _, exists := b.mutation.RequestID(); exists {
+				s.SetIgnore(auditrecord.FieldRequestID)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(auditrecord.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.AuditRecord.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *AuditRecordUpsertBulk) Ignore() *AuditRecordUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *AuditRecordUpsertBulk) DoNothing() *AuditRecordUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the AuditRecordCreateBulk.OnConflict
+// documentation for more info.
+func (u *AuditRecordUpsertBulk) Update(set func(*AuditRecordUpsert)) *AuditRecordUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&AuditRecordUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *AuditRecordUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("store: OnConflict was set for builder %d. Set it on the AuditRecordCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("store: missing options for AuditRecordCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *AuditRecordUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/karasunokami/chat-service/internal/store/auditrecord"
+	"github.com/karasunokami/chat-service/internal/store/predicate"
+)
+
+// AuditRecordDelete is the builder for deleting a AuditRecord entity.
+type AuditRecordDelete struct {
+	config
+	hooks    []Hook
+	mutation *AuditRecordMutation
+}
+
+// Where appends a list predicates to the AuditRecordDelete builder.
+func (ard *AuditRecordDelete) Where(ps ...predicate.AuditRecord) *AuditRecordDelete {
+	ard.mutation.Where(ps...)
+	return ard
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ard *AuditRecordDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks[int, AuditRecordMutation](ctx, ard.sqlExec, ard.mutation, ard.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ard *AuditRecordDelete) ExecX(ctx context.Context) int {
+	n, err := ard.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ard *AuditRecordDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(auditrecord.Table, sqlgraph.NewFieldSpec(auditrecord.FieldID, field.TypeUUID))
+	if ps := ard.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ard.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ard.mutation.done = true
+	return affected, err
+}
+
+// AuditRecordDeleteOne is the builder for deleting a single AuditRecord entity.
+type AuditRecordDeleteOne struct {
+	ard *AuditRecordDelete
+}
+
+// Where appends a list predicates to the AuditRecordDelete builder.
+func (ardo *AuditRecordDeleteOne) Where(ps ...predicate.AuditRecord) *AuditRecordDeleteOne {
+	ardo.ard.mutation.Where(ps...)
+	return ardo
+}
+
+// Exec executes the deletion query.
+func (ardo *AuditRecordDeleteOne) Exec(ctx context.Context) error {
+	n, err := ardo.ard.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{auditrecord.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ardo *AuditRecordDeleteOne) ExecX(ctx context.Context) {
+	if err := ardo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,199 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/karasunokami/chat-service/internal/store/auditrecord"
+	"github.com/karasunokami/chat-service/internal/store/predicate"
+)
+
+// AuditRecordUpdate is the builder for updating AuditRecord entities.
+type AuditRecordUpdate struct {
+	config
+	hooks    []Hook
+	mutation *AuditRecordMutation
+}
+
+// Where appends a list predicates to the AuditRecordUpdate builder.
+func (aru *AuditRecordUpdate) Where(ps ...predicate.AuditRecord) *AuditRecordUpdate {
+	aru.mutation.Where(ps...)
+	return aru
+}
+
+// Mutation returns the AuditRecordMutation object of the builder.
+func (aru *AuditRecordUpdate) Mutation() *AuditRecordMutation {
+	return aru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (aru *AuditRecordUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks[int, AuditRecordMutation](ctx, aru.sqlSave, aru.mutation, aru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aru *AuditRecordUpdate) SaveX(ctx context.Context) int {
+	affected, err := aru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (aru *AuditRecordUpdate) Exec(ctx context.Context) error {
+	_, err := aru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aru *AuditRecordUpdate) ExecX(ctx context.Context) {
+	if err := aru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (aru *AuditRecordUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(auditrecord.Table, auditrecord.Columns, sqlgraph.NewFieldSpec(auditrecord.FieldID, field.TypeUUID))
+	if ps := aru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if aru.mutation.ManagerIDCleared() {
+		_spec.ClearField(auditrecord.FieldManagerID, field.TypeUUID)
+	}
+	if aru.mutation.ChatIDCleared() {
+		_spec.ClearField(auditrecord.FieldChatID, field.TypeUUID)
+	}
+	if aru.mutation.ProblemIDCleared() {
+		_spec.ClearField(auditrecord.FieldProblemID, field.TypeUUID)
+	}
+	if aru.mutation.MessageIDCleared() {
+		_spec.ClearField(auditrecord.FieldMessageID, field.TypeUUID)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, aru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditrecord.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	aru.mutation.done = true
+	return n, nil
+}
+
+// AuditRecordUpdateOne is the builder for updating a single AuditRecord entity.
+type AuditRecordUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *AuditRecordMutation
+}
+
+// Mutation returns the AuditRecordMutation object of the builder.
+func (aruo *AuditRecordUpdateOne) Mutation() *AuditRecordMutation {
+	return aruo.mutation
+}
+
+// Where appends a list predicates to the AuditRecordUpdate builder.
+func (aruo *AuditRecordUpdateOne) Where(ps ...predicate.AuditRecord) *AuditRecordUpdateOne {
+	aruo.mutation.Where(ps...)
+	return aruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aruo *AuditRecordUpdateOne) Select(field string, fields ...string) *AuditRecordUpdateOne {
+	aruo.fields = append([]string{field}, fields...)
+	return aruo
+}
+
+// Save executes the query and returns the updated AuditRecord entity.
+func (aruo *AuditRecordUpdateOne) Save(ctx context.Context) (*AuditRecord, error) {
+	return withHooks[*AuditRecord, AuditRecordMutation](ctx, aruo.sqlSave, aruo.mutation, aruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aruo *AuditRecordUpdateOne) SaveX(ctx context.Context) *AuditRecord {
+	node, err := aruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aruo *AuditRecordUpdateOne) Exec(ctx context.Context) error {
+	_, err := aruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aruo *AuditRecordUpdateOne) ExecX(ctx context.Context) {
+	if err := aruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (aruo *AuditRecordUpdateOne) sqlSave(ctx context.Context) (_node *AuditRecord, err error) {
+	_spec := sqlgraph.NewUpdateSpec(auditrecord.Table, auditrecord.Columns, sqlgraph.NewFieldSpec(auditrecord.FieldID, field.TypeUUID))
+	id, ok := aruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`store: missing "AuditRecord.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, auditrecord.FieldID)
+		for _, f := range fields {
+			if !auditrecord.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("store: invalid field %q for query", f)}
+			}
+			if f != auditrecord.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if aruo.mutation.ManagerIDCleared() {
+		_spec.ClearField(auditrecord.FieldManagerID, field.TypeUUID)
+	}
+	if aruo.mutation.ChatIDCleared() {
+		_spec.ClearField(auditrecord.FieldChatID, field.TypeUUID)
+	}
+	if aruo.mutation.ProblemIDCleared() {
+		_spec.ClearField(auditrecord.FieldProblemID, field.TypeUUID)
+	}
+	if aruo.mutation.MessageIDCleared() {
+		_spec.ClearField(auditrecord.FieldMessageID, field.TypeUUID)
+	}
+	_node = &AuditRecord{config: aruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{auditrecord.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aruo.mutation.done = true
+	return _node, nil
+}
@@ -15,11 +15,13 @@ import (
 	"entgo.io/ent/dialect"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/karasunokami/chat-service/internal/store/auditrecord"
 	"github.com/karasunokami/chat-service/internal/store/chat"
 	"github.com/karasunokami/chat-service/internal/store/failedjob"
 	"github.com/karasunokami/chat-service/internal/store/job"
 	"github.com/karasunokami/chat-service/internal/store/message"
 	"github.com/karasunokami/chat-service/internal/store/problem"
+	"github.com/karasunokami/chat-service/internal/store/scheduledmessage"
 
 	stdsql "database/sql"
 )
@@ -29,6 +31,8 @@ type Client struct {
 	config
 	// Schema is the client for creating, migrating and dropping schema.
 	Schema *migrate.Schema
+	// AuditRecord is the client for interacting with the AuditRecord builders.
+	AuditRecord *AuditRecordClient
 	// Chat is the client for interacting with the Chat builders.
 	Chat *ChatClient
 	// FailedJob is the client for interacting with the FailedJob builders.
@@ -39,6 +43,8 @@ type Client struct {
 	Message *MessageClient
 	// Problem is the client for interacting with the Problem builders.
 	Problem *ProblemClient
+	// ScheduledMessage is the client for interacting with the ScheduledMessage builders.
+	ScheduledMessage *ScheduledMessageClient
 }
 
 // NewClient creates a new client configured with the given options.
@@ -52,11 +58,13 @@ func NewClient(opts ...Option) *Client {
 
 func (c *Client) init() {
 	c.Schema = migrate.NewSchema(c.driver)
+	c.AuditRecord = NewAuditRecordClient(c.config)
 	c.Chat = NewChatClient(c.config)
 	c.FailedJob = NewFailedJobClient(c.config)
 	c.Job = NewJobClient(c.config)
 	c.Message = NewMessageClient(c.config)
 	c.Problem = NewProblemClient(c.config)
+	c.ScheduledMessage = NewScheduledMessageClient(c.config)
 }
 
 type (
@@ -137,13 +145,15 @@ func (c *Client) Tx(ctx context.Context) (*Tx, error) {
 	cfg := c.config
 	cfg.driver = tx
 	return &Tx{
-		ctx:       ctx,
-		config:    cfg,
-		Chat:      NewChatClient(cfg),
-		FailedJob: NewFailedJobClient(cfg),
-		Job:       NewJobClient(cfg),
-		Message:   NewMessageClient(cfg),
-		Problem:   NewProblemClient(cfg),
+		ctx:              ctx,
+		config:           cfg,
+		AuditRecord:      NewAuditRecordClient(cfg),
+		Chat:             NewChatClient(cfg),
+		FailedJob:        NewFailedJobClient(cfg),
+		Job:              NewJobClient(cfg),
+		Message:          NewMessageClient(cfg),
+		Problem:          NewProblemClient(cfg),
+		ScheduledMessage: NewScheduledMessageClient(cfg),
 	}, nil
 }
 
@@ -161,20 +171,22 @@ func (c *Client) BeginTx(ctx context.Context, opts *sql.TxOptions) (*Tx, error)
 	cfg := c.config
 	cfg.driver = &txDriver{tx: tx, drv: c.driver}
 	return &Tx{
-		ctx:       ctx,
-		config:    cfg,
-		Chat:      NewChatClient(cfg),
-		FailedJob: NewFailedJobClient(cfg),
-		Job:       NewJobClient(cfg),
-		Message:   NewMessageClient(cfg),
-		Problem:   NewProblemClient(cfg),
+		ctx:              ctx,
+		config:           cfg,
+		AuditRecord:      NewAuditRecordClient(cfg),
+		Chat:             NewChatClient(cfg),
+		FailedJob:        NewFailedJobClient(cfg),
+		Job:              NewJobClient(cfg),
+		Message:          NewMessageClient(cfg),
+		Problem:          NewProblemClient(cfg),
+		ScheduledMessage: NewScheduledMessageClient(cfg),
 	}, nil
 }
 
 // Debug returns a new debug-client. It's used to get verbose logging on specific operations.
 //
 //	client.Debug().
-//		Chat.
+//		AuditRecord.
 //		Query().
 //		Count(ctx)
 func (c *Client) Debug() *Client {
@@ -196,26 +208,30 @@ func (c *Client) Close() error {
 // Use adds the mutation hooks to all the entity clients.
 // In order to add hooks to a specific client, call: `client.Node.Use(...)`.
 func (c *Client) Use(hooks ...Hook) {
-	c.Chat.Use(hooks...)
-	c.FailedJob.Use(hooks...)
-	c.Job.Use(hooks...)
-	c.Message.Use(hooks...)
-	c.Problem.Use(hooks...)
+	for _, n := range []interface{ Use(...Hook) }{
+		c.AuditRecord, c.Chat, c.FailedJob, c.Job, c.Message, c.Problem,
+		c.ScheduledMessage,
+	} {
+		n.Use(hooks...)
+	}
 }
 
 // Intercept adds the query interceptors to all the entity clients.
 // In order to add interceptors to a specific client, call: `client.Node.Intercept(...)`.
 func (c *Client) Intercept(interceptors ...Interceptor) {
-	c.Chat.Intercept(interceptors...)
-	c.FailedJob.Intercept(interceptors...)
-	c.Job.Intercept(interceptors...)
-	c.Message.Intercept(interceptors...)
-	c.Problem.Intercept(interceptors...)
+	for _, n := range []interface{ Intercept(...Interceptor) }{
+		c.AuditRecord, c.Chat, c.FailedJob, c.Job, c.Message, c.Problem,
+		c.ScheduledMessage,
+	} {
+		n.Intercept(interceptors...)
+	}
 }
 
 // Mutate implements the ent.Mutator interface.
 func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 	switch m := m.(type) {
+	case *AuditRecordMutation:
+		return c.AuditRecord.mutate(ctx, m)
 	case *ChatMutation:
 		return c.Chat.mutate(ctx, m)
 	case *FailedJobMutation:
@@ -226,11 +242,131 @@ func (c *Client) Mutate(ctx context.Context, m Mutation) (Value, error) {
 		return c.Message.mutate(ctx, m)
 	case *ProblemMutation:
 		return c.Problem.mutate(ctx, m)
+	case *ScheduledMessageMutation:
+		return c.ScheduledMessage.mutate(ctx, m)
 	default:
 		return nil, fmt.Errorf("store: unknown mutation type %T", m)
 	}
 }
 
+// AuditRecordClient is a client for the AuditRecord schema.
+type AuditRecordClient struct {
+	config
+}
+
+// NewAuditRecordClient returns a client for the AuditRecord from the given config.
+func NewAuditRecordClient(c config) *AuditRecordClient {
+	return &AuditRecordClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `auditrecord.Hooks(f(g(h())))`.
+func (c *AuditRecordClient) Use(hooks ...Hook) {
+	c.hooks.AuditRecord = append(c.hooks.AuditRecord, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `auditrecord.Intercept(f(g(h())))`.
+func (c *AuditRecordClient) Intercept(interceptors ...Interceptor) {
+	c.inters.AuditRecord = append(c.inters.AuditRecord, interceptors...)
+}
+
+// Create returns a builder for creating a AuditRecord entity.
+func (c *AuditRecordClient) Create() *AuditRecordCreate {
+	mutation := newAuditRecordMutation(c.config, OpCreate)
+	return &AuditRecordCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of AuditRecord entities.
+func (c *AuditRecordClient) CreateBulk(builders ...*AuditRecordCreate) *AuditRecordCreateBulk {
+	return &AuditRecordCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for AuditRecord.
+func (c *AuditRecordClient) Update() *AuditRecordUpdate {
+	mutation := newAuditRecordMutation(c.config, OpUpdate)
+	return &AuditRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *AuditRecordClient) UpdateOne(ar *AuditRecord) *AuditRecordUpdateOne {
+	mutation := newAuditRecordMutation(c.config, OpUpdateOne, withAuditRecord(ar))
+	return &AuditRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *AuditRecordClient) UpdateOneID(id types.AuditRecordID) *AuditRecordUpdateOne {
+	mutation := newAuditRecordMutation(c.config, OpUpdateOne, withAuditRecordID(id))
+	return &AuditRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for AuditRecord.
+func (c *AuditRecordClient) Delete() *AuditRecordDelete {
+	mutation := newAuditRecordMutation(c.config, OpDelete)
+	return &AuditRecordDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *AuditRecordClient) DeleteOne(ar *AuditRecord) *AuditRecordDeleteOne {
+	return c.DeleteOneID(ar.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *AuditRecordClient) DeleteOneID(id types.AuditRecordID) *AuditRecordDeleteOne {
+	builder := c.Delete().Where(auditrecord.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &AuditRecordDeleteOne{builder}
+}
+
+// Query returns a query builder for AuditRecord.
+func (c *AuditRecordClient) Query() *AuditRecordQuery {
+	return &AuditRecordQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeAuditRecord},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a AuditRecord entity by its id.
+func (c *AuditRecordClient) Get(ctx context.Context, id types.AuditRecordID) (*AuditRecord, error) {
+	return c.Query().Where(auditrecord.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *AuditRecordClient) GetX(ctx context.Context, id types.AuditRecordID) *AuditRecord {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *AuditRecordClient) Hooks() []Hook {
+	return c.hooks.AuditRecord
+}
+
+// Interceptors returns the client interceptors.
+func (c *AuditRecordClient) Interceptors() []Interceptor {
+	return c.inters.AuditRecord
+}
+
+func (c *AuditRecordClient) mutate(ctx context.Context, m *AuditRecordMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&AuditRecordCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&AuditRecordUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&AuditRecordUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&AuditRecordDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("store: unknown AuditRecord mutation op: %q", m.Op())
+	}
+}
+
 // ChatClient is a client for the Chat schema.
 type ChatClient struct {
 	config
@@ -917,13 +1053,132 @@ func (c *ProblemClient) mutate(ctx context.Context, m *ProblemMutation) (Value,
 	}
 }
 
+// ScheduledMessageClient is a client for the ScheduledMessage schema.
+type ScheduledMessageClient struct {
+	config
+}
+
+// NewScheduledMessageClient returns a client for the ScheduledMessage from the given config.
+func NewScheduledMessageClient(c config) *ScheduledMessageClient {
+	return &ScheduledMessageClient{config: c}
+}
+
+// Use adds a list of mutation hooks to the hooks stack.
+// A call to `Use(f, g, h)` equals to `scheduledmessage.Hooks(f(g(h())))`.
+func (c *ScheduledMessageClient) Use(hooks ...Hook) {
+	c.hooks.ScheduledMessage = append(c.hooks.ScheduledMessage, hooks...)
+}
+
+// Intercept adds a list of query interceptors to the interceptors stack.
+// A call to `Intercept(f, g, h)` equals to `scheduledmessage.Intercept(f(g(h())))`.
+func (c *ScheduledMessageClient) Intercept(interceptors ...Interceptor) {
+	c.inters.ScheduledMessage = append(c.inters.ScheduledMessage, interceptors...)
+}
+
+// Create returns a builder for creating a ScheduledMessage entity.
+func (c *ScheduledMessageClient) Create() *ScheduledMessageCreate {
+	mutation := newScheduledMessageMutation(c.config, OpCreate)
+	return &ScheduledMessageCreate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// CreateBulk returns a builder for creating a bulk of ScheduledMessage entities.
+func (c *ScheduledMessageClient) CreateBulk(builders ...*ScheduledMessageCreate) *ScheduledMessageCreateBulk {
+	return &ScheduledMessageCreateBulk{config: c.config, builders: builders}
+}
+
+// Update returns an update builder for ScheduledMessage.
+func (c *ScheduledMessageClient) Update() *ScheduledMessageUpdate {
+	mutation := newScheduledMessageMutation(c.config, OpUpdate)
+	return &ScheduledMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOne returns an update builder for the given entity.
+func (c *ScheduledMessageClient) UpdateOne(sm *ScheduledMessage) *ScheduledMessageUpdateOne {
+	mutation := newScheduledMessageMutation(c.config, OpUpdateOne, withScheduledMessage(sm))
+	return &ScheduledMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// UpdateOneID returns an update builder for the given id.
+func (c *ScheduledMessageClient) UpdateOneID(id types.ScheduledMessageID) *ScheduledMessageUpdateOne {
+	mutation := newScheduledMessageMutation(c.config, OpUpdateOne, withScheduledMessageID(id))
+	return &ScheduledMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// Delete returns a delete builder for ScheduledMessage.
+func (c *ScheduledMessageClient) Delete() *ScheduledMessageDelete {
+	mutation := newScheduledMessageMutation(c.config, OpDelete)
+	return &ScheduledMessageDelete{config: c.config, hooks: c.Hooks(), mutation: mutation}
+}
+
+// DeleteOne returns a builder for deleting the given entity.
+func (c *ScheduledMessageClient) DeleteOne(sm *ScheduledMessage) *ScheduledMessageDeleteOne {
+	return c.DeleteOneID(sm.ID)
+}
+
+// DeleteOneID returns a builder for deleting the given entity by its id.
+func (c *ScheduledMessageClient) DeleteOneID(id types.ScheduledMessageID) *ScheduledMessageDeleteOne {
+	builder := c.Delete().Where(scheduledmessage.ID(id))
+	builder.mutation.id = &id
+	builder.mutation.op = OpDeleteOne
+	return &ScheduledMessageDeleteOne{builder}
+}
+
+// Query returns a query builder for ScheduledMessage.
+func (c *ScheduledMessageClient) Query() *ScheduledMessageQuery {
+	return &ScheduledMessageQuery{
+		config: c.config,
+		ctx:    &QueryContext{Type: TypeScheduledMessage},
+		inters: c.Interceptors(),
+	}
+}
+
+// Get returns a ScheduledMessage entity by its id.
+func (c *ScheduledMessageClient) Get(ctx context.Context, id types.ScheduledMessageID) (*ScheduledMessage, error) {
+	return c.Query().Where(scheduledmessage.ID(id)).Only(ctx)
+}
+
+// GetX is like Get, but panics if an error occurs.
+func (c *ScheduledMessageClient) GetX(ctx context.Context, id types.ScheduledMessageID) *ScheduledMessage {
+	obj, err := c.Get(ctx, id)
+	if err != nil {
+		panic(err)
+	}
+	return obj
+}
+
+// Hooks returns the client hooks.
+func (c *ScheduledMessageClient) Hooks() []Hook {
+	return c.hooks.ScheduledMessage
+}
+
+// Interceptors returns the client interceptors.
+func (c *ScheduledMessageClient) Interceptors() []Interceptor {
+	return c.inters.ScheduledMessage
+}
+
+func (c *ScheduledMessageClient) mutate(ctx context.Context, m *ScheduledMessageMutation) (Value, error) {
+	switch m.Op() {
+	case OpCreate:
+		return (&ScheduledMessageCreate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdate:
+		return (&ScheduledMessageUpdate{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpUpdateOne:
+		return (&ScheduledMessageUpdateOne{config: c.config, hooks: c.Hooks(), mutation: m}).Save(ctx)
+	case OpDelete, OpDeleteOne:
+		return (&ScheduledMessageDelete{config: c.config, hooks: c.Hooks(), mutation: m}).Exec(ctx)
+	default:
+		return nil, fmt.Errorf("store: unknown ScheduledMessage mutation op: %q", m.Op())
+	}
+}
+
 // hooks and interceptors per client, for fast access.
 type (
 	hooks struct {
-		Chat, FailedJob, Job, Message, Problem []ent.Hook
+		AuditRecord, Chat, FailedJob, Job, Message, Problem, ScheduledMessage []ent.Hook
 	}
 	inters struct {
-		Chat, FailedJob, Job, Message, Problem []ent.Interceptor
+		AuditRecord, Chat, FailedJob, Job, Message, Problem,
+		ScheduledMessage []ent.Interceptor
 	}
 )
 
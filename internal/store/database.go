@@ -5,7 +5,10 @@ package store
 import (
 	"context"
 
+	"github.com/karasunokami/chat-service/internal/tracing"
+
 	"entgo.io/ent/dialect/sql"
+	"go.opentelemetry.io/otel/trace"
 	"go.uber.org/zap"
 )
 
@@ -23,12 +26,14 @@ func NewDatabase(client *Client, lg *zap.Logger) *Database {
 // RunInTx runs the given function f within a transaction.
 // Inspired by https://entgo.io/docs/transactions/#best-practices.
 // If there is already a transaction in the context, then the method uses it.
-func (db *Database) RunInTx(ctx context.Context, f func(context.Context) error) error {
-	var err error
-
+func (db *Database) RunInTx(ctx context.Context, f func(context.Context) error) (err error) {
 	tx := TxFromContext(ctx)
 
 	if tx == nil {
+		var span trace.Span
+		ctx, span = tracing.Start(ctx, "store.RunInTx")
+		defer func() { tracing.End(span, err) }()
+
 		tx, err = db.loadClient(ctx).Tx(ctx)
 		if err != nil {
 			return err
@@ -94,6 +99,11 @@ func (db *Database) Query(ctx context.Context, query string, args ...interface{}
 	return &rows, nil
 }
 
+// AuditRecord is the client for interacting with the AuditRecord builders.
+func (db *Database) AuditRecord(ctx context.Context) *AuditRecordClient {
+	return db.loadClient(ctx).AuditRecord
+}
+
 // Chat is the client for interacting with the Chat builders.
 func (db *Database) Chat(ctx context.Context) *ChatClient {
 	return db.loadClient(ctx).Chat
@@ -118,3 +128,8 @@ func (db *Database) Message(ctx context.Context) *MessageClient {
 func (db *Database) Problem(ctx context.Context) *ProblemClient {
 	return db.loadClient(ctx).Problem
 }
+
+// ScheduledMessage is the client for interacting with the ScheduledMessage builders.
+func (db *Database) ScheduledMessage(ctx context.Context) *ScheduledMessageClient {
+	return db.loadClient(ctx).ScheduledMessage
+}
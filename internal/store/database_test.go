@@ -152,3 +152,7 @@ func (s *StoreSuite) TestNoNestedTransactions() {
 	})
 	s.Require().NoError(err)
 }
+
+func (s *StoreSuite) TestPing() {
+	s.Require().NoError(s.Database.Ping(s.Ctx))
+}
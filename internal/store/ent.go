@@ -11,11 +11,13 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/karasunokami/chat-service/internal/store/auditrecord"
 	"github.com/karasunokami/chat-service/internal/store/chat"
 	"github.com/karasunokami/chat-service/internal/store/failedjob"
 	"github.com/karasunokami/chat-service/internal/store/job"
 	"github.com/karasunokami/chat-service/internal/store/message"
 	"github.com/karasunokami/chat-service/internal/store/problem"
+	"github.com/karasunokami/chat-service/internal/store/scheduledmessage"
 )
 
 // ent aliases to avoid import conflicts in user's code.
@@ -69,11 +71,13 @@ type OrderFunc func(*sql.Selector)
 // columnChecker returns a function indicates if the column exists in the given column.
 func columnChecker(table string) func(string) error {
 	checks := map[string]func(string) bool{
-		chat.Table:      chat.ValidColumn,
-		failedjob.Table: failedjob.ValidColumn,
-		job.Table:       job.ValidColumn,
-		message.Table:   message.ValidColumn,
-		problem.Table:   problem.ValidColumn,
+		auditrecord.Table:      auditrecord.ValidColumn,
+		chat.Table:             chat.ValidColumn,
+		failedjob.Table:        failedjob.ValidColumn,
+		job.Table:              job.ValidColumn,
+		message.Table:          message.ValidColumn,
+		problem.Table:          problem.ValidColumn,
+		scheduledmessage.Table: scheduledmessage.ValidColumn,
 	}
 	check, ok := checks[table]
 	if !ok {
@@ -9,6 +9,18 @@ import (
 	"github.com/karasunokami/chat-service/internal/store"
 )
 
+// The AuditRecordFunc type is an adapter to allow the use of ordinary
+// function as AuditRecord mutator.
+type AuditRecordFunc func(context.Context, *store.AuditRecordMutation) (store.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f AuditRecordFunc) Mutate(ctx context.Context, m store.Mutation) (store.Value, error) {
+	if mv, ok := m.(*store.AuditRecordMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *store.AuditRecordMutation", m)
+}
+
 // The ChatFunc type is an adapter to allow the use of ordinary
 // function as Chat mutator.
 type ChatFunc func(context.Context, *store.ChatMutation) (store.Value, error)
@@ -69,6 +81,18 @@ func (f ProblemFunc) Mutate(ctx context.Context, m store.Mutation) (store.Value,
 	return nil, fmt.Errorf("unexpected mutation type %T. expect *store.ProblemMutation", m)
 }
 
+// The ScheduledMessageFunc type is an adapter to allow the use of ordinary
+// function as ScheduledMessage mutator.
+type ScheduledMessageFunc func(context.Context, *store.ScheduledMessageMutation) (store.Value, error)
+
+// Mutate calls f(ctx, m).
+func (f ScheduledMessageFunc) Mutate(ctx context.Context, m store.Mutation) (store.Value, error) {
+	if mv, ok := m.(*store.ScheduledMessageMutation); ok {
+		return f(ctx, mv)
+	}
+	return nil, fmt.Errorf("unexpected mutation type %T. expect *store.ScheduledMessageMutation", m)
+}
+
 // Condition is a hook condition function.
 type Condition func(context.Context, store.Mutation) bool
 
@@ -37,8 +37,16 @@ type Message struct {
 	CheckedAt time.Time `json:"checked_at,omitempty"`
 	// IsBlocked holds the value of the "is_blocked" field.
 	IsBlocked bool `json:"is_blocked,omitempty"`
+	// ReviewRequestedAt holds the value of the "review_requested_at" field.
+	ReviewRequestedAt time.Time `json:"review_requested_at,omitempty"`
+	// AfcResends holds the value of the "afc_resends" field.
+	AfcResends int `json:"afc_resends,omitempty"`
+	// AfcResentAt holds the value of the "afc_resent_at" field.
+	AfcResentAt time.Time `json:"afc_resent_at,omitempty"`
 	// IsService holds the value of the "is_service" field.
 	IsService bool `json:"is_service,omitempty"`
+	// IsInternalNote holds the value of the "is_internal_note" field.
+	IsInternalNote bool `json:"is_internal_note,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -88,11 +96,13 @@ func (*Message) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case message.FieldIsVisibleForClient, message.FieldIsVisibleForManager, message.FieldIsBlocked, message.FieldIsService:
+		case message.FieldIsVisibleForClient, message.FieldIsVisibleForManager, message.FieldIsBlocked, message.FieldIsService, message.FieldIsInternalNote:
 			values[i] = new(sql.NullBool)
+		case message.FieldAfcResends:
+			values[i] = new(sql.NullInt64)
 		case message.FieldBody:
 			values[i] = new(sql.NullString)
-		case message.FieldCheckedAt, message.FieldCreatedAt:
+		case message.FieldCheckedAt, message.FieldReviewRequestedAt, message.FieldAfcResentAt, message.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		case message.FieldChatID:
 			values[i] = new(types.ChatID)
@@ -179,12 +189,36 @@ func (m *Message) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				m.IsBlocked = value.Bool
 			}
+		case message.FieldReviewRequestedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field review_requested_at", values[i])
+			} else if value.Valid {
+				m.ReviewRequestedAt = value.Time
+			}
+		case message.FieldAfcResends:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field afc_resends", values[i])
+			} else if value.Valid {
+				m.AfcResends = int(value.Int64)
+			}
+		case message.FieldAfcResentAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field afc_resent_at", values[i])
+			} else if value.Valid {
+				m.AfcResentAt = value.Time
+			}
 		case message.FieldIsService:
 			if value, ok := values[i].(*sql.NullBool); !ok {
 				return fmt.Errorf("unexpected type %T for field is_service", values[i])
 			} else if value.Valid {
 				m.IsService = value.Bool
 			}
+		case message.FieldIsInternalNote:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field is_internal_note", values[i])
+			} else if value.Valid {
+				m.IsInternalNote = value.Bool
+			}
 		case message.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -256,9 +290,21 @@ func (m *Message) String() string {
 	builder.WriteString("is_blocked=")
 	builder.WriteString(fmt.Sprintf("%v", m.IsBlocked))
 	builder.WriteString(", ")
+	builder.WriteString("review_requested_at=")
+	builder.WriteString(m.ReviewRequestedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("afc_resends=")
+	builder.WriteString(fmt.Sprintf("%v", m.AfcResends))
+	builder.WriteString(", ")
+	builder.WriteString("afc_resent_at=")
+	builder.WriteString(m.AfcResentAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("is_service=")
 	builder.WriteString(fmt.Sprintf("%v", m.IsService))
 	builder.WriteString(", ")
+	builder.WriteString("is_internal_note=")
+	builder.WriteString(fmt.Sprintf("%v", m.IsInternalNote))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(m.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
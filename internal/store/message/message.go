@@ -31,8 +31,16 @@ const (
 	FieldCheckedAt = "checked_at"
 	// FieldIsBlocked holds the string denoting the is_blocked field in the database.
 	FieldIsBlocked = "is_blocked"
+	// FieldReviewRequestedAt holds the string denoting the review_requested_at field in the database.
+	FieldReviewRequestedAt = "review_requested_at"
+	// FieldAfcResends holds the string denoting the afc_resends field in the database.
+	FieldAfcResends = "afc_resends"
+	// FieldAfcResentAt holds the string denoting the afc_resent_at field in the database.
+	FieldAfcResentAt = "afc_resent_at"
 	// FieldIsService holds the string denoting the is_service field in the database.
 	FieldIsService = "is_service"
+	// FieldIsInternalNote holds the string denoting the is_internal_note field in the database.
+	FieldIsInternalNote = "is_internal_note"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// EdgeChat holds the string denoting the chat edge name in mutations.
@@ -69,7 +77,11 @@ var Columns = []string{
 	FieldBody,
 	FieldCheckedAt,
 	FieldIsBlocked,
+	FieldReviewRequestedAt,
+	FieldAfcResends,
+	FieldAfcResentAt,
 	FieldIsService,
+	FieldIsInternalNote,
 	FieldCreatedAt,
 }
 
@@ -92,8 +104,12 @@ var (
 	BodyValidator func(string) error
 	// DefaultIsBlocked holds the default value on creation for the "is_blocked" field.
 	DefaultIsBlocked bool
+	// DefaultAfcResends holds the default value on creation for the "afc_resends" field.
+	DefaultAfcResends int
 	// DefaultIsService holds the default value on creation for the "is_service" field.
 	DefaultIsService bool
+	// DefaultIsInternalNote holds the default value on creation for the "is_internal_note" field.
+	DefaultIsInternalNote bool
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultID holds the default value on creation for the "id" field.
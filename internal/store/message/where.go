@@ -101,11 +101,31 @@ func IsBlocked(v bool) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldIsBlocked, v))
 }
 
+// ReviewRequestedAt applies equality check predicate on the "review_requested_at" field. It's identical to ReviewRequestedAtEQ.
+func ReviewRequestedAt(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldReviewRequestedAt, v))
+}
+
+// AfcResends applies equality check predicate on the "afc_resends" field. It's identical to AfcResendsEQ.
+func AfcResends(v int) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldAfcResends, v))
+}
+
+// AfcResentAt applies equality check predicate on the "afc_resent_at" field. It's identical to AfcResentAtEQ.
+func AfcResentAt(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldAfcResentAt, v))
+}
+
 // IsService applies equality check predicate on the "is_service" field. It's identical to IsServiceEQ.
 func IsService(v bool) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldIsService, v))
 }
 
+// IsInternalNote applies equality check predicate on the "is_internal_note" field. It's identical to IsInternalNoteEQ.
+func IsInternalNote(v bool) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldIsInternalNote, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldCreatedAt, v))
@@ -396,6 +416,146 @@ func IsBlockedNEQ(v bool) predicate.Message {
 	return predicate.Message(sql.FieldNEQ(FieldIsBlocked, v))
 }
 
+// ReviewRequestedAtEQ applies the EQ predicate on the "review_requested_at" field.
+func ReviewRequestedAtEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldReviewRequestedAt, v))
+}
+
+// ReviewRequestedAtNEQ applies the NEQ predicate on the "review_requested_at" field.
+func ReviewRequestedAtNEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldReviewRequestedAt, v))
+}
+
+// ReviewRequestedAtIn applies the In predicate on the "review_requested_at" field.
+func ReviewRequestedAtIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldReviewRequestedAt, vs...))
+}
+
+// ReviewRequestedAtNotIn applies the NotIn predicate on the "review_requested_at" field.
+func ReviewRequestedAtNotIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldReviewRequestedAt, vs...))
+}
+
+// ReviewRequestedAtGT applies the GT predicate on the "review_requested_at" field.
+func ReviewRequestedAtGT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldReviewRequestedAt, v))
+}
+
+// ReviewRequestedAtGTE applies the GTE predicate on the "review_requested_at" field.
+func ReviewRequestedAtGTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldReviewRequestedAt, v))
+}
+
+// ReviewRequestedAtLT applies the LT predicate on the "review_requested_at" field.
+func ReviewRequestedAtLT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldReviewRequestedAt, v))
+}
+
+// ReviewRequestedAtLTE applies the LTE predicate on the "review_requested_at" field.
+func ReviewRequestedAtLTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldReviewRequestedAt, v))
+}
+
+// ReviewRequestedAtIsNil applies the IsNil predicate on the "review_requested_at" field.
+func ReviewRequestedAtIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldReviewRequestedAt))
+}
+
+// ReviewRequestedAtNotNil applies the NotNil predicate on the "review_requested_at" field.
+func ReviewRequestedAtNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldReviewRequestedAt))
+}
+
+// AfcResendsEQ applies the EQ predicate on the "afc_resends" field.
+func AfcResendsEQ(v int) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldAfcResends, v))
+}
+
+// AfcResendsNEQ applies the NEQ predicate on the "afc_resends" field.
+func AfcResendsNEQ(v int) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldAfcResends, v))
+}
+
+// AfcResendsIn applies the In predicate on the "afc_resends" field.
+func AfcResendsIn(vs ...int) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldAfcResends, vs...))
+}
+
+// AfcResendsNotIn applies the NotIn predicate on the "afc_resends" field.
+func AfcResendsNotIn(vs ...int) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldAfcResends, vs...))
+}
+
+// AfcResendsGT applies the GT predicate on the "afc_resends" field.
+func AfcResendsGT(v int) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldAfcResends, v))
+}
+
+// AfcResendsGTE applies the GTE predicate on the "afc_resends" field.
+func AfcResendsGTE(v int) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldAfcResends, v))
+}
+
+// AfcResendsLT applies the LT predicate on the "afc_resends" field.
+func AfcResendsLT(v int) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldAfcResends, v))
+}
+
+// AfcResendsLTE applies the LTE predicate on the "afc_resends" field.
+func AfcResendsLTE(v int) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldAfcResends, v))
+}
+
+// AfcResentAtEQ applies the EQ predicate on the "afc_resent_at" field.
+func AfcResentAtEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldAfcResentAt, v))
+}
+
+// AfcResentAtNEQ applies the NEQ predicate on the "afc_resent_at" field.
+func AfcResentAtNEQ(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldAfcResentAt, v))
+}
+
+// AfcResentAtIn applies the In predicate on the "afc_resent_at" field.
+func AfcResentAtIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldIn(FieldAfcResentAt, vs...))
+}
+
+// AfcResentAtNotIn applies the NotIn predicate on the "afc_resent_at" field.
+func AfcResentAtNotIn(vs ...time.Time) predicate.Message {
+	return predicate.Message(sql.FieldNotIn(FieldAfcResentAt, vs...))
+}
+
+// AfcResentAtGT applies the GT predicate on the "afc_resent_at" field.
+func AfcResentAtGT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGT(FieldAfcResentAt, v))
+}
+
+// AfcResentAtGTE applies the GTE predicate on the "afc_resent_at" field.
+func AfcResentAtGTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldGTE(FieldAfcResentAt, v))
+}
+
+// AfcResentAtLT applies the LT predicate on the "afc_resent_at" field.
+func AfcResentAtLT(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLT(FieldAfcResentAt, v))
+}
+
+// AfcResentAtLTE applies the LTE predicate on the "afc_resent_at" field.
+func AfcResentAtLTE(v time.Time) predicate.Message {
+	return predicate.Message(sql.FieldLTE(FieldAfcResentAt, v))
+}
+
+// AfcResentAtIsNil applies the IsNil predicate on the "afc_resent_at" field.
+func AfcResentAtIsNil() predicate.Message {
+	return predicate.Message(sql.FieldIsNull(FieldAfcResentAt))
+}
+
+// AfcResentAtNotNil applies the NotNil predicate on the "afc_resent_at" field.
+func AfcResentAtNotNil() predicate.Message {
+	return predicate.Message(sql.FieldNotNull(FieldAfcResentAt))
+}
+
 // IsServiceEQ applies the EQ predicate on the "is_service" field.
 func IsServiceEQ(v bool) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldIsService, v))
@@ -406,6 +566,16 @@ func IsServiceNEQ(v bool) predicate.Message {
 	return predicate.Message(sql.FieldNEQ(FieldIsService, v))
 }
 
+// IsInternalNoteEQ applies the EQ predicate on the "is_internal_note" field.
+func IsInternalNoteEQ(v bool) predicate.Message {
+	return predicate.Message(sql.FieldEQ(FieldIsInternalNote, v))
+}
+
+// IsInternalNoteNEQ applies the NEQ predicate on the "is_internal_note" field.
+func IsInternalNoteNEQ(v bool) predicate.Message {
+	return predicate.Message(sql.FieldNEQ(FieldIsInternalNote, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Message {
 	return predicate.Message(sql.FieldEQ(FieldCreatedAt, v))
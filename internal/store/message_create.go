@@ -128,6 +128,48 @@ func (mc *MessageCreate) SetNillableIsBlocked(b *bool) *MessageCreate {
 	return mc
 }
 
+// SetReviewRequestedAt sets the "review_requested_at" field.
+func (mc *MessageCreate) SetReviewRequestedAt(t time.Time) *MessageCreate {
+	mc.mutation.SetReviewRequestedAt(t)
+	return mc
+}
+
+// SetNillableReviewRequestedAt sets the "review_requested_at" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableReviewRequestedAt(t *time.Time) *MessageCreate {
+	if t != nil {
+		mc.SetReviewRequestedAt(*t)
+	}
+	return mc
+}
+
+// SetAfcResends sets the "afc_resends" field.
+func (mc *MessageCreate) SetAfcResends(i int) *MessageCreate {
+	mc.mutation.SetAfcResends(i)
+	return mc
+}
+
+// SetNillableAfcResends sets the "afc_resends" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableAfcResends(i *int) *MessageCreate {
+	if i != nil {
+		mc.SetAfcResends(*i)
+	}
+	return mc
+}
+
+// SetAfcResentAt sets the "afc_resent_at" field.
+func (mc *MessageCreate) SetAfcResentAt(t time.Time) *MessageCreate {
+	mc.mutation.SetAfcResentAt(t)
+	return mc
+}
+
+// SetNillableAfcResentAt sets the "afc_resent_at" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableAfcResentAt(t *time.Time) *MessageCreate {
+	if t != nil {
+		mc.SetAfcResentAt(*t)
+	}
+	return mc
+}
+
 // SetIsService sets the "is_service" field.
 func (mc *MessageCreate) SetIsService(b bool) *MessageCreate {
 	mc.mutation.SetIsService(b)
@@ -142,6 +184,20 @@ func (mc *MessageCreate) SetNillableIsService(b *bool) *MessageCreate {
 	return mc
 }
 
+// SetIsInternalNote sets the "is_internal_note" field.
+func (mc *MessageCreate) SetIsInternalNote(b bool) *MessageCreate {
+	mc.mutation.SetIsInternalNote(b)
+	return mc
+}
+
+// SetNillableIsInternalNote sets the "is_internal_note" field if the given value is not nil.
+func (mc *MessageCreate) SetNillableIsInternalNote(b *bool) *MessageCreate {
+	if b != nil {
+		mc.SetIsInternalNote(*b)
+	}
+	return mc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (mc *MessageCreate) SetCreatedAt(t time.Time) *MessageCreate {
 	mc.mutation.SetCreatedAt(t)
@@ -227,10 +283,18 @@ func (mc *MessageCreate) defaults() {
 		v := message.DefaultIsBlocked
 		mc.mutation.SetIsBlocked(v)
 	}
+	if _, ok := mc.mutation.AfcResends(); !ok {
+		v := message.DefaultAfcResends
+		mc.mutation.SetAfcResends(v)
+	}
 	if _, ok := mc.mutation.IsService(); !ok {
 		v := message.DefaultIsService
 		mc.mutation.SetIsService(v)
 	}
+	if _, ok := mc.mutation.IsInternalNote(); !ok {
+		v := message.DefaultIsInternalNote
+		mc.mutation.SetIsInternalNote(v)
+	}
 	if _, ok := mc.mutation.CreatedAt(); !ok {
 		v := message.DefaultCreatedAt()
 		mc.mutation.SetCreatedAt(v)
@@ -286,9 +350,15 @@ func (mc *MessageCreate) check() error {
 	if _, ok := mc.mutation.IsBlocked(); !ok {
 		return &ValidationError{Name: "is_blocked", err: errors.New(`store: missing required field "Message.is_blocked"`)}
 	}
+	if _, ok := mc.mutation.AfcResends(); !ok {
+		return &ValidationError{Name: "afc_resends", err: errors.New(`store: missing required field "Message.afc_resends"`)}
+	}
 	if _, ok := mc.mutation.IsService(); !ok {
 		return &ValidationError{Name: "is_service", err: errors.New(`store: missing required field "Message.is_service"`)}
 	}
+	if _, ok := mc.mutation.IsInternalNote(); !ok {
+		return &ValidationError{Name: "is_internal_note", err: errors.New(`store: missing required field "Message.is_internal_note"`)}
+	}
 	if _, ok := mc.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`store: missing required field "Message.created_at"`)}
 	}
@@ -367,10 +437,26 @@ func (mc *MessageCreate) createSpec() (*Message, *sqlgraph.CreateSpec) {
 		_spec.SetField(message.FieldIsBlocked, field.TypeBool, value)
 		_node.IsBlocked = value
 	}
+	if value, ok := mc.mutation.ReviewRequestedAt(); ok {
+		_spec.SetField(message.FieldReviewRequestedAt, field.TypeTime, value)
+		_node.ReviewRequestedAt = value
+	}
+	if value, ok := mc.mutation.AfcResends(); ok {
+		_spec.SetField(message.FieldAfcResends, field.TypeInt, value)
+		_node.AfcResends = value
+	}
+	if value, ok := mc.mutation.AfcResentAt(); ok {
+		_spec.SetField(message.FieldAfcResentAt, field.TypeTime, value)
+		_node.AfcResentAt = value
+	}
 	if value, ok := mc.mutation.IsService(); ok {
 		_spec.SetField(message.FieldIsService, field.TypeBool, value)
 		_node.IsService = value
 	}
+	if value, ok := mc.mutation.IsInternalNote(); ok {
+		_spec.SetField(message.FieldIsInternalNote, field.TypeBool, value)
+		_node.IsInternalNote = value
+	}
 	if value, ok := mc.mutation.CreatedAt(); ok {
 		_spec.SetField(message.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -563,6 +649,60 @@ func (u *MessageUpsert) UpdateIsBlocked() *MessageUpsert {
 	return u
 }
 
+// SetReviewRequestedAt sets the "review_requested_at" field.
+func (u *MessageUpsert) SetReviewRequestedAt(v time.Time) *MessageUpsert {
+	u.Set(message.FieldReviewRequestedAt, v)
+	return u
+}
+
+// UpdateReviewRequestedAt sets the "review_requested_at" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateReviewRequestedAt() *MessageUpsert {
+	u.SetExcluded(message.FieldReviewRequestedAt)
+	return u
+}
+
+// ClearReviewRequestedAt clears the value of the "review_requested_at" field.
+func (u *MessageUpsert) ClearReviewRequestedAt() *MessageUpsert {
+	u.SetNull(message.FieldReviewRequestedAt)
+	return u
+}
+
+// SetAfcResends sets the "afc_resends" field.
+func (u *MessageUpsert) SetAfcResends(v int) *MessageUpsert {
+	u.Set(message.FieldAfcResends, v)
+	return u
+}
+
+// UpdateAfcResends sets the "afc_resends" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateAfcResends() *MessageUpsert {
+	u.SetExcluded(message.FieldAfcResends)
+	return u
+}
+
+// AddAfcResends adds v to the "afc_resends" field.
+func (u *MessageUpsert) AddAfcResends(v int) *MessageUpsert {
+	u.Add(message.FieldAfcResends, v)
+	return u
+}
+
+// SetAfcResentAt sets the "afc_resent_at" field.
+func (u *MessageUpsert) SetAfcResentAt(v time.Time) *MessageUpsert {
+	u.Set(message.FieldAfcResentAt, v)
+	return u
+}
+
+// UpdateAfcResentAt sets the "afc_resent_at" field to the value that was provided on create.
+func (u *MessageUpsert) UpdateAfcResentAt() *MessageUpsert {
+	u.SetExcluded(message.FieldAfcResentAt)
+	return u
+}
+
+// ClearAfcResentAt clears the value of the "afc_resent_at" field.
+func (u *MessageUpsert) ClearAfcResentAt() *MessageUpsert {
+	u.SetNull(message.FieldAfcResentAt)
+	return u
+}
+
 // SetIsService sets the "is_service" field.
 func (u *MessageUpsert) SetIsService(v bool) *MessageUpsert {
 	u.Set(message.FieldIsService, v)
@@ -598,6 +738,9 @@ func (u *MessageUpsertOne) UpdateNewValues() *MessageUpsertOne {
 		if _, exists := u.create.mutation.Body(); exists {
 			s.SetIgnore(message.FieldBody)
 		}
+		if _, exists := u.create.mutation.IsInternalNote(); exists {
+			s.SetIgnore(message.FieldIsInternalNote)
+		}
 		if _, exists := u.create.mutation.CreatedAt(); exists {
 			s.SetIgnore(message.FieldCreatedAt)
 		}
@@ -751,6 +894,69 @@ func (u *MessageUpsertOne) UpdateIsBlocked() *MessageUpsertOne {
 	})
 }
 
+// SetReviewRequestedAt sets the "review_requested_at" field.
+func (u *MessageUpsertOne) SetReviewRequestedAt(v time.Time) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetReviewRequestedAt(v)
+	})
+}
+
+// UpdateReviewRequestedAt sets the "review_requested_at" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateReviewRequestedAt() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateReviewRequestedAt()
+	})
+}
+
+// ClearReviewRequestedAt clears the value of the "review_requested_at" field.
+func (u *MessageUpsertOne) ClearReviewRequestedAt() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearReviewRequestedAt()
+	})
+}
+
+// SetAfcResends sets the "afc_resends" field.
+func (u *MessageUpsertOne) SetAfcResends(v int) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetAfcResends(v)
+	})
+}
+
+// AddAfcResends adds v to the "afc_resends" field.
+func (u *MessageUpsertOne) AddAfcResends(v int) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.AddAfcResends(v)
+	})
+}
+
+// UpdateAfcResends sets the "afc_resends" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateAfcResends() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateAfcResends()
+	})
+}
+
+// SetAfcResentAt sets the "afc_resent_at" field.
+func (u *MessageUpsertOne) SetAfcResentAt(v time.Time) *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetAfcResentAt(v)
+	})
+}
+
+// UpdateAfcResentAt sets the "afc_resent_at" field to the value that was provided on create.
+func (u *MessageUpsertOne) UpdateAfcResentAt() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateAfcResentAt()
+	})
+}
+
+// ClearAfcResentAt clears the value of the "afc_resent_at" field.
+func (u *MessageUpsertOne) ClearAfcResentAt() *MessageUpsertOne {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearAfcResentAt()
+	})
+}
+
 // SetIsService sets the "is_service" field.
 func (u *MessageUpsertOne) SetIsService(v bool) *MessageUpsertOne {
 	return u.Update(func(s *MessageUpsert) {
@@ -950,6 +1156,9 @@ func (u *MessageUpsertBulk) UpdateNewValues() *MessageUpsertBulk {
 			if _, exists := b.mutation.Body(); exists {
 				s.SetIgnore(message.FieldBody)
 			}
+			if _, exists := b.mutation.IsInternalNote(); exists {
+				s.SetIgnore(message.FieldIsInternalNote)
+			}
 			if _, exists := b.mutation.CreatedAt(); exists {
 				s.SetIgnore(message.FieldCreatedAt)
 			}
@@ -1104,6 +1313,69 @@ func (u *MessageUpsertBulk) UpdateIsBlocked() *MessageUpsertBulk {
 	})
 }
 
+// SetReviewRequestedAt sets the "review_requested_at" field.
+func (u *MessageUpsertBulk) SetReviewRequestedAt(v time.Time) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetReviewRequestedAt(v)
+	})
+}
+
+// UpdateReviewRequestedAt sets the "review_requested_at" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateReviewRequestedAt() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateReviewRequestedAt()
+	})
+}
+
+// ClearReviewRequestedAt clears the value of the "review_requested_at" field.
+func (u *MessageUpsertBulk) ClearReviewRequestedAt() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearReviewRequestedAt()
+	})
+}
+
+// SetAfcResends sets the "afc_resends" field.
+func (u *MessageUpsertBulk) SetAfcResends(v int) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetAfcResends(v)
+	})
+}
+
+// AddAfcResends adds v to the "afc_resends" field.
+func (u *MessageUpsertBulk) AddAfcResends(v int) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.AddAfcResends(v)
+	})
+}
+
+// UpdateAfcResends sets the "afc_resends" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateAfcResends() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateAfcResends()
+	})
+}
+
+// SetAfcResentAt sets the "afc_resent_at" field.
+func (u *MessageUpsertBulk) SetAfcResentAt(v time.Time) *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.SetAfcResentAt(v)
+	})
+}
+
+// UpdateAfcResentAt sets the "afc_resent_at" field to the value that was provided on create.
+func (u *MessageUpsertBulk) UpdateAfcResentAt() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.UpdateAfcResentAt()
+	})
+}
+
+// ClearAfcResentAt clears the value of the "afc_resent_at" field.
+func (u *MessageUpsertBulk) ClearAfcResentAt() *MessageUpsertBulk {
+	return u.Update(func(s *MessageUpsert) {
+		s.ClearAfcResentAt()
+	})
+}
+
 // SetIsService sets the "is_service" field.
 func (u *MessageUpsertBulk) SetIsService(v bool) *MessageUpsertBulk {
 	return u.Update(func(s *MessageUpsert) {
@@ -138,6 +138,67 @@ func (mu *MessageUpdate) SetNillableIsBlocked(b *bool) *MessageUpdate {
 	return mu
 }
 
+// SetReviewRequestedAt sets the "review_requested_at" field.
+func (mu *MessageUpdate) SetReviewRequestedAt(t time.Time) *MessageUpdate {
+	mu.mutation.SetReviewRequestedAt(t)
+	return mu
+}
+
+// SetNillableReviewRequestedAt sets the "review_requested_at" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableReviewRequestedAt(t *time.Time) *MessageUpdate {
+	if t != nil {
+		mu.SetReviewRequestedAt(*t)
+	}
+	return mu
+}
+
+// ClearReviewRequestedAt clears the value of the "review_requested_at" field.
+func (mu *MessageUpdate) ClearReviewRequestedAt() *MessageUpdate {
+	mu.mutation.ClearReviewRequestedAt()
+	return mu
+}
+
+// SetAfcResends sets the "afc_resends" field.
+func (mu *MessageUpdate) SetAfcResends(i int) *MessageUpdate {
+	mu.mutation.ResetAfcResends()
+	mu.mutation.SetAfcResends(i)
+	return mu
+}
+
+// SetNillableAfcResends sets the "afc_resends" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableAfcResends(i *int) *MessageUpdate {
+	if i != nil {
+		mu.SetAfcResends(*i)
+	}
+	return mu
+}
+
+// AddAfcResends adds i to the "afc_resends" field.
+func (mu *MessageUpdate) AddAfcResends(i int) *MessageUpdate {
+	mu.mutation.AddAfcResends(i)
+	return mu
+}
+
+// SetAfcResentAt sets the "afc_resent_at" field.
+func (mu *MessageUpdate) SetAfcResentAt(t time.Time) *MessageUpdate {
+	mu.mutation.SetAfcResentAt(t)
+	return mu
+}
+
+// SetNillableAfcResentAt sets the "afc_resent_at" field if the given value is not nil.
+func (mu *MessageUpdate) SetNillableAfcResentAt(t *time.Time) *MessageUpdate {
+	if t != nil {
+		mu.SetAfcResentAt(*t)
+	}
+	return mu
+}
+
+// ClearAfcResentAt clears the value of the "afc_resent_at" field.
+func (mu *MessageUpdate) ClearAfcResentAt() *MessageUpdate {
+	mu.mutation.ClearAfcResentAt()
+	return mu
+}
+
 // SetIsService sets the "is_service" field.
 func (mu *MessageUpdate) SetIsService(b bool) *MessageUpdate {
 	mu.mutation.SetIsService(b)
@@ -260,6 +321,24 @@ func (mu *MessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := mu.mutation.IsBlocked(); ok {
 		_spec.SetField(message.FieldIsBlocked, field.TypeBool, value)
 	}
+	if value, ok := mu.mutation.ReviewRequestedAt(); ok {
+		_spec.SetField(message.FieldReviewRequestedAt, field.TypeTime, value)
+	}
+	if mu.mutation.ReviewRequestedAtCleared() {
+		_spec.ClearField(message.FieldReviewRequestedAt, field.TypeTime)
+	}
+	if value, ok := mu.mutation.AfcResends(); ok {
+		_spec.SetField(message.FieldAfcResends, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.AddedAfcResends(); ok {
+		_spec.AddField(message.FieldAfcResends, field.TypeInt, value)
+	}
+	if value, ok := mu.mutation.AfcResentAt(); ok {
+		_spec.SetField(message.FieldAfcResentAt, field.TypeTime, value)
+	}
+	if mu.mutation.AfcResentAtCleared() {
+		_spec.ClearField(message.FieldAfcResentAt, field.TypeTime)
+	}
 	if value, ok := mu.mutation.IsService(); ok {
 		_spec.SetField(message.FieldIsService, field.TypeBool, value)
 	}
@@ -420,6 +499,67 @@ func (muo *MessageUpdateOne) SetNillableIsBlocked(b *bool) *MessageUpdateOne {
 	return muo
 }
 
+// SetReviewRequestedAt sets the "review_requested_at" field.
+func (muo *MessageUpdateOne) SetReviewRequestedAt(t time.Time) *MessageUpdateOne {
+	muo.mutation.SetReviewRequestedAt(t)
+	return muo
+}
+
+// SetNillableReviewRequestedAt sets the "review_requested_at" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableReviewRequestedAt(t *time.Time) *MessageUpdateOne {
+	if t != nil {
+		muo.SetReviewRequestedAt(*t)
+	}
+	return muo
+}
+
+// ClearReviewRequestedAt clears the value of the "review_requested_at" field.
+func (muo *MessageUpdateOne) ClearReviewRequestedAt() *MessageUpdateOne {
+	muo.mutation.ClearReviewRequestedAt()
+	return muo
+}
+
+// SetAfcResends sets the "afc_resends" field.
+func (muo *MessageUpdateOne) SetAfcResends(i int) *MessageUpdateOne {
+	muo.mutation.ResetAfcResends()
+	muo.mutation.SetAfcResends(i)
+	return muo
+}
+
+// SetNillableAfcResends sets the "afc_resends" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableAfcResends(i *int) *MessageUpdateOne {
+	if i != nil {
+		muo.SetAfcResends(*i)
+	}
+	return muo
+}
+
+// AddAfcResends adds i to the "afc_resends" field.
+func (muo *MessageUpdateOne) AddAfcResends(i int) *MessageUpdateOne {
+	muo.mutation.AddAfcResends(i)
+	return muo
+}
+
+// SetAfcResentAt sets the "afc_resent_at" field.
+func (muo *MessageUpdateOne) SetAfcResentAt(t time.Time) *MessageUpdateOne {
+	muo.mutation.SetAfcResentAt(t)
+	return muo
+}
+
+// SetNillableAfcResentAt sets the "afc_resent_at" field if the given value is not nil.
+func (muo *MessageUpdateOne) SetNillableAfcResentAt(t *time.Time) *MessageUpdateOne {
+	if t != nil {
+		muo.SetAfcResentAt(*t)
+	}
+	return muo
+}
+
+// ClearAfcResentAt clears the value of the "afc_resent_at" field.
+func (muo *MessageUpdateOne) ClearAfcResentAt() *MessageUpdateOne {
+	muo.mutation.ClearAfcResentAt()
+	return muo
+}
+
 // SetIsService sets the "is_service" field.
 func (muo *MessageUpdateOne) SetIsService(b bool) *MessageUpdateOne {
 	muo.mutation.SetIsService(b)
@@ -572,6 +712,24 @@ func (muo *MessageUpdateOne) sqlSave(ctx context.Context) (_node *Message, err e
 	if value, ok := muo.mutation.IsBlocked(); ok {
 		_spec.SetField(message.FieldIsBlocked, field.TypeBool, value)
 	}
+	if value, ok := muo.mutation.ReviewRequestedAt(); ok {
+		_spec.SetField(message.FieldReviewRequestedAt, field.TypeTime, value)
+	}
+	if muo.mutation.ReviewRequestedAtCleared() {
+		_spec.ClearField(message.FieldReviewRequestedAt, field.TypeTime)
+	}
+	if value, ok := muo.mutation.AfcResends(); ok {
+		_spec.SetField(message.FieldAfcResends, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.AddedAfcResends(); ok {
+		_spec.AddField(message.FieldAfcResends, field.TypeInt, value)
+	}
+	if value, ok := muo.mutation.AfcResentAt(); ok {
+		_spec.SetField(message.FieldAfcResentAt, field.TypeTime, value)
+	}
+	if muo.mutation.AfcResentAtCleared() {
+		_spec.ClearField(message.FieldAfcResentAt, field.TypeTime)
+	}
 	if value, ok := muo.mutation.IsService(); ok {
 		_spec.SetField(message.FieldIsService, field.TypeBool, value)
 	}
@@ -9,6 +9,35 @@ import (
 )
 
 var (
+	// AuditRecordsColumns holds the columns for the "audit_records" table.
+	AuditRecordsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "manager_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "action", Type: field.TypeEnum, Enums: []string{"get_chat_history", "send_message", "close_chat", "free_hands", "send_internal_note", "set_status", "afc_timeout_deliver", "afc_timeout_block"}},
+		{Name: "chat_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "problem_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "message_id", Type: field.TypeUUID, Nullable: true},
+		{Name: "request_id", Type: field.TypeUUID},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// AuditRecordsTable holds the schema information for the "audit_records" table.
+	AuditRecordsTable = &schema.Table{
+		Name:       "audit_records",
+		Columns:    AuditRecordsColumns,
+		PrimaryKey: []*schema.Column{AuditRecordsColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "auditrecord_manager_id_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditRecordsColumns[1], AuditRecordsColumns[7]},
+			},
+			{
+				Name:    "auditrecord_created_at",
+				Unique:  false,
+				Columns: []*schema.Column{AuditRecordsColumns[7]},
+			},
+		},
+	}
 	// ChatsColumns holds the columns for the "chats" table.
 	ChatsColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUUID, Unique: true},
@@ -75,7 +104,11 @@ var (
 		{Name: "body", Type: field.TypeString, Size: 3000},
 		{Name: "checked_at", Type: field.TypeTime, Nullable: true},
 		{Name: "is_blocked", Type: field.TypeBool, Default: false},
+		{Name: "review_requested_at", Type: field.TypeTime, Nullable: true},
+		{Name: "afc_resends", Type: field.TypeInt, Default: 0},
+		{Name: "afc_resent_at", Type: field.TypeTime, Nullable: true},
 		{Name: "is_service", Type: field.TypeBool, Default: false},
+		{Name: "is_internal_note", Type: field.TypeBool, Default: false},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "chat_id", Type: field.TypeUUID},
 		{Name: "problem_id", Type: field.TypeUUID},
@@ -88,13 +121,13 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "messages_chats_messages",
-				Columns:    []*schema.Column{MessagesColumns[10]},
+				Columns:    []*schema.Column{MessagesColumns[14]},
 				RefColumns: []*schema.Column{ChatsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
 			{
 				Symbol:     "messages_problems_messages",
-				Columns:    []*schema.Column{MessagesColumns[11]},
+				Columns:    []*schema.Column{MessagesColumns[15]},
 				RefColumns: []*schema.Column{ProblemsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -111,12 +144,12 @@ var (
 			{
 				Name:    "message_created_at_chat_id",
 				Unique:  false,
-				Columns: []*schema.Column{MessagesColumns[9], MessagesColumns[10]},
+				Columns: []*schema.Column{MessagesColumns[13], MessagesColumns[14]},
 			},
 			{
 				Name:    "message_created_at_problem_id",
 				Unique:  false,
-				Columns: []*schema.Column{MessagesColumns[9], MessagesColumns[11]},
+				Columns: []*schema.Column{MessagesColumns[13], MessagesColumns[15]},
 			},
 		},
 	}
@@ -125,6 +158,7 @@ var (
 		{Name: "id", Type: field.TypeUUID, Unique: true},
 		{Name: "manager_id", Type: field.TypeUUID, Nullable: true},
 		{Name: "resolved_at", Type: field.TypeTime, Nullable: true},
+		{Name: "reassign_requested_at", Type: field.TypeTime, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "chat_id", Type: field.TypeUUID},
 	}
@@ -136,7 +170,7 @@ var (
 		ForeignKeys: []*schema.ForeignKey{
 			{
 				Symbol:     "problems_chats_problems",
-				Columns:    []*schema.Column{ProblemsColumns[4]},
+				Columns:    []*schema.Column{ProblemsColumns[5]},
 				RefColumns: []*schema.Column{ChatsColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -145,7 +179,7 @@ var (
 			{
 				Name:    "problem_chat_id",
 				Unique:  false,
-				Columns: []*schema.Column{ProblemsColumns[4]},
+				Columns: []*schema.Column{ProblemsColumns[5]},
 			},
 			{
 				Name:    "problem_manager_id",
@@ -154,13 +188,39 @@ var (
 			},
 		},
 	}
+	// ScheduledMessagesColumns holds the columns for the "scheduled_messages" table.
+	ScheduledMessagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeUUID, Unique: true},
+		{Name: "chat_id", Type: field.TypeUUID},
+		{Name: "problem_id", Type: field.TypeUUID},
+		{Name: "manager_id", Type: field.TypeUUID},
+		{Name: "initial_request_id", Type: field.TypeUUID, Unique: true},
+		{Name: "body", Type: field.TypeString, Size: 3000},
+		{Name: "deliver_at", Type: field.TypeTime},
+		{Name: "created_at", Type: field.TypeTime},
+	}
+	// ScheduledMessagesTable holds the schema information for the "scheduled_messages" table.
+	ScheduledMessagesTable = &schema.Table{
+		Name:       "scheduled_messages",
+		Columns:    ScheduledMessagesColumns,
+		PrimaryKey: []*schema.Column{ScheduledMessagesColumns[0]},
+		Indexes: []*schema.Index{
+			{
+				Name:    "scheduledmessage_manager_id_deliver_at",
+				Unique:  false,
+				Columns: []*schema.Column{ScheduledMessagesColumns[3], ScheduledMessagesColumns[6]},
+			},
+		},
+	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AuditRecordsTable,
 		ChatsTable,
 		FailedJobsTable,
 		JobsTable,
 		MessagesTable,
 		ProblemsTable,
+		ScheduledMessagesTable,
 	}
 )
 
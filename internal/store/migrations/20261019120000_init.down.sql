@@ -0,0 +1,12 @@
+-- reverse: create "scheduled_messages" table
+DROP TABLE "scheduled_messages";
+-- reverse: create "messages" table
+DROP TABLE "messages";
+-- reverse: create "problems" table
+DROP TABLE "problems";
+-- reverse: create "jobs" table
+DROP TABLE "jobs";
+-- reverse: create "failed_jobs" table
+DROP TABLE "failed_jobs";
+-- reverse: create "chats" table
+DROP TABLE "chats";
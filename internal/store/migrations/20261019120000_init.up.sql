@@ -0,0 +1,37 @@
+-- Baseline of the schema previously created by ent auto-migration.
+-- "IF NOT EXISTS" lets databases created before versioned migrations adopt it as is.
+
+-- create "chats" table
+CREATE TABLE IF NOT EXISTS "chats" ("id" uuid NOT NULL, "client_id" uuid NOT NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "chat_client_id" to table: "chats"
+CREATE INDEX IF NOT EXISTS "chat_client_id" ON "chats" ("client_id");
+-- create index "chats_client_id_key" to table: "chats"
+CREATE UNIQUE INDEX IF NOT EXISTS "chats_client_id_key" ON "chats" ("client_id");
+-- create "failed_jobs" table
+CREATE TABLE IF NOT EXISTS "failed_jobs" ("id" uuid NOT NULL, "name" character varying NOT NULL, "payload" text NOT NULL, "reason" character varying NOT NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create "jobs" table
+CREATE TABLE IF NOT EXISTS "jobs" ("id" uuid NOT NULL, "name" character varying NOT NULL, "payload" text NOT NULL, "attempts" bigint NOT NULL DEFAULT 0, "available_at" timestamptz NOT NULL, "reserved_until" timestamptz NOT NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "job_available_at_reserved_until" to table: "jobs"
+CREATE INDEX IF NOT EXISTS "job_available_at_reserved_until" ON "jobs" ("available_at", "reserved_until");
+-- create "problems" table
+CREATE TABLE IF NOT EXISTS "problems" ("id" uuid NOT NULL, "manager_id" uuid NULL, "resolved_at" timestamptz NULL, "created_at" timestamptz NOT NULL, "chat_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "problems_chats_problems" FOREIGN KEY ("chat_id") REFERENCES "chats" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- create index "problem_chat_id" to table: "problems"
+CREATE INDEX IF NOT EXISTS "problem_chat_id" ON "problems" ("chat_id");
+-- create index "problem_manager_id" to table: "problems"
+CREATE INDEX IF NOT EXISTS "problem_manager_id" ON "problems" ("manager_id");
+-- create "messages" table
+CREATE TABLE IF NOT EXISTS "messages" ("id" uuid NOT NULL, "author_id" uuid NULL, "initial_request_id" uuid NULL, "is_visible_for_client" boolean NOT NULL DEFAULT false, "is_visible_for_manager" boolean NOT NULL DEFAULT false, "body" character varying NOT NULL, "checked_at" timestamptz NULL, "is_blocked" boolean NOT NULL DEFAULT false, "is_service" boolean NOT NULL DEFAULT false, "created_at" timestamptz NOT NULL, "chat_id" uuid NOT NULL, "problem_id" uuid NOT NULL, PRIMARY KEY ("id"), CONSTRAINT "messages_chats_messages" FOREIGN KEY ("chat_id") REFERENCES "chats" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION, CONSTRAINT "messages_problems_messages" FOREIGN KEY ("problem_id") REFERENCES "problems" ("id") ON UPDATE NO ACTION ON DELETE NO ACTION);
+-- create index "message_created_at_chat_id" to table: "messages"
+CREATE INDEX IF NOT EXISTS "message_created_at_chat_id" ON "messages" ("created_at", "chat_id");
+-- create index "message_created_at_problem_id" to table: "messages"
+CREATE INDEX IF NOT EXISTS "message_created_at_problem_id" ON "messages" ("created_at", "problem_id");
+-- create index "message_initial_request_id" to table: "messages"
+CREATE UNIQUE INDEX IF NOT EXISTS "message_initial_request_id" ON "messages" ("initial_request_id") WHERE (NOT is_service);
+-- create index "messages_initial_request_id_key" to table: "messages"
+CREATE UNIQUE INDEX IF NOT EXISTS "messages_initial_request_id_key" ON "messages" ("initial_request_id");
+-- create "scheduled_messages" table
+CREATE TABLE IF NOT EXISTS "scheduled_messages" ("id" uuid NOT NULL, "chat_id" uuid NOT NULL, "problem_id" uuid NOT NULL, "manager_id" uuid NOT NULL, "initial_request_id" uuid NOT NULL, "body" character varying NOT NULL, "deliver_at" timestamptz NOT NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "scheduledmessage_manager_id_deliver_at" to table: "scheduled_messages"
+CREATE INDEX IF NOT EXISTS "scheduledmessage_manager_id_deliver_at" ON "scheduled_messages" ("manager_id", "deliver_at");
+-- create index "scheduled_messages_initial_request_id_key" to table: "scheduled_messages"
+CREATE UNIQUE INDEX IF NOT EXISTS "scheduled_messages_initial_request_id_key" ON "scheduled_messages" ("initial_request_id");
@@ -0,0 +1,5 @@
+-- reverse: append-only trigger of "audit_records" table
+DROP TRIGGER "audit_records_append_only" ON "audit_records";
+DROP FUNCTION "audit_records_append_only"();
+-- reverse: create "audit_records" table
+DROP TABLE "audit_records";
@@ -0,0 +1,12 @@
+-- "IF NOT EXISTS" keeps databases created by ent auto-migration adoptable, see the init migration.
+
+-- create "audit_records" table
+CREATE TABLE IF NOT EXISTS "audit_records" ("id" uuid NOT NULL, "manager_id" uuid NOT NULL, "action" character varying NOT NULL, "chat_id" uuid NULL, "problem_id" uuid NULL, "request_id" uuid NOT NULL, "created_at" timestamptz NOT NULL, PRIMARY KEY ("id"));
+-- create index "auditrecord_created_at" to table: "audit_records"
+CREATE INDEX IF NOT EXISTS "auditrecord_created_at" ON "audit_records" ("created_at");
+-- create index "auditrecord_manager_id_created_at" to table: "audit_records"
+CREATE INDEX IF NOT EXISTS "auditrecord_manager_id_created_at" ON "audit_records" ("manager_id", "created_at");
+-- The audit log is append-only: records cannot be changed or deleted.
+CREATE OR REPLACE FUNCTION "audit_records_append_only"() RETURNS trigger LANGUAGE plpgsql AS 'BEGIN RAISE EXCEPTION ''audit_records is append-only''; END;';
+DROP TRIGGER IF EXISTS "audit_records_append_only" ON "audit_records";
+CREATE TRIGGER "audit_records_append_only" BEFORE UPDATE OR DELETE ON "audit_records" FOR EACH ROW EXECUTE FUNCTION "audit_records_append_only"();
@@ -0,0 +1,2 @@
+-- modify "messages" table
+ALTER TABLE "messages" ADD COLUMN IF NOT EXISTS "is_internal_note" boolean NOT NULL DEFAULT false;
@@ -0,0 +1,2 @@
+-- reverse: modify "problems" table
+ALTER TABLE "problems" DROP COLUMN "reassign_requested_at";
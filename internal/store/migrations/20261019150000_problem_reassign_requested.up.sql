@@ -0,0 +1,2 @@
+-- modify "problems" table
+ALTER TABLE "problems" ADD COLUMN IF NOT EXISTS "reassign_requested_at" timestamptz NULL;
@@ -0,0 +1,2 @@
+-- modify "messages" table
+ALTER TABLE "messages" ADD COLUMN IF NOT EXISTS "review_requested_at" timestamptz NULL;
@@ -0,0 +1,6 @@
+-- The system audit records of the afc watchdog have no manager and cannot be deleted, see the audit_records migration.
+DO 'BEGIN IF EXISTS (SELECT 1 FROM "audit_records" WHERE "manager_id" IS NULL) THEN RAISE EXCEPTION ''irreversible migration: audit_records has system records without manager_id''; END IF; END;';
+-- reverse: modify "messages" table
+ALTER TABLE "messages" DROP COLUMN "afc_resent_at", DROP COLUMN "afc_resends";
+-- reverse: modify "audit_records" table
+ALTER TABLE "audit_records" DROP COLUMN "message_id", ALTER COLUMN "manager_id" SET NOT NULL;
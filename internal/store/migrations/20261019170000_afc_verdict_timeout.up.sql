@@ -0,0 +1,4 @@
+-- modify "audit_records" table
+ALTER TABLE "audit_records" ALTER COLUMN "manager_id" DROP NOT NULL, ADD COLUMN IF NOT EXISTS "message_id" uuid NULL;
+-- modify "messages" table
+ALTER TABLE "messages" ADD COLUMN IF NOT EXISTS "afc_resends" bigint NOT NULL DEFAULT 0, ADD COLUMN IF NOT EXISTS "afc_resent_at" timestamptz NULL;
@@ -0,0 +1,357 @@
+package migrations
+
+import (
+	"context"
+	"database/sql"
+	"embed"
+	"errors"
+	"fmt"
+	"io/fs"
+	"path"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Files holds versioned migrations in the golang-migrate format:
+// <version>_<name>.up.sql and <version>_<name>.down.sql.
+//
+// New migrations are generated from the ent schema diff by cmd/gen-migration.
+//
+//go:embed *.sql
+var Files embed.FS
+
+const (
+	// trackingTable stores versions of the applied migrations.
+	trackingTable = "schema_migrations"
+
+	// lockKey is a key of the advisory lock preventing concurrent migrations.
+	lockKey = 7_424_313_910
+
+	upSuffix   = ".up.sql"
+	downSuffix = ".down.sql"
+)
+
+var (
+	ErrSchemaBehind    = errors.New("database schema is behind")
+	ErrNothingToRevert = errors.New("no applied migrations to revert")
+)
+
+type Migration struct {
+	Version int64
+	Name    string
+
+	up   string
+	down string
+}
+
+type Status struct {
+	Migration
+	AppliedAt *time.Time
+}
+
+func (s Status) Applied() bool {
+	return s.AppliedAt != nil
+}
+
+//go:generate options-gen -out-filename=migrations_options.gen.go -from-struct=Options
+type Options struct {
+	db    *sql.DB `option:"mandatory" validate:"required"`
+	files fs.FS
+}
+
+type Migrator struct {
+	db         *sql.DB
+	migrations []Migration
+}
+
+func New(opts Options) (*Migrator, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate options, err=%v", err)
+	}
+
+	files := opts.files
+	if files == nil {
+		files = Files
+	}
+
+	migrations, err := parse(files)
+	if err != nil {
+		return nil, fmt.Errorf("parse migrations, err=%v", err)
+	}
+
+	return &Migrator{
+		db:         opts.db,
+		migrations: migrations,
+	}, nil
+}
+
+// Up applies all pending migrations in order of versions and returns the applied ones.
+func (m *Migrator) Up(ctx context.Context) ([]Migration, error) {
+	var applied []Migration
+
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		versions, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for _, mg := range m.migrations {
+			if _, ok := versions[mg.Version]; ok {
+				continue
+			}
+
+			if err := apply(ctx, conn, mg.up, func(tx *sql.Tx) error {
+				_, err := tx.ExecContext(ctx,
+					"INSERT INTO "+trackingTable+" (version, name) VALUES ($1, $2)", mg.Version, mg.Name)
+				return err
+			}); err != nil {
+				return fmt.Errorf("apply migration %d_%s, err=%v", mg.Version, mg.Name, err)
+			}
+
+			applied = append(applied, mg)
+		}
+
+		return nil
+	})
+
+	return applied, err
+}
+
+// Down reverts the last applied migration and returns it.
+func (m *Migrator) Down(ctx context.Context) (Migration, error) {
+	var reverted Migration
+
+	err := m.withLock(ctx, func(conn *sql.Conn) error {
+		versions, err := appliedVersions(ctx, conn)
+		if err != nil {
+			return err
+		}
+
+		for i := len(m.migrations) - 1; i >= 0; i-- {
+			mg := m.migrations[i]
+			if _, ok := versions[mg.Version]; !ok {
+				continue
+			}
+
+			if err := apply(ctx, conn, mg.down, func(tx *sql.Tx) error {
+				_, err := tx.ExecContext(ctx, "DELETE FROM "+trackingTable+" WHERE version = $1", mg.Version)
+				return err
+			}); err != nil {
+				return fmt.Errorf("revert migration %d_%s, err=%v", mg.Version, mg.Name, err)
+			}
+
+			reverted = mg
+			return nil
+		}
+
+		return ErrNothingToRevert
+	})
+
+	return reverted, err
+}
+
+// Status returns all known migrations with the time they were applied at.
+func (m *Migrator) Status(ctx context.Context) ([]Status, error) {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("get db conn, err=%v", err)
+	}
+	defer conn.Close()
+
+	versions, err := appliedVersions(ctx, conn)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]Status, 0, len(m.migrations))
+	for _, mg := range m.migrations {
+		s := Status{Migration: mg}
+		if t, ok := versions[mg.Version]; ok {
+			t := t
+			s.AppliedAt = &t
+		}
+		result = append(result, s)
+	}
+
+	return result, nil
+}
+
+// CheckUpToDate returns ErrSchemaBehind if there are not applied migrations.
+// Migrations unknown to the binary are allowed, e.g. the DB was migrated by a newer version.
+func (m *Migrator) CheckUpToDate(ctx context.Context) error {
+	statuses, err := m.Status(ctx)
+	if err != nil {
+		return fmt.Errorf("get migrations status, err=%v", err)
+	}
+
+	var pending []string
+	for _, s := range statuses {
+		if !s.Applied() {
+			pending = append(pending, fmt.Sprintf("%d_%s", s.Version, s.Name))
+		}
+	}
+
+	if len(pending) > 0 {
+		return fmt.Errorf("%w: pending migrations %v", ErrSchemaBehind, pending)
+	}
+
+	return nil
+}
+
+func (m *Migrator) withLock(ctx context.Context, f func(conn *sql.Conn) error) error {
+	conn, err := m.db.Conn(ctx)
+	if err != nil {
+		return fmt.Errorf("get db conn, err=%v", err)
+	}
+	defer conn.Close()
+
+	if _, err := conn.ExecContext(ctx, "SELECT pg_advisory_lock($1)", lockKey); err != nil {
+		return fmt.Errorf("acquire migrations lock, err=%v", err)
+	}
+	defer func() {
+		// Use the background context to release the lock even if ctx is already done.
+		_, _ = conn.ExecContext(context.Background(), "SELECT pg_advisory_unlock($1)", lockKey)
+	}()
+
+	if err := createTrackingTable(ctx, conn); err != nil {
+		return err
+	}
+
+	return f(conn)
+}
+
+func createTrackingTable(ctx context.Context, conn *sql.Conn) error {
+	_, err := conn.ExecContext(ctx, `CREATE TABLE IF NOT EXISTS `+trackingTable+` (
+	version bigint NOT NULL PRIMARY KEY,
+	name character varying NOT NULL,
+	applied_at timestamptz NOT NULL DEFAULT now()
+)`)
+	if err != nil {
+		return fmt.Errorf("create migrations tracking table, err=%v", err)
+	}
+	return nil
+}
+
+func appliedVersions(ctx context.Context, conn *sql.Conn) (map[int64]time.Time, error) {
+	var exists bool
+	if err := conn.QueryRowContext(ctx, "SELECT to_regclass($1) IS NOT NULL", trackingTable).Scan(&exists); err != nil {
+		return nil, fmt.Errorf("check migrations tracking table, err=%v", err)
+	}
+	if !exists {
+		return map[int64]time.Time{}, nil
+	}
+
+	rows, err := conn.QueryContext(ctx, "SELECT version, applied_at FROM "+trackingTable)
+	if err != nil {
+		return nil, fmt.Errorf("select applied migrations, err=%v", err)
+	}
+	defer rows.Close()
+
+	result := make(map[int64]time.Time)
+	for rows.Next() {
+		var (
+			v int64
+			t time.Time
+		)
+		if err := rows.Scan(&v, &t); err != nil {
+			return nil, fmt.Errorf("scan applied migration, err=%v", err)
+		}
+		result[v] = t
+	}
+
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("iterate applied migrations, err=%v", err)
+	}
+	return result, nil
+}
+
+// apply executes the migration script and tracks it in the same transaction.
+func apply(ctx context.Context, conn *sql.Conn, script string, track func(tx *sql.Tx) error) error {
+	tx, err := conn.BeginTx(ctx, nil)
+	if err != nil {
+		return fmt.Errorf("begin tx, err=%v", err)
+	}
+	defer func() { _ = tx.Rollback() }()
+
+	if strings.TrimSpace(script) != "" {
+		if _, err := tx.ExecContext(ctx, script); err != nil {
+			return fmt.Errorf("exec script, err=%v", err)
+		}
+	}
+
+	if err := track(tx); err != nil {
+		return fmt.Errorf("track migration, err=%v", err)
+	}
+
+	if err := tx.Commit(); err != nil {
+		return fmt.Errorf("commit tx, err=%v", err)
+	}
+	return nil
+}
+
+func parse(files fs.FS) ([]Migration, error) {
+	entries, err := fs.ReadDir(files, ".")
+	if err != nil {
+		return nil, fmt.Errorf("read dir, err=%v", err)
+	}
+
+	byVersion := make(map[int64]*Migration)
+	for _, e := range entries {
+		if e.IsDir() || path.Ext(e.Name()) != ".sql" {
+			continue
+		}
+
+		fileName := e.Name()
+
+		var base string
+		var isUp bool
+		switch {
+		case strings.HasSuffix(fileName, upSuffix):
+			base, isUp = strings.TrimSuffix(fileName, upSuffix), true
+		case strings.HasSuffix(fileName, downSuffix):
+			base = strings.TrimSuffix(fileName, downSuffix)
+		default:
+			return nil, fmt.Errorf("unexpected migration file %q", fileName)
+		}
+
+		rawVersion, name, _ := strings.Cut(base, "_")
+		version, err := strconv.ParseInt(rawVersion, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parse version of %q, err=%v", fileName, err)
+		}
+
+		content, err := fs.ReadFile(files, fileName)
+		if err != nil {
+			return nil, fmt.Errorf("read %q, err=%v", fileName, err)
+		}
+
+		mg, ok := byVersion[version]
+		if !ok {
+			mg = &Migration{Version: version, Name: name}
+			byVersion[version] = mg
+		}
+		if mg.Name != name {
+			return nil, fmt.Errorf("migration %d has different names: %q and %q", version, mg.Name, name)
+		}
+
+		if isUp {
+			mg.up = string(content)
+		} else {
+			mg.down = string(content)
+		}
+	}
+
+	result := make([]Migration, 0, len(byVersion))
+	for _, mg := range byVersion {
+		if mg.up == "" {
+			return nil, fmt.Errorf("migration %d_%s has no up script", mg.Version, mg.Name)
+		}
+		result = append(result, *mg)
+	}
+
+	sort.Slice(result, func(i, j int) bool { return result[i].Version < result[j].Version })
+
+	return result, nil
+}
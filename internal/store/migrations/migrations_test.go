@@ -0,0 +1,178 @@
+//go:build integration
+
+package migrations_test
+
+import (
+	"bytes"
+	"database/sql"
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/karasunokami/chat-service/internal/store/migrate"
+	"github.com/karasunokami/chat-service/internal/store/migrations"
+	"github.com/karasunokami/chat-service/internal/testingh"
+	"github.com/karasunokami/chat-service/internal/types"
+
+	"entgo.io/ent/dialect"
+	entsql "entgo.io/ent/dialect/sql"
+	"github.com/google/uuid"
+	"github.com/stretchr/testify/suite"
+)
+
+type MigrationsSuite struct {
+	testingh.ContextSuite
+
+	db       *sql.DB
+	cleanUp  func()
+	migrator *migrations.Migrator
+}
+
+func TestMigrationsSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(MigrationsSuite))
+}
+
+func (s *MigrationsSuite) SetupTest() {
+	s.ContextSuite.SetupTest()
+
+	dbName := "TestMigrationsSuite" + strings.ReplaceAll(uuid.New().String(), "-", "")
+
+	db, cleanUp := testingh.PrepareEmptyDB(s.Ctx, s.T(), dbName)
+	s.db = db
+	s.cleanUp = func() { cleanUp(s.SuiteCtx) }
+
+	var err error
+	s.migrator, err = migrations.New(migrations.NewOptions(s.db))
+	s.Require().NoError(err)
+}
+
+func (s *MigrationsSuite) TearDownTest() {
+	s.cleanUp()
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *MigrationsSuite) TestUpDownStatus() {
+	// Empty database.
+	statuses, err := s.migrator.Status(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().NotEmpty(statuses)
+	for _, st := range statuses {
+		s.False(st.Applied())
+	}
+	s.ErrorIs(s.migrator.CheckUpToDate(s.Ctx), migrations.ErrSchemaBehind)
+
+	// Apply all.
+	applied, err := s.migrator.Up(s.Ctx)
+	s.Require().NoError(err)
+	s.Len(applied, len(statuses))
+	s.NoError(s.migrator.CheckUpToDate(s.Ctx))
+
+	// Nothing to apply.
+	applied, err = s.migrator.Up(s.Ctx)
+	s.Require().NoError(err)
+	s.Empty(applied)
+
+	// Revert the last one.
+	reverted, err := s.migrator.Down(s.Ctx)
+	s.Require().NoError(err)
+	s.Equal(statuses[len(statuses)-1].Version, reverted.Version)
+	s.ErrorIs(s.migrator.CheckUpToDate(s.Ctx), migrations.ErrSchemaBehind)
+
+	statuses, err = s.migrator.Status(s.Ctx)
+	s.Require().NoError(err)
+	s.False(statuses[len(statuses)-1].Applied())
+}
+
+func (s *MigrationsSuite) TestDownAll() {
+	applied, err := s.migrator.Up(s.Ctx)
+	s.Require().NoError(err)
+
+	for range applied {
+		_, err := s.migrator.Down(s.Ctx)
+		s.Require().NoError(err)
+	}
+
+	_, err = s.migrator.Down(s.Ctx)
+	s.ErrorIs(err, migrations.ErrNothingToRevert)
+}
+
+func (s *MigrationsSuite) TestMigrationsAreInSyncWithEntSchema() {
+	_, err := s.migrator.Up(s.Ctx)
+	s.Require().NoError(err)
+
+	buf := new(bytes.Buffer)
+	err = migrate.NewSchema(entsql.OpenDB(dialect.Postgres, s.db)).WriteTo(s.Ctx, buf)
+	s.Require().NoError(err)
+	s.Empty(buf.String(), "ent schema has changes not covered by migrations, run cmd/gen-migration")
+}
+
+func (s *MigrationsSuite) TestAdoptAutoMigratedDatabase() {
+	err := migrate.NewSchema(entsql.OpenDB(dialect.Postgres, s.db)).Create(s.Ctx)
+	s.Require().NoError(err)
+
+	_, err = s.migrator.Up(s.Ctx)
+	s.Require().NoError(err)
+	s.NoError(s.migrator.CheckUpToDate(s.Ctx))
+}
+
+func (s *MigrationsSuite) TestAuditRecordsAreAppendOnly() {
+	_, err := s.migrator.Up(s.Ctx)
+	s.Require().NoError(err)
+
+	_, err = s.db.ExecContext(s.Ctx, `INSERT INTO "audit_records"
+		("id", "manager_id", "action", "request_id", "created_at") VALUES ($1, $2, 'free_hands', $3, now())`,
+		types.NewAuditRecordID(), types.NewUserID(), types.NewRequestID())
+	s.Require().NoError(err)
+
+	_, err = s.db.ExecContext(s.Ctx, `UPDATE "audit_records" SET "action" = 'close_chat'`)
+	s.Require().Error(err)
+
+	_, err = s.db.ExecContext(s.Ctx, `DELETE FROM "audit_records"`)
+	s.Require().Error(err)
+}
+
+func (s *MigrationsSuite) TestSystemAuditRecordsMakeAfcVerdictTimeoutIrreversible() {
+	applied, err := s.migrator.Up(s.Ctx)
+	s.Require().NoError(err)
+	last := applied[len(applied)-1]
+	s.Require().Equal("afc_verdict_timeout", last.Name)
+
+	_, err = s.db.ExecContext(s.Ctx, `INSERT INTO "audit_records"
+		("id", "action", "message_id", "request_id", "created_at") VALUES ($1, 'afc_timeout_deliver', $2, $3, now())`,
+		types.NewAuditRecordID(), types.NewMessageID(), types.NewRequestID())
+	s.Require().NoError(err)
+
+	_, err = s.migrator.Down(s.Ctx)
+	s.Require().ErrorContains(err, "irreversible migration")
+
+	statuses, err := s.migrator.Status(s.Ctx)
+	s.Require().NoError(err)
+	s.True(statuses[len(statuses)-1].Applied())
+}
+
+func (s *MigrationsSuite) TestFailedMigrationIsNotTracked() {
+	m, err := migrations.New(migrations.NewOptions(s.db, migrations.WithFiles(fstest.MapFS{
+		"1_ok.up.sql":     {Data: []byte(`CREATE TABLE "t1" ("id" bigint);`)},
+		"1_ok.down.sql":   {Data: []byte(`DROP TABLE "t1";`)},
+		"2_fail.up.sql":   {Data: []byte(`CREATE TABLE "t2" ("id" bigint); SELECT * FROM "unknown";`)},
+		"2_fail.down.sql": {Data: []byte(`DROP TABLE "t2";`)},
+	})))
+	s.Require().NoError(err)
+
+	applied, err := m.Up(s.Ctx)
+	s.Require().Error(err)
+	s.Require().Len(applied, 1)
+	s.Equal(int64(1), applied[0].Version)
+
+	statuses, err := m.Status(s.Ctx)
+	s.Require().NoError(err)
+	s.Require().Len(statuses, 2)
+	s.True(statuses[0].Applied())
+	s.False(statuses[1].Applied())
+
+	var exists bool
+	err = s.db.QueryRowContext(s.Ctx, `SELECT to_regclass('t2') IS NOT NULL`).Scan(&exists)
+	s.Require().NoError(err)
+	s.False(exists)
+}
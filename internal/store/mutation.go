@@ -11,12 +11,14 @@ import (
 
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
+	"github.com/karasunokami/chat-service/internal/store/auditrecord"
 	"github.com/karasunokami/chat-service/internal/store/chat"
 	"github.com/karasunokami/chat-service/internal/store/failedjob"
 	"github.com/karasunokami/chat-service/internal/store/job"
 	"github.com/karasunokami/chat-service/internal/store/message"
 	"github.com/karasunokami/chat-service/internal/store/predicate"
 	"github.com/karasunokami/chat-service/internal/store/problem"
+	"github.com/karasunokami/chat-service/internal/store/scheduledmessage"
 	"github.com/karasunokami/chat-service/internal/types"
 )
 
@@ -29,44 +31,45 @@ const (
 	OpUpdateOne = ent.OpUpdateOne
 
 	// Node types.
-	TypeChat      = "Chat"
-	TypeFailedJob = "FailedJob"
-	TypeJob       = "Job"
-	TypeMessage   = "Message"
-	TypeProblem   = "Problem"
+	TypeAuditRecord      = "AuditRecord"
+	TypeChat             = "Chat"
+	TypeFailedJob        = "FailedJob"
+	TypeJob              = "Job"
+	TypeMessage          = "Message"
+	TypeProblem          = "Problem"
+	TypeScheduledMessage = "ScheduledMessage"
 )
 
-// ChatMutation represents an operation that mutates the Chat nodes in the graph.
-type ChatMutation struct {
+// AuditRecordMutation represents an operation that mutates the AuditRecord nodes in the graph.
+type AuditRecordMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *types.ChatID
-	client_id       *types.UserID
-	created_at      *time.Time
-	clearedFields   map[string]struct{}
-	messages        map[types.MessageID]struct{}
-	removedmessages map[types.MessageID]struct{}
-	clearedmessages bool
-	problems        map[types.ProblemID]struct{}
-	removedproblems map[types.ProblemID]struct{}
-	clearedproblems bool
-	done            bool
-	oldValue        func(context.Context) (*Chat, error)
-	predicates      []predicate.Chat
+	op            Op
+	typ           string
+	id            *types.AuditRecordID
+	manager_id    *types.UserID
+	action        *auditrecord.Action
+	chat_id       *types.ChatID
+	problem_id    *types.ProblemID
+	message_id    *types.MessageID
+	request_id    *types.RequestID
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*AuditRecord, error)
+	predicates    []predicate.AuditRecord
 }
 
-var _ ent.Mutation = (*ChatMutation)(nil)
+var _ ent.Mutation = (*AuditRecordMutation)(nil)
 
-// chatOption allows management of the mutation configuration using functional options.
-type chatOption func(*ChatMutation)
+// auditrecordOption allows management of the mutation configuration using functional options.
+type auditrecordOption func(*AuditRecordMutation)
 
-// newChatMutation creates new mutation for the Chat entity.
-func newChatMutation(c config, op Op, opts ...chatOption) *ChatMutation {
-	m := &ChatMutation{
+// newAuditRecordMutation creates new mutation for the AuditRecord entity.
+func newAuditRecordMutation(c config, op Op, opts ...auditrecordOption) *AuditRecordMutation {
+	m := &AuditRecordMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeChat,
+		typ:           TypeAuditRecord,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -75,20 +78,20 @@ func newChatMutation(c config, op Op, opts ...chatOption) *ChatMutation {
 	return m
 }
 
-// withChatID sets the ID field of the mutation.
-func withChatID(id types.ChatID) chatOption {
-	return func(m *ChatMutation) {
+// withAuditRecordID sets the ID field of the mutation.
+func withAuditRecordID(id types.AuditRecordID) auditrecordOption {
+	return func(m *AuditRecordMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Chat
+			value *AuditRecord
 		)
-		m.oldValue = func(ctx context.Context) (*Chat, error) {
+		m.oldValue = func(ctx context.Context) (*AuditRecord, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Chat.Get(ctx, id)
+					value, err = m.Client().AuditRecord.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -97,10 +100,10 @@ func withChatID(id types.ChatID) chatOption {
 	}
 }
 
-// withChat sets the old Chat of the mutation.
-func withChat(node *Chat) chatOption {
-	return func(m *ChatMutation) {
-		m.oldValue = func(context.Context) (*Chat, error) {
+// withAuditRecord sets the old AuditRecord of the mutation.
+func withAuditRecord(node *AuditRecord) auditrecordOption {
+	return func(m *AuditRecordMutation) {
+		m.oldValue = func(context.Context) (*AuditRecord, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -109,7 +112,7 @@ func withChat(node *Chat) chatOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ChatMutation) Client() *Client {
+func (m AuditRecordMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -117,7 +120,7 @@ func (m ChatMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ChatMutation) Tx() (*Tx, error) {
+func (m AuditRecordMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("store: mutation is not running in a transaction")
 	}
@@ -127,14 +130,14 @@ func (m ChatMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Chat entities.
-func (m *ChatMutation) SetID(id types.ChatID) {
+// operation is only accepted on creation of AuditRecord entities.
+func (m *AuditRecordMutation) SetID(id types.AuditRecordID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ChatMutation) ID() (id types.ChatID, exists bool) {
+func (m *AuditRecordMutation) ID() (id types.AuditRecordID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -145,241 +148,380 @@ func (m *ChatMutation) ID() (id types.ChatID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ChatMutation) IDs(ctx context.Context) ([]types.ChatID, error) {
+func (m *AuditRecordMutation) IDs(ctx context.Context) ([]types.AuditRecordID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []types.ChatID{id}, nil
+			return []types.AuditRecordID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Chat.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().AuditRecord.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetClientID sets the "client_id" field.
-func (m *ChatMutation) SetClientID(ti types.UserID) {
-	m.client_id = &ti
+// SetManagerID sets the "manager_id" field.
+func (m *AuditRecordMutation) SetManagerID(ti types.UserID) {
+	m.manager_id = &ti
 }
 
-// ClientID returns the value of the "client_id" field in the mutation.
-func (m *ChatMutation) ClientID() (r types.UserID, exists bool) {
-	v := m.client_id
+// ManagerID returns the value of the "manager_id" field in the mutation.
+func (m *AuditRecordMutation) ManagerID() (r types.UserID, exists bool) {
+	v := m.manager_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldClientID returns the old "client_id" field's value of the Chat entity.
-// If the Chat object wasn't provided to the builder, the object is fetched from the database.
+// OldManagerID returns the old "manager_id" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChatMutation) OldClientID(ctx context.Context) (v types.UserID, err error) {
+func (m *AuditRecordMutation) OldManagerID(ctx context.Context) (v types.UserID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
+		return v, errors.New("OldManagerID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldClientID requires an ID field in the mutation")
+		return v, errors.New("OldManagerID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
+		return v, fmt.Errorf("querying old value for OldManagerID: %w", err)
 	}
-	return oldValue.ClientID, nil
+	return oldValue.ManagerID, nil
 }
 
-// ResetClientID resets all changes to the "client_id" field.
-func (m *ChatMutation) ResetClientID() {
-	m.client_id = nil
+// ClearManagerID clears the value of the "manager_id" field.
+func (m *AuditRecordMutation) ClearManagerID() {
+	m.manager_id = nil
+	m.clearedFields[auditrecord.FieldManagerID] = struct{}{}
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ChatMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ManagerIDCleared returns if the "manager_id" field was cleared in this mutation.
+func (m *AuditRecordMutation) ManagerIDCleared() bool {
+	_, ok := m.clearedFields[auditrecord.FieldManagerID]
+	return ok
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ChatMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// ResetManagerID resets all changes to the "manager_id" field.
+func (m *AuditRecordMutation) ResetManagerID() {
+	m.manager_id = nil
+	delete(m.clearedFields, auditrecord.FieldManagerID)
+}
+
+// SetAction sets the "action" field.
+func (m *AuditRecordMutation) SetAction(a auditrecord.Action) {
+	m.action = &a
+}
+
+// Action returns the value of the "action" field in the mutation.
+func (m *AuditRecordMutation) Action() (r auditrecord.Action, exists bool) {
+	v := m.action
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Chat entity.
-// If the Chat object wasn't provided to the builder, the object is fetched from the database.
+// OldAction returns the old "action" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ChatMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *AuditRecordMutation) OldAction(ctx context.Context) (v auditrecord.Action, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldAction is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldAction requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldAction: %w", err)
 	}
-	return oldValue.CreatedAt, nil
+	return oldValue.Action, nil
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ChatMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ResetAction resets all changes to the "action" field.
+func (m *AuditRecordMutation) ResetAction() {
+	m.action = nil
 }
 
-// AddMessageIDs adds the "messages" edge to the Message entity by ids.
-func (m *ChatMutation) AddMessageIDs(ids ...types.MessageID) {
-	if m.messages == nil {
-		m.messages = make(map[types.MessageID]struct{})
+// SetChatID sets the "chat_id" field.
+func (m *AuditRecordMutation) SetChatID(ti types.ChatID) {
+	m.chat_id = &ti
+}
+
+// ChatID returns the value of the "chat_id" field in the mutation.
+func (m *AuditRecordMutation) ChatID() (r types.ChatID, exists bool) {
+	v := m.chat_id
+	if v == nil {
+		return
 	}
-	for i := range ids {
-		m.messages[ids[i]] = struct{}{}
+	return *v, true
+}
+
+// OldChatID returns the old "chat_id" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditRecordMutation) OldChatID(ctx context.Context) (v types.ChatID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChatID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
 	}
+	return oldValue.ChatID, nil
 }
 
-// ClearMessages clears the "messages" edge to the Message entity.
-func (m *ChatMutation) ClearMessages() {
-	m.clearedmessages = true
+// ClearChatID clears the value of the "chat_id" field.
+func (m *AuditRecordMutation) ClearChatID() {
+	m.chat_id = nil
+	m.clearedFields[auditrecord.FieldChatID] = struct{}{}
 }
 
-// MessagesCleared reports if the "messages" edge to the Message entity was cleared.
-func (m *ChatMutation) MessagesCleared() bool {
-	return m.clearedmessages
+// ChatIDCleared returns if the "chat_id" field was cleared in this mutation.
+func (m *AuditRecordMutation) ChatIDCleared() bool {
+	_, ok := m.clearedFields[auditrecord.FieldChatID]
+	return ok
 }
 
-// RemoveMessageIDs removes the "messages" edge to the Message entity by IDs.
-func (m *ChatMutation) RemoveMessageIDs(ids ...types.MessageID) {
-	if m.removedmessages == nil {
-		m.removedmessages = make(map[types.MessageID]struct{})
-	}
-	for i := range ids {
-		delete(m.messages, ids[i])
-		m.removedmessages[ids[i]] = struct{}{}
-	}
+// ResetChatID resets all changes to the "chat_id" field.
+func (m *AuditRecordMutation) ResetChatID() {
+	m.chat_id = nil
+	delete(m.clearedFields, auditrecord.FieldChatID)
 }
 
-// RemovedMessages returns the removed IDs of the "messages" edge to the Message entity.
-func (m *ChatMutation) RemovedMessagesIDs() (ids []types.MessageID) {
-	for id := range m.removedmessages {
-		ids = append(ids, id)
+// SetProblemID sets the "problem_id" field.
+func (m *AuditRecordMutation) SetProblemID(ti types.ProblemID) {
+	m.problem_id = &ti
+}
+
+// ProblemID returns the value of the "problem_id" field in the mutation.
+func (m *AuditRecordMutation) ProblemID() (r types.ProblemID, exists bool) {
+	v := m.problem_id
+	if v == nil {
+		return
 	}
-	return
+	return *v, true
 }
 
-// MessagesIDs returns the "messages" edge IDs in the mutation.
-func (m *ChatMutation) MessagesIDs() (ids []types.MessageID) {
-	for id := range m.messages {
-		ids = append(ids, id)
+// OldProblemID returns the old "problem_id" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditRecordMutation) OldProblemID(ctx context.Context) (v types.ProblemID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProblemID is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProblemID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProblemID: %w", err)
+	}
+	return oldValue.ProblemID, nil
 }
 
-// ResetMessages resets all changes to the "messages" edge.
-func (m *ChatMutation) ResetMessages() {
-	m.messages = nil
-	m.clearedmessages = false
-	m.removedmessages = nil
+// ClearProblemID clears the value of the "problem_id" field.
+func (m *AuditRecordMutation) ClearProblemID() {
+	m.problem_id = nil
+	m.clearedFields[auditrecord.FieldProblemID] = struct{}{}
 }
 
-// AddProblemIDs adds the "problems" edge to the Problem entity by ids.
-func (m *ChatMutation) AddProblemIDs(ids ...types.ProblemID) {
-	if m.problems == nil {
-		m.problems = make(map[types.ProblemID]struct{})
-	}
-	for i := range ids {
-		m.problems[ids[i]] = struct{}{}
-	}
+// ProblemIDCleared returns if the "problem_id" field was cleared in this mutation.
+func (m *AuditRecordMutation) ProblemIDCleared() bool {
+	_, ok := m.clearedFields[auditrecord.FieldProblemID]
+	return ok
 }
 
-// ClearProblems clears the "problems" edge to the Problem entity.
-func (m *ChatMutation) ClearProblems() {
-	m.clearedproblems = true
+// ResetProblemID resets all changes to the "problem_id" field.
+func (m *AuditRecordMutation) ResetProblemID() {
+	m.problem_id = nil
+	delete(m.clearedFields, auditrecord.FieldProblemID)
 }
 
-// ProblemsCleared reports if the "problems" edge to the Problem entity was cleared.
-func (m *ChatMutation) ProblemsCleared() bool {
-	return m.clearedproblems
+// SetMessageID sets the "message_id" field.
+func (m *AuditRecordMutation) SetMessageID(ti types.MessageID) {
+	m.message_id = &ti
 }
 
-// RemoveProblemIDs removes the "problems" edge to the Problem entity by IDs.
-func (m *ChatMutation) RemoveProblemIDs(ids ...types.ProblemID) {
-	if m.removedproblems == nil {
-		m.removedproblems = make(map[types.ProblemID]struct{})
-	}
-	for i := range ids {
-		delete(m.problems, ids[i])
-		m.removedproblems[ids[i]] = struct{}{}
+// MessageID returns the value of the "message_id" field in the mutation.
+func (m *AuditRecordMutation) MessageID() (r types.MessageID, exists bool) {
+	v := m.message_id
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedProblems returns the removed IDs of the "problems" edge to the Problem entity.
-func (m *ChatMutation) RemovedProblemsIDs() (ids []types.ProblemID) {
-	for id := range m.removedproblems {
-		ids = append(ids, id)
+// OldMessageID returns the old "message_id" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditRecordMutation) OldMessageID(ctx context.Context) (v types.MessageID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldMessageID is only allowed on UpdateOne operations")
 	}
-	return
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldMessageID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldMessageID: %w", err)
+	}
+	return oldValue.MessageID, nil
 }
 
-// ProblemsIDs returns the "problems" edge IDs in the mutation.
-func (m *ChatMutation) ProblemsIDs() (ids []types.ProblemID) {
-	for id := range m.problems {
-		ids = append(ids, id)
-	}
-	return
+// ClearMessageID clears the value of the "message_id" field.
+func (m *AuditRecordMutation) ClearMessageID() {
+	m.message_id = nil
+	m.clearedFields[auditrecord.FieldMessageID] = struct{}{}
 }
 
-// ResetProblems resets all changes to the "problems" edge.
-func (m *ChatMutation) ResetProblems() {
-	m.problems = nil
-	m.clearedproblems = false
-	m.removedproblems = nil
+// MessageIDCleared returns if the "message_id" field was cleared in this mutation.
+func (m *AuditRecordMutation) MessageIDCleared() bool {
+	_, ok := m.clearedFields[auditrecord.FieldMessageID]
+	return ok
 }
 
-// Where appends a list predicates to the ChatMutation builder.
-func (m *ChatMutation) Where(ps ...predicate.Chat) {
-	m.predicates = append(m.predicates, ps...)
+// ResetMessageID resets all changes to the "message_id" field.
+func (m *AuditRecordMutation) ResetMessageID() {
+	m.message_id = nil
+	delete(m.clearedFields, auditrecord.FieldMessageID)
 }
 
-// WhereP appends storage-level predicates to the ChatMutation builder. Using this method,
-// users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ChatMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Chat, len(ps))
-	for i := range ps {
-		p[i] = ps[i]
-	}
-	m.Where(p...)
+// SetRequestID sets the "request_id" field.
+func (m *AuditRecordMutation) SetRequestID(ti types.RequestID) {
+	m.request_id = &ti
 }
 
-// Op returns the operation name.
-func (m *ChatMutation) Op() Op {
-	return m.op
+// RequestID returns the value of the "request_id" field in the mutation.
+func (m *AuditRecordMutation) RequestID() (r types.RequestID, exists bool) {
+	v := m.request_id
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetOp allows setting the mutation operation.
-func (m *ChatMutation) SetOp(op Op) {
-	m.op = op
+// OldRequestID returns the old "request_id" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditRecordMutation) OldRequestID(ctx context.Context) (v types.RequestID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldRequestID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldRequestID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldRequestID: %w", err)
+	}
+	return oldValue.RequestID, nil
 }
 
-// Type returns the node type of this mutation (Chat).
-func (m *ChatMutation) Type() string {
-	return m.typ
+// ResetRequestID resets all changes to the "request_id" field.
+func (m *AuditRecordMutation) ResetRequestID() {
+	m.request_id = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *AuditRecordMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *AuditRecordMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the AuditRecord entity.
+// If the AuditRecord object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *AuditRecordMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *AuditRecordMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the AuditRecordMutation builder.
+func (m *AuditRecordMutation) Where(ps ...predicate.AuditRecord) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the AuditRecordMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *AuditRecordMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.AuditRecord, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *AuditRecordMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *AuditRecordMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (AuditRecord).
+func (m *AuditRecordMutation) Type() string {
+	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ChatMutation) Fields() []string {
-	fields := make([]string, 0, 2)
-	if m.client_id != nil {
-		fields = append(fields, chat.FieldClientID)
+func (m *AuditRecordMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.manager_id != nil {
+		fields = append(fields, auditrecord.FieldManagerID)
+	}
+	if m.action != nil {
+		fields = append(fields, auditrecord.FieldAction)
+	}
+	if m.chat_id != nil {
+		fields = append(fields, auditrecord.FieldChatID)
+	}
+	if m.problem_id != nil {
+		fields = append(fields, auditrecord.FieldProblemID)
+	}
+	if m.message_id != nil {
+		fields = append(fields, auditrecord.FieldMessageID)
+	}
+	if m.request_id != nil {
+		fields = append(fields, auditrecord.FieldRequestID)
 	}
 	if m.created_at != nil {
-		fields = append(fields, chat.FieldCreatedAt)
+		fields = append(fields, auditrecord.FieldCreatedAt)
 	}
 	return fields
 }
@@ -387,11 +529,21 @@ func (m *ChatMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ChatMutation) Field(name string) (ent.Value, bool) {
+func (m *AuditRecordMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case chat.FieldClientID:
-		return m.ClientID()
-	case chat.FieldCreatedAt:
+	case auditrecord.FieldManagerID:
+		return m.ManagerID()
+	case auditrecord.FieldAction:
+		return m.Action()
+	case auditrecord.FieldChatID:
+		return m.ChatID()
+	case auditrecord.FieldProblemID:
+		return m.ProblemID()
+	case auditrecord.FieldMessageID:
+		return m.MessageID()
+	case auditrecord.FieldRequestID:
+		return m.RequestID()
+	case auditrecord.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -400,29 +552,74 @@ func (m *ChatMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ChatMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *AuditRecordMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case chat.FieldClientID:
-		return m.OldClientID(ctx)
-	case chat.FieldCreatedAt:
+	case auditrecord.FieldManagerID:
+		return m.OldManagerID(ctx)
+	case auditrecord.FieldAction:
+		return m.OldAction(ctx)
+	case auditrecord.FieldChatID:
+		return m.OldChatID(ctx)
+	case auditrecord.FieldProblemID:
+		return m.OldProblemID(ctx)
+	case auditrecord.FieldMessageID:
+		return m.OldMessageID(ctx)
+	case auditrecord.FieldRequestID:
+		return m.OldRequestID(ctx)
+	case auditrecord.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Chat field %s", name)
+	return nil, fmt.Errorf("unknown AuditRecord field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ChatMutation) SetField(name string, value ent.Value) error {
+func (m *AuditRecordMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case chat.FieldClientID:
+	case auditrecord.FieldManagerID:
 		v, ok := value.(types.UserID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetClientID(v)
+		m.SetManagerID(v)
 		return nil
-	case chat.FieldCreatedAt:
+	case auditrecord.FieldAction:
+		v, ok := value.(auditrecord.Action)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAction(v)
+		return nil
+	case auditrecord.FieldChatID:
+		v, ok := value.(types.ChatID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChatID(v)
+		return nil
+	case auditrecord.FieldProblemID:
+		v, ok := value.(types.ProblemID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProblemID(v)
+		return nil
+	case auditrecord.FieldMessageID:
+		v, ok := value.(types.MessageID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetMessageID(v)
+		return nil
+	case auditrecord.FieldRequestID:
+		v, ok := value.(types.RequestID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetRequestID(v)
+		return nil
+	case auditrecord.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -430,201 +627,185 @@ func (m *ChatMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Chat field %s", name)
+	return fmt.Errorf("unknown AuditRecord field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ChatMutation) AddedFields() []string {
+func (m *AuditRecordMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ChatMutation) AddedField(name string) (ent.Value, bool) {
+func (m *AuditRecordMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ChatMutation) AddField(name string, value ent.Value) error {
+func (m *AuditRecordMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Chat numeric field %s", name)
+	return fmt.Errorf("unknown AuditRecord numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ChatMutation) ClearedFields() []string {
-	return nil
+func (m *AuditRecordMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(auditrecord.FieldManagerID) {
+		fields = append(fields, auditrecord.FieldManagerID)
+	}
+	if m.FieldCleared(auditrecord.FieldChatID) {
+		fields = append(fields, auditrecord.FieldChatID)
+	}
+	if m.FieldCleared(auditrecord.FieldProblemID) {
+		fields = append(fields, auditrecord.FieldProblemID)
+	}
+	if m.FieldCleared(auditrecord.FieldMessageID) {
+		fields = append(fields, auditrecord.FieldMessageID)
+	}
+	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ChatMutation) FieldCleared(name string) bool {
+func (m *AuditRecordMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ChatMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Chat nullable field %s", name)
+func (m *AuditRecordMutation) ClearField(name string) error {
+	switch name {
+	case auditrecord.FieldManagerID:
+		m.ClearManagerID()
+		return nil
+	case auditrecord.FieldChatID:
+		m.ClearChatID()
+		return nil
+	case auditrecord.FieldProblemID:
+		m.ClearProblemID()
+		return nil
+	case auditrecord.FieldMessageID:
+		m.ClearMessageID()
+		return nil
+	}
+	return fmt.Errorf("unknown AuditRecord nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ChatMutation) ResetField(name string) error {
+func (m *AuditRecordMutation) ResetField(name string) error {
 	switch name {
-	case chat.FieldClientID:
-		m.ResetClientID()
+	case auditrecord.FieldManagerID:
+		m.ResetManagerID()
 		return nil
-	case chat.FieldCreatedAt:
+	case auditrecord.FieldAction:
+		m.ResetAction()
+		return nil
+	case auditrecord.FieldChatID:
+		m.ResetChatID()
+		return nil
+	case auditrecord.FieldProblemID:
+		m.ResetProblemID()
+		return nil
+	case auditrecord.FieldMessageID:
+		m.ResetMessageID()
+		return nil
+	case auditrecord.FieldRequestID:
+		m.ResetRequestID()
+		return nil
+	case auditrecord.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Chat field %s", name)
+	return fmt.Errorf("unknown AuditRecord field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ChatMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.messages != nil {
-		edges = append(edges, chat.EdgeMessages)
-	}
-	if m.problems != nil {
-		edges = append(edges, chat.EdgeProblems)
-	}
+func (m *AuditRecordMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ChatMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case chat.EdgeMessages:
-		ids := make([]ent.Value, 0, len(m.messages))
-		for id := range m.messages {
-			ids = append(ids, id)
-		}
-		return ids
-	case chat.EdgeProblems:
-		ids := make([]ent.Value, 0, len(m.problems))
-		for id := range m.problems {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *AuditRecordMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ChatMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedmessages != nil {
-		edges = append(edges, chat.EdgeMessages)
-	}
-	if m.removedproblems != nil {
-		edges = append(edges, chat.EdgeProblems)
-	}
+func (m *AuditRecordMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ChatMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case chat.EdgeMessages:
-		ids := make([]ent.Value, 0, len(m.removedmessages))
-		for id := range m.removedmessages {
-			ids = append(ids, id)
-		}
-		return ids
-	case chat.EdgeProblems:
-		ids := make([]ent.Value, 0, len(m.removedproblems))
-		for id := range m.removedproblems {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *AuditRecordMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ChatMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedmessages {
-		edges = append(edges, chat.EdgeMessages)
-	}
-	if m.clearedproblems {
-		edges = append(edges, chat.EdgeProblems)
-	}
+func (m *AuditRecordMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ChatMutation) EdgeCleared(name string) bool {
-	switch name {
-	case chat.EdgeMessages:
-		return m.clearedmessages
-	case chat.EdgeProblems:
-		return m.clearedproblems
-	}
+func (m *AuditRecordMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ChatMutation) ClearEdge(name string) error {
-	switch name {
-	}
-	return fmt.Errorf("unknown Chat unique edge %s", name)
+func (m *AuditRecordMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown AuditRecord unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ChatMutation) ResetEdge(name string) error {
-	switch name {
-	case chat.EdgeMessages:
-		m.ResetMessages()
-		return nil
-	case chat.EdgeProblems:
-		m.ResetProblems()
-		return nil
-	}
-	return fmt.Errorf("unknown Chat edge %s", name)
+func (m *AuditRecordMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown AuditRecord edge %s", name)
 }
 
-// FailedJobMutation represents an operation that mutates the FailedJob nodes in the graph.
-type FailedJobMutation struct {
+// ChatMutation represents an operation that mutates the Chat nodes in the graph.
+type ChatMutation struct {
 	config
-	op            Op
-	typ           string
-	id            *types.FailedJobID
-	name          *string
-	payload       *string
-	reason        *string
-	created_at    *time.Time
-	clearedFields map[string]struct{}
-	done          bool
-	oldValue      func(context.Context) (*FailedJob, error)
-	predicates    []predicate.FailedJob
+	op              Op
+	typ             string
+	id              *types.ChatID
+	client_id       *types.UserID
+	created_at      *time.Time
+	clearedFields   map[string]struct{}
+	messages        map[types.MessageID]struct{}
+	removedmessages map[types.MessageID]struct{}
+	clearedmessages bool
+	problems        map[types.ProblemID]struct{}
+	removedproblems map[types.ProblemID]struct{}
+	clearedproblems bool
+	done            bool
+	oldValue        func(context.Context) (*Chat, error)
+	predicates      []predicate.Chat
 }
 
-var _ ent.Mutation = (*FailedJobMutation)(nil)
-
-// failedjobOption allows management of the mutation configuration using functional options.
-type failedjobOption func(*FailedJobMutation)
+var _ ent.Mutation = (*ChatMutation)(nil)
 
-// newFailedJobMutation creates new mutation for the FailedJob entity.
-func newFailedJobMutation(c config, op Op, opts ...failedjobOption) *FailedJobMutation {
-	m := &FailedJobMutation{
+// chatOption allows management of the mutation configuration using functional options.
+type chatOption func(*ChatMutation)
+
+// newChatMutation creates new mutation for the Chat entity.
+func newChatMutation(c config, op Op, opts ...chatOption) *ChatMutation {
+	m := &ChatMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeFailedJob,
+		typ:           TypeChat,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -633,20 +814,20 @@ func newFailedJobMutation(c config, op Op, opts ...failedjobOption) *FailedJobMu
 	return m
 }
 
-// withFailedJobID sets the ID field of the mutation.
-func withFailedJobID(id types.FailedJobID) failedjobOption {
-	return func(m *FailedJobMutation) {
+// withChatID sets the ID field of the mutation.
+func withChatID(id types.ChatID) chatOption {
+	return func(m *ChatMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *FailedJob
+			value *Chat
 		)
-		m.oldValue = func(ctx context.Context) (*FailedJob, error) {
+		m.oldValue = func(ctx context.Context) (*Chat, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().FailedJob.Get(ctx, id)
+					value, err = m.Client().Chat.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -655,10 +836,10 @@ func withFailedJobID(id types.FailedJobID) failedjobOption {
 	}
 }
 
-// withFailedJob sets the old FailedJob of the mutation.
-func withFailedJob(node *FailedJob) failedjobOption {
-	return func(m *FailedJobMutation) {
-		m.oldValue = func(context.Context) (*FailedJob, error) {
+// withChat sets the old Chat of the mutation.
+func withChat(node *Chat) chatOption {
+	return func(m *ChatMutation) {
+		m.oldValue = func(context.Context) (*Chat, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -667,7 +848,7 @@ func withFailedJob(node *FailedJob) failedjobOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m FailedJobMutation) Client() *Client {
+func (m ChatMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -675,7 +856,7 @@ func (m FailedJobMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m FailedJobMutation) Tx() (*Tx, error) {
+func (m ChatMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("store: mutation is not running in a transaction")
 	}
@@ -685,14 +866,14 @@ func (m FailedJobMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of FailedJob entities.
-func (m *FailedJobMutation) SetID(id types.FailedJobID) {
+// operation is only accepted on creation of Chat entities.
+func (m *ChatMutation) SetID(id types.ChatID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *FailedJobMutation) ID() (id types.FailedJobID, exists bool) {
+func (m *ChatMutation) ID() (id types.ChatID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -703,174 +884,210 @@ func (m *FailedJobMutation) ID() (id types.FailedJobID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *FailedJobMutation) IDs(ctx context.Context) ([]types.FailedJobID, error) {
+func (m *ChatMutation) IDs(ctx context.Context) ([]types.ChatID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []types.FailedJobID{id}, nil
+			return []types.ChatID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().FailedJob.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Chat.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetName sets the "name" field.
-func (m *FailedJobMutation) SetName(s string) {
-	m.name = &s
+// SetClientID sets the "client_id" field.
+func (m *ChatMutation) SetClientID(ti types.UserID) {
+	m.client_id = &ti
 }
 
-// Name returns the value of the "name" field in the mutation.
-func (m *FailedJobMutation) Name() (r string, exists bool) {
-	v := m.name
+// ClientID returns the value of the "client_id" field in the mutation.
+func (m *ChatMutation) ClientID() (r types.UserID, exists bool) {
+	v := m.client_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the FailedJob entity.
-// If the FailedJob object wasn't provided to the builder, the object is fetched from the database.
+// OldClientID returns the old "client_id" field's value of the Chat entity.
+// If the Chat object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FailedJobMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *ChatMutation) OldClientID(ctx context.Context) (v types.UserID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldName is only allowed on UpdateOne operations")
+		return v, errors.New("OldClientID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldName requires an ID field in the mutation")
+		return v, errors.New("OldClientID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldName: %w", err)
+		return v, fmt.Errorf("querying old value for OldClientID: %w", err)
 	}
-	return oldValue.Name, nil
+	return oldValue.ClientID, nil
 }
 
-// ResetName resets all changes to the "name" field.
-func (m *FailedJobMutation) ResetName() {
-	m.name = nil
+// ResetClientID resets all changes to the "client_id" field.
+func (m *ChatMutation) ResetClientID() {
+	m.client_id = nil
 }
 
-// SetPayload sets the "payload" field.
-func (m *FailedJobMutation) SetPayload(s string) {
-	m.payload = &s
+// SetCreatedAt sets the "created_at" field.
+func (m *ChatMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// Payload returns the value of the "payload" field in the mutation.
-func (m *FailedJobMutation) Payload() (r string, exists bool) {
-	v := m.payload
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ChatMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldPayload returns the old "payload" field's value of the FailedJob entity.
-// If the FailedJob object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Chat entity.
+// If the Chat object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FailedJobMutation) OldPayload(ctx context.Context) (v string, err error) {
+func (m *ChatMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldPayload requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.Payload, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetPayload resets all changes to the "payload" field.
-func (m *FailedJobMutation) ResetPayload() {
-	m.payload = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ChatMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// SetReason sets the "reason" field.
-func (m *FailedJobMutation) SetReason(s string) {
-	m.reason = &s
+// AddMessageIDs adds the "messages" edge to the Message entity by ids.
+func (m *ChatMutation) AddMessageIDs(ids ...types.MessageID) {
+	if m.messages == nil {
+		m.messages = make(map[types.MessageID]struct{})
+	}
+	for i := range ids {
+		m.messages[ids[i]] = struct{}{}
+	}
 }
 
-// Reason returns the value of the "reason" field in the mutation.
-func (m *FailedJobMutation) Reason() (r string, exists bool) {
-	v := m.reason
-	if v == nil {
-		return
-	}
-	return *v, true
+// ClearMessages clears the "messages" edge to the Message entity.
+func (m *ChatMutation) ClearMessages() {
+	m.clearedmessages = true
 }
 
-// OldReason returns the old "reason" field's value of the FailedJob entity.
-// If the FailedJob object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FailedJobMutation) OldReason(ctx context.Context) (v string, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReason is only allowed on UpdateOne operations")
+// MessagesCleared reports if the "messages" edge to the Message entity was cleared.
+func (m *ChatMutation) MessagesCleared() bool {
+	return m.clearedmessages
+}
+
+// RemoveMessageIDs removes the "messages" edge to the Message entity by IDs.
+func (m *ChatMutation) RemoveMessageIDs(ids ...types.MessageID) {
+	if m.removedmessages == nil {
+		m.removedmessages = make(map[types.MessageID]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReason requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.messages, ids[i])
+		m.removedmessages[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReason: %w", err)
+}
+
+// RemovedMessages returns the removed IDs of the "messages" edge to the Message entity.
+func (m *ChatMutation) RemovedMessagesIDs() (ids []types.MessageID) {
+	for id := range m.removedmessages {
+		ids = append(ids, id)
 	}
-	return oldValue.Reason, nil
+	return
 }
 
-// ResetReason resets all changes to the "reason" field.
-func (m *FailedJobMutation) ResetReason() {
-	m.reason = nil
+// MessagesIDs returns the "messages" edge IDs in the mutation.
+func (m *ChatMutation) MessagesIDs() (ids []types.MessageID) {
+	for id := range m.messages {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *FailedJobMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// ResetMessages resets all changes to the "messages" edge.
+func (m *ChatMutation) ResetMessages() {
+	m.messages = nil
+	m.clearedmessages = false
+	m.removedmessages = nil
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *FailedJobMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
-	if v == nil {
-		return
+// AddProblemIDs adds the "problems" edge to the Problem entity by ids.
+func (m *ChatMutation) AddProblemIDs(ids ...types.ProblemID) {
+	if m.problems == nil {
+		m.problems = make(map[types.ProblemID]struct{})
+	}
+	for i := range ids {
+		m.problems[ids[i]] = struct{}{}
 	}
-	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the FailedJob entity.
-// If the FailedJob object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *FailedJobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+// ClearProblems clears the "problems" edge to the Problem entity.
+func (m *ChatMutation) ClearProblems() {
+	m.clearedproblems = true
+}
+
+// ProblemsCleared reports if the "problems" edge to the Problem entity was cleared.
+func (m *ChatMutation) ProblemsCleared() bool {
+	return m.clearedproblems
+}
+
+// RemoveProblemIDs removes the "problems" edge to the Problem entity by IDs.
+func (m *ChatMutation) RemoveProblemIDs(ids ...types.ProblemID) {
+	if m.removedproblems == nil {
+		m.removedproblems = make(map[types.ProblemID]struct{})
 	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	for i := range ids {
+		delete(m.problems, ids[i])
+		m.removedproblems[ids[i]] = struct{}{}
 	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+}
+
+// RemovedProblems returns the removed IDs of the "problems" edge to the Problem entity.
+func (m *ChatMutation) RemovedProblemsIDs() (ids []types.ProblemID) {
+	for id := range m.removedproblems {
+		ids = append(ids, id)
 	}
-	return oldValue.CreatedAt, nil
+	return
 }
 
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *FailedJobMutation) ResetCreatedAt() {
-	m.created_at = nil
+// ProblemsIDs returns the "problems" edge IDs in the mutation.
+func (m *ChatMutation) ProblemsIDs() (ids []types.ProblemID) {
+	for id := range m.problems {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// Where appends a list predicates to the FailedJobMutation builder.
-func (m *FailedJobMutation) Where(ps ...predicate.FailedJob) {
+// ResetProblems resets all changes to the "problems" edge.
+func (m *ChatMutation) ResetProblems() {
+	m.problems = nil
+	m.clearedproblems = false
+	m.removedproblems = nil
+}
+
+// Where appends a list predicates to the ChatMutation builder.
+func (m *ChatMutation) Where(ps ...predicate.Chat) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the FailedJobMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ChatMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *FailedJobMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.FailedJob, len(ps))
+func (m *ChatMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Chat, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -878,36 +1095,30 @@ func (m *FailedJobMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *FailedJobMutation) Op() Op {
+func (m *ChatMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *FailedJobMutation) SetOp(op Op) {
+func (m *ChatMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (FailedJob).
-func (m *FailedJobMutation) Type() string {
+// Type returns the node type of this mutation (Chat).
+func (m *ChatMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *FailedJobMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.name != nil {
-		fields = append(fields, failedjob.FieldName)
-	}
-	if m.payload != nil {
-		fields = append(fields, failedjob.FieldPayload)
-	}
-	if m.reason != nil {
-		fields = append(fields, failedjob.FieldReason)
+func (m *ChatMutation) Fields() []string {
+	fields := make([]string, 0, 2)
+	if m.client_id != nil {
+		fields = append(fields, chat.FieldClientID)
 	}
 	if m.created_at != nil {
-		fields = append(fields, failedjob.FieldCreatedAt)
+		fields = append(fields, chat.FieldCreatedAt)
 	}
 	return fields
 }
@@ -915,15 +1126,11 @@ func (m *FailedJobMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *FailedJobMutation) Field(name string) (ent.Value, bool) {
+func (m *ChatMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case failedjob.FieldName:
-		return m.Name()
-	case failedjob.FieldPayload:
-		return m.Payload()
-	case failedjob.FieldReason:
-		return m.Reason()
-	case failedjob.FieldCreatedAt:
+	case chat.FieldClientID:
+		return m.ClientID()
+	case chat.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -932,47 +1139,29 @@ func (m *FailedJobMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *FailedJobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ChatMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case failedjob.FieldName:
-		return m.OldName(ctx)
-	case failedjob.FieldPayload:
-		return m.OldPayload(ctx)
-	case failedjob.FieldReason:
-		return m.OldReason(ctx)
-	case failedjob.FieldCreatedAt:
+	case chat.FieldClientID:
+		return m.OldClientID(ctx)
+	case chat.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown FailedJob field %s", name)
+	return nil, fmt.Errorf("unknown Chat field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FailedJobMutation) SetField(name string, value ent.Value) error {
+func (m *ChatMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case failedjob.FieldName:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetName(v)
-		return nil
-	case failedjob.FieldPayload:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetPayload(v)
-		return nil
-	case failedjob.FieldReason:
-		v, ok := value.(string)
+	case chat.FieldClientID:
+		v, ok := value.(types.UserID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReason(v)
+		m.SetClientID(v)
 		return nil
-	case failedjob.FieldCreatedAt:
+	case chat.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -980,148 +1169,201 @@ func (m *FailedJobMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown FailedJob field %s", name)
+	return fmt.Errorf("unknown Chat field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *FailedJobMutation) AddedFields() []string {
+func (m *ChatMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *FailedJobMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ChatMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *FailedJobMutation) AddField(name string, value ent.Value) error {
+func (m *ChatMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown FailedJob numeric field %s", name)
+	return fmt.Errorf("unknown Chat numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *FailedJobMutation) ClearedFields() []string {
+func (m *ChatMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *FailedJobMutation) FieldCleared(name string) bool {
+func (m *ChatMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *FailedJobMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown FailedJob nullable field %s", name)
+func (m *ChatMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Chat nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *FailedJobMutation) ResetField(name string) error {
+func (m *ChatMutation) ResetField(name string) error {
 	switch name {
-	case failedjob.FieldName:
-		m.ResetName()
-		return nil
-	case failedjob.FieldPayload:
-		m.ResetPayload()
-		return nil
-	case failedjob.FieldReason:
-		m.ResetReason()
+	case chat.FieldClientID:
+		m.ResetClientID()
 		return nil
-	case failedjob.FieldCreatedAt:
+	case chat.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown FailedJob field %s", name)
+	return fmt.Errorf("unknown Chat field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *FailedJobMutation) AddedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ChatMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.messages != nil {
+		edges = append(edges, chat.EdgeMessages)
+	}
+	if m.problems != nil {
+		edges = append(edges, chat.EdgeProblems)
+	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *FailedJobMutation) AddedIDs(name string) []ent.Value {
+func (m *ChatMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case chat.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.messages))
+		for id := range m.messages {
+			ids = append(ids, id)
+		}
+		return ids
+	case chat.EdgeProblems:
+		ids := make([]ent.Value, 0, len(m.problems))
+		for id := range m.problems {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *FailedJobMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ChatMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.removedmessages != nil {
+		edges = append(edges, chat.EdgeMessages)
+	}
+	if m.removedproblems != nil {
+		edges = append(edges, chat.EdgeProblems)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *FailedJobMutation) RemovedIDs(name string) []ent.Value {
+func (m *ChatMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case chat.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.removedmessages))
+		for id := range m.removedmessages {
+			ids = append(ids, id)
+		}
+		return ids
+	case chat.EdgeProblems:
+		ids := make([]ent.Value, 0, len(m.removedproblems))
+		for id := range m.removedproblems {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *FailedJobMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 0)
+func (m *ChatMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedmessages {
+		edges = append(edges, chat.EdgeMessages)
+	}
+	if m.clearedproblems {
+		edges = append(edges, chat.EdgeProblems)
+	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *FailedJobMutation) EdgeCleared(name string) bool {
+func (m *ChatMutation) EdgeCleared(name string) bool {
+	switch name {
+	case chat.EdgeMessages:
+		return m.clearedmessages
+	case chat.EdgeProblems:
+		return m.clearedproblems
+	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *FailedJobMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown FailedJob unique edge %s", name)
+func (m *ChatMutation) ClearEdge(name string) error {
+	switch name {
+	}
+	return fmt.Errorf("unknown Chat unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *FailedJobMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown FailedJob edge %s", name)
+func (m *ChatMutation) ResetEdge(name string) error {
+	switch name {
+	case chat.EdgeMessages:
+		m.ResetMessages()
+		return nil
+	case chat.EdgeProblems:
+		m.ResetProblems()
+		return nil
+	}
+	return fmt.Errorf("unknown Chat edge %s", name)
 }
 
-// JobMutation represents an operation that mutates the Job nodes in the graph.
-type JobMutation struct {
+// FailedJobMutation represents an operation that mutates the FailedJob nodes in the graph.
+type FailedJobMutation struct {
 	config
-	op             Op
-	typ            string
-	id             *types.JobID
-	name           *string
-	payload        *string
-	attempts       *int
-	addattempts    *int
-	available_at   *time.Time
-	reserved_until *time.Time
-	created_at     *time.Time
-	clearedFields  map[string]struct{}
-	done           bool
-	oldValue       func(context.Context) (*Job, error)
-	predicates     []predicate.Job
+	op            Op
+	typ           string
+	id            *types.FailedJobID
+	name          *string
+	payload       *string
+	reason        *string
+	created_at    *time.Time
+	clearedFields map[string]struct{}
+	done          bool
+	oldValue      func(context.Context) (*FailedJob, error)
+	predicates    []predicate.FailedJob
 }
 
-var _ ent.Mutation = (*JobMutation)(nil)
+var _ ent.Mutation = (*FailedJobMutation)(nil)
 
-// jobOption allows management of the mutation configuration using functional options.
-type jobOption func(*JobMutation)
+// failedjobOption allows management of the mutation configuration using functional options.
+type failedjobOption func(*FailedJobMutation)
 
-// newJobMutation creates new mutation for the Job entity.
-func newJobMutation(c config, op Op, opts ...jobOption) *JobMutation {
-	m := &JobMutation{
+// newFailedJobMutation creates new mutation for the FailedJob entity.
+func newFailedJobMutation(c config, op Op, opts ...failedjobOption) *FailedJobMutation {
+	m := &FailedJobMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeJob,
+		typ:           TypeFailedJob,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1130,20 +1372,20 @@ func newJobMutation(c config, op Op, opts ...jobOption) *JobMutation {
 	return m
 }
 
-// withJobID sets the ID field of the mutation.
-func withJobID(id types.JobID) jobOption {
-	return func(m *JobMutation) {
+// withFailedJobID sets the ID field of the mutation.
+func withFailedJobID(id types.FailedJobID) failedjobOption {
+	return func(m *FailedJobMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Job
+			value *FailedJob
 		)
-		m.oldValue = func(ctx context.Context) (*Job, error) {
+		m.oldValue = func(ctx context.Context) (*FailedJob, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Job.Get(ctx, id)
+					value, err = m.Client().FailedJob.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1152,19 +1394,19 @@ func withJobID(id types.JobID) jobOption {
 	}
 }
 
-// withJob sets the old Job of the mutation.
-func withJob(node *Job) jobOption {
-	return func(m *JobMutation) {
-		m.oldValue = func(context.Context) (*Job, error) {
-			return node, nil
-		}
+// withFailedJob sets the old FailedJob of the mutation.
+func withFailedJob(node *FailedJob) failedjobOption {
+	return func(m *FailedJobMutation) {
+		m.oldValue = func(context.Context) (*FailedJob, error) {
+			return node, nil
+		}
 		m.id = &node.ID
 	}
 }
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m JobMutation) Client() *Client {
+func (m FailedJobMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1172,7 +1414,7 @@ func (m JobMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m JobMutation) Tx() (*Tx, error) {
+func (m FailedJobMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("store: mutation is not running in a transaction")
 	}
@@ -1182,14 +1424,14 @@ func (m JobMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Job entities.
-func (m *JobMutation) SetID(id types.JobID) {
+// operation is only accepted on creation of FailedJob entities.
+func (m *FailedJobMutation) SetID(id types.FailedJobID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *JobMutation) ID() (id types.JobID, exists bool) {
+func (m *FailedJobMutation) ID() (id types.FailedJobID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1200,28 +1442,28 @@ func (m *JobMutation) ID() (id types.JobID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *JobMutation) IDs(ctx context.Context) ([]types.JobID, error) {
+func (m *FailedJobMutation) IDs(ctx context.Context) ([]types.FailedJobID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []types.JobID{id}, nil
+			return []types.FailedJobID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Job.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().FailedJob.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetName sets the "name" field.
-func (m *JobMutation) SetName(s string) {
+func (m *FailedJobMutation) SetName(s string) {
 	m.name = &s
 }
 
 // Name returns the value of the "name" field in the mutation.
-func (m *JobMutation) Name() (r string, exists bool) {
+func (m *FailedJobMutation) Name() (r string, exists bool) {
 	v := m.name
 	if v == nil {
 		return
@@ -1229,10 +1471,10 @@ func (m *JobMutation) Name() (r string, exists bool) {
 	return *v, true
 }
 
-// OldName returns the old "name" field's value of the Job entity.
-// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the FailedJob entity.
+// If the FailedJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobMutation) OldName(ctx context.Context) (v string, err error) {
+func (m *FailedJobMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
@@ -1247,17 +1489,17 @@ func (m *JobMutation) OldName(ctx context.Context) (v string, err error) {
 }
 
 // ResetName resets all changes to the "name" field.
-func (m *JobMutation) ResetName() {
+func (m *FailedJobMutation) ResetName() {
 	m.name = nil
 }
 
 // SetPayload sets the "payload" field.
-func (m *JobMutation) SetPayload(s string) {
+func (m *FailedJobMutation) SetPayload(s string) {
 	m.payload = &s
 }
 
 // Payload returns the value of the "payload" field in the mutation.
-func (m *JobMutation) Payload() (r string, exists bool) {
+func (m *FailedJobMutation) Payload() (r string, exists bool) {
 	v := m.payload
 	if v == nil {
 		return
@@ -1265,10 +1507,10 @@ func (m *JobMutation) Payload() (r string, exists bool) {
 	return *v, true
 }
 
-// OldPayload returns the old "payload" field's value of the Job entity.
-// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// OldPayload returns the old "payload" field's value of the FailedJob entity.
+// If the FailedJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobMutation) OldPayload(ctx context.Context) (v string, err error) {
+func (m *FailedJobMutation) OldPayload(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
 	}
@@ -1283,145 +1525,53 @@ func (m *JobMutation) OldPayload(ctx context.Context) (v string, err error) {
 }
 
 // ResetPayload resets all changes to the "payload" field.
-func (m *JobMutation) ResetPayload() {
+func (m *FailedJobMutation) ResetPayload() {
 	m.payload = nil
 }
 
-// SetAttempts sets the "attempts" field.
-func (m *JobMutation) SetAttempts(i int) {
-	m.attempts = &i
-	m.addattempts = nil
-}
-
-// Attempts returns the value of the "attempts" field in the mutation.
-func (m *JobMutation) Attempts() (r int, exists bool) {
-	v := m.attempts
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldAttempts returns the old "attempts" field's value of the Job entity.
-// If the Job object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobMutation) OldAttempts(ctx context.Context) (v int, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAttempts requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
-	}
-	return oldValue.Attempts, nil
-}
-
-// AddAttempts adds i to the "attempts" field.
-func (m *JobMutation) AddAttempts(i int) {
-	if m.addattempts != nil {
-		*m.addattempts += i
-	} else {
-		m.addattempts = &i
-	}
-}
-
-// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
-func (m *JobMutation) AddedAttempts() (r int, exists bool) {
-	v := m.addattempts
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// ResetAttempts resets all changes to the "attempts" field.
-func (m *JobMutation) ResetAttempts() {
-	m.attempts = nil
-	m.addattempts = nil
-}
-
-// SetAvailableAt sets the "available_at" field.
-func (m *JobMutation) SetAvailableAt(t time.Time) {
-	m.available_at = &t
-}
-
-// AvailableAt returns the value of the "available_at" field in the mutation.
-func (m *JobMutation) AvailableAt() (r time.Time, exists bool) {
-	v := m.available_at
-	if v == nil {
-		return
-	}
-	return *v, true
-}
-
-// OldAvailableAt returns the old "available_at" field's value of the Job entity.
-// If the Job object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobMutation) OldAvailableAt(ctx context.Context) (v time.Time, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAvailableAt is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAvailableAt requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAvailableAt: %w", err)
-	}
-	return oldValue.AvailableAt, nil
-}
-
-// ResetAvailableAt resets all changes to the "available_at" field.
-func (m *JobMutation) ResetAvailableAt() {
-	m.available_at = nil
-}
-
-// SetReservedUntil sets the "reserved_until" field.
-func (m *JobMutation) SetReservedUntil(t time.Time) {
-	m.reserved_until = &t
+// SetReason sets the "reason" field.
+func (m *FailedJobMutation) SetReason(s string) {
+	m.reason = &s
 }
 
-// ReservedUntil returns the value of the "reserved_until" field in the mutation.
-func (m *JobMutation) ReservedUntil() (r time.Time, exists bool) {
-	v := m.reserved_until
+// Reason returns the value of the "reason" field in the mutation.
+func (m *FailedJobMutation) Reason() (r string, exists bool) {
+	v := m.reason
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldReservedUntil returns the old "reserved_until" field's value of the Job entity.
-// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// OldReason returns the old "reason" field's value of the FailedJob entity.
+// If the FailedJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobMutation) OldReservedUntil(ctx context.Context) (v time.Time, err error) {
+func (m *FailedJobMutation) OldReason(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldReservedUntil is only allowed on UpdateOne operations")
+		return v, errors.New("OldReason is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldReservedUntil requires an ID field in the mutation")
+		return v, errors.New("OldReason requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldReservedUntil: %w", err)
+		return v, fmt.Errorf("querying old value for OldReason: %w", err)
 	}
-	return oldValue.ReservedUntil, nil
+	return oldValue.Reason, nil
 }
 
-// ResetReservedUntil resets all changes to the "reserved_until" field.
-func (m *JobMutation) ResetReservedUntil() {
-	m.reserved_until = nil
+// ResetReason resets all changes to the "reason" field.
+func (m *FailedJobMutation) ResetReason() {
+	m.reason = nil
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *JobMutation) SetCreatedAt(t time.Time) {
+func (m *FailedJobMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *JobMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *FailedJobMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -1429,10 +1579,10 @@ func (m *JobMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Job entity.
-// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the FailedJob entity.
+// If the FailedJob object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *JobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *FailedJobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -1447,19 +1597,19 @@ func (m *JobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error)
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *JobMutation) ResetCreatedAt() {
+func (m *FailedJobMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
-// Where appends a list predicates to the JobMutation builder.
-func (m *JobMutation) Where(ps ...predicate.Job) {
+// Where appends a list predicates to the FailedJobMutation builder.
+func (m *FailedJobMutation) Where(ps ...predicate.FailedJob) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the JobMutation builder. Using this method,
+// WhereP appends storage-level predicates to the FailedJobMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *JobMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Job, len(ps))
+func (m *FailedJobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.FailedJob, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -1467,42 +1617,36 @@ func (m *JobMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *JobMutation) Op() Op {
+func (m *FailedJobMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *JobMutation) SetOp(op Op) {
+func (m *FailedJobMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Job).
-func (m *JobMutation) Type() string {
+// Type returns the node type of this mutation (FailedJob).
+func (m *FailedJobMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *JobMutation) Fields() []string {
-	fields := make([]string, 0, 6)
+func (m *FailedJobMutation) Fields() []string {
+	fields := make([]string, 0, 4)
 	if m.name != nil {
-		fields = append(fields, job.FieldName)
+		fields = append(fields, failedjob.FieldName)
 	}
 	if m.payload != nil {
-		fields = append(fields, job.FieldPayload)
-	}
-	if m.attempts != nil {
-		fields = append(fields, job.FieldAttempts)
-	}
-	if m.available_at != nil {
-		fields = append(fields, job.FieldAvailableAt)
+		fields = append(fields, failedjob.FieldPayload)
 	}
-	if m.reserved_until != nil {
-		fields = append(fields, job.FieldReservedUntil)
+	if m.reason != nil {
+		fields = append(fields, failedjob.FieldReason)
 	}
 	if m.created_at != nil {
-		fields = append(fields, job.FieldCreatedAt)
+		fields = append(fields, failedjob.FieldCreatedAt)
 	}
 	return fields
 }
@@ -1510,19 +1654,15 @@ func (m *JobMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *JobMutation) Field(name string) (ent.Value, bool) {
+func (m *FailedJobMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case job.FieldName:
+	case failedjob.FieldName:
 		return m.Name()
-	case job.FieldPayload:
+	case failedjob.FieldPayload:
 		return m.Payload()
-	case job.FieldAttempts:
-		return m.Attempts()
-	case job.FieldAvailableAt:
-		return m.AvailableAt()
-	case job.FieldReservedUntil:
-		return m.ReservedUntil()
-	case job.FieldCreatedAt:
+	case failedjob.FieldReason:
+		return m.Reason()
+	case failedjob.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -1531,65 +1671,47 @@ func (m *JobMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *JobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *FailedJobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case job.FieldName:
+	case failedjob.FieldName:
 		return m.OldName(ctx)
-	case job.FieldPayload:
+	case failedjob.FieldPayload:
 		return m.OldPayload(ctx)
-	case job.FieldAttempts:
-		return m.OldAttempts(ctx)
-	case job.FieldAvailableAt:
-		return m.OldAvailableAt(ctx)
-	case job.FieldReservedUntil:
-		return m.OldReservedUntil(ctx)
-	case job.FieldCreatedAt:
+	case failedjob.FieldReason:
+		return m.OldReason(ctx)
+	case failedjob.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Job field %s", name)
+	return nil, fmt.Errorf("unknown FailedJob field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *JobMutation) SetField(name string, value ent.Value) error {
+func (m *FailedJobMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case job.FieldName:
+	case failedjob.FieldName:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetName(v)
 		return nil
-	case job.FieldPayload:
+	case failedjob.FieldPayload:
 		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetPayload(v)
 		return nil
-	case job.FieldAttempts:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAttempts(v)
-		return nil
-	case job.FieldAvailableAt:
-		v, ok := value.(time.Time)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetAvailableAt(v)
-		return nil
-	case job.FieldReservedUntil:
-		v, ok := value.(time.Time)
+	case failedjob.FieldReason:
+		v, ok := value.(string)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetReservedUntil(v)
+		m.SetReason(v)
 		return nil
-	case job.FieldCreatedAt:
+	case failedjob.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -1597,175 +1719,148 @@ func (m *JobMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Job field %s", name)
+	return fmt.Errorf("unknown FailedJob field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *JobMutation) AddedFields() []string {
-	var fields []string
-	if m.addattempts != nil {
-		fields = append(fields, job.FieldAttempts)
-	}
-	return fields
+func (m *FailedJobMutation) AddedFields() []string {
+	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *JobMutation) AddedField(name string) (ent.Value, bool) {
-	switch name {
-	case job.FieldAttempts:
-		return m.AddedAttempts()
-	}
+func (m *FailedJobMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *JobMutation) AddField(name string, value ent.Value) error {
+func (m *FailedJobMutation) AddField(name string, value ent.Value) error {
 	switch name {
-	case job.FieldAttempts:
-		v, ok := value.(int)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.AddAttempts(v)
-		return nil
 	}
-	return fmt.Errorf("unknown Job numeric field %s", name)
+	return fmt.Errorf("unknown FailedJob numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *JobMutation) ClearedFields() []string {
+func (m *FailedJobMutation) ClearedFields() []string {
 	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *JobMutation) FieldCleared(name string) bool {
+func (m *FailedJobMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *JobMutation) ClearField(name string) error {
-	return fmt.Errorf("unknown Job nullable field %s", name)
+func (m *FailedJobMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown FailedJob nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *JobMutation) ResetField(name string) error {
+func (m *FailedJobMutation) ResetField(name string) error {
 	switch name {
-	case job.FieldName:
+	case failedjob.FieldName:
 		m.ResetName()
 		return nil
-	case job.FieldPayload:
+	case failedjob.FieldPayload:
 		m.ResetPayload()
 		return nil
-	case job.FieldAttempts:
-		m.ResetAttempts()
-		return nil
-	case job.FieldAvailableAt:
-		m.ResetAvailableAt()
-		return nil
-	case job.FieldReservedUntil:
-		m.ResetReservedUntil()
+	case failedjob.FieldReason:
+		m.ResetReason()
 		return nil
-	case job.FieldCreatedAt:
+	case failedjob.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Job field %s", name)
+	return fmt.Errorf("unknown FailedJob field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *JobMutation) AddedEdges() []string {
+func (m *FailedJobMutation) AddedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *JobMutation) AddedIDs(name string) []ent.Value {
+func (m *FailedJobMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *JobMutation) RemovedEdges() []string {
+func (m *FailedJobMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *JobMutation) RemovedIDs(name string) []ent.Value {
+func (m *FailedJobMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *JobMutation) ClearedEdges() []string {
+func (m *FailedJobMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *JobMutation) EdgeCleared(name string) bool {
+func (m *FailedJobMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *JobMutation) ClearEdge(name string) error {
-	return fmt.Errorf("unknown Job unique edge %s", name)
+func (m *FailedJobMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown FailedJob unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *JobMutation) ResetEdge(name string) error {
-	return fmt.Errorf("unknown Job edge %s", name)
+func (m *FailedJobMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown FailedJob edge %s", name)
 }
 
-// MessageMutation represents an operation that mutates the Message nodes in the graph.
-type MessageMutation struct {
+// JobMutation represents an operation that mutates the Job nodes in the graph.
+type JobMutation struct {
 	config
-	op                     Op
-	typ                    string
-	id                     *types.MessageID
-	author_id              *types.UserID
-	initial_request_id     *types.RequestID
-	is_visible_for_client  *bool
-	is_visible_for_manager *bool
-	body                   *string
-	checked_at             *time.Time
-	is_blocked             *bool
-	is_service             *bool
-	created_at             *time.Time
-	clearedFields          map[string]struct{}
-	chat                   *types.ChatID
-	clearedchat            bool
-	problem                *types.ProblemID
-	clearedproblem         bool
-	done                   bool
-	oldValue               func(context.Context) (*Message, error)
-	predicates             []predicate.Message
+	op             Op
+	typ            string
+	id             *types.JobID
+	name           *string
+	payload        *string
+	attempts       *int
+	addattempts    *int
+	available_at   *time.Time
+	reserved_until *time.Time
+	created_at     *time.Time
+	clearedFields  map[string]struct{}
+	done           bool
+	oldValue       func(context.Context) (*Job, error)
+	predicates     []predicate.Job
 }
 
-var _ ent.Mutation = (*MessageMutation)(nil)
+var _ ent.Mutation = (*JobMutation)(nil)
 
-// messageOption allows management of the mutation configuration using functional options.
-type messageOption func(*MessageMutation)
+// jobOption allows management of the mutation configuration using functional options.
+type jobOption func(*JobMutation)
 
-// newMessageMutation creates new mutation for the Message entity.
-func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
-	m := &MessageMutation{
+// newJobMutation creates new mutation for the Job entity.
+func newJobMutation(c config, op Op, opts ...jobOption) *JobMutation {
+	m := &JobMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeMessage,
+		typ:           TypeJob,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -1774,20 +1869,20 @@ func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation
 	return m
 }
 
-// withMessageID sets the ID field of the mutation.
-func withMessageID(id types.MessageID) messageOption {
-	return func(m *MessageMutation) {
+// withJobID sets the ID field of the mutation.
+func withJobID(id types.JobID) jobOption {
+	return func(m *JobMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Message
+			value *Job
 		)
-		m.oldValue = func(ctx context.Context) (*Message, error) {
+		m.oldValue = func(ctx context.Context) (*Job, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Message.Get(ctx, id)
+					value, err = m.Client().Job.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -1796,10 +1891,10 @@ func withMessageID(id types.MessageID) messageOption {
 	}
 }
 
-// withMessage sets the old Message of the mutation.
-func withMessage(node *Message) messageOption {
-	return func(m *MessageMutation) {
-		m.oldValue = func(context.Context) (*Message, error) {
+// withJob sets the old Job of the mutation.
+func withJob(node *Job) jobOption {
+	return func(m *JobMutation) {
+		m.oldValue = func(context.Context) (*Job, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -1808,7 +1903,7 @@ func withMessage(node *Message) messageOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m MessageMutation) Client() *Client {
+func (m JobMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -1816,7 +1911,7 @@ func (m MessageMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m MessageMutation) Tx() (*Tx, error) {
+func (m JobMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("store: mutation is not running in a transaction")
 	}
@@ -1826,14 +1921,14 @@ func (m MessageMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Message entities.
-func (m *MessageMutation) SetID(id types.MessageID) {
+// operation is only accepted on creation of Job entities.
+func (m *JobMutation) SetID(id types.JobID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *MessageMutation) ID() (id types.MessageID, exists bool) {
+func (m *JobMutation) ID() (id types.JobID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -1844,427 +1939,2171 @@ func (m *MessageMutation) ID() (id types.MessageID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *MessageMutation) IDs(ctx context.Context) ([]types.MessageID, error) {
+func (m *JobMutation) IDs(ctx context.Context) ([]types.JobID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []types.MessageID{id}, nil
+			return []types.JobID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().Job.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
-// SetChatID sets the "chat_id" field.
-func (m *MessageMutation) SetChatID(ti types.ChatID) {
-	m.chat = &ti
+// SetName sets the "name" field.
+func (m *JobMutation) SetName(s string) {
+	m.name = &s
 }
 
-// ChatID returns the value of the "chat_id" field in the mutation.
-func (m *MessageMutation) ChatID() (r types.ChatID, exists bool) {
-	v := m.chat
+// Name returns the value of the "name" field in the mutation.
+func (m *JobMutation) Name() (r string, exists bool) {
+	v := m.name
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldChatID returns the old "chat_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldName returns the old "name" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldChatID(ctx context.Context) (v types.ChatID, err error) {
+func (m *JobMutation) OldName(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
+		return v, errors.New("OldName is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldChatID requires an ID field in the mutation")
+		return v, errors.New("OldName requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
+		return v, fmt.Errorf("querying old value for OldName: %w", err)
 	}
-	return oldValue.ChatID, nil
+	return oldValue.Name, nil
 }
 
-// ResetChatID resets all changes to the "chat_id" field.
-func (m *MessageMutation) ResetChatID() {
-	m.chat = nil
+// ResetName resets all changes to the "name" field.
+func (m *JobMutation) ResetName() {
+	m.name = nil
 }
 
-// SetProblemID sets the "problem_id" field.
-func (m *MessageMutation) SetProblemID(ti types.ProblemID) {
-	m.problem = &ti
+// SetPayload sets the "payload" field.
+func (m *JobMutation) SetPayload(s string) {
+	m.payload = &s
 }
 
-// ProblemID returns the value of the "problem_id" field in the mutation.
-func (m *MessageMutation) ProblemID() (r types.ProblemID, exists bool) {
-	v := m.problem
+// Payload returns the value of the "payload" field in the mutation.
+func (m *JobMutation) Payload() (r string, exists bool) {
+	v := m.payload
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldProblemID returns the old "problem_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldPayload returns the old "payload" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldProblemID(ctx context.Context) (v types.ProblemID, err error) {
+func (m *JobMutation) OldPayload(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldProblemID is only allowed on UpdateOne operations")
+		return v, errors.New("OldPayload is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldProblemID requires an ID field in the mutation")
+		return v, errors.New("OldPayload requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldProblemID: %w", err)
+		return v, fmt.Errorf("querying old value for OldPayload: %w", err)
 	}
-	return oldValue.ProblemID, nil
+	return oldValue.Payload, nil
 }
 
-// ResetProblemID resets all changes to the "problem_id" field.
-func (m *MessageMutation) ResetProblemID() {
-	m.problem = nil
+// ResetPayload resets all changes to the "payload" field.
+func (m *JobMutation) ResetPayload() {
+	m.payload = nil
 }
 
-// SetAuthorID sets the "author_id" field.
-func (m *MessageMutation) SetAuthorID(ti types.UserID) {
-	m.author_id = &ti
+// SetAttempts sets the "attempts" field.
+func (m *JobMutation) SetAttempts(i int) {
+	m.attempts = &i
+	m.addattempts = nil
 }
 
-// AuthorID returns the value of the "author_id" field in the mutation.
-func (m *MessageMutation) AuthorID() (r types.UserID, exists bool) {
-	v := m.author_id
+// Attempts returns the value of the "attempts" field in the mutation.
+func (m *JobMutation) Attempts() (r int, exists bool) {
+	v := m.attempts
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldAuthorID returns the old "author_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldAttempts returns the old "attempts" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldAuthorID(ctx context.Context) (v types.UserID, err error) {
+func (m *JobMutation) OldAttempts(ctx context.Context) (v int, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldAuthorID is only allowed on UpdateOne operations")
+		return v, errors.New("OldAttempts is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldAuthorID requires an ID field in the mutation")
+		return v, errors.New("OldAttempts requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldAuthorID: %w", err)
+		return v, fmt.Errorf("querying old value for OldAttempts: %w", err)
 	}
-	return oldValue.AuthorID, nil
+	return oldValue.Attempts, nil
 }
 
-// ClearAuthorID clears the value of the "author_id" field.
-func (m *MessageMutation) ClearAuthorID() {
-	m.author_id = nil
-	m.clearedFields[message.FieldAuthorID] = struct{}{}
+// AddAttempts adds i to the "attempts" field.
+func (m *JobMutation) AddAttempts(i int) {
+	if m.addattempts != nil {
+		*m.addattempts += i
+	} else {
+		m.addattempts = &i
+	}
 }
 
-// AuthorIDCleared returns if the "author_id" field was cleared in this mutation.
-func (m *MessageMutation) AuthorIDCleared() bool {
-	_, ok := m.clearedFields[message.FieldAuthorID]
-	return ok
+// AddedAttempts returns the value that was added to the "attempts" field in this mutation.
+func (m *JobMutation) AddedAttempts() (r int, exists bool) {
+	v := m.addattempts
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// ResetAuthorID resets all changes to the "author_id" field.
-func (m *MessageMutation) ResetAuthorID() {
-	m.author_id = nil
-	delete(m.clearedFields, message.FieldAuthorID)
+// ResetAttempts resets all changes to the "attempts" field.
+func (m *JobMutation) ResetAttempts() {
+	m.attempts = nil
+	m.addattempts = nil
 }
 
-// SetInitialRequestID sets the "initial_request_id" field.
-func (m *MessageMutation) SetInitialRequestID(ti types.RequestID) {
-	m.initial_request_id = &ti
+// SetAvailableAt sets the "available_at" field.
+func (m *JobMutation) SetAvailableAt(t time.Time) {
+	m.available_at = &t
 }
 
-// InitialRequestID returns the value of the "initial_request_id" field in the mutation.
-func (m *MessageMutation) InitialRequestID() (r types.RequestID, exists bool) {
-	v := m.initial_request_id
+// AvailableAt returns the value of the "available_at" field in the mutation.
+func (m *JobMutation) AvailableAt() (r time.Time, exists bool) {
+	v := m.available_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldInitialRequestID returns the old "initial_request_id" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldAvailableAt returns the old "available_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldInitialRequestID(ctx context.Context) (v types.RequestID, err error) {
+func (m *JobMutation) OldAvailableAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldInitialRequestID is only allowed on UpdateOne operations")
+		return v, errors.New("OldAvailableAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldInitialRequestID requires an ID field in the mutation")
+		return v, errors.New("OldAvailableAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldInitialRequestID: %w", err)
+		return v, fmt.Errorf("querying old value for OldAvailableAt: %w", err)
 	}
-	return oldValue.InitialRequestID, nil
+	return oldValue.AvailableAt, nil
 }
 
-// ClearInitialRequestID clears the value of the "initial_request_id" field.
-func (m *MessageMutation) ClearInitialRequestID() {
-	m.initial_request_id = nil
-	m.clearedFields[message.FieldInitialRequestID] = struct{}{}
+// ResetAvailableAt resets all changes to the "available_at" field.
+func (m *JobMutation) ResetAvailableAt() {
+	m.available_at = nil
 }
 
-// InitialRequestIDCleared returns if the "initial_request_id" field was cleared in this mutation.
-func (m *MessageMutation) InitialRequestIDCleared() bool {
-	_, ok := m.clearedFields[message.FieldInitialRequestID]
-	return ok
+// SetReservedUntil sets the "reserved_until" field.
+func (m *JobMutation) SetReservedUntil(t time.Time) {
+	m.reserved_until = &t
 }
 
-// ResetInitialRequestID resets all changes to the "initial_request_id" field.
-func (m *MessageMutation) ResetInitialRequestID() {
-	m.initial_request_id = nil
-	delete(m.clearedFields, message.FieldInitialRequestID)
+// ReservedUntil returns the value of the "reserved_until" field in the mutation.
+func (m *JobMutation) ReservedUntil() (r time.Time, exists bool) {
+	v := m.reserved_until
+	if v == nil {
+		return
+	}
+	return *v, true
 }
 
-// SetIsVisibleForClient sets the "is_visible_for_client" field.
-func (m *MessageMutation) SetIsVisibleForClient(b bool) {
-	m.is_visible_for_client = &b
+// OldReservedUntil returns the old "reserved_until" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *JobMutation) OldReservedUntil(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReservedUntil is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReservedUntil requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReservedUntil: %w", err)
+	}
+	return oldValue.ReservedUntil, nil
 }
 
-// IsVisibleForClient returns the value of the "is_visible_for_client" field in the mutation.
-func (m *MessageMutation) IsVisibleForClient() (r bool, exists bool) {
-	v := m.is_visible_for_client
+// ResetReservedUntil resets all changes to the "reserved_until" field.
+func (m *JobMutation) ResetReservedUntil() {
+	m.reserved_until = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *JobMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *JobMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsVisibleForClient returns the old "is_visible_for_client" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Job entity.
+// If the Job object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldIsVisibleForClient(ctx context.Context) (v bool, err error) {
+func (m *JobMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsVisibleForClient is only allowed on UpdateOne operations")
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsVisibleForClient requires an ID field in the mutation")
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsVisibleForClient: %w", err)
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
 	}
-	return oldValue.IsVisibleForClient, nil
+	return oldValue.CreatedAt, nil
 }
 
-// ResetIsVisibleForClient resets all changes to the "is_visible_for_client" field.
-func (m *MessageMutation) ResetIsVisibleForClient() {
-	m.is_visible_for_client = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *JobMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// Where appends a list predicates to the JobMutation builder.
+func (m *JobMutation) Where(ps ...predicate.Job) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the JobMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *JobMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Job, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *JobMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *JobMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Job).
+func (m *JobMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *JobMutation) Fields() []string {
+	fields := make([]string, 0, 6)
+	if m.name != nil {
+		fields = append(fields, job.FieldName)
+	}
+	if m.payload != nil {
+		fields = append(fields, job.FieldPayload)
+	}
+	if m.attempts != nil {
+		fields = append(fields, job.FieldAttempts)
+	}
+	if m.available_at != nil {
+		fields = append(fields, job.FieldAvailableAt)
+	}
+	if m.reserved_until != nil {
+		fields = append(fields, job.FieldReservedUntil)
+	}
+	if m.created_at != nil {
+		fields = append(fields, job.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *JobMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case job.FieldName:
+		return m.Name()
+	case job.FieldPayload:
+		return m.Payload()
+	case job.FieldAttempts:
+		return m.Attempts()
+	case job.FieldAvailableAt:
+		return m.AvailableAt()
+	case job.FieldReservedUntil:
+		return m.ReservedUntil()
+	case job.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *JobMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case job.FieldName:
+		return m.OldName(ctx)
+	case job.FieldPayload:
+		return m.OldPayload(ctx)
+	case job.FieldAttempts:
+		return m.OldAttempts(ctx)
+	case job.FieldAvailableAt:
+		return m.OldAvailableAt(ctx)
+	case job.FieldReservedUntil:
+		return m.OldReservedUntil(ctx)
+	case job.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Job field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case job.FieldName:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetName(v)
+		return nil
+	case job.FieldPayload:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetPayload(v)
+		return nil
+	case job.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAttempts(v)
+		return nil
+	case job.FieldAvailableAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAvailableAt(v)
+		return nil
+	case job.FieldReservedUntil:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReservedUntil(v)
+		return nil
+	case job.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Job field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *JobMutation) AddedFields() []string {
+	var fields []string
+	if m.addattempts != nil {
+		fields = append(fields, job.FieldAttempts)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *JobMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case job.FieldAttempts:
+		return m.AddedAttempts()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *JobMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case job.FieldAttempts:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAttempts(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Job numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *JobMutation) ClearedFields() []string {
+	return nil
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *JobMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *JobMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown Job nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *JobMutation) ResetField(name string) error {
+	switch name {
+	case job.FieldName:
+		m.ResetName()
+		return nil
+	case job.FieldPayload:
+		m.ResetPayload()
+		return nil
+	case job.FieldAttempts:
+		m.ResetAttempts()
+		return nil
+	case job.FieldAvailableAt:
+		m.ResetAvailableAt()
+		return nil
+	case job.FieldReservedUntil:
+		m.ResetReservedUntil()
+		return nil
+	case job.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Job field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *JobMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *JobMutation) AddedIDs(name string) []ent.Value {
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *JobMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *JobMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *JobMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *JobMutation) EdgeCleared(name string) bool {
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *JobMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown Job unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *JobMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown Job edge %s", name)
+}
+
+// MessageMutation represents an operation that mutates the Message nodes in the graph.
+type MessageMutation struct {
+	config
+	op                     Op
+	typ                    string
+	id                     *types.MessageID
+	author_id              *types.UserID
+	initial_request_id     *types.RequestID
+	is_visible_for_client  *bool
+	is_visible_for_manager *bool
+	body                   *string
+	checked_at             *time.Time
+	is_blocked             *bool
+	review_requested_at    *time.Time
+	afc_resends            *int
+	addafc_resends         *int
+	afc_resent_at          *time.Time
+	is_service             *bool
+	is_internal_note       *bool
+	created_at             *time.Time
+	clearedFields          map[string]struct{}
+	chat                   *types.ChatID
+	clearedchat            bool
+	problem                *types.ProblemID
+	clearedproblem         bool
+	done                   bool
+	oldValue               func(context.Context) (*Message, error)
+	predicates             []predicate.Message
+}
+
+var _ ent.Mutation = (*MessageMutation)(nil)
+
+// messageOption allows management of the mutation configuration using functional options.
+type messageOption func(*MessageMutation)
+
+// newMessageMutation creates new mutation for the Message entity.
+func newMessageMutation(c config, op Op, opts ...messageOption) *MessageMutation {
+	m := &MessageMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeMessage,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withMessageID sets the ID field of the mutation.
+func withMessageID(id types.MessageID) messageOption {
+	return func(m *MessageMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Message
+		)
+		m.oldValue = func(ctx context.Context) (*Message, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Message.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withMessage sets the old Message of the mutation.
+func withMessage(node *Message) messageOption {
+	return func(m *MessageMutation) {
+		m.oldValue = func(context.Context) (*Message, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m MessageMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m MessageMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("store: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
+}
+
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Message entities.
+func (m *MessageMutation) SetID(id types.MessageID) {
+	m.id = &id
+}
+
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *MessageMutation) ID() (id types.MessageID, exists bool) {
+	if m.id == nil {
+		return
+	}
+	return *m.id, true
+}
+
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *MessageMutation) IDs(ctx context.Context) ([]types.MessageID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []types.MessageID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Message.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
+	}
+}
+
+// SetChatID sets the "chat_id" field.
+func (m *MessageMutation) SetChatID(ti types.ChatID) {
+	m.chat = &ti
+}
+
+// ChatID returns the value of the "chat_id" field in the mutation.
+func (m *MessageMutation) ChatID() (r types.ChatID, exists bool) {
+	v := m.chat
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldChatID returns the old "chat_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldChatID(ctx context.Context) (v types.ChatID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldChatID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
+	}
+	return oldValue.ChatID, nil
+}
+
+// ResetChatID resets all changes to the "chat_id" field.
+func (m *MessageMutation) ResetChatID() {
+	m.chat = nil
+}
+
+// SetProblemID sets the "problem_id" field.
+func (m *MessageMutation) SetProblemID(ti types.ProblemID) {
+	m.problem = &ti
+}
+
+// ProblemID returns the value of the "problem_id" field in the mutation.
+func (m *MessageMutation) ProblemID() (r types.ProblemID, exists bool) {
+	v := m.problem
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProblemID returns the old "problem_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldProblemID(ctx context.Context) (v types.ProblemID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProblemID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProblemID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProblemID: %w", err)
+	}
+	return oldValue.ProblemID, nil
+}
+
+// ResetProblemID resets all changes to the "problem_id" field.
+func (m *MessageMutation) ResetProblemID() {
+	m.problem = nil
+}
+
+// SetAuthorID sets the "author_id" field.
+func (m *MessageMutation) SetAuthorID(ti types.UserID) {
+	m.author_id = &ti
+}
+
+// AuthorID returns the value of the "author_id" field in the mutation.
+func (m *MessageMutation) AuthorID() (r types.UserID, exists bool) {
+	v := m.author_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAuthorID returns the old "author_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldAuthorID(ctx context.Context) (v types.UserID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAuthorID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAuthorID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAuthorID: %w", err)
+	}
+	return oldValue.AuthorID, nil
+}
+
+// ClearAuthorID clears the value of the "author_id" field.
+func (m *MessageMutation) ClearAuthorID() {
+	m.author_id = nil
+	m.clearedFields[message.FieldAuthorID] = struct{}{}
+}
+
+// AuthorIDCleared returns if the "author_id" field was cleared in this mutation.
+func (m *MessageMutation) AuthorIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldAuthorID]
+	return ok
+}
+
+// ResetAuthorID resets all changes to the "author_id" field.
+func (m *MessageMutation) ResetAuthorID() {
+	m.author_id = nil
+	delete(m.clearedFields, message.FieldAuthorID)
+}
+
+// SetInitialRequestID sets the "initial_request_id" field.
+func (m *MessageMutation) SetInitialRequestID(ti types.RequestID) {
+	m.initial_request_id = &ti
+}
+
+// InitialRequestID returns the value of the "initial_request_id" field in the mutation.
+func (m *MessageMutation) InitialRequestID() (r types.RequestID, exists bool) {
+	v := m.initial_request_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldInitialRequestID returns the old "initial_request_id" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldInitialRequestID(ctx context.Context) (v types.RequestID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldInitialRequestID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldInitialRequestID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldInitialRequestID: %w", err)
+	}
+	return oldValue.InitialRequestID, nil
+}
+
+// ClearInitialRequestID clears the value of the "initial_request_id" field.
+func (m *MessageMutation) ClearInitialRequestID() {
+	m.initial_request_id = nil
+	m.clearedFields[message.FieldInitialRequestID] = struct{}{}
+}
+
+// InitialRequestIDCleared returns if the "initial_request_id" field was cleared in this mutation.
+func (m *MessageMutation) InitialRequestIDCleared() bool {
+	_, ok := m.clearedFields[message.FieldInitialRequestID]
+	return ok
+}
+
+// ResetInitialRequestID resets all changes to the "initial_request_id" field.
+func (m *MessageMutation) ResetInitialRequestID() {
+	m.initial_request_id = nil
+	delete(m.clearedFields, message.FieldInitialRequestID)
+}
+
+// SetIsVisibleForClient sets the "is_visible_for_client" field.
+func (m *MessageMutation) SetIsVisibleForClient(b bool) {
+	m.is_visible_for_client = &b
+}
+
+// IsVisibleForClient returns the value of the "is_visible_for_client" field in the mutation.
+func (m *MessageMutation) IsVisibleForClient() (r bool, exists bool) {
+	v := m.is_visible_for_client
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsVisibleForClient returns the old "is_visible_for_client" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldIsVisibleForClient(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsVisibleForClient is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsVisibleForClient requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsVisibleForClient: %w", err)
+	}
+	return oldValue.IsVisibleForClient, nil
+}
+
+// ResetIsVisibleForClient resets all changes to the "is_visible_for_client" field.
+func (m *MessageMutation) ResetIsVisibleForClient() {
+	m.is_visible_for_client = nil
+}
+
+// SetIsVisibleForManager sets the "is_visible_for_manager" field.
+func (m *MessageMutation) SetIsVisibleForManager(b bool) {
+	m.is_visible_for_manager = &b
+}
+
+// IsVisibleForManager returns the value of the "is_visible_for_manager" field in the mutation.
+func (m *MessageMutation) IsVisibleForManager() (r bool, exists bool) {
+	v := m.is_visible_for_manager
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsVisibleForManager returns the old "is_visible_for_manager" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldIsVisibleForManager(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsVisibleForManager is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsVisibleForManager requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsVisibleForManager: %w", err)
+	}
+	return oldValue.IsVisibleForManager, nil
+}
+
+// ResetIsVisibleForManager resets all changes to the "is_visible_for_manager" field.
+func (m *MessageMutation) ResetIsVisibleForManager() {
+	m.is_visible_for_manager = nil
+}
+
+// SetBody sets the "body" field.
+func (m *MessageMutation) SetBody(s string) {
+	m.body = &s
+}
+
+// Body returns the value of the "body" field in the mutation.
+func (m *MessageMutation) Body() (r string, exists bool) {
+	v := m.body
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldBody returns the old "body" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldBody(ctx context.Context) (v string, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldBody requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+	}
+	return oldValue.Body, nil
+}
+
+// ResetBody resets all changes to the "body" field.
+func (m *MessageMutation) ResetBody() {
+	m.body = nil
+}
+
+// SetCheckedAt sets the "checked_at" field.
+func (m *MessageMutation) SetCheckedAt(t time.Time) {
+	m.checked_at = &t
+}
+
+// CheckedAt returns the value of the "checked_at" field in the mutation.
+func (m *MessageMutation) CheckedAt() (r time.Time, exists bool) {
+	v := m.checked_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCheckedAt returns the old "checked_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldCheckedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCheckedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCheckedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCheckedAt: %w", err)
+	}
+	return oldValue.CheckedAt, nil
+}
+
+// ClearCheckedAt clears the value of the "checked_at" field.
+func (m *MessageMutation) ClearCheckedAt() {
+	m.checked_at = nil
+	m.clearedFields[message.FieldCheckedAt] = struct{}{}
+}
+
+// CheckedAtCleared returns if the "checked_at" field was cleared in this mutation.
+func (m *MessageMutation) CheckedAtCleared() bool {
+	_, ok := m.clearedFields[message.FieldCheckedAt]
+	return ok
+}
+
+// ResetCheckedAt resets all changes to the "checked_at" field.
+func (m *MessageMutation) ResetCheckedAt() {
+	m.checked_at = nil
+	delete(m.clearedFields, message.FieldCheckedAt)
+}
+
+// SetIsBlocked sets the "is_blocked" field.
+func (m *MessageMutation) SetIsBlocked(b bool) {
+	m.is_blocked = &b
+}
+
+// IsBlocked returns the value of the "is_blocked" field in the mutation.
+func (m *MessageMutation) IsBlocked() (r bool, exists bool) {
+	v := m.is_blocked
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsBlocked returns the old "is_blocked" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldIsBlocked(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsBlocked is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsBlocked requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsBlocked: %w", err)
+	}
+	return oldValue.IsBlocked, nil
+}
+
+// ResetIsBlocked resets all changes to the "is_blocked" field.
+func (m *MessageMutation) ResetIsBlocked() {
+	m.is_blocked = nil
+}
+
+// SetReviewRequestedAt sets the "review_requested_at" field.
+func (m *MessageMutation) SetReviewRequestedAt(t time.Time) {
+	m.review_requested_at = &t
+}
+
+// ReviewRequestedAt returns the value of the "review_requested_at" field in the mutation.
+func (m *MessageMutation) ReviewRequestedAt() (r time.Time, exists bool) {
+	v := m.review_requested_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldReviewRequestedAt returns the old "review_requested_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldReviewRequestedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldReviewRequestedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldReviewRequestedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldReviewRequestedAt: %w", err)
+	}
+	return oldValue.ReviewRequestedAt, nil
+}
+
+// ClearReviewRequestedAt clears the value of the "review_requested_at" field.
+func (m *MessageMutation) ClearReviewRequestedAt() {
+	m.review_requested_at = nil
+	m.clearedFields[message.FieldReviewRequestedAt] = struct{}{}
+}
+
+// ReviewRequestedAtCleared returns if the "review_requested_at" field was cleared in this mutation.
+func (m *MessageMutation) ReviewRequestedAtCleared() bool {
+	_, ok := m.clearedFields[message.FieldReviewRequestedAt]
+	return ok
+}
+
+// ResetReviewRequestedAt resets all changes to the "review_requested_at" field.
+func (m *MessageMutation) ResetReviewRequestedAt() {
+	m.review_requested_at = nil
+	delete(m.clearedFields, message.FieldReviewRequestedAt)
+}
+
+// SetAfcResends sets the "afc_resends" field.
+func (m *MessageMutation) SetAfcResends(i int) {
+	m.afc_resends = &i
+	m.addafc_resends = nil
+}
+
+// AfcResends returns the value of the "afc_resends" field in the mutation.
+func (m *MessageMutation) AfcResends() (r int, exists bool) {
+	v := m.afc_resends
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAfcResends returns the old "afc_resends" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldAfcResends(ctx context.Context) (v int, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAfcResends is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAfcResends requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAfcResends: %w", err)
+	}
+	return oldValue.AfcResends, nil
+}
+
+// AddAfcResends adds i to the "afc_resends" field.
+func (m *MessageMutation) AddAfcResends(i int) {
+	if m.addafc_resends != nil {
+		*m.addafc_resends += i
+	} else {
+		m.addafc_resends = &i
+	}
+}
+
+// AddedAfcResends returns the value that was added to the "afc_resends" field in this mutation.
+func (m *MessageMutation) AddedAfcResends() (r int, exists bool) {
+	v := m.addafc_resends
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// ResetAfcResends resets all changes to the "afc_resends" field.
+func (m *MessageMutation) ResetAfcResends() {
+	m.afc_resends = nil
+	m.addafc_resends = nil
+}
+
+// SetAfcResentAt sets the "afc_resent_at" field.
+func (m *MessageMutation) SetAfcResentAt(t time.Time) {
+	m.afc_resent_at = &t
+}
+
+// AfcResentAt returns the value of the "afc_resent_at" field in the mutation.
+func (m *MessageMutation) AfcResentAt() (r time.Time, exists bool) {
+	v := m.afc_resent_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldAfcResentAt returns the old "afc_resent_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldAfcResentAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldAfcResentAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldAfcResentAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldAfcResentAt: %w", err)
+	}
+	return oldValue.AfcResentAt, nil
+}
+
+// ClearAfcResentAt clears the value of the "afc_resent_at" field.
+func (m *MessageMutation) ClearAfcResentAt() {
+	m.afc_resent_at = nil
+	m.clearedFields[message.FieldAfcResentAt] = struct{}{}
+}
+
+// AfcResentAtCleared returns if the "afc_resent_at" field was cleared in this mutation.
+func (m *MessageMutation) AfcResentAtCleared() bool {
+	_, ok := m.clearedFields[message.FieldAfcResentAt]
+	return ok
+}
+
+// ResetAfcResentAt resets all changes to the "afc_resent_at" field.
+func (m *MessageMutation) ResetAfcResentAt() {
+	m.afc_resent_at = nil
+	delete(m.clearedFields, message.FieldAfcResentAt)
+}
+
+// SetIsService sets the "is_service" field.
+func (m *MessageMutation) SetIsService(b bool) {
+	m.is_service = &b
+}
+
+// IsService returns the value of the "is_service" field in the mutation.
+func (m *MessageMutation) IsService() (r bool, exists bool) {
+	v := m.is_service
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsService returns the old "is_service" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldIsService(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsService is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsService requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsService: %w", err)
+	}
+	return oldValue.IsService, nil
+}
+
+// ResetIsService resets all changes to the "is_service" field.
+func (m *MessageMutation) ResetIsService() {
+	m.is_service = nil
+}
+
+// SetIsInternalNote sets the "is_internal_note" field.
+func (m *MessageMutation) SetIsInternalNote(b bool) {
+	m.is_internal_note = &b
+}
+
+// IsInternalNote returns the value of the "is_internal_note" field in the mutation.
+func (m *MessageMutation) IsInternalNote() (r bool, exists bool) {
+	v := m.is_internal_note
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldIsInternalNote returns the old "is_internal_note" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldIsInternalNote(ctx context.Context) (v bool, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldIsInternalNote is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldIsInternalNote requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldIsInternalNote: %w", err)
+	}
+	return oldValue.IsInternalNote, nil
+}
+
+// ResetIsInternalNote resets all changes to the "is_internal_note" field.
+func (m *MessageMutation) ResetIsInternalNote() {
+	m.is_internal_note = nil
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (m *MessageMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
+}
+
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *MessageMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldCreatedAt returns the old "created_at" field's value of the Message entity.
+// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *MessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
+}
+
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *MessageMutation) ResetCreatedAt() {
+	m.created_at = nil
+}
+
+// ClearChat clears the "chat" edge to the Chat entity.
+func (m *MessageMutation) ClearChat() {
+	m.clearedchat = true
+}
+
+// ChatCleared reports if the "chat" edge to the Chat entity was cleared.
+func (m *MessageMutation) ChatCleared() bool {
+	return m.clearedchat
+}
+
+// ChatIDs returns the "chat" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ChatID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) ChatIDs() (ids []types.ChatID) {
+	if id := m.chat; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetChat resets all changes to the "chat" edge.
+func (m *MessageMutation) ResetChat() {
+	m.chat = nil
+	m.clearedchat = false
+}
+
+// ClearProblem clears the "problem" edge to the Problem entity.
+func (m *MessageMutation) ClearProblem() {
+	m.clearedproblem = true
+}
+
+// ProblemCleared reports if the "problem" edge to the Problem entity was cleared.
+func (m *MessageMutation) ProblemCleared() bool {
+	return m.clearedproblem
+}
+
+// ProblemIDs returns the "problem" edge IDs in the mutation.
+// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
+// ProblemID instead. It exists only for internal usage by the builders.
+func (m *MessageMutation) ProblemIDs() (ids []types.ProblemID) {
+	if id := m.problem; id != nil {
+		ids = append(ids, *id)
+	}
+	return
+}
+
+// ResetProblem resets all changes to the "problem" edge.
+func (m *MessageMutation) ResetProblem() {
+	m.problem = nil
+	m.clearedproblem = false
+}
+
+// Where appends a list predicates to the MessageMutation builder.
+func (m *MessageMutation) Where(ps ...predicate.Message) {
+	m.predicates = append(m.predicates, ps...)
+}
+
+// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
+// users can use type-assertion to append predicates that do not depend on any generated package.
+func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Message, len(ps))
+	for i := range ps {
+		p[i] = ps[i]
+	}
+	m.Where(p...)
+}
+
+// Op returns the operation name.
+func (m *MessageMutation) Op() Op {
+	return m.op
+}
+
+// SetOp allows setting the mutation operation.
+func (m *MessageMutation) SetOp(op Op) {
+	m.op = op
+}
+
+// Type returns the node type of this mutation (Message).
+func (m *MessageMutation) Type() string {
+	return m.typ
+}
+
+// Fields returns all fields that were changed during this mutation. Note that in
+// order to get all numeric fields that were incremented/decremented, call
+// AddedFields().
+func (m *MessageMutation) Fields() []string {
+	fields := make([]string, 0, 15)
+	if m.chat != nil {
+		fields = append(fields, message.FieldChatID)
+	}
+	if m.problem != nil {
+		fields = append(fields, message.FieldProblemID)
+	}
+	if m.author_id != nil {
+		fields = append(fields, message.FieldAuthorID)
+	}
+	if m.initial_request_id != nil {
+		fields = append(fields, message.FieldInitialRequestID)
+	}
+	if m.is_visible_for_client != nil {
+		fields = append(fields, message.FieldIsVisibleForClient)
+	}
+	if m.is_visible_for_manager != nil {
+		fields = append(fields, message.FieldIsVisibleForManager)
+	}
+	if m.body != nil {
+		fields = append(fields, message.FieldBody)
+	}
+	if m.checked_at != nil {
+		fields = append(fields, message.FieldCheckedAt)
+	}
+	if m.is_blocked != nil {
+		fields = append(fields, message.FieldIsBlocked)
+	}
+	if m.review_requested_at != nil {
+		fields = append(fields, message.FieldReviewRequestedAt)
+	}
+	if m.afc_resends != nil {
+		fields = append(fields, message.FieldAfcResends)
+	}
+	if m.afc_resent_at != nil {
+		fields = append(fields, message.FieldAfcResentAt)
+	}
+	if m.is_service != nil {
+		fields = append(fields, message.FieldIsService)
+	}
+	if m.is_internal_note != nil {
+		fields = append(fields, message.FieldIsInternalNote)
+	}
+	if m.created_at != nil {
+		fields = append(fields, message.FieldCreatedAt)
+	}
+	return fields
+}
+
+// Field returns the value of a field with the given name. The second boolean
+// return value indicates that this field was not set, or was not defined in the
+// schema.
+func (m *MessageMutation) Field(name string) (ent.Value, bool) {
+	switch name {
+	case message.FieldChatID:
+		return m.ChatID()
+	case message.FieldProblemID:
+		return m.ProblemID()
+	case message.FieldAuthorID:
+		return m.AuthorID()
+	case message.FieldInitialRequestID:
+		return m.InitialRequestID()
+	case message.FieldIsVisibleForClient:
+		return m.IsVisibleForClient()
+	case message.FieldIsVisibleForManager:
+		return m.IsVisibleForManager()
+	case message.FieldBody:
+		return m.Body()
+	case message.FieldCheckedAt:
+		return m.CheckedAt()
+	case message.FieldIsBlocked:
+		return m.IsBlocked()
+	case message.FieldReviewRequestedAt:
+		return m.ReviewRequestedAt()
+	case message.FieldAfcResends:
+		return m.AfcResends()
+	case message.FieldAfcResentAt:
+		return m.AfcResentAt()
+	case message.FieldIsService:
+		return m.IsService()
+	case message.FieldIsInternalNote:
+		return m.IsInternalNote()
+	case message.FieldCreatedAt:
+		return m.CreatedAt()
+	}
+	return nil, false
+}
+
+// OldField returns the old value of the field from the database. An error is
+// returned if the mutation operation is not UpdateOne, or the query to the
+// database failed.
+func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+	switch name {
+	case message.FieldChatID:
+		return m.OldChatID(ctx)
+	case message.FieldProblemID:
+		return m.OldProblemID(ctx)
+	case message.FieldAuthorID:
+		return m.OldAuthorID(ctx)
+	case message.FieldInitialRequestID:
+		return m.OldInitialRequestID(ctx)
+	case message.FieldIsVisibleForClient:
+		return m.OldIsVisibleForClient(ctx)
+	case message.FieldIsVisibleForManager:
+		return m.OldIsVisibleForManager(ctx)
+	case message.FieldBody:
+		return m.OldBody(ctx)
+	case message.FieldCheckedAt:
+		return m.OldCheckedAt(ctx)
+	case message.FieldIsBlocked:
+		return m.OldIsBlocked(ctx)
+	case message.FieldReviewRequestedAt:
+		return m.OldReviewRequestedAt(ctx)
+	case message.FieldAfcResends:
+		return m.OldAfcResends(ctx)
+	case message.FieldAfcResentAt:
+		return m.OldAfcResentAt(ctx)
+	case message.FieldIsService:
+		return m.OldIsService(ctx)
+	case message.FieldIsInternalNote:
+		return m.OldIsInternalNote(ctx)
+	case message.FieldCreatedAt:
+		return m.OldCreatedAt(ctx)
+	}
+	return nil, fmt.Errorf("unknown Message field %s", name)
+}
+
+// SetField sets the value of a field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageMutation) SetField(name string, value ent.Value) error {
+	switch name {
+	case message.FieldChatID:
+		v, ok := value.(types.ChatID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetChatID(v)
+		return nil
+	case message.FieldProblemID:
+		v, ok := value.(types.ProblemID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProblemID(v)
+		return nil
+	case message.FieldAuthorID:
+		v, ok := value.(types.UserID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAuthorID(v)
+		return nil
+	case message.FieldInitialRequestID:
+		v, ok := value.(types.RequestID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInitialRequestID(v)
+		return nil
+	case message.FieldIsVisibleForClient:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsVisibleForClient(v)
+		return nil
+	case message.FieldIsVisibleForManager:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsVisibleForManager(v)
+		return nil
+	case message.FieldBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBody(v)
+		return nil
+	case message.FieldCheckedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCheckedAt(v)
+		return nil
+	case message.FieldIsBlocked:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsBlocked(v)
+		return nil
+	case message.FieldReviewRequestedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetReviewRequestedAt(v)
+		return nil
+	case message.FieldAfcResends:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAfcResends(v)
+		return nil
+	case message.FieldAfcResentAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetAfcResentAt(v)
+		return nil
+	case message.FieldIsService:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsService(v)
+		return nil
+	case message.FieldIsInternalNote:
+		v, ok := value.(bool)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetIsInternalNote(v)
+		return nil
+	case message.FieldCreatedAt:
+		v, ok := value.(time.Time)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetCreatedAt(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Message field %s", name)
+}
+
+// AddedFields returns all numeric fields that were incremented/decremented during
+// this mutation.
+func (m *MessageMutation) AddedFields() []string {
+	var fields []string
+	if m.addafc_resends != nil {
+		fields = append(fields, message.FieldAfcResends)
+	}
+	return fields
+}
+
+// AddedField returns the numeric value that was incremented/decremented on a field
+// with the given name. The second boolean return value indicates that this field
+// was not set, or was not defined in the schema.
+func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
+	switch name {
+	case message.FieldAfcResends:
+		return m.AddedAfcResends()
+	}
+	return nil, false
+}
+
+// AddField adds the value to the field with the given name. It returns an error if
+// the field is not defined in the schema, or if the type mismatched the field
+// type.
+func (m *MessageMutation) AddField(name string, value ent.Value) error {
+	switch name {
+	case message.FieldAfcResends:
+		v, ok := value.(int)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.AddAfcResends(v)
+		return nil
+	}
+	return fmt.Errorf("unknown Message numeric field %s", name)
+}
+
+// ClearedFields returns all nullable fields that were cleared during this
+// mutation.
+func (m *MessageMutation) ClearedFields() []string {
+	var fields []string
+	if m.FieldCleared(message.FieldAuthorID) {
+		fields = append(fields, message.FieldAuthorID)
+	}
+	if m.FieldCleared(message.FieldInitialRequestID) {
+		fields = append(fields, message.FieldInitialRequestID)
+	}
+	if m.FieldCleared(message.FieldCheckedAt) {
+		fields = append(fields, message.FieldCheckedAt)
+	}
+	if m.FieldCleared(message.FieldReviewRequestedAt) {
+		fields = append(fields, message.FieldReviewRequestedAt)
+	}
+	if m.FieldCleared(message.FieldAfcResentAt) {
+		fields = append(fields, message.FieldAfcResentAt)
+	}
+	return fields
+}
+
+// FieldCleared returns a boolean indicating if a field with the given name was
+// cleared in this mutation.
+func (m *MessageMutation) FieldCleared(name string) bool {
+	_, ok := m.clearedFields[name]
+	return ok
+}
+
+// ClearField clears the value of the field with the given name. It returns an
+// error if the field is not defined in the schema.
+func (m *MessageMutation) ClearField(name string) error {
+	switch name {
+	case message.FieldAuthorID:
+		m.ClearAuthorID()
+		return nil
+	case message.FieldInitialRequestID:
+		m.ClearInitialRequestID()
+		return nil
+	case message.FieldCheckedAt:
+		m.ClearCheckedAt()
+		return nil
+	case message.FieldReviewRequestedAt:
+		m.ClearReviewRequestedAt()
+		return nil
+	case message.FieldAfcResentAt:
+		m.ClearAfcResentAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Message nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *MessageMutation) ResetField(name string) error {
+	switch name {
+	case message.FieldChatID:
+		m.ResetChatID()
+		return nil
+	case message.FieldProblemID:
+		m.ResetProblemID()
+		return nil
+	case message.FieldAuthorID:
+		m.ResetAuthorID()
+		return nil
+	case message.FieldInitialRequestID:
+		m.ResetInitialRequestID()
+		return nil
+	case message.FieldIsVisibleForClient:
+		m.ResetIsVisibleForClient()
+		return nil
+	case message.FieldIsVisibleForManager:
+		m.ResetIsVisibleForManager()
+		return nil
+	case message.FieldBody:
+		m.ResetBody()
+		return nil
+	case message.FieldCheckedAt:
+		m.ResetCheckedAt()
+		return nil
+	case message.FieldIsBlocked:
+		m.ResetIsBlocked()
+		return nil
+	case message.FieldReviewRequestedAt:
+		m.ResetReviewRequestedAt()
+		return nil
+	case message.FieldAfcResends:
+		m.ResetAfcResends()
+		return nil
+	case message.FieldAfcResentAt:
+		m.ResetAfcResentAt()
+		return nil
+	case message.FieldIsService:
+		m.ResetIsService()
+		return nil
+	case message.FieldIsInternalNote:
+		m.ResetIsInternalNote()
+		return nil
+	case message.FieldCreatedAt:
+		m.ResetCreatedAt()
+		return nil
+	}
+	return fmt.Errorf("unknown Message field %s", name)
+}
+
+// AddedEdges returns all edge names that were set/added in this mutation.
+func (m *MessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.chat != nil {
+		edges = append(edges, message.EdgeChat)
+	}
+	if m.problem != nil {
+		edges = append(edges, message.EdgeProblem)
+	}
+	return edges
+}
+
+// AddedIDs returns all IDs (to other nodes) that were added for the given edge
+// name in this mutation.
+func (m *MessageMutation) AddedIDs(name string) []ent.Value {
+	switch name {
+	case message.EdgeChat:
+		if id := m.chat; id != nil {
+			return []ent.Value{*id}
+		}
+	case message.EdgeProblem:
+		if id := m.problem; id != nil {
+			return []ent.Value{*id}
+		}
+	}
+	return nil
+}
+
+// RemovedEdges returns all edge names that were removed in this mutation.
+func (m *MessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 2)
+	return edges
+}
+
+// RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
+// the given name in this mutation.
+func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
+	return nil
+}
+
+// ClearedEdges returns all edge names that were cleared in this mutation.
+func (m *MessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 2)
+	if m.clearedchat {
+		edges = append(edges, message.EdgeChat)
+	}
+	if m.clearedproblem {
+		edges = append(edges, message.EdgeProblem)
+	}
+	return edges
+}
+
+// EdgeCleared returns a boolean which indicates if the edge with the given name
+// was cleared in this mutation.
+func (m *MessageMutation) EdgeCleared(name string) bool {
+	switch name {
+	case message.EdgeChat:
+		return m.clearedchat
+	case message.EdgeProblem:
+		return m.clearedproblem
+	}
+	return false
+}
+
+// ClearEdge clears the value of the edge with the given name. It returns an error
+// if that edge is not defined in the schema.
+func (m *MessageMutation) ClearEdge(name string) error {
+	switch name {
+	case message.EdgeChat:
+		m.ClearChat()
+		return nil
+	case message.EdgeProblem:
+		m.ClearProblem()
+		return nil
+	}
+	return fmt.Errorf("unknown Message unique edge %s", name)
+}
+
+// ResetEdge resets all changes to the edge with the given name in this mutation.
+// It returns an error if the edge is not defined in the schema.
+func (m *MessageMutation) ResetEdge(name string) error {
+	switch name {
+	case message.EdgeChat:
+		m.ResetChat()
+		return nil
+	case message.EdgeProblem:
+		m.ResetProblem()
+		return nil
+	}
+	return fmt.Errorf("unknown Message edge %s", name)
+}
+
+// ProblemMutation represents an operation that mutates the Problem nodes in the graph.
+type ProblemMutation struct {
+	config
+	op                    Op
+	typ                   string
+	id                    *types.ProblemID
+	manager_id            *types.UserID
+	resolved_at           *time.Time
+	reassign_requested_at *time.Time
+	created_at            *time.Time
+	clearedFields         map[string]struct{}
+	chat                  *types.ChatID
+	clearedchat           bool
+	messages              map[types.MessageID]struct{}
+	removedmessages       map[types.MessageID]struct{}
+	clearedmessages       bool
+	done                  bool
+	oldValue              func(context.Context) (*Problem, error)
+	predicates            []predicate.Problem
+}
+
+var _ ent.Mutation = (*ProblemMutation)(nil)
+
+// problemOption allows management of the mutation configuration using functional options.
+type problemOption func(*ProblemMutation)
+
+// newProblemMutation creates new mutation for the Problem entity.
+func newProblemMutation(c config, op Op, opts ...problemOption) *ProblemMutation {
+	m := &ProblemMutation{
+		config:        c,
+		op:            op,
+		typ:           TypeProblem,
+		clearedFields: make(map[string]struct{}),
+	}
+	for _, opt := range opts {
+		opt(m)
+	}
+	return m
+}
+
+// withProblemID sets the ID field of the mutation.
+func withProblemID(id types.ProblemID) problemOption {
+	return func(m *ProblemMutation) {
+		var (
+			err   error
+			once  sync.Once
+			value *Problem
+		)
+		m.oldValue = func(ctx context.Context) (*Problem, error) {
+			once.Do(func() {
+				if m.done {
+					err = errors.New("querying old values post mutation is not allowed")
+				} else {
+					value, err = m.Client().Problem.Get(ctx, id)
+				}
+			})
+			return value, err
+		}
+		m.id = &id
+	}
+}
+
+// withProblem sets the old Problem of the mutation.
+func withProblem(node *Problem) problemOption {
+	return func(m *ProblemMutation) {
+		m.oldValue = func(context.Context) (*Problem, error) {
+			return node, nil
+		}
+		m.id = &node.ID
+	}
+}
+
+// Client returns a new `ent.Client` from the mutation. If the mutation was
+// executed in a transaction (ent.Tx), a transactional client is returned.
+func (m ProblemMutation) Client() *Client {
+	client := &Client{config: m.config}
+	client.init()
+	return client
+}
+
+// Tx returns an `ent.Tx` for mutations that were executed in transactions;
+// it returns an error otherwise.
+func (m ProblemMutation) Tx() (*Tx, error) {
+	if _, ok := m.driver.(*txDriver); !ok {
+		return nil, errors.New("store: mutation is not running in a transaction")
+	}
+	tx := &Tx{config: m.config}
+	tx.init()
+	return tx, nil
 }
 
-// SetIsVisibleForManager sets the "is_visible_for_manager" field.
-func (m *MessageMutation) SetIsVisibleForManager(b bool) {
-	m.is_visible_for_manager = &b
+// SetID sets the value of the id field. Note that this
+// operation is only accepted on creation of Problem entities.
+func (m *ProblemMutation) SetID(id types.ProblemID) {
+	m.id = &id
 }
 
-// IsVisibleForManager returns the value of the "is_visible_for_manager" field in the mutation.
-func (m *MessageMutation) IsVisibleForManager() (r bool, exists bool) {
-	v := m.is_visible_for_manager
-	if v == nil {
+// ID returns the ID value in the mutation. Note that the ID is only available
+// if it was provided to the builder or after it was returned from the database.
+func (m *ProblemMutation) ID() (id types.ProblemID, exists bool) {
+	if m.id == nil {
 		return
 	}
-	return *v, true
+	return *m.id, true
 }
 
-// OldIsVisibleForManager returns the old "is_visible_for_manager" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
-// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldIsVisibleForManager(ctx context.Context) (v bool, err error) {
-	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsVisibleForManager is only allowed on UpdateOne operations")
-	}
-	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsVisibleForManager requires an ID field in the mutation")
-	}
-	oldValue, err := m.oldValue(ctx)
-	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsVisibleForManager: %w", err)
+// IDs queries the database and returns the entity ids that match the mutation's predicate.
+// That means, if the mutation is applied within a transaction with an isolation level such
+// as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
+// or updated by the mutation.
+func (m *ProblemMutation) IDs(ctx context.Context) ([]types.ProblemID, error) {
+	switch {
+	case m.op.Is(OpUpdateOne | OpDeleteOne):
+		id, exists := m.ID()
+		if exists {
+			return []types.ProblemID{id}, nil
+		}
+		fallthrough
+	case m.op.Is(OpUpdate | OpDelete):
+		return m.Client().Problem.Query().Where(m.predicates...).IDs(ctx)
+	default:
+		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
-	return oldValue.IsVisibleForManager, nil
-}
-
-// ResetIsVisibleForManager resets all changes to the "is_visible_for_manager" field.
-func (m *MessageMutation) ResetIsVisibleForManager() {
-	m.is_visible_for_manager = nil
 }
 
-// SetBody sets the "body" field.
-func (m *MessageMutation) SetBody(s string) {
-	m.body = &s
+// SetChatID sets the "chat_id" field.
+func (m *ProblemMutation) SetChatID(ti types.ChatID) {
+	m.chat = &ti
 }
 
-// Body returns the value of the "body" field in the mutation.
-func (m *MessageMutation) Body() (r string, exists bool) {
-	v := m.body
+// ChatID returns the value of the "chat_id" field in the mutation.
+func (m *ProblemMutation) ChatID() (r types.ChatID, exists bool) {
+	v := m.chat
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldBody returns the old "body" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldChatID returns the old "chat_id" field's value of the Problem entity.
+// If the Problem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldBody(ctx context.Context) (v string, err error) {
+func (m *ProblemMutation) OldChatID(ctx context.Context) (v types.ChatID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldBody is only allowed on UpdateOne operations")
+		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldBody requires an ID field in the mutation")
+		return v, errors.New("OldChatID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldBody: %w", err)
+		return v, fmt.Errorf("querying old value for OldChatID: %w", err)
 	}
-	return oldValue.Body, nil
+	return oldValue.ChatID, nil
 }
 
-// ResetBody resets all changes to the "body" field.
-func (m *MessageMutation) ResetBody() {
-	m.body = nil
+// ResetChatID resets all changes to the "chat_id" field.
+func (m *ProblemMutation) ResetChatID() {
+	m.chat = nil
 }
 
-// SetCheckedAt sets the "checked_at" field.
-func (m *MessageMutation) SetCheckedAt(t time.Time) {
-	m.checked_at = &t
+// SetManagerID sets the "manager_id" field.
+func (m *ProblemMutation) SetManagerID(ti types.UserID) {
+	m.manager_id = &ti
 }
 
-// CheckedAt returns the value of the "checked_at" field in the mutation.
-func (m *MessageMutation) CheckedAt() (r time.Time, exists bool) {
-	v := m.checked_at
+// ManagerID returns the value of the "manager_id" field in the mutation.
+func (m *ProblemMutation) ManagerID() (r types.UserID, exists bool) {
+	v := m.manager_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCheckedAt returns the old "checked_at" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldManagerID returns the old "manager_id" field's value of the Problem entity.
+// If the Problem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldCheckedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ProblemMutation) OldManagerID(ctx context.Context) (v types.UserID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCheckedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldManagerID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCheckedAt requires an ID field in the mutation")
+		return v, errors.New("OldManagerID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCheckedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldManagerID: %w", err)
 	}
-	return oldValue.CheckedAt, nil
+	return oldValue.ManagerID, nil
 }
 
-// ClearCheckedAt clears the value of the "checked_at" field.
-func (m *MessageMutation) ClearCheckedAt() {
-	m.checked_at = nil
-	m.clearedFields[message.FieldCheckedAt] = struct{}{}
+// ClearManagerID clears the value of the "manager_id" field.
+func (m *ProblemMutation) ClearManagerID() {
+	m.manager_id = nil
+	m.clearedFields[problem.FieldManagerID] = struct{}{}
 }
 
-// CheckedAtCleared returns if the "checked_at" field was cleared in this mutation.
-func (m *MessageMutation) CheckedAtCleared() bool {
-	_, ok := m.clearedFields[message.FieldCheckedAt]
+// ManagerIDCleared returns if the "manager_id" field was cleared in this mutation.
+func (m *ProblemMutation) ManagerIDCleared() bool {
+	_, ok := m.clearedFields[problem.FieldManagerID]
 	return ok
 }
 
-// ResetCheckedAt resets all changes to the "checked_at" field.
-func (m *MessageMutation) ResetCheckedAt() {
-	m.checked_at = nil
-	delete(m.clearedFields, message.FieldCheckedAt)
+// ResetManagerID resets all changes to the "manager_id" field.
+func (m *ProblemMutation) ResetManagerID() {
+	m.manager_id = nil
+	delete(m.clearedFields, problem.FieldManagerID)
 }
 
-// SetIsBlocked sets the "is_blocked" field.
-func (m *MessageMutation) SetIsBlocked(b bool) {
-	m.is_blocked = &b
+// SetResolvedAt sets the "resolved_at" field.
+func (m *ProblemMutation) SetResolvedAt(t time.Time) {
+	m.resolved_at = &t
 }
 
-// IsBlocked returns the value of the "is_blocked" field in the mutation.
-func (m *MessageMutation) IsBlocked() (r bool, exists bool) {
-	v := m.is_blocked
+// ResolvedAt returns the value of the "resolved_at" field in the mutation.
+func (m *ProblemMutation) ResolvedAt() (r time.Time, exists bool) {
+	v := m.resolved_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsBlocked returns the old "is_blocked" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldResolvedAt returns the old "resolved_at" field's value of the Problem entity.
+// If the Problem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldIsBlocked(ctx context.Context) (v bool, err error) {
+func (m *ProblemMutation) OldResolvedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsBlocked is only allowed on UpdateOne operations")
+		return v, errors.New("OldResolvedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsBlocked requires an ID field in the mutation")
+		return v, errors.New("OldResolvedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsBlocked: %w", err)
+		return v, fmt.Errorf("querying old value for OldResolvedAt: %w", err)
 	}
-	return oldValue.IsBlocked, nil
+	return oldValue.ResolvedAt, nil
 }
 
-// ResetIsBlocked resets all changes to the "is_blocked" field.
-func (m *MessageMutation) ResetIsBlocked() {
-	m.is_blocked = nil
+// ClearResolvedAt clears the value of the "resolved_at" field.
+func (m *ProblemMutation) ClearResolvedAt() {
+	m.resolved_at = nil
+	m.clearedFields[problem.FieldResolvedAt] = struct{}{}
 }
 
-// SetIsService sets the "is_service" field.
-func (m *MessageMutation) SetIsService(b bool) {
-	m.is_service = &b
+// ResolvedAtCleared returns if the "resolved_at" field was cleared in this mutation.
+func (m *ProblemMutation) ResolvedAtCleared() bool {
+	_, ok := m.clearedFields[problem.FieldResolvedAt]
+	return ok
 }
 
-// IsService returns the value of the "is_service" field in the mutation.
-func (m *MessageMutation) IsService() (r bool, exists bool) {
-	v := m.is_service
+// ResetResolvedAt resets all changes to the "resolved_at" field.
+func (m *ProblemMutation) ResetResolvedAt() {
+	m.resolved_at = nil
+	delete(m.clearedFields, problem.FieldResolvedAt)
+}
+
+// SetReassignRequestedAt sets the "reassign_requested_at" field.
+func (m *ProblemMutation) SetReassignRequestedAt(t time.Time) {
+	m.reassign_requested_at = &t
+}
+
+// ReassignRequestedAt returns the value of the "reassign_requested_at" field in the mutation.
+func (m *ProblemMutation) ReassignRequestedAt() (r time.Time, exists bool) {
+	v := m.reassign_requested_at
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldIsService returns the old "is_service" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldReassignRequestedAt returns the old "reassign_requested_at" field's value of the Problem entity.
+// If the Problem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldIsService(ctx context.Context) (v bool, err error) {
+func (m *ProblemMutation) OldReassignRequestedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldIsService is only allowed on UpdateOne operations")
+		return v, errors.New("OldReassignRequestedAt is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldIsService requires an ID field in the mutation")
+		return v, errors.New("OldReassignRequestedAt requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldIsService: %w", err)
+		return v, fmt.Errorf("querying old value for OldReassignRequestedAt: %w", err)
 	}
-	return oldValue.IsService, nil
+	return oldValue.ReassignRequestedAt, nil
 }
 
-// ResetIsService resets all changes to the "is_service" field.
-func (m *MessageMutation) ResetIsService() {
-	m.is_service = nil
+// ClearReassignRequestedAt clears the value of the "reassign_requested_at" field.
+func (m *ProblemMutation) ClearReassignRequestedAt() {
+	m.reassign_requested_at = nil
+	m.clearedFields[problem.FieldReassignRequestedAt] = struct{}{}
+}
+
+// ReassignRequestedAtCleared returns if the "reassign_requested_at" field was cleared in this mutation.
+func (m *ProblemMutation) ReassignRequestedAtCleared() bool {
+	_, ok := m.clearedFields[problem.FieldReassignRequestedAt]
+	return ok
+}
+
+// ResetReassignRequestedAt resets all changes to the "reassign_requested_at" field.
+func (m *ProblemMutation) ResetReassignRequestedAt() {
+	m.reassign_requested_at = nil
+	delete(m.clearedFields, problem.FieldReassignRequestedAt)
 }
 
 // SetCreatedAt sets the "created_at" field.
-func (m *MessageMutation) SetCreatedAt(t time.Time) {
+func (m *ProblemMutation) SetCreatedAt(t time.Time) {
 	m.created_at = &t
 }
 
 // CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *MessageMutation) CreatedAt() (r time.Time, exists bool) {
+func (m *ProblemMutation) CreatedAt() (r time.Time, exists bool) {
 	v := m.created_at
 	if v == nil {
 		return
@@ -2272,10 +4111,10 @@ func (m *MessageMutation) CreatedAt() (r time.Time, exists bool) {
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Message entity.
-// If the Message object wasn't provided to the builder, the object is fetched from the database.
+// OldCreatedAt returns the old "created_at" field's value of the Problem entity.
+// If the Problem object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *MessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ProblemMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
@@ -2290,24 +4129,24 @@ func (m *MessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err er
 }
 
 // ResetCreatedAt resets all changes to the "created_at" field.
-func (m *MessageMutation) ResetCreatedAt() {
+func (m *ProblemMutation) ResetCreatedAt() {
 	m.created_at = nil
 }
 
 // ClearChat clears the "chat" edge to the Chat entity.
-func (m *MessageMutation) ClearChat() {
+func (m *ProblemMutation) ClearChat() {
 	m.clearedchat = true
 }
 
 // ChatCleared reports if the "chat" edge to the Chat entity was cleared.
-func (m *MessageMutation) ChatCleared() bool {
+func (m *ProblemMutation) ChatCleared() bool {
 	return m.clearedchat
 }
 
 // ChatIDs returns the "chat" edge IDs in the mutation.
 // Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
 // ChatID instead. It exists only for internal usage by the builders.
-func (m *MessageMutation) ChatIDs() (ids []types.ChatID) {
+func (m *ProblemMutation) ChatIDs() (ids []types.ChatID) {
 	if id := m.chat; id != nil {
 		ids = append(ids, *id)
 	}
@@ -2315,46 +4154,74 @@ func (m *MessageMutation) ChatIDs() (ids []types.ChatID) {
 }
 
 // ResetChat resets all changes to the "chat" edge.
-func (m *MessageMutation) ResetChat() {
+func (m *ProblemMutation) ResetChat() {
 	m.chat = nil
 	m.clearedchat = false
 }
 
-// ClearProblem clears the "problem" edge to the Problem entity.
-func (m *MessageMutation) ClearProblem() {
-	m.clearedproblem = true
+// AddMessageIDs adds the "messages" edge to the Message entity by ids.
+func (m *ProblemMutation) AddMessageIDs(ids ...types.MessageID) {
+	if m.messages == nil {
+		m.messages = make(map[types.MessageID]struct{})
+	}
+	for i := range ids {
+		m.messages[ids[i]] = struct{}{}
+	}
 }
 
-// ProblemCleared reports if the "problem" edge to the Problem entity was cleared.
-func (m *MessageMutation) ProblemCleared() bool {
-	return m.clearedproblem
+// ClearMessages clears the "messages" edge to the Message entity.
+func (m *ProblemMutation) ClearMessages() {
+	m.clearedmessages = true
+}
+
+// MessagesCleared reports if the "messages" edge to the Message entity was cleared.
+func (m *ProblemMutation) MessagesCleared() bool {
+	return m.clearedmessages
+}
+
+// RemoveMessageIDs removes the "messages" edge to the Message entity by IDs.
+func (m *ProblemMutation) RemoveMessageIDs(ids ...types.MessageID) {
+	if m.removedmessages == nil {
+		m.removedmessages = make(map[types.MessageID]struct{})
+	}
+	for i := range ids {
+		delete(m.messages, ids[i])
+		m.removedmessages[ids[i]] = struct{}{}
+	}
+}
+
+// RemovedMessages returns the removed IDs of the "messages" edge to the Message entity.
+func (m *ProblemMutation) RemovedMessagesIDs() (ids []types.MessageID) {
+	for id := range m.removedmessages {
+		ids = append(ids, id)
+	}
+	return
 }
 
-// ProblemIDs returns the "problem" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ProblemID instead. It exists only for internal usage by the builders.
-func (m *MessageMutation) ProblemIDs() (ids []types.ProblemID) {
-	if id := m.problem; id != nil {
-		ids = append(ids, *id)
+// MessagesIDs returns the "messages" edge IDs in the mutation.
+func (m *ProblemMutation) MessagesIDs() (ids []types.MessageID) {
+	for id := range m.messages {
+		ids = append(ids, id)
 	}
 	return
 }
 
-// ResetProblem resets all changes to the "problem" edge.
-func (m *MessageMutation) ResetProblem() {
-	m.problem = nil
-	m.clearedproblem = false
+// ResetMessages resets all changes to the "messages" edge.
+func (m *ProblemMutation) ResetMessages() {
+	m.messages = nil
+	m.clearedmessages = false
+	m.removedmessages = nil
 }
 
-// Where appends a list predicates to the MessageMutation builder.
-func (m *MessageMutation) Where(ps ...predicate.Message) {
+// Where appends a list predicates to the ProblemMutation builder.
+func (m *ProblemMutation) Where(ps ...predicate.Problem) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the MessageMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ProblemMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Message, len(ps))
+func (m *ProblemMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.Problem, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -2362,57 +4229,39 @@ func (m *MessageMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *MessageMutation) Op() Op {
+func (m *ProblemMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *MessageMutation) SetOp(op Op) {
+func (m *ProblemMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Message).
-func (m *MessageMutation) Type() string {
+// Type returns the node type of this mutation (Problem).
+func (m *ProblemMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *MessageMutation) Fields() []string {
-	fields := make([]string, 0, 11)
+func (m *ProblemMutation) Fields() []string {
+	fields := make([]string, 0, 5)
 	if m.chat != nil {
-		fields = append(fields, message.FieldChatID)
-	}
-	if m.problem != nil {
-		fields = append(fields, message.FieldProblemID)
-	}
-	if m.author_id != nil {
-		fields = append(fields, message.FieldAuthorID)
-	}
-	if m.initial_request_id != nil {
-		fields = append(fields, message.FieldInitialRequestID)
-	}
-	if m.is_visible_for_client != nil {
-		fields = append(fields, message.FieldIsVisibleForClient)
-	}
-	if m.is_visible_for_manager != nil {
-		fields = append(fields, message.FieldIsVisibleForManager)
-	}
-	if m.body != nil {
-		fields = append(fields, message.FieldBody)
+		fields = append(fields, problem.FieldChatID)
 	}
-	if m.checked_at != nil {
-		fields = append(fields, message.FieldCheckedAt)
+	if m.manager_id != nil {
+		fields = append(fields, problem.FieldManagerID)
 	}
-	if m.is_blocked != nil {
-		fields = append(fields, message.FieldIsBlocked)
+	if m.resolved_at != nil {
+		fields = append(fields, problem.FieldResolvedAt)
 	}
-	if m.is_service != nil {
-		fields = append(fields, message.FieldIsService)
+	if m.reassign_requested_at != nil {
+		fields = append(fields, problem.FieldReassignRequestedAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, message.FieldCreatedAt)
+		fields = append(fields, problem.FieldCreatedAt)
 	}
 	return fields
 }
@@ -2420,29 +4269,17 @@ func (m *MessageMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *MessageMutation) Field(name string) (ent.Value, bool) {
+func (m *ProblemMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case message.FieldChatID:
+	case problem.FieldChatID:
 		return m.ChatID()
-	case message.FieldProblemID:
-		return m.ProblemID()
-	case message.FieldAuthorID:
-		return m.AuthorID()
-	case message.FieldInitialRequestID:
-		return m.InitialRequestID()
-	case message.FieldIsVisibleForClient:
-		return m.IsVisibleForClient()
-	case message.FieldIsVisibleForManager:
-		return m.IsVisibleForManager()
-	case message.FieldBody:
-		return m.Body()
-	case message.FieldCheckedAt:
-		return m.CheckedAt()
-	case message.FieldIsBlocked:
-		return m.IsBlocked()
-	case message.FieldIsService:
-		return m.IsService()
-	case message.FieldCreatedAt:
+	case problem.FieldManagerID:
+		return m.ManagerID()
+	case problem.FieldResolvedAt:
+		return m.ResolvedAt()
+	case problem.FieldReassignRequestedAt:
+		return m.ReassignRequestedAt()
+	case problem.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -2451,110 +4288,56 @@ func (m *MessageMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *MessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ProblemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case message.FieldChatID:
+	case problem.FieldChatID:
 		return m.OldChatID(ctx)
-	case message.FieldProblemID:
-		return m.OldProblemID(ctx)
-	case message.FieldAuthorID:
-		return m.OldAuthorID(ctx)
-	case message.FieldInitialRequestID:
-		return m.OldInitialRequestID(ctx)
-	case message.FieldIsVisibleForClient:
-		return m.OldIsVisibleForClient(ctx)
-	case message.FieldIsVisibleForManager:
-		return m.OldIsVisibleForManager(ctx)
-	case message.FieldBody:
-		return m.OldBody(ctx)
-	case message.FieldCheckedAt:
-		return m.OldCheckedAt(ctx)
-	case message.FieldIsBlocked:
-		return m.OldIsBlocked(ctx)
-	case message.FieldIsService:
-		return m.OldIsService(ctx)
-	case message.FieldCreatedAt:
+	case problem.FieldManagerID:
+		return m.OldManagerID(ctx)
+	case problem.FieldResolvedAt:
+		return m.OldResolvedAt(ctx)
+	case problem.FieldReassignRequestedAt:
+		return m.OldReassignRequestedAt(ctx)
+	case problem.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Message field %s", name)
+	return nil, fmt.Errorf("unknown Problem field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageMutation) SetField(name string, value ent.Value) error {
+func (m *ProblemMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case message.FieldChatID:
+	case problem.FieldChatID:
 		v, ok := value.(types.ChatID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetChatID(v)
 		return nil
-	case message.FieldProblemID:
-		v, ok := value.(types.ProblemID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetProblemID(v)
-		return nil
-	case message.FieldAuthorID:
+	case problem.FieldManagerID:
 		v, ok := value.(types.UserID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetAuthorID(v)
-		return nil
-	case message.FieldInitialRequestID:
-		v, ok := value.(types.RequestID)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetInitialRequestID(v)
-		return nil
-	case message.FieldIsVisibleForClient:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsVisibleForClient(v)
-		return nil
-	case message.FieldIsVisibleForManager:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsVisibleForManager(v)
-		return nil
-	case message.FieldBody:
-		v, ok := value.(string)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetBody(v)
+		m.SetManagerID(v)
 		return nil
-	case message.FieldCheckedAt:
+	case problem.FieldResolvedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetCheckedAt(v)
-		return nil
-	case message.FieldIsBlocked:
-		v, ok := value.(bool)
-		if !ok {
-			return fmt.Errorf("unexpected type %T for field %s", value, name)
-		}
-		m.SetIsBlocked(v)
+		m.SetResolvedAt(v)
 		return nil
-	case message.FieldIsService:
-		v, ok := value.(bool)
+	case problem.FieldReassignRequestedAt:
+		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetIsService(v)
+		m.SetReassignRequestedAt(v)
 		return nil
-	case message.FieldCreatedAt:
+	case problem.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -2562,235 +4345,226 @@ func (m *MessageMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Message field %s", name)
+	return fmt.Errorf("unknown Problem field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *MessageMutation) AddedFields() []string {
+func (m *ProblemMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *MessageMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ProblemMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *MessageMutation) AddField(name string, value ent.Value) error {
+func (m *ProblemMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Message numeric field %s", name)
+	return fmt.Errorf("unknown Problem numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *MessageMutation) ClearedFields() []string {
+func (m *ProblemMutation) ClearedFields() []string {
 	var fields []string
-	if m.FieldCleared(message.FieldAuthorID) {
-		fields = append(fields, message.FieldAuthorID)
+	if m.FieldCleared(problem.FieldManagerID) {
+		fields = append(fields, problem.FieldManagerID)
 	}
-	if m.FieldCleared(message.FieldInitialRequestID) {
-		fields = append(fields, message.FieldInitialRequestID)
+	if m.FieldCleared(problem.FieldResolvedAt) {
+		fields = append(fields, problem.FieldResolvedAt)
 	}
-	if m.FieldCleared(message.FieldCheckedAt) {
-		fields = append(fields, message.FieldCheckedAt)
+	if m.FieldCleared(problem.FieldReassignRequestedAt) {
+		fields = append(fields, problem.FieldReassignRequestedAt)
 	}
 	return fields
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *MessageMutation) FieldCleared(name string) bool {
+func (m *ProblemMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *MessageMutation) ClearField(name string) error {
+func (m *ProblemMutation) ClearField(name string) error {
 	switch name {
-	case message.FieldAuthorID:
-		m.ClearAuthorID()
+	case problem.FieldManagerID:
+		m.ClearManagerID()
 		return nil
-	case message.FieldInitialRequestID:
-		m.ClearInitialRequestID()
+	case problem.FieldResolvedAt:
+		m.ClearResolvedAt()
 		return nil
-	case message.FieldCheckedAt:
-		m.ClearCheckedAt()
+	case problem.FieldReassignRequestedAt:
+		m.ClearReassignRequestedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Message nullable field %s", name)
-}
-
-// ResetField resets all changes in the mutation for the field with the given name.
-// It returns an error if the field is not defined in the schema.
-func (m *MessageMutation) ResetField(name string) error {
-	switch name {
-	case message.FieldChatID:
-		m.ResetChatID()
-		return nil
-	case message.FieldProblemID:
-		m.ResetProblemID()
-		return nil
-	case message.FieldAuthorID:
-		m.ResetAuthorID()
-		return nil
-	case message.FieldInitialRequestID:
-		m.ResetInitialRequestID()
-		return nil
-	case message.FieldIsVisibleForClient:
-		m.ResetIsVisibleForClient()
-		return nil
-	case message.FieldIsVisibleForManager:
-		m.ResetIsVisibleForManager()
-		return nil
-	case message.FieldBody:
-		m.ResetBody()
+	return fmt.Errorf("unknown Problem nullable field %s", name)
+}
+
+// ResetField resets all changes in the mutation for the field with the given name.
+// It returns an error if the field is not defined in the schema.
+func (m *ProblemMutation) ResetField(name string) error {
+	switch name {
+	case problem.FieldChatID:
+		m.ResetChatID()
 		return nil
-	case message.FieldCheckedAt:
-		m.ResetCheckedAt()
+	case problem.FieldManagerID:
+		m.ResetManagerID()
 		return nil
-	case message.FieldIsBlocked:
-		m.ResetIsBlocked()
+	case problem.FieldResolvedAt:
+		m.ResetResolvedAt()
 		return nil
-	case message.FieldIsService:
-		m.ResetIsService()
+	case problem.FieldReassignRequestedAt:
+		m.ResetReassignRequestedAt()
 		return nil
-	case message.FieldCreatedAt:
+	case problem.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Message field %s", name)
+	return fmt.Errorf("unknown Problem field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *MessageMutation) AddedEdges() []string {
+func (m *ProblemMutation) AddedEdges() []string {
 	edges := make([]string, 0, 2)
 	if m.chat != nil {
-		edges = append(edges, message.EdgeChat)
+		edges = append(edges, problem.EdgeChat)
 	}
-	if m.problem != nil {
-		edges = append(edges, message.EdgeProblem)
+	if m.messages != nil {
+		edges = append(edges, problem.EdgeMessages)
 	}
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *MessageMutation) AddedIDs(name string) []ent.Value {
+func (m *ProblemMutation) AddedIDs(name string) []ent.Value {
 	switch name {
-	case message.EdgeChat:
+	case problem.EdgeChat:
 		if id := m.chat; id != nil {
 			return []ent.Value{*id}
 		}
-	case message.EdgeProblem:
-		if id := m.problem; id != nil {
-			return []ent.Value{*id}
+	case problem.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.messages))
+		for id := range m.messages {
+			ids = append(ids, id)
 		}
+		return ids
 	}
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *MessageMutation) RemovedEdges() []string {
+func (m *ProblemMutation) RemovedEdges() []string {
 	edges := make([]string, 0, 2)
+	if m.removedmessages != nil {
+		edges = append(edges, problem.EdgeMessages)
+	}
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *MessageMutation) RemovedIDs(name string) []ent.Value {
+func (m *ProblemMutation) RemovedIDs(name string) []ent.Value {
+	switch name {
+	case problem.EdgeMessages:
+		ids := make([]ent.Value, 0, len(m.removedmessages))
+		for id := range m.removedmessages {
+			ids = append(ids, id)
+		}
+		return ids
+	}
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *MessageMutation) ClearedEdges() []string {
+func (m *ProblemMutation) ClearedEdges() []string {
 	edges := make([]string, 0, 2)
 	if m.clearedchat {
-		edges = append(edges, message.EdgeChat)
+		edges = append(edges, problem.EdgeChat)
 	}
-	if m.clearedproblem {
-		edges = append(edges, message.EdgeProblem)
+	if m.clearedmessages {
+		edges = append(edges, problem.EdgeMessages)
 	}
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *MessageMutation) EdgeCleared(name string) bool {
+func (m *ProblemMutation) EdgeCleared(name string) bool {
 	switch name {
-	case message.EdgeChat:
+	case problem.EdgeChat:
 		return m.clearedchat
-	case message.EdgeProblem:
-		return m.clearedproblem
+	case problem.EdgeMessages:
+		return m.clearedmessages
 	}
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *MessageMutation) ClearEdge(name string) error {
+func (m *ProblemMutation) ClearEdge(name string) error {
 	switch name {
-	case message.EdgeChat:
+	case problem.EdgeChat:
 		m.ClearChat()
 		return nil
-	case message.EdgeProblem:
-		m.ClearProblem()
-		return nil
 	}
-	return fmt.Errorf("unknown Message unique edge %s", name)
+	return fmt.Errorf("unknown Problem unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *MessageMutation) ResetEdge(name string) error {
+func (m *ProblemMutation) ResetEdge(name string) error {
 	switch name {
-	case message.EdgeChat:
+	case problem.EdgeChat:
 		m.ResetChat()
 		return nil
-	case message.EdgeProblem:
-		m.ResetProblem()
+	case problem.EdgeMessages:
+		m.ResetMessages()
 		return nil
 	}
-	return fmt.Errorf("unknown Message edge %s", name)
+	return fmt.Errorf("unknown Problem edge %s", name)
 }
 
-// ProblemMutation represents an operation that mutates the Problem nodes in the graph.
-type ProblemMutation struct {
+// ScheduledMessageMutation represents an operation that mutates the ScheduledMessage nodes in the graph.
+type ScheduledMessageMutation struct {
 	config
-	op              Op
-	typ             string
-	id              *types.ProblemID
-	manager_id      *types.UserID
-	resolved_at     *time.Time
-	created_at      *time.Time
-	clearedFields   map[string]struct{}
-	chat            *types.ChatID
-	clearedchat     bool
-	messages        map[types.MessageID]struct{}
-	removedmessages map[types.MessageID]struct{}
-	clearedmessages bool
-	done            bool
-	oldValue        func(context.Context) (*Problem, error)
-	predicates      []predicate.Problem
-}
-
-var _ ent.Mutation = (*ProblemMutation)(nil)
-
-// problemOption allows management of the mutation configuration using functional options.
-type problemOption func(*ProblemMutation)
-
-// newProblemMutation creates new mutation for the Problem entity.
-func newProblemMutation(c config, op Op, opts ...problemOption) *ProblemMutation {
-	m := &ProblemMutation{
+	op                 Op
+	typ                string
+	id                 *types.ScheduledMessageID
+	chat_id            *types.ChatID
+	problem_id         *types.ProblemID
+	manager_id         *types.UserID
+	initial_request_id *types.RequestID
+	body               *string
+	deliver_at         *time.Time
+	created_at         *time.Time
+	clearedFields      map[string]struct{}
+	done               bool
+	oldValue           func(context.Context) (*ScheduledMessage, error)
+	predicates         []predicate.ScheduledMessage
+}
+
+var _ ent.Mutation = (*ScheduledMessageMutation)(nil)
+
+// scheduledmessageOption allows management of the mutation configuration using functional options.
+type scheduledmessageOption func(*ScheduledMessageMutation)
+
+// newScheduledMessageMutation creates new mutation for the ScheduledMessage entity.
+func newScheduledMessageMutation(c config, op Op, opts ...scheduledmessageOption) *ScheduledMessageMutation {
+	m := &ScheduledMessageMutation{
 		config:        c,
 		op:            op,
-		typ:           TypeProblem,
+		typ:           TypeScheduledMessage,
 		clearedFields: make(map[string]struct{}),
 	}
 	for _, opt := range opts {
@@ -2799,20 +4573,20 @@ func newProblemMutation(c config, op Op, opts ...problemOption) *ProblemMutation
 	return m
 }
 
-// withProblemID sets the ID field of the mutation.
-func withProblemID(id types.ProblemID) problemOption {
-	return func(m *ProblemMutation) {
+// withScheduledMessageID sets the ID field of the mutation.
+func withScheduledMessageID(id types.ScheduledMessageID) scheduledmessageOption {
+	return func(m *ScheduledMessageMutation) {
 		var (
 			err   error
 			once  sync.Once
-			value *Problem
+			value *ScheduledMessage
 		)
-		m.oldValue = func(ctx context.Context) (*Problem, error) {
+		m.oldValue = func(ctx context.Context) (*ScheduledMessage, error) {
 			once.Do(func() {
 				if m.done {
 					err = errors.New("querying old values post mutation is not allowed")
 				} else {
-					value, err = m.Client().Problem.Get(ctx, id)
+					value, err = m.Client().ScheduledMessage.Get(ctx, id)
 				}
 			})
 			return value, err
@@ -2821,10 +4595,10 @@ func withProblemID(id types.ProblemID) problemOption {
 	}
 }
 
-// withProblem sets the old Problem of the mutation.
-func withProblem(node *Problem) problemOption {
-	return func(m *ProblemMutation) {
-		m.oldValue = func(context.Context) (*Problem, error) {
+// withScheduledMessage sets the old ScheduledMessage of the mutation.
+func withScheduledMessage(node *ScheduledMessage) scheduledmessageOption {
+	return func(m *ScheduledMessageMutation) {
+		m.oldValue = func(context.Context) (*ScheduledMessage, error) {
 			return node, nil
 		}
 		m.id = &node.ID
@@ -2833,7 +4607,7 @@ func withProblem(node *Problem) problemOption {
 
 // Client returns a new `ent.Client` from the mutation. If the mutation was
 // executed in a transaction (ent.Tx), a transactional client is returned.
-func (m ProblemMutation) Client() *Client {
+func (m ScheduledMessageMutation) Client() *Client {
 	client := &Client{config: m.config}
 	client.init()
 	return client
@@ -2841,7 +4615,7 @@ func (m ProblemMutation) Client() *Client {
 
 // Tx returns an `ent.Tx` for mutations that were executed in transactions;
 // it returns an error otherwise.
-func (m ProblemMutation) Tx() (*Tx, error) {
+func (m ScheduledMessageMutation) Tx() (*Tx, error) {
 	if _, ok := m.driver.(*txDriver); !ok {
 		return nil, errors.New("store: mutation is not running in a transaction")
 	}
@@ -2851,14 +4625,14 @@ func (m ProblemMutation) Tx() (*Tx, error) {
 }
 
 // SetID sets the value of the id field. Note that this
-// operation is only accepted on creation of Problem entities.
-func (m *ProblemMutation) SetID(id types.ProblemID) {
+// operation is only accepted on creation of ScheduledMessage entities.
+func (m *ScheduledMessageMutation) SetID(id types.ScheduledMessageID) {
 	m.id = &id
 }
 
 // ID returns the ID value in the mutation. Note that the ID is only available
 // if it was provided to the builder or after it was returned from the database.
-func (m *ProblemMutation) ID() (id types.ProblemID, exists bool) {
+func (m *ScheduledMessageMutation) ID() (id types.ScheduledMessageID, exists bool) {
 	if m.id == nil {
 		return
 	}
@@ -2869,39 +4643,39 @@ func (m *ProblemMutation) ID() (id types.ProblemID, exists bool) {
 // That means, if the mutation is applied within a transaction with an isolation level such
 // as sql.LevelSerializable, the returned ids match the ids of the rows that will be updated
 // or updated by the mutation.
-func (m *ProblemMutation) IDs(ctx context.Context) ([]types.ProblemID, error) {
+func (m *ScheduledMessageMutation) IDs(ctx context.Context) ([]types.ScheduledMessageID, error) {
 	switch {
 	case m.op.Is(OpUpdateOne | OpDeleteOne):
 		id, exists := m.ID()
 		if exists {
-			return []types.ProblemID{id}, nil
+			return []types.ScheduledMessageID{id}, nil
 		}
 		fallthrough
 	case m.op.Is(OpUpdate | OpDelete):
-		return m.Client().Problem.Query().Where(m.predicates...).IDs(ctx)
+		return m.Client().ScheduledMessage.Query().Where(m.predicates...).IDs(ctx)
 	default:
 		return nil, fmt.Errorf("IDs is not allowed on %s operations", m.op)
 	}
 }
 
 // SetChatID sets the "chat_id" field.
-func (m *ProblemMutation) SetChatID(ti types.ChatID) {
-	m.chat = &ti
+func (m *ScheduledMessageMutation) SetChatID(ti types.ChatID) {
+	m.chat_id = &ti
 }
 
 // ChatID returns the value of the "chat_id" field in the mutation.
-func (m *ProblemMutation) ChatID() (r types.ChatID, exists bool) {
-	v := m.chat
+func (m *ScheduledMessageMutation) ChatID() (r types.ChatID, exists bool) {
+	v := m.chat_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldChatID returns the old "chat_id" field's value of the Problem entity.
-// If the Problem object wasn't provided to the builder, the object is fetched from the database.
+// OldChatID returns the old "chat_id" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProblemMutation) OldChatID(ctx context.Context) (v types.ChatID, err error) {
+func (m *ScheduledMessageMutation) OldChatID(ctx context.Context) (v types.ChatID, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldChatID is only allowed on UpdateOne operations")
 	}
@@ -2916,17 +4690,53 @@ func (m *ProblemMutation) OldChatID(ctx context.Context) (v types.ChatID, err er
 }
 
 // ResetChatID resets all changes to the "chat_id" field.
-func (m *ProblemMutation) ResetChatID() {
-	m.chat = nil
+func (m *ScheduledMessageMutation) ResetChatID() {
+	m.chat_id = nil
+}
+
+// SetProblemID sets the "problem_id" field.
+func (m *ScheduledMessageMutation) SetProblemID(ti types.ProblemID) {
+	m.problem_id = &ti
+}
+
+// ProblemID returns the value of the "problem_id" field in the mutation.
+func (m *ScheduledMessageMutation) ProblemID() (r types.ProblemID, exists bool) {
+	v := m.problem_id
+	if v == nil {
+		return
+	}
+	return *v, true
+}
+
+// OldProblemID returns the old "problem_id" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldProblemID(ctx context.Context) (v types.ProblemID, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldProblemID is only allowed on UpdateOne operations")
+	}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldProblemID requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldProblemID: %w", err)
+	}
+	return oldValue.ProblemID, nil
+}
+
+// ResetProblemID resets all changes to the "problem_id" field.
+func (m *ScheduledMessageMutation) ResetProblemID() {
+	m.problem_id = nil
 }
 
 // SetManagerID sets the "manager_id" field.
-func (m *ProblemMutation) SetManagerID(ti types.UserID) {
+func (m *ScheduledMessageMutation) SetManagerID(ti types.UserID) {
 	m.manager_id = &ti
 }
 
 // ManagerID returns the value of the "manager_id" field in the mutation.
-func (m *ProblemMutation) ManagerID() (r types.UserID, exists bool) {
+func (m *ScheduledMessageMutation) ManagerID() (r types.UserID, exists bool) {
 	v := m.manager_id
 	if v == nil {
 		return
@@ -2934,10 +4744,10 @@ func (m *ProblemMutation) ManagerID() (r types.UserID, exists bool) {
 	return *v, true
 }
 
-// OldManagerID returns the old "manager_id" field's value of the Problem entity.
-// If the Problem object wasn't provided to the builder, the object is fetched from the database.
+// OldManagerID returns the old "manager_id" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProblemMutation) OldManagerID(ctx context.Context) (v types.UserID, err error) {
+func (m *ScheduledMessageMutation) OldManagerID(ctx context.Context) (v types.UserID, err error) {
 	if !m.op.Is(OpUpdateOne) {
 		return v, errors.New("OldManagerID is only allowed on UpdateOne operations")
 	}
@@ -2951,198 +4761,164 @@ func (m *ProblemMutation) OldManagerID(ctx context.Context) (v types.UserID, err
 	return oldValue.ManagerID, nil
 }
 
-// ClearManagerID clears the value of the "manager_id" field.
-func (m *ProblemMutation) ClearManagerID() {
-	m.manager_id = nil
-	m.clearedFields[problem.FieldManagerID] = struct{}{}
-}
-
-// ManagerIDCleared returns if the "manager_id" field was cleared in this mutation.
-func (m *ProblemMutation) ManagerIDCleared() bool {
-	_, ok := m.clearedFields[problem.FieldManagerID]
-	return ok
-}
-
 // ResetManagerID resets all changes to the "manager_id" field.
-func (m *ProblemMutation) ResetManagerID() {
+func (m *ScheduledMessageMutation) ResetManagerID() {
 	m.manager_id = nil
-	delete(m.clearedFields, problem.FieldManagerID)
 }
 
-// SetResolvedAt sets the "resolved_at" field.
-func (m *ProblemMutation) SetResolvedAt(t time.Time) {
-	m.resolved_at = &t
+// SetInitialRequestID sets the "initial_request_id" field.
+func (m *ScheduledMessageMutation) SetInitialRequestID(ti types.RequestID) {
+	m.initial_request_id = &ti
 }
 
-// ResolvedAt returns the value of the "resolved_at" field in the mutation.
-func (m *ProblemMutation) ResolvedAt() (r time.Time, exists bool) {
-	v := m.resolved_at
+// InitialRequestID returns the value of the "initial_request_id" field in the mutation.
+func (m *ScheduledMessageMutation) InitialRequestID() (r types.RequestID, exists bool) {
+	v := m.initial_request_id
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldResolvedAt returns the old "resolved_at" field's value of the Problem entity.
-// If the Problem object wasn't provided to the builder, the object is fetched from the database.
+// OldInitialRequestID returns the old "initial_request_id" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProblemMutation) OldResolvedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ScheduledMessageMutation) OldInitialRequestID(ctx context.Context) (v types.RequestID, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldResolvedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldInitialRequestID is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldResolvedAt requires an ID field in the mutation")
+		return v, errors.New("OldInitialRequestID requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldResolvedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldInitialRequestID: %w", err)
 	}
-	return oldValue.ResolvedAt, nil
-}
-
-// ClearResolvedAt clears the value of the "resolved_at" field.
-func (m *ProblemMutation) ClearResolvedAt() {
-	m.resolved_at = nil
-	m.clearedFields[problem.FieldResolvedAt] = struct{}{}
-}
-
-// ResolvedAtCleared returns if the "resolved_at" field was cleared in this mutation.
-func (m *ProblemMutation) ResolvedAtCleared() bool {
-	_, ok := m.clearedFields[problem.FieldResolvedAt]
-	return ok
+	return oldValue.InitialRequestID, nil
 }
 
-// ResetResolvedAt resets all changes to the "resolved_at" field.
-func (m *ProblemMutation) ResetResolvedAt() {
-	m.resolved_at = nil
-	delete(m.clearedFields, problem.FieldResolvedAt)
+// ResetInitialRequestID resets all changes to the "initial_request_id" field.
+func (m *ScheduledMessageMutation) ResetInitialRequestID() {
+	m.initial_request_id = nil
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (m *ProblemMutation) SetCreatedAt(t time.Time) {
-	m.created_at = &t
+// SetBody sets the "body" field.
+func (m *ScheduledMessageMutation) SetBody(s string) {
+	m.body = &s
 }
 
-// CreatedAt returns the value of the "created_at" field in the mutation.
-func (m *ProblemMutation) CreatedAt() (r time.Time, exists bool) {
-	v := m.created_at
+// Body returns the value of the "body" field in the mutation.
+func (m *ScheduledMessageMutation) Body() (r string, exists bool) {
+	v := m.body
 	if v == nil {
 		return
 	}
 	return *v, true
 }
 
-// OldCreatedAt returns the old "created_at" field's value of the Problem entity.
-// If the Problem object wasn't provided to the builder, the object is fetched from the database.
+// OldBody returns the old "body" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
 // An error is returned if the mutation operation is not UpdateOne, or the database query fails.
-func (m *ProblemMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+func (m *ScheduledMessageMutation) OldBody(ctx context.Context) (v string, err error) {
 	if !m.op.Is(OpUpdateOne) {
-		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
+		return v, errors.New("OldBody is only allowed on UpdateOne operations")
 	}
 	if m.id == nil || m.oldValue == nil {
-		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
+		return v, errors.New("OldBody requires an ID field in the mutation")
 	}
 	oldValue, err := m.oldValue(ctx)
 	if err != nil {
-		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+		return v, fmt.Errorf("querying old value for OldBody: %w", err)
 	}
-	return oldValue.CreatedAt, nil
-}
-
-// ResetCreatedAt resets all changes to the "created_at" field.
-func (m *ProblemMutation) ResetCreatedAt() {
-	m.created_at = nil
+	return oldValue.Body, nil
 }
 
-// ClearChat clears the "chat" edge to the Chat entity.
-func (m *ProblemMutation) ClearChat() {
-	m.clearedchat = true
+// ResetBody resets all changes to the "body" field.
+func (m *ScheduledMessageMutation) ResetBody() {
+	m.body = nil
 }
 
-// ChatCleared reports if the "chat" edge to the Chat entity was cleared.
-func (m *ProblemMutation) ChatCleared() bool {
-	return m.clearedchat
+// SetDeliverAt sets the "deliver_at" field.
+func (m *ScheduledMessageMutation) SetDeliverAt(t time.Time) {
+	m.deliver_at = &t
 }
 
-// ChatIDs returns the "chat" edge IDs in the mutation.
-// Note that IDs always returns len(IDs) <= 1 for unique edges, and you should use
-// ChatID instead. It exists only for internal usage by the builders.
-func (m *ProblemMutation) ChatIDs() (ids []types.ChatID) {
-	if id := m.chat; id != nil {
-		ids = append(ids, *id)
+// DeliverAt returns the value of the "deliver_at" field in the mutation.
+func (m *ScheduledMessageMutation) DeliverAt() (r time.Time, exists bool) {
+	v := m.deliver_at
+	if v == nil {
+		return
 	}
-	return
-}
-
-// ResetChat resets all changes to the "chat" edge.
-func (m *ProblemMutation) ResetChat() {
-	m.chat = nil
-	m.clearedchat = false
+	return *v, true
 }
 
-// AddMessageIDs adds the "messages" edge to the Message entity by ids.
-func (m *ProblemMutation) AddMessageIDs(ids ...types.MessageID) {
-	if m.messages == nil {
-		m.messages = make(map[types.MessageID]struct{})
+// OldDeliverAt returns the old "deliver_at" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldDeliverAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldDeliverAt is only allowed on UpdateOne operations")
 	}
-	for i := range ids {
-		m.messages[ids[i]] = struct{}{}
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldDeliverAt requires an ID field in the mutation")
+	}
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldDeliverAt: %w", err)
 	}
+	return oldValue.DeliverAt, nil
 }
 
-// ClearMessages clears the "messages" edge to the Message entity.
-func (m *ProblemMutation) ClearMessages() {
-	m.clearedmessages = true
+// ResetDeliverAt resets all changes to the "deliver_at" field.
+func (m *ScheduledMessageMutation) ResetDeliverAt() {
+	m.deliver_at = nil
 }
 
-// MessagesCleared reports if the "messages" edge to the Message entity was cleared.
-func (m *ProblemMutation) MessagesCleared() bool {
-	return m.clearedmessages
+// SetCreatedAt sets the "created_at" field.
+func (m *ScheduledMessageMutation) SetCreatedAt(t time.Time) {
+	m.created_at = &t
 }
 
-// RemoveMessageIDs removes the "messages" edge to the Message entity by IDs.
-func (m *ProblemMutation) RemoveMessageIDs(ids ...types.MessageID) {
-	if m.removedmessages == nil {
-		m.removedmessages = make(map[types.MessageID]struct{})
-	}
-	for i := range ids {
-		delete(m.messages, ids[i])
-		m.removedmessages[ids[i]] = struct{}{}
+// CreatedAt returns the value of the "created_at" field in the mutation.
+func (m *ScheduledMessageMutation) CreatedAt() (r time.Time, exists bool) {
+	v := m.created_at
+	if v == nil {
+		return
 	}
+	return *v, true
 }
 
-// RemovedMessages returns the removed IDs of the "messages" edge to the Message entity.
-func (m *ProblemMutation) RemovedMessagesIDs() (ids []types.MessageID) {
-	for id := range m.removedmessages {
-		ids = append(ids, id)
+// OldCreatedAt returns the old "created_at" field's value of the ScheduledMessage entity.
+// If the ScheduledMessage object wasn't provided to the builder, the object is fetched from the database.
+// An error is returned if the mutation operation is not UpdateOne, or the database query fails.
+func (m *ScheduledMessageMutation) OldCreatedAt(ctx context.Context) (v time.Time, err error) {
+	if !m.op.Is(OpUpdateOne) {
+		return v, errors.New("OldCreatedAt is only allowed on UpdateOne operations")
 	}
-	return
-}
-
-// MessagesIDs returns the "messages" edge IDs in the mutation.
-func (m *ProblemMutation) MessagesIDs() (ids []types.MessageID) {
-	for id := range m.messages {
-		ids = append(ids, id)
+	if m.id == nil || m.oldValue == nil {
+		return v, errors.New("OldCreatedAt requires an ID field in the mutation")
 	}
-	return
+	oldValue, err := m.oldValue(ctx)
+	if err != nil {
+		return v, fmt.Errorf("querying old value for OldCreatedAt: %w", err)
+	}
+	return oldValue.CreatedAt, nil
 }
 
-// ResetMessages resets all changes to the "messages" edge.
-func (m *ProblemMutation) ResetMessages() {
-	m.messages = nil
-	m.clearedmessages = false
-	m.removedmessages = nil
+// ResetCreatedAt resets all changes to the "created_at" field.
+func (m *ScheduledMessageMutation) ResetCreatedAt() {
+	m.created_at = nil
 }
 
-// Where appends a list predicates to the ProblemMutation builder.
-func (m *ProblemMutation) Where(ps ...predicate.Problem) {
+// Where appends a list predicates to the ScheduledMessageMutation builder.
+func (m *ScheduledMessageMutation) Where(ps ...predicate.ScheduledMessage) {
 	m.predicates = append(m.predicates, ps...)
 }
 
-// WhereP appends storage-level predicates to the ProblemMutation builder. Using this method,
+// WhereP appends storage-level predicates to the ScheduledMessageMutation builder. Using this method,
 // users can use type-assertion to append predicates that do not depend on any generated package.
-func (m *ProblemMutation) WhereP(ps ...func(*sql.Selector)) {
-	p := make([]predicate.Problem, len(ps))
+func (m *ScheduledMessageMutation) WhereP(ps ...func(*sql.Selector)) {
+	p := make([]predicate.ScheduledMessage, len(ps))
 	for i := range ps {
 		p[i] = ps[i]
 	}
@@ -3150,36 +4926,45 @@ func (m *ProblemMutation) WhereP(ps ...func(*sql.Selector)) {
 }
 
 // Op returns the operation name.
-func (m *ProblemMutation) Op() Op {
+func (m *ScheduledMessageMutation) Op() Op {
 	return m.op
 }
 
 // SetOp allows setting the mutation operation.
-func (m *ProblemMutation) SetOp(op Op) {
+func (m *ScheduledMessageMutation) SetOp(op Op) {
 	m.op = op
 }
 
-// Type returns the node type of this mutation (Problem).
-func (m *ProblemMutation) Type() string {
+// Type returns the node type of this mutation (ScheduledMessage).
+func (m *ScheduledMessageMutation) Type() string {
 	return m.typ
 }
 
 // Fields returns all fields that were changed during this mutation. Note that in
 // order to get all numeric fields that were incremented/decremented, call
 // AddedFields().
-func (m *ProblemMutation) Fields() []string {
-	fields := make([]string, 0, 4)
-	if m.chat != nil {
-		fields = append(fields, problem.FieldChatID)
+func (m *ScheduledMessageMutation) Fields() []string {
+	fields := make([]string, 0, 7)
+	if m.chat_id != nil {
+		fields = append(fields, scheduledmessage.FieldChatID)
+	}
+	if m.problem_id != nil {
+		fields = append(fields, scheduledmessage.FieldProblemID)
 	}
 	if m.manager_id != nil {
-		fields = append(fields, problem.FieldManagerID)
+		fields = append(fields, scheduledmessage.FieldManagerID)
 	}
-	if m.resolved_at != nil {
-		fields = append(fields, problem.FieldResolvedAt)
+	if m.initial_request_id != nil {
+		fields = append(fields, scheduledmessage.FieldInitialRequestID)
+	}
+	if m.body != nil {
+		fields = append(fields, scheduledmessage.FieldBody)
+	}
+	if m.deliver_at != nil {
+		fields = append(fields, scheduledmessage.FieldDeliverAt)
 	}
 	if m.created_at != nil {
-		fields = append(fields, problem.FieldCreatedAt)
+		fields = append(fields, scheduledmessage.FieldCreatedAt)
 	}
 	return fields
 }
@@ -3187,15 +4972,21 @@ func (m *ProblemMutation) Fields() []string {
 // Field returns the value of a field with the given name. The second boolean
 // return value indicates that this field was not set, or was not defined in the
 // schema.
-func (m *ProblemMutation) Field(name string) (ent.Value, bool) {
+func (m *ScheduledMessageMutation) Field(name string) (ent.Value, bool) {
 	switch name {
-	case problem.FieldChatID:
+	case scheduledmessage.FieldChatID:
 		return m.ChatID()
-	case problem.FieldManagerID:
+	case scheduledmessage.FieldProblemID:
+		return m.ProblemID()
+	case scheduledmessage.FieldManagerID:
 		return m.ManagerID()
-	case problem.FieldResolvedAt:
-		return m.ResolvedAt()
-	case problem.FieldCreatedAt:
+	case scheduledmessage.FieldInitialRequestID:
+		return m.InitialRequestID()
+	case scheduledmessage.FieldBody:
+		return m.Body()
+	case scheduledmessage.FieldDeliverAt:
+		return m.DeliverAt()
+	case scheduledmessage.FieldCreatedAt:
 		return m.CreatedAt()
 	}
 	return nil, false
@@ -3204,47 +4995,74 @@ func (m *ProblemMutation) Field(name string) (ent.Value, bool) {
 // OldField returns the old value of the field from the database. An error is
 // returned if the mutation operation is not UpdateOne, or the query to the
 // database failed.
-func (m *ProblemMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
+func (m *ScheduledMessageMutation) OldField(ctx context.Context, name string) (ent.Value, error) {
 	switch name {
-	case problem.FieldChatID:
+	case scheduledmessage.FieldChatID:
 		return m.OldChatID(ctx)
-	case problem.FieldManagerID:
+	case scheduledmessage.FieldProblemID:
+		return m.OldProblemID(ctx)
+	case scheduledmessage.FieldManagerID:
 		return m.OldManagerID(ctx)
-	case problem.FieldResolvedAt:
-		return m.OldResolvedAt(ctx)
-	case problem.FieldCreatedAt:
+	case scheduledmessage.FieldInitialRequestID:
+		return m.OldInitialRequestID(ctx)
+	case scheduledmessage.FieldBody:
+		return m.OldBody(ctx)
+	case scheduledmessage.FieldDeliverAt:
+		return m.OldDeliverAt(ctx)
+	case scheduledmessage.FieldCreatedAt:
 		return m.OldCreatedAt(ctx)
 	}
-	return nil, fmt.Errorf("unknown Problem field %s", name)
+	return nil, fmt.Errorf("unknown ScheduledMessage field %s", name)
 }
 
 // SetField sets the value of a field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProblemMutation) SetField(name string, value ent.Value) error {
+func (m *ScheduledMessageMutation) SetField(name string, value ent.Value) error {
 	switch name {
-	case problem.FieldChatID:
+	case scheduledmessage.FieldChatID:
 		v, ok := value.(types.ChatID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetChatID(v)
 		return nil
-	case problem.FieldManagerID:
+	case scheduledmessage.FieldProblemID:
+		v, ok := value.(types.ProblemID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetProblemID(v)
+		return nil
+	case scheduledmessage.FieldManagerID:
 		v, ok := value.(types.UserID)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
 		m.SetManagerID(v)
 		return nil
-	case problem.FieldResolvedAt:
+	case scheduledmessage.FieldInitialRequestID:
+		v, ok := value.(types.RequestID)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetInitialRequestID(v)
+		return nil
+	case scheduledmessage.FieldBody:
+		v, ok := value.(string)
+		if !ok {
+			return fmt.Errorf("unexpected type %T for field %s", value, name)
+		}
+		m.SetBody(v)
+		return nil
+	case scheduledmessage.FieldDeliverAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
 		}
-		m.SetResolvedAt(v)
+		m.SetDeliverAt(v)
 		return nil
-	case problem.FieldCreatedAt:
+	case scheduledmessage.FieldCreatedAt:
 		v, ok := value.(time.Time)
 		if !ok {
 			return fmt.Errorf("unexpected type %T for field %s", value, name)
@@ -3252,183 +5070,123 @@ func (m *ProblemMutation) SetField(name string, value ent.Value) error {
 		m.SetCreatedAt(v)
 		return nil
 	}
-	return fmt.Errorf("unknown Problem field %s", name)
+	return fmt.Errorf("unknown ScheduledMessage field %s", name)
 }
 
 // AddedFields returns all numeric fields that were incremented/decremented during
 // this mutation.
-func (m *ProblemMutation) AddedFields() []string {
+func (m *ScheduledMessageMutation) AddedFields() []string {
 	return nil
 }
 
 // AddedField returns the numeric value that was incremented/decremented on a field
 // with the given name. The second boolean return value indicates that this field
 // was not set, or was not defined in the schema.
-func (m *ProblemMutation) AddedField(name string) (ent.Value, bool) {
+func (m *ScheduledMessageMutation) AddedField(name string) (ent.Value, bool) {
 	return nil, false
 }
 
 // AddField adds the value to the field with the given name. It returns an error if
 // the field is not defined in the schema, or if the type mismatched the field
 // type.
-func (m *ProblemMutation) AddField(name string, value ent.Value) error {
+func (m *ScheduledMessageMutation) AddField(name string, value ent.Value) error {
 	switch name {
 	}
-	return fmt.Errorf("unknown Problem numeric field %s", name)
+	return fmt.Errorf("unknown ScheduledMessage numeric field %s", name)
 }
 
 // ClearedFields returns all nullable fields that were cleared during this
 // mutation.
-func (m *ProblemMutation) ClearedFields() []string {
-	var fields []string
-	if m.FieldCleared(problem.FieldManagerID) {
-		fields = append(fields, problem.FieldManagerID)
-	}
-	if m.FieldCleared(problem.FieldResolvedAt) {
-		fields = append(fields, problem.FieldResolvedAt)
-	}
-	return fields
+func (m *ScheduledMessageMutation) ClearedFields() []string {
+	return nil
 }
 
 // FieldCleared returns a boolean indicating if a field with the given name was
 // cleared in this mutation.
-func (m *ProblemMutation) FieldCleared(name string) bool {
+func (m *ScheduledMessageMutation) FieldCleared(name string) bool {
 	_, ok := m.clearedFields[name]
 	return ok
 }
 
 // ClearField clears the value of the field with the given name. It returns an
 // error if the field is not defined in the schema.
-func (m *ProblemMutation) ClearField(name string) error {
-	switch name {
-	case problem.FieldManagerID:
-		m.ClearManagerID()
-		return nil
-	case problem.FieldResolvedAt:
-		m.ClearResolvedAt()
-		return nil
-	}
-	return fmt.Errorf("unknown Problem nullable field %s", name)
+func (m *ScheduledMessageMutation) ClearField(name string) error {
+	return fmt.Errorf("unknown ScheduledMessage nullable field %s", name)
 }
 
 // ResetField resets all changes in the mutation for the field with the given name.
 // It returns an error if the field is not defined in the schema.
-func (m *ProblemMutation) ResetField(name string) error {
+func (m *ScheduledMessageMutation) ResetField(name string) error {
 	switch name {
-	case problem.FieldChatID:
+	case scheduledmessage.FieldChatID:
 		m.ResetChatID()
 		return nil
-	case problem.FieldManagerID:
+	case scheduledmessage.FieldProblemID:
+		m.ResetProblemID()
+		return nil
+	case scheduledmessage.FieldManagerID:
 		m.ResetManagerID()
 		return nil
-	case problem.FieldResolvedAt:
-		m.ResetResolvedAt()
+	case scheduledmessage.FieldInitialRequestID:
+		m.ResetInitialRequestID()
 		return nil
-	case problem.FieldCreatedAt:
+	case scheduledmessage.FieldBody:
+		m.ResetBody()
+		return nil
+	case scheduledmessage.FieldDeliverAt:
+		m.ResetDeliverAt()
+		return nil
+	case scheduledmessage.FieldCreatedAt:
 		m.ResetCreatedAt()
 		return nil
 	}
-	return fmt.Errorf("unknown Problem field %s", name)
+	return fmt.Errorf("unknown ScheduledMessage field %s", name)
 }
 
 // AddedEdges returns all edge names that were set/added in this mutation.
-func (m *ProblemMutation) AddedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.chat != nil {
-		edges = append(edges, problem.EdgeChat)
-	}
-	if m.messages != nil {
-		edges = append(edges, problem.EdgeMessages)
-	}
+func (m *ScheduledMessageMutation) AddedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // AddedIDs returns all IDs (to other nodes) that were added for the given edge
 // name in this mutation.
-func (m *ProblemMutation) AddedIDs(name string) []ent.Value {
-	switch name {
-	case problem.EdgeChat:
-		if id := m.chat; id != nil {
-			return []ent.Value{*id}
-		}
-	case problem.EdgeMessages:
-		ids := make([]ent.Value, 0, len(m.messages))
-		for id := range m.messages {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ScheduledMessageMutation) AddedIDs(name string) []ent.Value {
 	return nil
 }
 
 // RemovedEdges returns all edge names that were removed in this mutation.
-func (m *ProblemMutation) RemovedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.removedmessages != nil {
-		edges = append(edges, problem.EdgeMessages)
-	}
+func (m *ScheduledMessageMutation) RemovedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // RemovedIDs returns all IDs (to other nodes) that were removed for the edge with
 // the given name in this mutation.
-func (m *ProblemMutation) RemovedIDs(name string) []ent.Value {
-	switch name {
-	case problem.EdgeMessages:
-		ids := make([]ent.Value, 0, len(m.removedmessages))
-		for id := range m.removedmessages {
-			ids = append(ids, id)
-		}
-		return ids
-	}
+func (m *ScheduledMessageMutation) RemovedIDs(name string) []ent.Value {
 	return nil
 }
 
 // ClearedEdges returns all edge names that were cleared in this mutation.
-func (m *ProblemMutation) ClearedEdges() []string {
-	edges := make([]string, 0, 2)
-	if m.clearedchat {
-		edges = append(edges, problem.EdgeChat)
-	}
-	if m.clearedmessages {
-		edges = append(edges, problem.EdgeMessages)
-	}
+func (m *ScheduledMessageMutation) ClearedEdges() []string {
+	edges := make([]string, 0, 0)
 	return edges
 }
 
 // EdgeCleared returns a boolean which indicates if the edge with the given name
 // was cleared in this mutation.
-func (m *ProblemMutation) EdgeCleared(name string) bool {
-	switch name {
-	case problem.EdgeChat:
-		return m.clearedchat
-	case problem.EdgeMessages:
-		return m.clearedmessages
-	}
+func (m *ScheduledMessageMutation) EdgeCleared(name string) bool {
 	return false
 }
 
 // ClearEdge clears the value of the edge with the given name. It returns an error
 // if that edge is not defined in the schema.
-func (m *ProblemMutation) ClearEdge(name string) error {
-	switch name {
-	case problem.EdgeChat:
-		m.ClearChat()
-		return nil
-	}
-	return fmt.Errorf("unknown Problem unique edge %s", name)
+func (m *ScheduledMessageMutation) ClearEdge(name string) error {
+	return fmt.Errorf("unknown ScheduledMessage unique edge %s", name)
 }
 
 // ResetEdge resets all changes to the edge with the given name in this mutation.
 // It returns an error if the edge is not defined in the schema.
-func (m *ProblemMutation) ResetEdge(name string) error {
-	switch name {
-	case problem.EdgeChat:
-		m.ResetChat()
-		return nil
-	case problem.EdgeMessages:
-		m.ResetMessages()
-		return nil
-	}
-	return fmt.Errorf("unknown Problem edge %s", name)
+func (m *ScheduledMessageMutation) ResetEdge(name string) error {
+	return fmt.Errorf("unknown ScheduledMessage edge %s", name)
 }
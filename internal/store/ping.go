@@ -0,0 +1,9 @@
+package store
+
+import "context"
+
+// Ping checks the database connection.
+func (db *Database) Ping(ctx context.Context) error {
+	_, err := db.Exec(ctx, "select 1")
+	return err
+}
@@ -24,6 +24,8 @@ type Problem struct {
 	ManagerID types.UserID `json:"manager_id,omitempty"`
 	// ResolvedAt holds the value of the "resolved_at" field.
 	ResolvedAt time.Time `json:"resolved_at,omitempty"`
+	// ReassignRequestedAt holds the value of the "reassign_requested_at" field.
+	ReassignRequestedAt time.Time `json:"reassign_requested_at,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
@@ -69,7 +71,7 @@ func (*Problem) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
 	for i := range columns {
 		switch columns[i] {
-		case problem.FieldResolvedAt, problem.FieldCreatedAt:
+		case problem.FieldResolvedAt, problem.FieldReassignRequestedAt, problem.FieldCreatedAt:
 			values[i] = new(sql.NullTime)
 		case problem.FieldChatID:
 			values[i] = new(types.ChatID)
@@ -116,6 +118,12 @@ func (pr *Problem) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				pr.ResolvedAt = value.Time
 			}
+		case problem.FieldReassignRequestedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field reassign_requested_at", values[i])
+			} else if value.Valid {
+				pr.ReassignRequestedAt = value.Time
+			}
 		case problem.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -169,6 +177,9 @@ func (pr *Problem) String() string {
 	builder.WriteString("resolved_at=")
 	builder.WriteString(pr.ResolvedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("reassign_requested_at=")
+	builder.WriteString(pr.ReassignRequestedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(pr.CreatedAt.Format(time.ANSIC))
 	builder.WriteByte(')')
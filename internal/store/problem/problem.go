@@ -19,6 +19,8 @@ const (
 	FieldManagerID = "manager_id"
 	// FieldResolvedAt holds the string denoting the resolved_at field in the database.
 	FieldResolvedAt = "resolved_at"
+	// FieldReassignRequestedAt holds the string denoting the reassign_requested_at field in the database.
+	FieldReassignRequestedAt = "reassign_requested_at"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// EdgeChat holds the string denoting the chat edge name in mutations.
@@ -49,6 +51,7 @@ var Columns = []string{
 	FieldChatID,
 	FieldManagerID,
 	FieldResolvedAt,
+	FieldReassignRequestedAt,
 	FieldCreatedAt,
 }
 
@@ -71,6 +71,11 @@ func ResolvedAt(v time.Time) predicate.Problem {
 	return predicate.Problem(sql.FieldEQ(FieldResolvedAt, v))
 }
 
+// ReassignRequestedAt applies equality check predicate on the "reassign_requested_at" field. It's identical to ReassignRequestedAtEQ.
+func ReassignRequestedAt(v time.Time) predicate.Problem {
+	return predicate.Problem(sql.FieldEQ(FieldReassignRequestedAt, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Problem {
 	return predicate.Problem(sql.FieldEQ(FieldCreatedAt, v))
@@ -196,6 +201,56 @@ func ResolvedAtNotNil() predicate.Problem {
 	return predicate.Problem(sql.FieldNotNull(FieldResolvedAt))
 }
 
+// ReassignRequestedAtEQ applies the EQ predicate on the "reassign_requested_at" field.
+func ReassignRequestedAtEQ(v time.Time) predicate.Problem {
+	return predicate.Problem(sql.FieldEQ(FieldReassignRequestedAt, v))
+}
+
+// ReassignRequestedAtNEQ applies the NEQ predicate on the "reassign_requested_at" field.
+func ReassignRequestedAtNEQ(v time.Time) predicate.Problem {
+	return predicate.Problem(sql.FieldNEQ(FieldReassignRequestedAt, v))
+}
+
+// ReassignRequestedAtIn applies the In predicate on the "reassign_requested_at" field.
+func ReassignRequestedAtIn(vs ...time.Time) predicate.Problem {
+	return predicate.Problem(sql.FieldIn(FieldReassignRequestedAt, vs...))
+}
+
+// ReassignRequestedAtNotIn applies the NotIn predicate on the "reassign_requested_at" field.
+func ReassignRequestedAtNotIn(vs ...time.Time) predicate.Problem {
+	return predicate.Problem(sql.FieldNotIn(FieldReassignRequestedAt, vs...))
+}
+
+// ReassignRequestedAtGT applies the GT predicate on the "reassign_requested_at" field.
+func ReassignRequestedAtGT(v time.Time) predicate.Problem {
+	return predicate.Problem(sql.FieldGT(FieldReassignRequestedAt, v))
+}
+
+// ReassignRequestedAtGTE applies the GTE predicate on the "reassign_requested_at" field.
+func ReassignRequestedAtGTE(v time.Time) predicate.Problem {
+	return predicate.Problem(sql.FieldGTE(FieldReassignRequestedAt, v))
+}
+
+// ReassignRequestedAtLT applies the LT predicate on the "reassign_requested_at" field.
+func ReassignRequestedAtLT(v time.Time) predicate.Problem {
+	return predicate.Problem(sql.FieldLT(FieldReassignRequestedAt, v))
+}
+
+// ReassignRequestedAtLTE applies the LTE predicate on the "reassign_requested_at" field.
+func ReassignRequestedAtLTE(v time.Time) predicate.Problem {
+	return predicate.Problem(sql.FieldLTE(FieldReassignRequestedAt, v))
+}
+
+// ReassignRequestedAtIsNil applies the IsNil predicate on the "reassign_requested_at" field.
+func ReassignRequestedAtIsNil() predicate.Problem {
+	return predicate.Problem(sql.FieldIsNull(FieldReassignRequestedAt))
+}
+
+// ReassignRequestedAtNotNil applies the NotNil predicate on the "reassign_requested_at" field.
+func ReassignRequestedAtNotNil() predicate.Problem {
+	return predicate.Problem(sql.FieldNotNull(FieldReassignRequestedAt))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.Problem {
 	return predicate.Problem(sql.FieldEQ(FieldCreatedAt, v))
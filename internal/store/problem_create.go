@@ -60,6 +60,20 @@ func (pc *ProblemCreate) SetNillableResolvedAt(t *time.Time) *ProblemCreate {
 	return pc
 }
 
+// SetReassignRequestedAt sets the "reassign_requested_at" field.
+func (pc *ProblemCreate) SetReassignRequestedAt(t time.Time) *ProblemCreate {
+	pc.mutation.SetReassignRequestedAt(t)
+	return pc
+}
+
+// SetNillableReassignRequestedAt sets the "reassign_requested_at" field if the given value is not nil.
+func (pc *ProblemCreate) SetNillableReassignRequestedAt(t *time.Time) *ProblemCreate {
+	if t != nil {
+		pc.SetReassignRequestedAt(*t)
+	}
+	return pc
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (pc *ProblemCreate) SetCreatedAt(t time.Time) *ProblemCreate {
 	pc.mutation.SetCreatedAt(t)
@@ -223,6 +237,10 @@ func (pc *ProblemCreate) createSpec() (*Problem, *sqlgraph.CreateSpec) {
 		_spec.SetField(problem.FieldResolvedAt, field.TypeTime, value)
 		_node.ResolvedAt = value
 	}
+	if value, ok := pc.mutation.ReassignRequestedAt(); ok {
+		_spec.SetField(problem.FieldReassignRequestedAt, field.TypeTime, value)
+		_node.ReassignRequestedAt = value
+	}
 	if value, ok := pc.mutation.CreatedAt(); ok {
 		_spec.SetField(problem.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -348,6 +366,24 @@ func (u *ProblemUpsert) ClearResolvedAt() *ProblemUpsert {
 	return u
 }
 
+// SetReassignRequestedAt sets the "reassign_requested_at" field.
+func (u *ProblemUpsert) SetReassignRequestedAt(v time.Time) *ProblemUpsert {
+	u.Set(problem.FieldReassignRequestedAt, v)
+	return u
+}
+
+// UpdateReassignRequestedAt sets the "reassign_requested_at" field to the value that was provided on create.
+func (u *ProblemUpsert) UpdateReassignRequestedAt() *ProblemUpsert {
+	u.SetExcluded(problem.FieldReassignRequestedAt)
+	return u
+}
+
+// ClearReassignRequestedAt clears the value of the "reassign_requested_at" field.
+func (u *ProblemUpsert) ClearReassignRequestedAt() *ProblemUpsert {
+	u.SetNull(problem.FieldReassignRequestedAt)
+	return u
+}
+
 // UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
 // Using this option is equivalent to using:
 //
@@ -444,6 +480,27 @@ func (u *ProblemUpsertOne) ClearResolvedAt() *ProblemUpsertOne {
 	})
 }
 
+// SetReassignRequestedAt sets the "reassign_requested_at" field.
+func (u *ProblemUpsertOne) SetReassignRequestedAt(v time.Time) *ProblemUpsertOne {
+	return u.Update(func(s *ProblemUpsert) {
+		s.SetReassignRequestedAt(v)
+	})
+}
+
+// UpdateReassignRequestedAt sets the "reassign_requested_at" field to the value that was provided on create.
+func (u *ProblemUpsertOne) UpdateReassignRequestedAt() *ProblemUpsertOne {
+	return u.Update(func(s *ProblemUpsert) {
+		s.UpdateReassignRequestedAt()
+	})
+}
+
+// ClearReassignRequestedAt clears the value of the "reassign_requested_at" field.
+func (u *ProblemUpsertOne) ClearReassignRequestedAt() *ProblemUpsertOne {
+	return u.Update(func(s *ProblemUpsert) {
+		s.ClearReassignRequestedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *ProblemUpsertOne) Exec(ctx context.Context) error {
 	if len(u.create.conflict) == 0 {
@@ -703,6 +760,27 @@ func (u *ProblemUpsertBulk) ClearResolvedAt() *ProblemUpsertBulk {
 	})
 }
 
+// SetReassignRequestedAt sets the "reassign_requested_at" field.
+func (u *ProblemUpsertBulk) SetReassignRequestedAt(v time.Time) *ProblemUpsertBulk {
+	return u.Update(func(s *ProblemUpsert) {
+		s.SetReassignRequestedAt(v)
+	})
+}
+
+// UpdateReassignRequestedAt sets the "reassign_requested_at" field to the value that was provided on create.
+func (u *ProblemUpsertBulk) UpdateReassignRequestedAt() *ProblemUpsertBulk {
+	return u.Update(func(s *ProblemUpsert) {
+		s.UpdateReassignRequestedAt()
+	})
+}
+
+// ClearReassignRequestedAt clears the value of the "reassign_requested_at" field.
+func (u *ProblemUpsertBulk) ClearReassignRequestedAt() *ProblemUpsertBulk {
+	return u.Update(func(s *ProblemUpsert) {
+		s.ClearReassignRequestedAt()
+	})
+}
+
 // Exec executes the query.
 func (u *ProblemUpsertBulk) Exec(ctx context.Context) error {
 	for i, b := range u.create.builders {
@@ -70,6 +70,26 @@ func (pu *ProblemUpdate) ClearResolvedAt() *ProblemUpdate {
 	return pu
 }
 
+// SetReassignRequestedAt sets the "reassign_requested_at" field.
+func (pu *ProblemUpdate) SetReassignRequestedAt(t time.Time) *ProblemUpdate {
+	pu.mutation.SetReassignRequestedAt(t)
+	return pu
+}
+
+// SetNillableReassignRequestedAt sets the "reassign_requested_at" field if the given value is not nil.
+func (pu *ProblemUpdate) SetNillableReassignRequestedAt(t *time.Time) *ProblemUpdate {
+	if t != nil {
+		pu.SetReassignRequestedAt(*t)
+	}
+	return pu
+}
+
+// ClearReassignRequestedAt clears the value of the "reassign_requested_at" field.
+func (pu *ProblemUpdate) ClearReassignRequestedAt() *ProblemUpdate {
+	pu.mutation.ClearReassignRequestedAt()
+	return pu
+}
+
 // AddMessageIDs adds the "messages" edge to the Message entity by IDs.
 func (pu *ProblemUpdate) AddMessageIDs(ids ...types.MessageID) *ProblemUpdate {
 	pu.mutation.AddMessageIDs(ids...)
@@ -175,6 +195,12 @@ func (pu *ProblemUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if pu.mutation.ResolvedAtCleared() {
 		_spec.ClearField(problem.FieldResolvedAt, field.TypeTime)
 	}
+	if value, ok := pu.mutation.ReassignRequestedAt(); ok {
+		_spec.SetField(problem.FieldReassignRequestedAt, field.TypeTime, value)
+	}
+	if pu.mutation.ReassignRequestedAtCleared() {
+		_spec.ClearField(problem.FieldReassignRequestedAt, field.TypeTime)
+	}
 	if pu.mutation.MessagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -280,6 +306,26 @@ func (puo *ProblemUpdateOne) ClearResolvedAt() *ProblemUpdateOne {
 	return puo
 }
 
+// SetReassignRequestedAt sets the "reassign_requested_at" field.
+func (puo *ProblemUpdateOne) SetReassignRequestedAt(t time.Time) *ProblemUpdateOne {
+	puo.mutation.SetReassignRequestedAt(t)
+	return puo
+}
+
+// SetNillableReassignRequestedAt sets the "reassign_requested_at" field if the given value is not nil.
+func (puo *ProblemUpdateOne) SetNillableReassignRequestedAt(t *time.Time) *ProblemUpdateOne {
+	if t != nil {
+		puo.SetReassignRequestedAt(*t)
+	}
+	return puo
+}
+
+// ClearReassignRequestedAt clears the value of the "reassign_requested_at" field.
+func (puo *ProblemUpdateOne) ClearReassignRequestedAt() *ProblemUpdateOne {
+	puo.mutation.ClearReassignRequestedAt()
+	return puo
+}
+
 // AddMessageIDs adds the "messages" edge to the Message entity by IDs.
 func (puo *ProblemUpdateOne) AddMessageIDs(ids ...types.MessageID) *ProblemUpdateOne {
 	puo.mutation.AddMessageIDs(ids...)
@@ -415,6 +461,12 @@ func (puo *ProblemUpdateOne) sqlSave(ctx context.Context) (_node *Problem, err e
 	if puo.mutation.ResolvedAtCleared() {
 		_spec.ClearField(problem.FieldResolvedAt, field.TypeTime)
 	}
+	if value, ok := puo.mutation.ReassignRequestedAt(); ok {
+		_spec.SetField(problem.FieldReassignRequestedAt, field.TypeTime, value)
+	}
+	if puo.mutation.ReassignRequestedAtCleared() {
+		_spec.ClearField(problem.FieldReassignRequestedAt, field.TypeTime)
+	}
 	if puo.mutation.MessagesCleared() {
 		edge := &sqlgraph.EdgeSpec{
 			Rel:     sqlgraph.O2M,
@@ -5,11 +5,13 @@ package store
 import (
 	"time"
 
+	"github.com/karasunokami/chat-service/internal/store/auditrecord"
 	"github.com/karasunokami/chat-service/internal/store/chat"
 	"github.com/karasunokami/chat-service/internal/store/failedjob"
 	"github.com/karasunokami/chat-service/internal/store/job"
 	"github.com/karasunokami/chat-service/internal/store/message"
 	"github.com/karasunokami/chat-service/internal/store/problem"
+	"github.com/karasunokami/chat-service/internal/store/scheduledmessage"
 	"github.com/karasunokami/chat-service/internal/store/schema"
 	"github.com/karasunokami/chat-service/internal/types"
 )
@@ -18,6 +20,16 @@ import (
 // (default values, validators, hooks and policies) and stitches it
 // to their package variables.
 func init() {
+	auditrecordFields := schema.AuditRecord{}.Fields()
+	_ = auditrecordFields
+	// auditrecordDescCreatedAt is the schema descriptor for created_at field.
+	auditrecordDescCreatedAt := auditrecordFields[7].Descriptor()
+	// auditrecord.DefaultCreatedAt holds the default value on creation for the created_at field.
+	auditrecord.DefaultCreatedAt = auditrecordDescCreatedAt.Default.(func() time.Time)
+	// auditrecordDescID is the schema descriptor for id field.
+	auditrecordDescID := auditrecordFields[0].Descriptor()
+	// auditrecord.DefaultID holds the default value on creation for the id field.
+	auditrecord.DefaultID = auditrecordDescID.Default.(func() types.AuditRecordID)
 	chatFields := schema.Chat{}.Fields()
 	_ = chatFields
 	// chatDescCreatedAt is the schema descriptor for created_at field.
@@ -124,12 +136,20 @@ func init() {
 	messageDescIsBlocked := messageFields[9].Descriptor()
 	// message.DefaultIsBlocked holds the default value on creation for the is_blocked field.
 	message.DefaultIsBlocked = messageDescIsBlocked.Default.(bool)
+	// messageDescAfcResends is the schema descriptor for afc_resends field.
+	messageDescAfcResends := messageFields[11].Descriptor()
+	// message.DefaultAfcResends holds the default value on creation for the afc_resends field.
+	message.DefaultAfcResends = messageDescAfcResends.Default.(int)
 	// messageDescIsService is the schema descriptor for is_service field.
-	messageDescIsService := messageFields[10].Descriptor()
+	messageDescIsService := messageFields[13].Descriptor()
 	// message.DefaultIsService holds the default value on creation for the is_service field.
 	message.DefaultIsService = messageDescIsService.Default.(bool)
+	// messageDescIsInternalNote is the schema descriptor for is_internal_note field.
+	messageDescIsInternalNote := messageFields[14].Descriptor()
+	// message.DefaultIsInternalNote holds the default value on creation for the is_internal_note field.
+	message.DefaultIsInternalNote = messageDescIsInternalNote.Default.(bool)
 	// messageDescCreatedAt is the schema descriptor for created_at field.
-	messageDescCreatedAt := messageFields[11].Descriptor()
+	messageDescCreatedAt := messageFields[15].Descriptor()
 	// message.DefaultCreatedAt holds the default value on creation for the created_at field.
 	message.DefaultCreatedAt = messageDescCreatedAt.Default.(func() time.Time)
 	// messageDescID is the schema descriptor for id field.
@@ -139,11 +159,39 @@ func init() {
 	problemFields := schema.Problem{}.Fields()
 	_ = problemFields
 	// problemDescCreatedAt is the schema descriptor for created_at field.
-	problemDescCreatedAt := problemFields[4].Descriptor()
+	problemDescCreatedAt := problemFields[5].Descriptor()
 	// problem.DefaultCreatedAt holds the default value on creation for the created_at field.
 	problem.DefaultCreatedAt = problemDescCreatedAt.Default.(func() time.Time)
 	// problemDescID is the schema descriptor for id field.
 	problemDescID := problemFields[0].Descriptor()
 	// problem.DefaultID holds the default value on creation for the id field.
 	problem.DefaultID = problemDescID.Default.(func() types.ProblemID)
+	scheduledmessageFields := schema.ScheduledMessage{}.Fields()
+	_ = scheduledmessageFields
+	// scheduledmessageDescBody is the schema descriptor for body field.
+	scheduledmessageDescBody := scheduledmessageFields[5].Descriptor()
+	// scheduledmessage.BodyValidator is a validator for the "body" field. It is called by the builders before save.
+	scheduledmessage.BodyValidator = func() func(string) error {
+		validators := scheduledmessageDescBody.Validators
+		fns := [...]func(string) error{
+			validators[0].(func(string) error),
+			validators[1].(func(string) error),
+		}
+		return func(body string) error {
+			for _, fn := range fns {
+				if err := fn(body); err != nil {
+					return err
+				}
+			}
+			return nil
+		}
+	}()
+	// scheduledmessageDescCreatedAt is the schema descriptor for created_at field.
+	scheduledmessageDescCreatedAt := scheduledmessageFields[7].Descriptor()
+	// scheduledmessage.DefaultCreatedAt holds the default value on creation for the created_at field.
+	scheduledmessage.DefaultCreatedAt = scheduledmessageDescCreatedAt.Default.(func() time.Time)
+	// scheduledmessageDescID is the schema descriptor for id field.
+	scheduledmessageDescID := scheduledmessageFields[0].Descriptor()
+	// scheduledmessage.DefaultID holds the default value on creation for the id field.
+	scheduledmessage.DefaultID = scheduledmessageDescID.Default.(func() types.ScheduledMessageID)
 }
@@ -0,0 +1,171 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/karasunokami/chat-service/internal/store/scheduledmessage"
+	"github.com/karasunokami/chat-service/internal/types"
+)
+
+// ScheduledMessage is the model entity for the ScheduledMessage schema.
+type ScheduledMessage struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID types.ScheduledMessageID `json:"id,omitempty"`
+	// ChatID holds the value of the "chat_id" field.
+	ChatID types.ChatID `json:"chat_id,omitempty"`
+	// ProblemID holds the value of the "problem_id" field.
+	ProblemID types.ProblemID `json:"problem_id,omitempty"`
+	// ManagerID holds the value of the "manager_id" field.
+	ManagerID types.UserID `json:"manager_id,omitempty"`
+	// InitialRequestID holds the value of the "initial_request_id" field.
+	InitialRequestID types.RequestID `json:"initial_request_id,omitempty"`
+	// Body holds the value of the "body" field.
+	Body string `json:"body,omitempty"`
+	// DeliverAt holds the value of the "deliver_at" field.
+	DeliverAt time.Time `json:"deliver_at,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ScheduledMessage) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case scheduledmessage.FieldBody:
+			values[i] = new(sql.NullString)
+		case scheduledmessage.FieldDeliverAt, scheduledmessage.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		case scheduledmessage.FieldChatID:
+			values[i] = new(types.ChatID)
+		case scheduledmessage.FieldProblemID:
+			values[i] = new(types.ProblemID)
+		case scheduledmessage.FieldInitialRequestID:
+			values[i] = new(types.RequestID)
+		case scheduledmessage.FieldID:
+			values[i] = new(types.ScheduledMessageID)
+		case scheduledmessage.FieldManagerID:
+			values[i] = new(types.UserID)
+		default:
+			return nil, fmt.Errorf("unexpected column %q for type ScheduledMessage", columns[i])
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ScheduledMessage fields.
+func (sm *ScheduledMessage) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case scheduledmessage.FieldID:
+			if value, ok := values[i].(*types.ScheduledMessageID); !ok {
+				return fmt.Errorf("unexpected type %T for field id", values[i])
+			} else if value != nil {
+				sm.ID = *value
+			}
+		case scheduledmessage.FieldChatID:
+			if value, ok := values[i].(*types.ChatID); !ok {
+				return fmt.Errorf("unexpected type %T for field chat_id", values[i])
+			} else if value != nil {
+				sm.ChatID = *value
+			}
+		case scheduledmessage.FieldProblemID:
+			if value, ok := values[i].(*types.ProblemID); !ok {
+				return fmt.Errorf("unexpected type %T for field problem_id", values[i])
+			} else if value != nil {
+				sm.ProblemID = *value
+			}
+		case scheduledmessage.FieldManagerID:
+			if value, ok := values[i].(*types.UserID); !ok {
+				return fmt.Errorf("unexpected type %T for field manager_id", values[i])
+			} else if value != nil {
+				sm.ManagerID = *value
+			}
+		case scheduledmessage.FieldInitialRequestID:
+			if value, ok := values[i].(*types.RequestID); !ok {
+				return fmt.Errorf("unexpected type %T for field initial_request_id", values[i])
+			} else if value != nil {
+				sm.InitialRequestID = *value
+			}
+		case scheduledmessage.FieldBody:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field body", values[i])
+			} else if value.Valid {
+				sm.Body = value.String
+			}
+		case scheduledmessage.FieldDeliverAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field deliver_at", values[i])
+			} else if value.Valid {
+				sm.DeliverAt = value.Time
+			}
+		case scheduledmessage.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				sm.CreatedAt = value.Time
+			}
+		}
+	}
+	return nil
+}
+
+// Update returns a builder for updating this ScheduledMessage.
+// Note that you need to call ScheduledMessage.Unwrap() before calling this method if this ScheduledMessage
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (sm *ScheduledMessage) Update() *ScheduledMessageUpdateOne {
+	return NewScheduledMessageClient(sm.config).UpdateOne(sm)
+}
+
+// Unwrap unwraps the ScheduledMessage entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (sm *ScheduledMessage) Unwrap() *ScheduledMessage {
+	_tx, ok := sm.config.driver.(*txDriver)
+	if !ok {
+		panic("store: ScheduledMessage is not a transactional entity")
+	}
+	sm.config.driver = _tx.drv
+	return sm
+}
+
+// String implements the fmt.Stringer.
+func (sm *ScheduledMessage) String() string {
+	var builder strings.Builder
+	builder.WriteString("ScheduledMessage(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", sm.ID))
+	builder.WriteString("chat_id=")
+	builder.WriteString(fmt.Sprintf("%v", sm.ChatID))
+	builder.WriteString(", ")
+	builder.WriteString("problem_id=")
+	builder.WriteString(fmt.Sprintf("%v", sm.ProblemID))
+	builder.WriteString(", ")
+	builder.WriteString("manager_id=")
+	builder.WriteString(fmt.Sprintf("%v", sm.ManagerID))
+	builder.WriteString(", ")
+	builder.WriteString("initial_request_id=")
+	builder.WriteString(fmt.Sprintf("%v", sm.InitialRequestID))
+	builder.WriteString(", ")
+	builder.WriteString("body=")
+	builder.WriteString(sm.Body)
+	builder.WriteString(", ")
+	builder.WriteString("deliver_at=")
+	builder.WriteString(sm.DeliverAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(sm.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ScheduledMessages is a parsable slice of ScheduledMessage.
+type ScheduledMessages []*ScheduledMessage
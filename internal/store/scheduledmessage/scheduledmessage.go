@@ -0,0 +1,63 @@
+// Code generated by ent, DO NOT EDIT.
+
+package scheduledmessage
+
+import (
+	"time"
+
+	"github.com/karasunokami/chat-service/internal/types"
+)
+
+const (
+	// Label holds the string label denoting the scheduledmessage type in the database.
+	Label = "scheduled_message"
+	// FieldID holds the string denoting the id field in the database.
+	FieldID = "id"
+	// FieldChatID holds the string denoting the chat_id field in the database.
+	FieldChatID = "chat_id"
+	// FieldProblemID holds the string denoting the problem_id field in the database.
+	FieldProblemID = "problem_id"
+	// FieldManagerID holds the string denoting the manager_id field in the database.
+	FieldManagerID = "manager_id"
+	// FieldInitialRequestID holds the string denoting the initial_request_id field in the database.
+	FieldInitialRequestID = "initial_request_id"
+	// FieldBody holds the string denoting the body field in the database.
+	FieldBody = "body"
+	// FieldDeliverAt holds the string denoting the deliver_at field in the database.
+	FieldDeliverAt = "deliver_at"
+	// FieldCreatedAt holds the string denoting the created_at field in the database.
+	FieldCreatedAt = "created_at"
+	// Table holds the table name of the scheduledmessage in the database.
+	Table = "scheduled_messages"
+)
+
+// Columns holds all SQL columns for scheduledmessage fields.
+var Columns = []string{
+	FieldID,
+	FieldChatID,
+	FieldProblemID,
+	FieldManagerID,
+	FieldInitialRequestID,
+	FieldBody,
+	FieldDeliverAt,
+	FieldCreatedAt,
+}
+
+// ValidColumn reports if the column name is valid (part of the table columns).
+func ValidColumn(column string) bool {
+	for i := range Columns {
+		if column == Columns[i] {
+			return true
+		}
+	}
+	return false
+}
+
+var (
+	// BodyValidator is a validator for the "body" field. It is called by the builders before save.
+	BodyValidator func(string) error
+	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
+	DefaultCreatedAt func() time.Time
+	// DefaultID holds the default value on creation for the "id" field.
+	DefaultID func() types.ScheduledMessageID
+)
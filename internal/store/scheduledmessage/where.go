@@ -0,0 +1,428 @@
+// Code generated by ent, DO NOT EDIT.
+
+package scheduledmessage
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"github.com/karasunokami/chat-service/internal/store/predicate"
+	"github.com/karasunokami/chat-service/internal/types"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id types.ScheduledMessageID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id types.ScheduledMessageID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id types.ScheduledMessageID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...types.ScheduledMessageID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...types.ScheduledMessageID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id types.ScheduledMessageID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id types.ScheduledMessageID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id types.ScheduledMessageID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id types.ScheduledMessageID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldID, id))
+}
+
+// ChatID applies equality check predicate on the "chat_id" field. It's identical to ChatIDEQ.
+func ChatID(v types.ChatID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldChatID, v))
+}
+
+// ProblemID applies equality check predicate on the "problem_id" field. It's identical to ProblemIDEQ.
+func ProblemID(v types.ProblemID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldProblemID, v))
+}
+
+// ManagerID applies equality check predicate on the "manager_id" field. It's identical to ManagerIDEQ.
+func ManagerID(v types.UserID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldManagerID, v))
+}
+
+// InitialRequestID applies equality check predicate on the "initial_request_id" field. It's identical to InitialRequestIDEQ.
+func InitialRequestID(v types.RequestID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldInitialRequestID, v))
+}
+
+// Body applies equality check predicate on the "body" field. It's identical to BodyEQ.
+func Body(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldBody, v))
+}
+
+// DeliverAt applies equality check predicate on the "deliver_at" field. It's identical to DeliverAtEQ.
+func DeliverAt(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldDeliverAt, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ChatIDEQ applies the EQ predicate on the "chat_id" field.
+func ChatIDEQ(v types.ChatID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldChatID, v))
+}
+
+// ChatIDNEQ applies the NEQ predicate on the "chat_id" field.
+func ChatIDNEQ(v types.ChatID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldChatID, v))
+}
+
+// ChatIDIn applies the In predicate on the "chat_id" field.
+func ChatIDIn(vs ...types.ChatID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldChatID, vs...))
+}
+
+// ChatIDNotIn applies the NotIn predicate on the "chat_id" field.
+func ChatIDNotIn(vs ...types.ChatID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldChatID, vs...))
+}
+
+// ChatIDGT applies the GT predicate on the "chat_id" field.
+func ChatIDGT(v types.ChatID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldChatID, v))
+}
+
+// ChatIDGTE applies the GTE predicate on the "chat_id" field.
+func ChatIDGTE(v types.ChatID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldChatID, v))
+}
+
+// ChatIDLT applies the LT predicate on the "chat_id" field.
+func ChatIDLT(v types.ChatID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldChatID, v))
+}
+
+// ChatIDLTE applies the LTE predicate on the "chat_id" field.
+func ChatIDLTE(v types.ChatID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldChatID, v))
+}
+
+// ProblemIDEQ applies the EQ predicate on the "problem_id" field.
+func ProblemIDEQ(v types.ProblemID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldProblemID, v))
+}
+
+// ProblemIDNEQ applies the NEQ predicate on the "problem_id" field.
+func ProblemIDNEQ(v types.ProblemID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldProblemID, v))
+}
+
+// ProblemIDIn applies the In predicate on the "problem_id" field.
+func ProblemIDIn(vs ...types.ProblemID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldProblemID, vs...))
+}
+
+// ProblemIDNotIn applies the NotIn predicate on the "problem_id" field.
+func ProblemIDNotIn(vs ...types.ProblemID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldProblemID, vs...))
+}
+
+// ProblemIDGT applies the GT predicate on the "problem_id" field.
+func ProblemIDGT(v types.ProblemID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldProblemID, v))
+}
+
+// ProblemIDGTE applies the GTE predicate on the "problem_id" field.
+func ProblemIDGTE(v types.ProblemID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldProblemID, v))
+}
+
+// ProblemIDLT applies the LT predicate on the "problem_id" field.
+func ProblemIDLT(v types.ProblemID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldProblemID, v))
+}
+
+// ProblemIDLTE applies the LTE predicate on the "problem_id" field.
+func ProblemIDLTE(v types.ProblemID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldProblemID, v))
+}
+
+// ManagerIDEQ applies the EQ predicate on the "manager_id" field.
+func ManagerIDEQ(v types.UserID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldManagerID, v))
+}
+
+// ManagerIDNEQ applies the NEQ predicate on the "manager_id" field.
+func ManagerIDNEQ(v types.UserID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldManagerID, v))
+}
+
+// ManagerIDIn applies the In predicate on the "manager_id" field.
+func ManagerIDIn(vs ...types.UserID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldManagerID, vs...))
+}
+
+// ManagerIDNotIn applies the NotIn predicate on the "manager_id" field.
+func ManagerIDNotIn(vs ...types.UserID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldManagerID, vs...))
+}
+
+// ManagerIDGT applies the GT predicate on the "manager_id" field.
+func ManagerIDGT(v types.UserID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldManagerID, v))
+}
+
+// ManagerIDGTE applies the GTE predicate on the "manager_id" field.
+func ManagerIDGTE(v types.UserID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldManagerID, v))
+}
+
+// ManagerIDLT applies the LT predicate on the "manager_id" field.
+func ManagerIDLT(v types.UserID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldManagerID, v))
+}
+
+// ManagerIDLTE applies the LTE predicate on the "manager_id" field.
+func ManagerIDLTE(v types.UserID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldManagerID, v))
+}
+
+// InitialRequestIDEQ applies the EQ predicate on the "initial_request_id" field.
+func InitialRequestIDEQ(v types.RequestID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldInitialRequestID, v))
+}
+
+// InitialRequestIDNEQ applies the NEQ predicate on the "initial_request_id" field.
+func InitialRequestIDNEQ(v types.RequestID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldInitialRequestID, v))
+}
+
+// InitialRequestIDIn applies the In predicate on the "initial_request_id" field.
+func InitialRequestIDIn(vs ...types.RequestID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldInitialRequestID, vs...))
+}
+
+// InitialRequestIDNotIn applies the NotIn predicate on the "initial_request_id" field.
+func InitialRequestIDNotIn(vs ...types.RequestID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldInitialRequestID, vs...))
+}
+
+// InitialRequestIDGT applies the GT predicate on the "initial_request_id" field.
+func InitialRequestIDGT(v types.RequestID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldInitialRequestID, v))
+}
+
+// InitialRequestIDGTE applies the GTE predicate on the "initial_request_id" field.
+func InitialRequestIDGTE(v types.RequestID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldInitialRequestID, v))
+}
+
+// InitialRequestIDLT applies the LT predicate on the "initial_request_id" field.
+func InitialRequestIDLT(v types.RequestID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldInitialRequestID, v))
+}
+
+// InitialRequestIDLTE applies the LTE predicate on the "initial_request_id" field.
+func InitialRequestIDLTE(v types.RequestID) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldInitialRequestID, v))
+}
+
+// BodyEQ applies the EQ predicate on the "body" field.
+func BodyEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldBody, v))
+}
+
+// BodyNEQ applies the NEQ predicate on the "body" field.
+func BodyNEQ(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldBody, v))
+}
+
+// BodyIn applies the In predicate on the "body" field.
+func BodyIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldBody, vs...))
+}
+
+// BodyNotIn applies the NotIn predicate on the "body" field.
+func BodyNotIn(vs ...string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldBody, vs...))
+}
+
+// BodyGT applies the GT predicate on the "body" field.
+func BodyGT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldBody, v))
+}
+
+// BodyGTE applies the GTE predicate on the "body" field.
+func BodyGTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldBody, v))
+}
+
+// BodyLT applies the LT predicate on the "body" field.
+func BodyLT(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldBody, v))
+}
+
+// BodyLTE applies the LTE predicate on the "body" field.
+func BodyLTE(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldBody, v))
+}
+
+// BodyContains applies the Contains predicate on the "body" field.
+func BodyContains(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContains(FieldBody, v))
+}
+
+// BodyHasPrefix applies the HasPrefix predicate on the "body" field.
+func BodyHasPrefix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasPrefix(FieldBody, v))
+}
+
+// BodyHasSuffix applies the HasSuffix predicate on the "body" field.
+func BodyHasSuffix(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldHasSuffix(FieldBody, v))
+}
+
+// BodyEqualFold applies the EqualFold predicate on the "body" field.
+func BodyEqualFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEqualFold(FieldBody, v))
+}
+
+// BodyContainsFold applies the ContainsFold predicate on the "body" field.
+func BodyContainsFold(v string) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldContainsFold(FieldBody, v))
+}
+
+// DeliverAtEQ applies the EQ predicate on the "deliver_at" field.
+func DeliverAtEQ(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldDeliverAt, v))
+}
+
+// DeliverAtNEQ applies the NEQ predicate on the "deliver_at" field.
+func DeliverAtNEQ(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldDeliverAt, v))
+}
+
+// DeliverAtIn applies the In predicate on the "deliver_at" field.
+func DeliverAtIn(vs ...time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldDeliverAt, vs...))
+}
+
+// DeliverAtNotIn applies the NotIn predicate on the "deliver_at" field.
+func DeliverAtNotIn(vs ...time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldDeliverAt, vs...))
+}
+
+// DeliverAtGT applies the GT predicate on the "deliver_at" field.
+func DeliverAtGT(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldDeliverAt, v))
+}
+
+// DeliverAtGTE applies the GTE predicate on the "deliver_at" field.
+func DeliverAtGTE(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldDeliverAt, v))
+}
+
+// DeliverAtLT applies the LT predicate on the "deliver_at" field.
+func DeliverAtLT(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldDeliverAt, v))
+}
+
+// DeliverAtLTE applies the LTE predicate on the "deliver_at" field.
+func DeliverAtLTE(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldDeliverAt, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ScheduledMessage) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for _, p := range predicates {
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ScheduledMessage) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(func(s *sql.Selector) {
+		s1 := s.Clone().SetP(nil)
+		for i, p := range predicates {
+			if i > 0 {
+				s1.Or()
+			}
+			p(s1)
+		}
+		s.Where(s1.P())
+	})
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ScheduledMessage) predicate.ScheduledMessage {
+	return predicate.ScheduledMessage(func(s *sql.Selector) {
+		p(s.Not())
+	})
+}
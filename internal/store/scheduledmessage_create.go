@@ -0,0 +1,624 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect"
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/karasunokami/chat-service/internal/store/scheduledmessage"
+	"github.com/karasunokami/chat-service/internal/types"
+)
+
+// ScheduledMessageCreate is the builder for creating a ScheduledMessage entity.
+type ScheduledMessageCreate struct {
+	config
+	mutation *ScheduledMessageMutation
+	hooks    []Hook
+	conflict []sql.ConflictOption
+}
+
+// SetChatID sets the "chat_id" field.
+func (smc *ScheduledMessageCreate) SetChatID(ti types.ChatID) *ScheduledMessageCreate {
+	smc.mutation.SetChatID(ti)
+	return smc
+}
+
+// SetProblemID sets the "problem_id" field.
+func (smc *ScheduledMessageCreate) SetProblemID(ti types.ProblemID) *ScheduledMessageCreate {
+	smc.mutation.SetProblemID(ti)
+	return smc
+}
+
+// SetManagerID sets the "manager_id" field.
+func (smc *ScheduledMessageCreate) SetManagerID(ti types.UserID) *ScheduledMessageCreate {
+	smc.mutation.SetManagerID(ti)
+	return smc
+}
+
+// SetInitialRequestID sets the "initial_request_id" field.
+func (smc *ScheduledMessageCreate) SetInitialRequestID(ti types.RequestID) *ScheduledMessageCreate {
+	smc.mutation.SetInitialRequestID(ti)
+	return smc
+}
+
+// SetBody sets the "body" field.
+func (smc *ScheduledMessageCreate) SetBody(s string) *ScheduledMessageCreate {
+	smc.mutation.SetBody(s)
+	return smc
+}
+
+// SetDeliverAt sets the "deliver_at" field.
+func (smc *ScheduledMessageCreate) SetDeliverAt(t time.Time) *ScheduledMessageCreate {
+	smc.mutation.SetDeliverAt(t)
+	return smc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (smc *ScheduledMessageCreate) SetCreatedAt(t time.Time) *ScheduledMessageCreate {
+	smc.mutation.SetCreatedAt(t)
+	return smc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (smc *ScheduledMessageCreate) SetNillableCreatedAt(t *time.Time) *ScheduledMessageCreate {
+	if t != nil {
+		smc.SetCreatedAt(*t)
+	}
+	return smc
+}
+
+// SetID sets the "id" field.
+func (smc *ScheduledMessageCreate) SetID(tmi types.ScheduledMessageID) *ScheduledMessageCreate {
+	smc.mutation.SetID(tmi)
+	return smc
+}
+
+// SetNillableID sets the "id" field if the given value is not nil.
+func (smc *ScheduledMessageCreate) SetNillableID(tmi *types.ScheduledMessageID) *ScheduledMessageCreate {
+	if tmi != nil {
+		smc.SetID(*tmi)
+	}
+	return smc
+}
+
+// Mutation returns the ScheduledMessageMutation object of the builder.
+func (smc *ScheduledMessageCreate) Mutation() *ScheduledMessageMutation {
+	return smc.mutation
+}
+
+// Save creates the ScheduledMessage in the database.
+func (smc *ScheduledMessageCreate) Save(ctx context.Context) (*ScheduledMessage, error) {
+	smc.defaults()
+	return withHooks[*ScheduledMessage, ScheduledMessageMutation](ctx, smc.sqlSave, smc.mutation, smc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (smc *ScheduledMessageCreate) SaveX(ctx context.Context) *ScheduledMessage {
+	v, err := smc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (smc *ScheduledMessageCreate) Exec(ctx context.Context) error {
+	_, err := smc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smc *ScheduledMessageCreate) ExecX(ctx context.Context) {
+	if err := smc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (smc *ScheduledMessageCreate) defaults() {
+	if _, ok := smc.mutation.CreatedAt(); !ok {
+		v := scheduledmessage.DefaultCreatedAt()
+		smc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := smc.mutation.ID(); !ok {
+		v := scheduledmessage.DefaultID()
+		smc.mutation.SetID(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (smc *ScheduledMessageCreate) check() error {
+	if _, ok := smc.mutation.ChatID(); !ok {
+		return &ValidationError{Name: "chat_id", err: errors.New(`store: missing required field "ScheduledMessage.chat_id"`)}
+	}
+	if v, ok := smc.mutation.ChatID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "chat_id", err: fmt.Errorf(`store: validator failed for field "ScheduledMessage.chat_id": %w`, err)}
+		}
+	}
+	if _, ok := smc.mutation.ProblemID(); !ok {
+		return &ValidationError{Name: "problem_id", err: errors.New(`store: missing required field "ScheduledMessage.problem_id"`)}
+	}
+	if v, ok := smc.mutation.ProblemID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "problem_id", err: fmt.Errorf(`store: validator failed for field "ScheduledMessage.problem_id": %w`, err)}
+		}
+	}
+	if _, ok := smc.mutation.ManagerID(); !ok {
+		return &ValidationError{Name: "manager_id", err: errors.New(`store: missing required field "ScheduledMessage.manager_id"`)}
+	}
+	if v, ok := smc.mutation.ManagerID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "manager_id", err: fmt.Errorf(`store: validator failed for field "ScheduledMessage.manager_id": %w`, err)}
+		}
+	}
+	if _, ok := smc.mutation.InitialRequestID(); !ok {
+		return &ValidationError{Name: "initial_request_id", err: errors.New(`store: missing required field "ScheduledMessage.initial_request_id"`)}
+	}
+	if v, ok := smc.mutation.InitialRequestID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "initial_request_id", err: fmt.Errorf(`store: validator failed for field "ScheduledMessage.initial_request_id": %w`, err)}
+		}
+	}
+	if _, ok := smc.mutation.Body(); !ok {
+		return &ValidationError{Name: "body", err: errors.New(`store: missing required field "ScheduledMessage.body"`)}
+	}
+	if v, ok := smc.mutation.Body(); ok {
+		if err := scheduledmessage.BodyValidator(v); err != nil {
+			return &ValidationError{Name: "body", err: fmt.Errorf(`store: validator failed for field "ScheduledMessage.body": %w`, err)}
+		}
+	}
+	if _, ok := smc.mutation.DeliverAt(); !ok {
+		return &ValidationError{Name: "deliver_at", err: errors.New(`store: missing required field "ScheduledMessage.deliver_at"`)}
+	}
+	if _, ok := smc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`store: missing required field "ScheduledMessage.created_at"`)}
+	}
+	if v, ok := smc.mutation.ID(); ok {
+		if err := v.Validate(); err != nil {
+			return &ValidationError{Name: "id", err: fmt.Errorf(`store: validator failed for field "ScheduledMessage.id": %w`, err)}
+		}
+	}
+	return nil
+}
+
+func (smc *ScheduledMessageCreate) sqlSave(ctx context.Context) (*ScheduledMessage, error) {
+	if err := smc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := smc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, smc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	if _spec.ID.Value != nil {
+		if id, ok := _spec.ID.Value.(*types.ScheduledMessageID); ok {
+			_node.ID = *id
+		} else if err := _node.ID.Scan(_spec.ID.Value); err != nil {
+			return nil, err
+		}
+	}
+	smc.mutation.id = &_node.ID
+	smc.mutation.done = true
+	return _node, nil
+}
+
+func (smc *ScheduledMessageCreate) createSpec() (*ScheduledMessage, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ScheduledMessage{config: smc.config}
+		_spec = sqlgraph.NewCreateSpec(scheduledmessage.Table, sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeUUID))
+	)
+	_spec.OnConflict = smc.conflict
+	if id, ok := smc.mutation.ID(); ok {
+		_node.ID = id
+		_spec.ID.Value = &id
+	}
+	if value, ok := smc.mutation.ChatID(); ok {
+		_spec.SetField(scheduledmessage.FieldChatID, field.TypeUUID, value)
+		_node.ChatID = value
+	}
+	if value, ok := smc.mutation.ProblemID(); ok {
+		_spec.SetField(scheduledmessage.FieldProblemID, field.TypeUUID, value)
+		_node.ProblemID = value
+	}
+	if value, ok := smc.mutation.ManagerID(); ok {
+		_spec.SetField(scheduledmessage.FieldManagerID, field.TypeUUID, value)
+		_node.ManagerID = value
+	}
+	if value, ok := smc.mutation.InitialRequestID(); ok {
+		_spec.SetField(scheduledmessage.FieldInitialRequestID, field.TypeUUID, value)
+		_node.InitialRequestID = value
+	}
+	if value, ok := smc.mutation.Body(); ok {
+		_spec.SetField(scheduledmessage.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := smc.mutation.DeliverAt(); ok {
+		_spec.SetField(scheduledmessage.FieldDeliverAt, field.TypeTime, value)
+		_node.DeliverAt = value
+	}
+	if value, ok := smc.mutation.CreatedAt(); ok {
+		_spec.SetField(scheduledmessage.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	return _node, _spec
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ScheduledMessage.Create().
+//		SetChatID(v).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ScheduledMessageUpsert) {
+//			SetChatID(v+v).
+//		}).
+//		Exec(ctx)
+func (smc *ScheduledMessageCreate) OnConflict(opts ...sql.ConflictOption) *ScheduledMessageUpsertOne {
+	smc.conflict = opts
+	return &ScheduledMessageUpsertOne{
+		create: smc,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ScheduledMessage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (smc *ScheduledMessageCreate) OnConflictColumns(columns ...string) *ScheduledMessageUpsertOne {
+	smc.conflict = append(smc.conflict, sql.ConflictColumns(columns...))
+	return &ScheduledMessageUpsertOne{
+		create: smc,
+	}
+}
+
+type (
+	// ScheduledMessageUpsertOne is the builder for "upsert"-ing
+	//  one ScheduledMessage node.
+	ScheduledMessageUpsertOne struct {
+		create *ScheduledMessageCreate
+	}
+
+	// ScheduledMessageUpsert is the "OnConflict" setter.
+	ScheduledMessageUpsert struct {
+		*sql.UpdateSet
+	}
+)
+
+// UpdateNewValues updates the mutable fields using the new values that were set on create except the ID field.
+// Using this option is equivalent to using:
+//
+//	client.ScheduledMessage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(scheduledmessage.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ScheduledMessageUpsertOne) UpdateNewValues() *ScheduledMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		if _, exists := u.create.mutation.ID(); exists {
+			s.SetIgnore(scheduledmessage.FieldID)
+		}
+		if _, exists := u.create.mutation.ChatID(); exists {
+			s.SetIgnore(scheduledmessage.FieldChatID)
+		}
+		if _, exists := u.create.mutation.ProblemID(); exists {
+			s.SetIgnore(scheduledmessage.FieldProblemID)
+		}
+		if _, exists := u.create.mutation.ManagerID(); exists {
+			s.SetIgnore(scheduledmessage.FieldManagerID)
+		}
+		if _, exists := u.create.mutation.InitialRequestID(); exists {
+			s.SetIgnore(scheduledmessage.FieldInitialRequestID)
+		}
+		if _, exists := u.create.mutation.Body(); exists {
+			s.SetIgnore(scheduledmessage.FieldBody)
+		}
+		if _, exists := u.create.mutation.DeliverAt(); exists {
+			s.SetIgnore(scheduledmessage.FieldDeliverAt)
+		}
+		if _, exists := u.create.mutation.CreatedAt(); exists {
+			s.SetIgnore(scheduledmessage.FieldCreatedAt)
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ScheduledMessage.Create().
+//	    OnConflict(sql.ResolveWithIgnore()).
+//	    Exec(ctx)
+func (u *ScheduledMessageUpsertOne) Ignore() *ScheduledMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ScheduledMessageUpsertOne) DoNothing() *ScheduledMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ScheduledMessageCreate.OnConflict
+// documentation for more info.
+func (u *ScheduledMessageUpsertOne) Update(set func(*ScheduledMessageUpsert)) *ScheduledMessageUpsertOne {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ScheduledMessageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ScheduledMessageUpsertOne) Exec(ctx context.Context) error {
+	if len(u.create.conflict) == 0 {
+		return errors.New("store: missing options for ScheduledMessageCreate.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ScheduledMessageUpsertOne) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// Exec executes the UPSERT query and returns the inserted/updated ID.
+func (u *ScheduledMessageUpsertOne) ID(ctx context.Context) (id types.ScheduledMessageID, err error) {
+	if u.create.driver.Dialect() == dialect.MySQL {
+		// In case of "ON CONFLICT", there is no way to get back non-numeric ID
+		// fields from the database since MySQL does not support the RETURNING clause.
+		return id, errors.New("store: ScheduledMessageUpsertOne.ID is not supported by MySQL driver. Use ScheduledMessageUpsertOne.Exec instead")
+	}
+	node, err := u.create.Save(ctx)
+	if err != nil {
+		return id, err
+	}
+	return node.ID, nil
+}
+
+// IDX is like ID, but panics if an error occurs.
+func (u *ScheduledMessageUpsertOne) IDX(ctx context.Context) types.ScheduledMessageID {
+	id, err := u.ID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// ScheduledMessageCreateBulk is the builder for creating many ScheduledMessage entities in bulk.
+type ScheduledMessageCreateBulk struct {
+	config
+	builders []*ScheduledMessageCreate
+	conflict []sql.ConflictOption
+}
+
+// Save creates the ScheduledMessage entities in the database.
+func (smcb *ScheduledMessageCreateBulk) Save(ctx context.Context) ([]*ScheduledMessage, error) {
+	specs := make([]*sqlgraph.CreateSpec, len(smcb.builders))
+	nodes := make([]*ScheduledMessage, len(smcb.builders))
+	mutators := make([]Mutator, len(smcb.builders))
+	for i := range smcb.builders {
+		func(i int, root context.Context) {
+			builder := smcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ScheduledMessageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				nodes[i], specs[i] = builder.createSpec()
+				var err error
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, smcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					spec.OnConflict = smcb.conflict
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, smcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, smcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (smcb *ScheduledMessageCreateBulk) SaveX(ctx context.Context) []*ScheduledMessage {
+	v, err := smcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (smcb *ScheduledMessageCreateBulk) Exec(ctx context.Context) error {
+	_, err := smcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smcb *ScheduledMessageCreateBulk) ExecX(ctx context.Context) {
+	if err := smcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// OnConflict allows configuring the `ON CONFLICT` / `ON DUPLICATE KEY` clause
+// of the `INSERT` statement. For example:
+//
+//	client.ScheduledMessage.CreateBulk(builders...).
+//		OnConflict(
+//			// Update the row with the new values
+//			// the was proposed for insertion.
+//			sql.ResolveWithNewValues(),
+//		).
+//		// Override some of the fields with custom
+//		// update values.
+//		Update(func(u *ent.ScheduledMessageUpsert) {
+//			SetChatID(v+v).
+//		}).
+//		Exec(ctx)
+func (smcb *ScheduledMessageCreateBulk) OnConflict(opts ...sql.ConflictOption) *ScheduledMessageUpsertBulk {
+	smcb.conflict = opts
+	return &ScheduledMessageUpsertBulk{
+		create: smcb,
+	}
+}
+
+// OnConflictColumns calls `OnConflict` and configures the columns
+// as conflict target. Using this option is equivalent to using:
+//
+//	client.ScheduledMessage.Create().
+//		OnConflict(sql.ConflictColumns(columns...)).
+//		Exec(ctx)
+func (smcb *ScheduledMessageCreateBulk) OnConflictColumns(columns ...string) *ScheduledMessageUpsertBulk {
+	smcb.conflict = append(smcb.conflict, sql.ConflictColumns(columns...))
+	return &ScheduledMessageUpsertBulk{
+		create: smcb,
+	}
+}
+
+// ScheduledMessageUpsertBulk is the builder for "upsert"-ing
+// a bulk of ScheduledMessage nodes.
+type ScheduledMessageUpsertBulk struct {
+	create *ScheduledMessageCreateBulk
+}
+
+// UpdateNewValues updates the mutable fields using the new values that
+// were set on create. Using this option is equivalent to using:
+//
+//	client.ScheduledMessage.Create().
+//		OnConflict(
+//			sql.ResolveWithNewValues(),
+//			sql.ResolveWith(func(u *sql.UpdateSet) {
+//				u.SetIgnore(scheduledmessage.FieldID)
+//			}),
+//		).
+//		Exec(ctx)
+func (u *ScheduledMessageUpsertBulk) UpdateNewValues() *ScheduledMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithNewValues())
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(s *sql.UpdateSet) {
+		for _, b := range u.create.builders {
+			if _, exists := b.mutation.ID(); exists {
+				s.SetIgnore(scheduledmessage.FieldID)
+			}
+			if _, exists := b.mutation.ChatID(); exists {
+				s.SetIgnore(scheduledmessage.FieldChatID)
+			}
+			if _, exists := b.mutation.ProblemID(); exists {
+				s.SetIgnore(scheduledmessage.FieldProblemID)
+			}
+			if _, exists := b.mutation.ManagerID(); exists {
+				s.SetIgnore(scheduledmessage.FieldManagerID)
+			}
+			if _, exists := b.mutation.InitialRequestID(); exists {
+				s.SetIgnore(scheduledmessage.FieldInitialRequestID)
+			}
+			if _, exists := b.mutation.Body(); exists {
+				s.SetIgnore(scheduledmessage.FieldBody)
+			}
+			if _, exists := b.mutation.DeliverAt(); exists {
+				s.SetIgnore(scheduledmessage.FieldDeliverAt)
+			}
+			if _, exists := b.mutation.CreatedAt(); exists {
+				s.SetIgnore(scheduledmessage.FieldCreatedAt)
+			}
+		}
+	}))
+	return u
+}
+
+// Ignore sets each column to itself in case of conflict.
+// Using this option is equivalent to using:
+//
+//	client.ScheduledMessage.Create().
+//		OnConflict(sql.ResolveWithIgnore()).
+//		Exec(ctx)
+func (u *ScheduledMessageUpsertBulk) Ignore() *ScheduledMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWithIgnore())
+	return u
+}
+
+// DoNothing configures the conflict_action to `DO NOTHING`.
+// Supported only by SQLite and PostgreSQL.
+func (u *ScheduledMessageUpsertBulk) DoNothing() *ScheduledMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.DoNothing())
+	return u
+}
+
+// Update allows overriding fields `UPDATE` values. See the ScheduledMessageCreateBulk.OnConflict
+// documentation for more info.
+func (u *ScheduledMessageUpsertBulk) Update(set func(*ScheduledMessageUpsert)) *ScheduledMessageUpsertBulk {
+	u.create.conflict = append(u.create.conflict, sql.ResolveWith(func(update *sql.UpdateSet) {
+		set(&ScheduledMessageUpsert{UpdateSet: update})
+	}))
+	return u
+}
+
+// Exec executes the query.
+func (u *ScheduledMessageUpsertBulk) Exec(ctx context.Context) error {
+	for i, b := range u.create.builders {
+		if len(b.conflict) != 0 {
+			return fmt.Errorf("store: OnConflict was set for builder %d. Set it on the ScheduledMessageCreateBulk instead", i)
+		}
+	}
+	if len(u.create.conflict) == 0 {
+		return errors.New("store: missing options for ScheduledMessageCreateBulk.OnConflict")
+	}
+	return u.create.Exec(ctx)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (u *ScheduledMessageUpsertBulk) ExecX(ctx context.Context) {
+	if err := u.create.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
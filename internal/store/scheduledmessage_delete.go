@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/karasunokami/chat-service/internal/store/predicate"
+	"github.com/karasunokami/chat-service/internal/store/scheduledmessage"
+)
+
+// ScheduledMessageDelete is the builder for deleting a ScheduledMessage entity.
+type ScheduledMessageDelete struct {
+	config
+	hooks    []Hook
+	mutation *ScheduledMessageMutation
+}
+
+// Where appends a list predicates to the ScheduledMessageDelete builder.
+func (smd *ScheduledMessageDelete) Where(ps ...predicate.ScheduledMessage) *ScheduledMessageDelete {
+	smd.mutation.Where(ps...)
+	return smd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (smd *ScheduledMessageDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks[int, ScheduledMessageMutation](ctx, smd.sqlExec, smd.mutation, smd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smd *ScheduledMessageDelete) ExecX(ctx context.Context) int {
+	n, err := smd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (smd *ScheduledMessageDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(scheduledmessage.Table, sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeUUID))
+	if ps := smd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, smd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	smd.mutation.done = true
+	return affected, err
+}
+
+// ScheduledMessageDeleteOne is the builder for deleting a single ScheduledMessage entity.
+type ScheduledMessageDeleteOne struct {
+	smd *ScheduledMessageDelete
+}
+
+// Where appends a list predicates to the ScheduledMessageDelete builder.
+func (smdo *ScheduledMessageDeleteOne) Where(ps ...predicate.ScheduledMessage) *ScheduledMessageDeleteOne {
+	smdo.smd.mutation.Where(ps...)
+	return smdo
+}
+
+// Exec executes the deletion query.
+func (smdo *ScheduledMessageDeleteOne) Exec(ctx context.Context) error {
+	n, err := smdo.smd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{scheduledmessage.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smdo *ScheduledMessageDeleteOne) ExecX(ctx context.Context) {
+	if err := smdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
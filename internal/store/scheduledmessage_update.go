@@ -0,0 +1,175 @@
+// Code generated by ent, DO NOT EDIT.
+
+package store
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/karasunokami/chat-service/internal/store/predicate"
+	"github.com/karasunokami/chat-service/internal/store/scheduledmessage"
+)
+
+// ScheduledMessageUpdate is the builder for updating ScheduledMessage entities.
+type ScheduledMessageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ScheduledMessageMutation
+}
+
+// Where appends a list predicates to the ScheduledMessageUpdate builder.
+func (smu *ScheduledMessageUpdate) Where(ps ...predicate.ScheduledMessage) *ScheduledMessageUpdate {
+	smu.mutation.Where(ps...)
+	return smu
+}
+
+// Mutation returns the ScheduledMessageMutation object of the builder.
+func (smu *ScheduledMessageUpdate) Mutation() *ScheduledMessageMutation {
+	return smu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (smu *ScheduledMessageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks[int, ScheduledMessageMutation](ctx, smu.sqlSave, smu.mutation, smu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (smu *ScheduledMessageUpdate) SaveX(ctx context.Context) int {
+	affected, err := smu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (smu *ScheduledMessageUpdate) Exec(ctx context.Context) error {
+	_, err := smu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smu *ScheduledMessageUpdate) ExecX(ctx context.Context) {
+	if err := smu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (smu *ScheduledMessageUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	_spec := sqlgraph.NewUpdateSpec(scheduledmessage.Table, scheduledmessage.Columns, sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeUUID))
+	if ps := smu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, smu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scheduledmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	smu.mutation.done = true
+	return n, nil
+}
+
+// ScheduledMessageUpdateOne is the builder for updating a single ScheduledMessage entity.
+type ScheduledMessageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ScheduledMessageMutation
+}
+
+// Mutation returns the ScheduledMessageMutation object of the builder.
+func (smuo *ScheduledMessageUpdateOne) Mutation() *ScheduledMessageMutation {
+	return smuo.mutation
+}
+
+// Where appends a list predicates to the ScheduledMessageUpdate builder.
+func (smuo *ScheduledMessageUpdateOne) Where(ps ...predicate.ScheduledMessage) *ScheduledMessageUpdateOne {
+	smuo.mutation.Where(ps...)
+	return smuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (smuo *ScheduledMessageUpdateOne) Select(field string, fields ...string) *ScheduledMessageUpdateOne {
+	smuo.fields = append([]string{field}, fields...)
+	return smuo
+}
+
+// Save executes the query and returns the updated ScheduledMessage entity.
+func (smuo *ScheduledMessageUpdateOne) Save(ctx context.Context) (*ScheduledMessage, error) {
+	return withHooks[*ScheduledMessage, ScheduledMessageMutation](ctx, smuo.sqlSave, smuo.mutation, smuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (smuo *ScheduledMessageUpdateOne) SaveX(ctx context.Context) *ScheduledMessage {
+	node, err := smuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (smuo *ScheduledMessageUpdateOne) Exec(ctx context.Context) error {
+	_, err := smuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (smuo *ScheduledMessageUpdateOne) ExecX(ctx context.Context) {
+	if err := smuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+func (smuo *ScheduledMessageUpdateOne) sqlSave(ctx context.Context) (_node *ScheduledMessage, err error) {
+	_spec := sqlgraph.NewUpdateSpec(scheduledmessage.Table, scheduledmessage.Columns, sqlgraph.NewFieldSpec(scheduledmessage.FieldID, field.TypeUUID))
+	id, ok := smuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`store: missing "ScheduledMessage.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := smuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, scheduledmessage.FieldID)
+		for _, f := range fields {
+			if !scheduledmessage.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("store: invalid field %q for query", f)}
+			}
+			if f != scheduledmessage.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := smuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	_node = &ScheduledMessage{config: smuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, smuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{scheduledmessage.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	smuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,41 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/karasunokami/chat-service/internal/types"
+)
+
+// AuditRecord holds the schema definition for the AuditRecord entity.
+// It is an append-only log of the manager actions and the system decisions made instead of them.
+type AuditRecord struct {
+	ent.Schema
+}
+
+// Fields of the AuditRecord.
+func (AuditRecord) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", types.AuditRecordID{}).Default(types.NewAuditRecordID).Unique().Immutable(),
+		// manager_id is empty for the system actions.
+		field.UUID("manager_id", types.UserID{}).Optional().Immutable(),
+		field.Enum("action").
+			Values(
+				"get_chat_history", "send_message", "close_chat", "free_hands", "send_internal_note", "set_status",
+				"afc_timeout_deliver", "afc_timeout_block",
+			).
+			Immutable(),
+		field.UUID("chat_id", types.ChatID{}).Optional().Immutable(),
+		field.UUID("problem_id", types.ProblemID{}).Optional().Immutable(),
+		field.UUID("message_id", types.MessageID{}).Optional().Immutable(),
+		field.UUID("request_id", types.RequestID{}).Immutable(),
+		field.Time("created_at").Default(defaultTime).Immutable(),
+	}
+}
+
+func (AuditRecord) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("manager_id", "created_at"),
+		index.Fields("created_at"),
+	}
+}
@@ -27,7 +27,14 @@ func (Message) Fields() []ent.Field {
 		field.Text("body").Immutable().MaxLen(3000).MinLen(1),
 		field.Time("checked_at").Optional(),
 		field.Bool("is_blocked").Default(false),
+		// review_requested_at is set while the suspicious message waits for the supervisor decision.
+		field.Time("review_requested_at").Optional(),
+		// afc_resends counts how many times the message was resent to AFC because of the verdict timeout.
+		field.Int("afc_resends").Default(0),
+		field.Time("afc_resent_at").Optional(),
 		field.Bool("is_service").Default(false),
+		// is_internal_note marks the staff note, which is never visible for the client and is not checked by AFC.
+		field.Bool("is_internal_note").Default(false).Immutable(),
 		field.Time("created_at").Default(defaultTime).Immutable(),
 	}
 }
@@ -20,6 +20,8 @@ func (Problem) Fields() []ent.Field {
 		field.UUID("chat_id", types.ChatID{}).Immutable(),
 		field.UUID("manager_id", types.UserID{}).Optional(),
 		field.Time("resolved_at").Optional(),
+		// reassign_requested_at is set when the manager of the problem has been offline for too long.
+		field.Time("reassign_requested_at").Optional(),
 		field.Time("created_at").Default(defaultTime).Immutable(),
 	}
 }
@@ -0,0 +1,34 @@
+package schema
+
+import (
+	"entgo.io/ent"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+	"github.com/karasunokami/chat-service/internal/types"
+)
+
+// ScheduledMessage holds the schema definition for the ScheduledMessage entity.
+// It is a manager message postponed until the deliver_at moment.
+type ScheduledMessage struct {
+	ent.Schema
+}
+
+// Fields of the ScheduledMessage.
+func (ScheduledMessage) Fields() []ent.Field {
+	return []ent.Field{
+		field.UUID("id", types.ScheduledMessageID{}).Default(types.NewScheduledMessageID).Unique().Immutable(),
+		field.UUID("chat_id", types.ChatID{}).Immutable(),
+		field.UUID("problem_id", types.ProblemID{}).Immutable(),
+		field.UUID("manager_id", types.UserID{}).Immutable(),
+		field.UUID("initial_request_id", types.RequestID{}).Unique().Immutable(),
+		field.Text("body").Immutable().MaxLen(3000).MinLen(1),
+		field.Time("deliver_at").Immutable(),
+		field.Time("created_at").Default(defaultTime).Immutable(),
+	}
+}
+
+func (ScheduledMessage) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("manager_id", "deliver_at"),
+	}
+}
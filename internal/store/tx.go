@@ -14,6 +14,8 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AuditRecord is the client for interacting with the AuditRecord builders.
+	AuditRecord *AuditRecordClient
 	// Chat is the client for interacting with the Chat builders.
 	Chat *ChatClient
 	// FailedJob is the client for interacting with the FailedJob builders.
@@ -24,6 +26,8 @@ type Tx struct {
 	Message *MessageClient
 	// Problem is the client for interacting with the Problem builders.
 	Problem *ProblemClient
+	// ScheduledMessage is the client for interacting with the ScheduledMessage builders.
+	ScheduledMessage *ScheduledMessageClient
 
 	// lazily loaded.
 	client     *Client
@@ -155,11 +159,13 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AuditRecord = NewAuditRecordClient(tx.config)
 	tx.Chat = NewChatClient(tx.config)
 	tx.FailedJob = NewFailedJobClient(tx.config)
 	tx.Job = NewJobClient(tx.config)
 	tx.Message = NewMessageClient(tx.config)
 	tx.Problem = NewProblemClient(tx.config)
+	tx.ScheduledMessage = NewScheduledMessageClient(tx.config)
 }
 
 // txDriver wraps the given dialect.Tx with a nop dialect.Driver implementation.
@@ -169,7 +175,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Chat.QueryXXX(), the query will be executed
+// applies a query, for example: AuditRecord.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -54,6 +54,35 @@ func PrepareDB(ctx context.Context, t *testing.T, dbName string) (st *store.Clie
 	}
 }
 
+// PrepareEmptyDB creates the database without any schema.
+func PrepareEmptyDB(ctx context.Context, t *testing.T, dbName string) (db *sql.DB, cleanUp func(ctx context.Context)) {
+	t.Helper()
+	require.NotEmpty(t, dbName)
+
+	pgx, err := store.NewPgxDB(store.NewPgxOptions(
+		Config.PostgresAddress,
+		Config.PostgresUser,
+		Config.PostgresPassword,
+		"postgres",
+	))
+	require.NoError(t, err)
+	require.NoError(t, createDatabase(ctx, dbName, pgx))
+
+	db, err = store.NewPgxDB(store.NewPgxOptions(
+		Config.PostgresAddress,
+		Config.PostgresUser,
+		Config.PostgresPassword,
+		dbName,
+	))
+	require.NoError(t, err)
+
+	return db, func(ctx2 context.Context) {
+		assert.NoError(t, db.Close())
+		assert.NoError(t, dropDatabaseIfExists(ctx2, dbName, pgx))
+		assert.NoError(t, pgx.Close())
+	}
+}
+
 func createDatabase(ctx context.Context, dbName string, db *sql.DB) error {
 	if err := dropDatabaseIfExists(ctx, dbName, db); err != nil {
 		return fmt.Errorf("drop database %s: %v", dbName, err)
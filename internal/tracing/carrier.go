@@ -0,0 +1,68 @@
+package tracing
+
+import (
+	"context"
+
+	msgbus "github.com/karasunokami/chat-service/internal/services/msg-bus"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+)
+
+// InjectMap returns the trace context of ctx as a map.
+// The map is empty if ctx has no span.
+func InjectMap(ctx context.Context) map[string]string {
+	carrier := propagation.MapCarrier{}
+	otel.GetTextMapPropagator().Inject(ctx, carrier)
+
+	return carrier
+}
+
+// ExtractMap restores the trace context injected by InjectMap.
+func ExtractMap(ctx context.Context, m map[string]string) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, propagation.MapCarrier(m))
+}
+
+// InjectMessageHeaders appends the trace context of ctx to the bus message headers.
+func InjectMessageHeaders(ctx context.Context, msg *msgbus.Message) {
+	otel.GetTextMapPropagator().Inject(ctx, messageHeadersCarrier{msg: msg})
+}
+
+// ExtractMessageHeaders restores the trace context from the bus message headers.
+func ExtractMessageHeaders(ctx context.Context, msg *msgbus.Message) context.Context {
+	return otel.GetTextMapPropagator().Extract(ctx, messageHeadersCarrier{msg: msg})
+}
+
+type messageHeadersCarrier struct {
+	msg *msgbus.Message
+}
+
+func (c messageHeadersCarrier) Get(key string) string {
+	for _, h := range c.msg.Headers {
+		if h.Key == key {
+			return string(h.Value)
+		}
+	}
+
+	return ""
+}
+
+func (c messageHeadersCarrier) Set(key, value string) {
+	for i, h := range c.msg.Headers {
+		if h.Key == key {
+			c.msg.Headers[i].Value = []byte(value)
+			return
+		}
+	}
+
+	c.msg.Headers = append(c.msg.Headers, msgbus.Header{Key: key, Value: []byte(value)})
+}
+
+func (c messageHeadersCarrier) Keys() []string {
+	keys := make([]string, 0, len(c.msg.Headers))
+	for _, h := range c.msg.Headers {
+		keys = append(keys, h.Key)
+	}
+
+	return keys
+}
@@ -0,0 +1,51 @@
+package tracing_test
+
+import (
+	"context"
+	"testing"
+
+	msgbus "github.com/karasunokami/chat-service/internal/services/msg-bus"
+	"github.com/karasunokami/chat-service/internal/tracing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func TestMessageHeaders(t *testing.T) {
+	ctx := newTracedContext(t)
+
+	msg := msgbus.Message{Headers: []msgbus.Header{{Key: "LAST_ERROR", Value: []byte("error")}}}
+	tracing.InjectMessageHeaders(ctx, &msg)
+	require.Len(t, msg.Headers, 2)
+
+	restored := trace.SpanContextFromContext(tracing.ExtractMessageHeaders(context.Background(), &msg))
+	assert.Equal(t, trace.SpanContextFromContext(ctx).TraceID(), restored.TraceID())
+	assert.Equal(t, trace.SpanContextFromContext(ctx).SpanID(), restored.SpanID())
+	assert.True(t, restored.IsRemote())
+}
+
+func TestMap(t *testing.T) {
+	assert.Empty(t, tracing.InjectMap(context.Background()))
+
+	ctx := newTracedContext(t)
+
+	m := tracing.InjectMap(ctx)
+	require.NotEmpty(t, m)
+
+	restored := trace.SpanContextFromContext(tracing.ExtractMap(context.Background(), m))
+	assert.Equal(t, trace.SpanContextFromContext(ctx).TraceID(), restored.TraceID())
+}
+
+func newTracedContext(t *testing.T) context.Context {
+	t.Helper()
+
+	_, err := tracing.New(tracing.NewOptions("test", tracing.ExporterNone))
+	require.NoError(t, err)
+
+	return trace.ContextWithSpanContext(context.Background(), trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{1, 2, 3},
+		SpanID:     trace.SpanID{4, 5, 6},
+		TraceFlags: trace.FlagsSampled,
+	}))
+}
@@ -0,0 +1,116 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.17.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+const tracerName = "github.com/karasunokami/chat-service"
+
+const (
+	ExporterNone   = "none"
+	ExporterStdout = "stdout"
+	ExporterOTLP   = "otlp"
+)
+
+var errNoOTLPEndpoint = errors.New("otlp endpoint is required for otlp exporter")
+
+//go:generate options-gen -out-filename=tracing_options.gen.go -from-struct=Options
+type Options struct {
+	serviceName  string `option:"mandatory" validate:"required"`
+	exporter     string `option:"mandatory" validate:"required,oneof=none stdout otlp"`
+	otlpEndpoint string `validate:"omitempty,hostname_port"`
+	otlpInsecure bool
+	sampleRatio  float64 `default:"1" validate:"min=0,max=1"`
+}
+
+// Provider owns the global tracer provider and flushes spans on shutdown.
+type Provider struct {
+	tp *sdktrace.TracerProvider
+}
+
+// New configures the global tracer provider and the W3C trace context propagator.
+func New(opts Options) (*Provider, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, fmt.Errorf("validate options, err=%v", err)
+	}
+
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(
+		propagation.TraceContext{},
+		propagation.Baggage{},
+	))
+
+	if opts.exporter == ExporterNone {
+		return &Provider{}, nil
+	}
+
+	exp, err := newExporter(opts)
+	if err != nil {
+		return nil, fmt.Errorf("create exporter, err=%v", err)
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exp),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(opts.sampleRatio))),
+		sdktrace.WithResource(resource.NewSchemaless(semconv.ServiceName(opts.serviceName))),
+	)
+	otel.SetTracerProvider(tp)
+
+	return &Provider{tp: tp}, nil
+}
+
+func newExporter(opts Options) (sdktrace.SpanExporter, error) {
+	switch opts.exporter {
+	case ExporterStdout:
+		return stdouttrace.New()
+
+	case ExporterOTLP:
+		if opts.otlpEndpoint == "" {
+			return nil, errNoOTLPEndpoint
+		}
+
+		clientOpts := []otlptracegrpc.Option{otlptracegrpc.WithEndpoint(opts.otlpEndpoint)}
+		if opts.otlpInsecure {
+			clientOpts = append(clientOpts, otlptracegrpc.WithInsecure())
+		}
+
+		return otlptracegrpc.New(context.Background(), clientOpts...)
+	}
+
+	return nil, fmt.Errorf("unknown exporter %q", opts.exporter)
+}
+
+// Shutdown flushes the remaining spans.
+func (p *Provider) Shutdown(ctx context.Context) error {
+	if p.tp == nil {
+		return nil
+	}
+
+	return p.tp.Shutdown(ctx)
+}
+
+// Start starts a new span with the service tracer.
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(tracerName).Start(ctx, name, opts...)
+}
+
+// End marks the span as failed if err is not nil and ends it.
+func End(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+
+	span.End()
+}
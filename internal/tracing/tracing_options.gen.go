@@ -0,0 +1,85 @@
+// Code generated by options-gen. DO NOT EDIT.
+package tracing
+
+import (
+	fmt461e464ebed9 "fmt"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	serviceName string,
+	exporter string,
+	options ...OptOptionsSetter,
+) Options {
+	o := Options{}
+
+	// Setting defaults from field tag (if present)
+	o.sampleRatio = 1
+
+	o.serviceName = serviceName
+	o.exporter = exporter
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func WithOtlpEndpoint(opt string) OptOptionsSetter {
+	return func(o *Options) {
+		o.otlpEndpoint = opt
+	}
+}
+
+func WithOtlpInsecure(opt bool) OptOptionsSetter {
+	return func(o *Options) {
+		o.otlpInsecure = opt
+	}
+}
+
+func WithSampleRatio(opt float64) OptOptionsSetter {
+	return func(o *Options) {
+		o.sampleRatio = opt
+	}
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("serviceName", _validate_Options_serviceName(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("exporter", _validate_Options_exporter(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("otlpEndpoint", _validate_Options_otlpEndpoint(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("sampleRatio", _validate_Options_sampleRatio(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_serviceName(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.serviceName, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `serviceName` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_exporter(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.exporter, "required,oneof=none stdout otlp"); err != nil {
+		return fmt461e464ebed9.Errorf("field `exporter` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_otlpEndpoint(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.otlpEndpoint, "omitempty,hostname_port"); err != nil {
+		return fmt461e464ebed9.Errorf("field `otlpEndpoint` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_sampleRatio(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.sampleRatio, "min=0,max=1"); err != nil {
+		return fmt461e464ebed9.Errorf("field `sampleRatio` did not pass the test: %w", err)
+	}
+	return nil
+}
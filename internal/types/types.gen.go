@@ -241,8 +241,66 @@ func (t EventID) AsPointer() *EventID {
 	}
 	return &t
 }
+var ScheduledMessageIDNil = ScheduledMessageID(uuid.Nil)
+
+type ScheduledMessageID uuid.UUID                             //
+func NewScheduledMessageID() ScheduledMessageID                           { return ScheduledMessageID(uuid.New()) }
+func (t ScheduledMessageID) String() string                   { return uuid.UUID(t).String() }
+func (t ScheduledMessageID) Value() (driver.Value, error)     { return t.String(), nil }
+func (t *ScheduledMessageID) Scan(src any) error              { return (*uuid.UUID)(t).Scan(src) }
+func (t ScheduledMessageID) MarshalText() ([]byte, error)     { return uuid.UUID(t).MarshalText() }
+func (t *ScheduledMessageID) UnmarshalText(data []byte) error { return (*uuid.UUID)(t).UnmarshalText(data) }
+func (t ScheduledMessageID) IsZero() bool                     { return t == ScheduledMessageIDNil }
+func (t ScheduledMessageID) Matches(x interface{}) bool {
+	v, ok := x.(ScheduledMessageID)
+	if !ok {
+		return false
+	}
+	return t.String() == v.String()
+}
+func (t ScheduledMessageID) Validate() error {
+	if t.IsZero() {
+		return errors.New("zero ScheduledMessageID")
+	}
+	return nil
+}
+func (t ScheduledMessageID) AsPointer() *ScheduledMessageID {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
+var AuditRecordIDNil = AuditRecordID(uuid.Nil)
+
+type AuditRecordID uuid.UUID                             //
+func NewAuditRecordID() AuditRecordID                           { return AuditRecordID(uuid.New()) }
+func (t AuditRecordID) String() string                   { return uuid.UUID(t).String() }
+func (t AuditRecordID) Value() (driver.Value, error)     { return t.String(), nil }
+func (t *AuditRecordID) Scan(src any) error              { return (*uuid.UUID)(t).Scan(src) }
+func (t AuditRecordID) MarshalText() ([]byte, error)     { return uuid.UUID(t).MarshalText() }
+func (t *AuditRecordID) UnmarshalText(data []byte) error { return (*uuid.UUID)(t).UnmarshalText(data) }
+func (t AuditRecordID) IsZero() bool                     { return t == AuditRecordIDNil }
+func (t AuditRecordID) Matches(x interface{}) bool {
+	v, ok := x.(AuditRecordID)
+	if !ok {
+		return false
+	}
+	return t.String() == v.String()
+}
+func (t AuditRecordID) Validate() error {
+	if t.IsZero() {
+		return errors.New("zero AuditRecordID")
+	}
+	return nil
+}
+func (t AuditRecordID) AsPointer() *AuditRecordID {
+	if t.IsZero() {
+		return nil
+	}
+	return &t
+}
 type TypeSet = interface {
-	ChatID|MessageID|ProblemID|UserID|RequestID|JobID|FailedJobID|EventID
+	ChatID|MessageID|ProblemID|UserID|RequestID|JobID|FailedJobID|EventID|ScheduledMessageID|AuditRecordID
 }
 
 func Parse[T TypeSet](s string) (T, error) {
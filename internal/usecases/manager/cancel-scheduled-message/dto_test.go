@@ -0,0 +1,69 @@
+package cancelscheduledmessage_test
+
+import (
+	"testing"
+
+	"github.com/karasunokami/chat-service/internal/types"
+	cancelscheduledmessage "github.com/karasunokami/chat-service/internal/usecases/manager/cancel-scheduled-message"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequest_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		request cancelscheduledmessage.Request
+		wantErr bool
+	}{
+		// Positive.
+		{
+			name: "valid request",
+			request: cancelscheduledmessage.Request{
+				ID:                 types.NewRequestID(),
+				ManagerID:          types.NewUserID(),
+				ScheduledMessageID: types.NewScheduledMessageID(),
+			},
+			wantErr: false,
+		},
+
+		// Negative.
+		{
+			name: "require request id",
+			request: cancelscheduledmessage.Request{
+				ID:                 types.RequestIDNil,
+				ManagerID:          types.NewUserID(),
+				ScheduledMessageID: types.NewScheduledMessageID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "require manager id",
+			request: cancelscheduledmessage.Request{
+				ID:                 types.NewRequestID(),
+				ManagerID:          types.UserIDNil,
+				ScheduledMessageID: types.NewScheduledMessageID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "require scheduled message id",
+			request: cancelscheduledmessage.Request{
+				ID:                 types.NewRequestID(),
+				ManagerID:          types.NewUserID(),
+				ScheduledMessageID: types.ScheduledMessageIDNil,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,50 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+
+// Package cancelscheduledmessagemocks is a generated GoMock package.
+package cancelscheduledmessagemocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	types "github.com/karasunokami/chat-service/internal/types"
+)
+
+// MockscheduledMessagesRepository is a mock of scheduledMessagesRepository interface.
+type MockscheduledMessagesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockscheduledMessagesRepositoryMockRecorder
+}
+
+// MockscheduledMessagesRepositoryMockRecorder is the mock recorder for MockscheduledMessagesRepository.
+type MockscheduledMessagesRepositoryMockRecorder struct {
+	mock *MockscheduledMessagesRepository
+}
+
+// NewMockscheduledMessagesRepository creates a new mock instance.
+func NewMockscheduledMessagesRepository(ctrl *gomock.Controller) *MockscheduledMessagesRepository {
+	mock := &MockscheduledMessagesRepository{ctrl: ctrl}
+	mock.recorder = &MockscheduledMessagesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockscheduledMessagesRepository) EXPECT() *MockscheduledMessagesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// DeleteManagerMessage mocks base method.
+func (m *MockscheduledMessagesRepository) DeleteManagerMessage(ctx context.Context, managerID types.UserID, id types.ScheduledMessageID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteManagerMessage", ctx, managerID, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteManagerMessage indicates an expected call of DeleteManagerMessage.
+func (mr *MockscheduledMessagesRepositoryMockRecorder) DeleteManagerMessage(ctx, managerID, id interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteManagerMessage", reflect.TypeOf((*MockscheduledMessagesRepository)(nil).DeleteManagerMessage), ctx, managerID, id)
+}
@@ -0,0 +1,57 @@
+package cancelscheduledmessage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	scheduledmessagesrepo "github.com/karasunokami/chat-service/internal/repositories/scheduledmessages"
+	"github.com/karasunokami/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=cancelscheduledmessagemocks
+
+var (
+	ErrInvalidRequest           = errors.New("invalid request")
+	ErrScheduledMessageNotFound = errors.New("scheduled message not found")
+)
+
+type scheduledMessagesRepository interface {
+	DeleteManagerMessage(ctx context.Context, managerID types.UserID, id types.ScheduledMessageID) error
+}
+
+//go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
+type Options struct {
+	scheduledMessagesRepository scheduledMessagesRepository `option:"mandatory" validate:"required"`
+}
+
+type UseCase struct {
+	Options
+}
+
+func New(opts Options) (UseCase, error) {
+	if err := opts.Validate(); err != nil {
+		return UseCase{}, fmt.Errorf("validate options, err=%v", err)
+	}
+
+	return UseCase{opts}, nil
+}
+
+// Handle deletes the scheduled message. The already planned outbox job
+// finds nothing to deliver and finishes without side effects.
+func (u UseCase) Handle(ctx context.Context, req Request) error {
+	if err := req.Validate(); err != nil {
+		return ErrInvalidRequest
+	}
+
+	err := u.scheduledMessagesRepository.DeleteManagerMessage(ctx, req.ManagerID, req.ScheduledMessageID)
+	if err != nil {
+		if errors.Is(err, scheduledmessagesrepo.ErrMsgNotFound) {
+			return ErrScheduledMessageNotFound
+		}
+
+		return fmt.Errorf("scheduled messages repository, delete manager message, err=%w", err)
+	}
+
+	return nil
+}
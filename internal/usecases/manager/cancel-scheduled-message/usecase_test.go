@@ -0,0 +1,96 @@
+package cancelscheduledmessage_test
+
+import (
+	"errors"
+	"testing"
+
+	scheduledmessagesrepo "github.com/karasunokami/chat-service/internal/repositories/scheduledmessages"
+	"github.com/karasunokami/chat-service/internal/testingh"
+	"github.com/karasunokami/chat-service/internal/types"
+	cancelscheduledmessage "github.com/karasunokami/chat-service/internal/usecases/manager/cancel-scheduled-message"
+	cancelscheduledmessagemocks "github.com/karasunokami/chat-service/internal/usecases/manager/cancel-scheduled-message/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl          *gomock.Controller
+	scheduledRepo *cancelscheduledmessagemocks.MockscheduledMessagesRepository
+	uCase         cancelscheduledmessage.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.scheduledRepo = cancelscheduledmessagemocks.NewMockscheduledMessagesRepository(s.ctrl)
+
+	var err error
+	s.uCase, err = cancelscheduledmessage.New(cancelscheduledmessage.NewOptions(s.scheduledRepo))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) newRequest() cancelscheduledmessage.Request {
+	return cancelscheduledmessage.Request{
+		ID:                 types.NewRequestID(),
+		ManagerID:          types.NewUserID(),
+		ScheduledMessageID: types.NewScheduledMessageID(),
+	}
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	err := s.uCase.Handle(s.Ctx, cancelscheduledmessage.Request{})
+	s.Require().ErrorIs(err, cancelscheduledmessage.ErrInvalidRequest)
+}
+
+func (s *UseCaseSuite) TestNotFound() {
+	// Arrange.
+	req := s.newRequest()
+	s.scheduledRepo.EXPECT().DeleteManagerMessage(s.Ctx, req.ManagerID, req.ScheduledMessageID).
+		Return(scheduledmessagesrepo.ErrMsgNotFound)
+
+	// Action.
+	err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, cancelscheduledmessage.ErrScheduledMessageNotFound)
+}
+
+func (s *UseCaseSuite) TestRepoError() {
+	// Arrange.
+	req := s.newRequest()
+	expectedErr := errors.New("unexpected")
+	s.scheduledRepo.EXPECT().DeleteManagerMessage(s.Ctx, req.ManagerID, req.ScheduledMessageID).Return(expectedErr)
+
+	// Action.
+	err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, expectedErr)
+}
+
+func (s *UseCaseSuite) TestSuccess() {
+	// Arrange.
+	req := s.newRequest()
+	s.scheduledRepo.EXPECT().DeleteManagerMessage(s.Ctx, req.ManagerID, req.ScheduledMessageID).Return(nil)
+
+	// Action.
+	err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+}
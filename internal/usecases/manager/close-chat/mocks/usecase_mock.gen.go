@@ -10,6 +10,7 @@ import (
 	time "time"
 
 	gomock "github.com/golang/mock/gomock"
+	auditrepo "github.com/karasunokami/chat-service/internal/repositories/audit"
 	messagesrepo "github.com/karasunokami/chat-service/internal/repositories/messages"
 	types "github.com/karasunokami/chat-service/internal/types"
 )
@@ -142,6 +143,43 @@ func (mr *MockoutboxServiceMockRecorder) Put(ctx, name, payload, availableAt int
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockoutboxService)(nil).Put), ctx, name, payload, availableAt)
 }
 
+// MockauditLog is a mock of auditLog interface.
+type MockauditLog struct {
+	ctrl     *gomock.Controller
+	recorder *MockauditLogMockRecorder
+}
+
+// MockauditLogMockRecorder is the mock recorder for MockauditLog.
+type MockauditLogMockRecorder struct {
+	mock *MockauditLog
+}
+
+// NewMockauditLog creates a new mock instance.
+func NewMockauditLog(ctrl *gomock.Controller) *MockauditLog {
+	mock := &MockauditLog{ctrl: ctrl}
+	mock.recorder = &MockauditLogMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauditLog) EXPECT() *MockauditLogMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockauditLog) Create(ctx context.Context, rec auditrepo.Record) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, rec)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockauditLogMockRecorder) Create(ctx, rec interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockauditLog)(nil).Create), ctx, rec)
+}
+
 // Mocktransactor is a mock of transactor interface.
 type Mocktransactor struct {
 	ctrl     *gomock.Controller
@@ -15,6 +15,7 @@ func NewOptions(
 	problemsRepo problemsRepo,
 	messagesRepo messagesRepo,
 	transactor transactor,
+	auditLog auditLog,
 	options ...OptOptionsSetter,
 ) Options {
 	o := Options{}
@@ -25,6 +26,7 @@ func NewOptions(
 	o.problemsRepo = problemsRepo
 	o.messagesRepo = messagesRepo
 	o.transactor = transactor
+	o.auditLog = auditLog
 
 	for _, opt := range options {
 		opt(&o)
@@ -38,6 +40,7 @@ func (o *Options) Validate() error {
 	errs.Add(errors461e464ebed9.NewValidationError("problemsRepo", _validate_Options_problemsRepo(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("messagesRepo", _validate_Options_messagesRepo(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("transactor", _validate_Options_transactor(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("auditLog", _validate_Options_auditLog(o)))
 	return errs.AsError()
 }
 
@@ -68,3 +71,10 @@ func _validate_Options_transactor(o *Options) error {
 	}
 	return nil
 }
+
+func _validate_Options_auditLog(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.auditLog, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `auditLog` did not pass the test: %w", err)
+	}
+	return nil
+}
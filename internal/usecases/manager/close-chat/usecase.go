@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	auditrepo "github.com/karasunokami/chat-service/internal/repositories/audit"
 	messagesrepo "github.com/karasunokami/chat-service/internal/repositories/messages"
 	problemsrepo "github.com/karasunokami/chat-service/internal/repositories/problems"
 	chatclosed "github.com/karasunokami/chat-service/internal/services/outbox/jobs/chat-closed"
@@ -44,6 +45,10 @@ type outboxService interface {
 	Put(ctx context.Context, name, payload string, availableAt time.Time) (types.JobID, error)
 }
 
+type auditLog interface {
+	Create(ctx context.Context, rec auditrepo.Record) error
+}
+
 type transactor interface {
 	RunInTx(ctx context.Context, f func(context.Context) error) error
 }
@@ -54,6 +59,7 @@ type Options struct {
 	problemsRepo  problemsRepo  `option:"mandatory" validate:"required"`
 	messagesRepo  messagesRepo  `option:"mandatory" validate:"required"`
 	transactor    transactor    `option:"mandatory" validate:"required"`
+	auditLog      auditLog      `option:"mandatory" validate:"required"`
 }
 
 type UseCase struct {
@@ -108,6 +114,17 @@ func (u UseCase) Handle(ctx context.Context, req Request) error {
 			return fmt.Errorf("put job to outbox service, err=%w", err)
 		}
 
+		err = u.auditLog.Create(ctx, auditrepo.Record{
+			ManagerID: req.ManagerID,
+			Action:    auditrepo.ActionCloseChat,
+			ChatID:    req.ChatID,
+			ProblemID: problemID,
+			RequestID: req.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("audit log, create record, err=%w", err)
+		}
+
 		return nil
 	})
 	if err != nil {
@@ -5,6 +5,7 @@ import (
 	"io"
 	"testing"
 
+	auditrepo "github.com/karasunokami/chat-service/internal/repositories/audit"
 	messagesrepo "github.com/karasunokami/chat-service/internal/repositories/messages"
 	chatclosed "github.com/karasunokami/chat-service/internal/services/outbox/jobs/chat-closed"
 	"github.com/karasunokami/chat-service/internal/testingh"
@@ -24,6 +25,7 @@ type UseCaseSuite struct {
 	messagesRepoMock  *closechatmocks.MockmessagesRepo
 	outboxServiceMock *closechatmocks.MockoutboxService
 	transactorMock    *closechatmocks.Mocktransactor
+	auditLogMock      *closechatmocks.MockauditLog
 	uCase             closechat.UseCase
 }
 
@@ -38,6 +40,7 @@ func (s *UseCaseSuite) SetupTest() {
 	s.messagesRepoMock = closechatmocks.NewMockmessagesRepo(s.ctrl)
 	s.outboxServiceMock = closechatmocks.NewMockoutboxService(s.ctrl)
 	s.transactorMock = closechatmocks.NewMocktransactor(s.ctrl)
+	s.auditLogMock = closechatmocks.NewMockauditLog(s.ctrl)
 
 	var err error
 	s.uCase, err = closechat.New(closechat.NewOptions(
@@ -45,6 +48,7 @@ func (s *UseCaseSuite) SetupTest() {
 		s.problemsRepoMock,
 		s.messagesRepoMock,
 		s.transactorMock,
+		s.auditLogMock,
 	))
 	s.Require().NoError(err)
 
@@ -235,6 +239,42 @@ func (s *UseCaseSuite) TestOutboxPutError() {
 	s.ErrorIs(err, expectedError)
 }
 
+func (s *UseCaseSuite) TestAuditLogError() {
+	// Arrange.
+	reqID := types.NewRequestID()
+	managerID := types.NewUserID()
+	chatID := types.NewChatID()
+	problemID := types.NewProblemID()
+	msg := messagesrepo.Message{ID: types.NewMessageID()}
+
+	req := closechat.Request{
+		ID:        reqID,
+		ManagerID: managerID,
+		ChatID:    chatID,
+	}
+
+	expectedError := io.EOF
+
+	s.problemsRepoMock.EXPECT().GetAssignedProblemID(s.Ctx, managerID, chatID).Return(problemID, nil)
+	s.transactorMock.EXPECT().RunInTx(s.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+	s.problemsRepoMock.EXPECT().MarkProblemAsResolved(s.Ctx, problemID).Return(nil)
+	s.messagesRepoMock.EXPECT().CreateClientService(s.Ctx, problemID, chatID, gomock.Any()).
+		Return(&msg, nil)
+	s.outboxServiceMock.EXPECT().Put(s.Ctx, chatclosed.Name, gomock.Any(), gomock.Any()).
+		Return(types.NewJobID(), nil)
+	s.auditLogMock.EXPECT().Create(s.Ctx, gomock.Any()).Return(expectedError)
+
+	// Action.
+	err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, expectedError)
+}
+
 func (s *UseCaseSuite) TestSuccessStory() {
 	// Arrange.
 	reqID := types.NewRequestID()
@@ -266,6 +306,13 @@ func (s *UseCaseSuite) TestSuccessStory() {
 
 	s.outboxServiceMock.EXPECT().Put(s.Ctx, chatclosed.Name, payload, gomock.Any()).
 		Return(types.NewJobID(), nil)
+	s.auditLogMock.EXPECT().Create(s.Ctx, auditrepo.Record{
+		ManagerID: managerID,
+		Action:    auditrepo.ActionCloseChat,
+		ChatID:    chatID,
+		ProblemID: problemID,
+		RequestID: reqID,
+	}).Return(nil)
 
 	// Action.
 	err = s.uCase.Handle(s.Ctx, req)
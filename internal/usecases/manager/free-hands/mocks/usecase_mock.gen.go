@@ -9,9 +9,48 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	auditrepo "github.com/karasunokami/chat-service/internal/repositories/audit"
+	managerpresence "github.com/karasunokami/chat-service/internal/services/manager-presence"
 	types "github.com/karasunokami/chat-service/internal/types"
 )
 
+// MockmanagerPresence is a mock of managerPresence interface.
+type MockmanagerPresence struct {
+	ctrl     *gomock.Controller
+	recorder *MockmanagerPresenceMockRecorder
+}
+
+// MockmanagerPresenceMockRecorder is the mock recorder for MockmanagerPresence.
+type MockmanagerPresenceMockRecorder struct {
+	mock *MockmanagerPresence
+}
+
+// NewMockmanagerPresence creates a new mock instance.
+func NewMockmanagerPresence(ctrl *gomock.Controller) *MockmanagerPresence {
+	mock := &MockmanagerPresence{ctrl: ctrl}
+	mock.recorder = &MockmanagerPresenceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmanagerPresence) EXPECT() *MockmanagerPresenceMockRecorder {
+	return m.recorder
+}
+
+// Status mocks base method.
+func (m *MockmanagerPresence) Status(managerID types.UserID) managerpresence.Status {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Status", managerID)
+	ret0, _ := ret[0].(managerpresence.Status)
+	return ret0
+}
+
+// Status indicates an expected call of Status.
+func (mr *MockmanagerPresenceMockRecorder) Status(managerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Status", reflect.TypeOf((*MockmanagerPresence)(nil).Status), managerID)
+}
+
 // MockmanagerLoadService is a mock of managerLoadService interface.
 type MockmanagerLoadService struct {
 	ctrl     *gomock.Controller
@@ -86,3 +125,40 @@ func (mr *MockmanagerPoolMockRecorder) Put(ctx, managerID interface{}) *gomock.C
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Put", reflect.TypeOf((*MockmanagerPool)(nil).Put), ctx, managerID)
 }
+
+// MockauditLog is a mock of auditLog interface.
+type MockauditLog struct {
+	ctrl     *gomock.Controller
+	recorder *MockauditLogMockRecorder
+}
+
+// MockauditLogMockRecorder is the mock recorder for MockauditLog.
+type MockauditLogMockRecorder struct {
+	mock *MockauditLog
+}
+
+// NewMockauditLog creates a new mock instance.
+func NewMockauditLog(ctrl *gomock.Controller) *MockauditLog {
+	mock := &MockauditLog{ctrl: ctrl}
+	mock.recorder = &MockauditLogMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauditLog) EXPECT() *MockauditLogMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockauditLog) Create(ctx context.Context, rec auditrepo.Record) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, rec)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockauditLogMockRecorder) Create(ctx, rec interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockauditLog)(nil).Create), ctx, rec)
+}
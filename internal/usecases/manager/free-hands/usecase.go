@@ -5,16 +5,23 @@ import (
 	"errors"
 	"fmt"
 
+	auditrepo "github.com/karasunokami/chat-service/internal/repositories/audit"
+	managerpresence "github.com/karasunokami/chat-service/internal/services/manager-presence"
 	"github.com/karasunokami/chat-service/internal/types"
 )
 
 //go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=mocks
 
 var (
-	ErrInvalidRequest  = errors.New("invalid request")
-	ErrManagerOverload = errors.New("manager overload")
+	ErrInvalidRequest   = errors.New("invalid request")
+	ErrManagerOverload  = errors.New("manager overload")
+	ErrManagerNotOnline = errors.New("manager not online")
 )
 
+type managerPresence interface {
+	Status(managerID types.UserID) managerpresence.Status
+}
+
 type managerLoadService interface {
 	CanManagerTakeProblem(ctx context.Context, managerID types.UserID) (bool, error)
 }
@@ -23,10 +30,16 @@ type managerPool interface {
 	Put(ctx context.Context, managerID types.UserID) error
 }
 
+type auditLog interface {
+	Create(ctx context.Context, rec auditrepo.Record) error
+}
+
 //go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
 type Options struct {
 	managerLoadSvc managerLoadService `option:"mandatory" validate:"required"`
 	managerPool    managerPool        `option:"mandatory" validate:"required"`
+	auditLog       auditLog           `option:"mandatory" validate:"required"`
+	presence       managerPresence    `option:"mandatory" validate:"required"`
 }
 
 type UseCase struct {
@@ -47,6 +60,11 @@ func (u UseCase) Handle(ctx context.Context, req Request) error {
 		return fmt.Errorf("validate request, err=%w", ErrInvalidRequest)
 	}
 
+	// The away or disconnected manager would be removed from the pool by the presence service anyway.
+	if u.presence.Status(req.ManagerID) != managerpresence.StatusOnline {
+		return ErrManagerNotOnline
+	}
+
 	can, err := u.managerLoadSvc.CanManagerTakeProblem(ctx, req.ManagerID)
 	if err != nil {
 		return fmt.Errorf("managers load service can manager take problem, err=%v", err)
@@ -61,5 +79,15 @@ func (u UseCase) Handle(ctx context.Context, req Request) error {
 		return fmt.Errorf("put manager in managers pool, err=%v", err)
 	}
 
+	// The pool is not transactional, so the record is written after the manager is put into it.
+	err = u.auditLog.Create(ctx, auditrepo.Record{
+		ManagerID: req.ManagerID,
+		Action:    auditrepo.ActionFreeHands,
+		RequestID: req.ID,
+	})
+	if err != nil {
+		return fmt.Errorf("audit log, create record, err=%v", err)
+	}
+
 	return nil
 }
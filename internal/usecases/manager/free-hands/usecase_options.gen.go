@@ -13,6 +13,8 @@ type OptOptionsSetter func(o *Options)
 func NewOptions(
 	managerLoadSvc managerLoadService,
 	managerPool managerPool,
+	auditLog auditLog,
+	presence managerPresence,
 	options ...OptOptionsSetter,
 ) Options {
 	o := Options{}
@@ -21,6 +23,8 @@ func NewOptions(
 
 	o.managerLoadSvc = managerLoadSvc
 	o.managerPool = managerPool
+	o.auditLog = auditLog
+	o.presence = presence
 
 	for _, opt := range options {
 		opt(&o)
@@ -32,6 +36,8 @@ func (o *Options) Validate() error {
 	errs := new(errors461e464ebed9.ValidationErrors)
 	errs.Add(errors461e464ebed9.NewValidationError("managerLoadSvc", _validate_Options_managerLoadSvc(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("managerPool", _validate_Options_managerPool(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("auditLog", _validate_Options_auditLog(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("presence", _validate_Options_presence(o)))
 	return errs.AsError()
 }
 
@@ -48,3 +54,17 @@ func _validate_Options_managerPool(o *Options) error {
 	}
 	return nil
 }
+
+func _validate_Options_auditLog(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.auditLog, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `auditLog` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_presence(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.presence, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `presence` did not pass the test: %w", err)
+	}
+	return nil
+}
@@ -4,6 +4,8 @@ import (
 	"errors"
 	"testing"
 
+	auditrepo "github.com/karasunokami/chat-service/internal/repositories/audit"
+	managerpresence "github.com/karasunokami/chat-service/internal/services/manager-presence"
 	"github.com/karasunokami/chat-service/internal/testingh"
 	"github.com/karasunokami/chat-service/internal/types"
 	freehands "github.com/karasunokami/chat-service/internal/usecases/manager/free-hands"
@@ -19,6 +21,8 @@ type UseCaseSuite struct {
 	ctrl      *gomock.Controller
 	mLoadMock *freehandsmocks.MockmanagerLoadService
 	mPoolMock *freehandsmocks.MockmanagerPool
+	auditMock *freehandsmocks.MockauditLog
+	presence  *freehandsmocks.MockmanagerPresence
 	uCase     freehands.UseCase
 }
 
@@ -31,9 +35,12 @@ func (s *UseCaseSuite) SetupTest() {
 	s.ctrl = gomock.NewController(s.T())
 	s.mLoadMock = freehandsmocks.NewMockmanagerLoadService(s.ctrl)
 	s.mPoolMock = freehandsmocks.NewMockmanagerPool(s.ctrl)
+	s.auditMock = freehandsmocks.NewMockauditLog(s.ctrl)
+	s.presence = freehandsmocks.NewMockmanagerPresence(s.ctrl)
+	s.presence.EXPECT().Status(gomock.Any()).Return(managerpresence.StatusOnline).AnyTimes()
 
 	var err error
-	s.uCase, err = freehands.New(freehands.NewOptions(s.mLoadMock, s.mPoolMock))
+	s.uCase, err = freehands.New(freehands.NewOptions(s.mLoadMock, s.mPoolMock, s.auditMock, s.presence))
 	s.Require().NoError(err)
 
 	s.ContextSuite.SetupTest()
@@ -57,6 +64,29 @@ func (s *UseCaseSuite) TestInvalidRequest() {
 	s.ErrorIs(err, freehands.ErrInvalidRequest)
 }
 
+func (s *UseCaseSuite) TestManagerNotOnline() {
+	for _, status := range []managerpresence.Status{managerpresence.StatusAway, managerpresence.StatusOffline} {
+		s.Run(string(status), func() {
+			// Arrange.
+			presence := freehandsmocks.NewMockmanagerPresence(s.ctrl)
+			uCase, err := freehands.New(freehands.NewOptions(s.mLoadMock, s.mPoolMock, s.auditMock, presence))
+			s.Require().NoError(err)
+
+			managerID := types.NewUserID()
+			presence.EXPECT().Status(managerID).Return(status)
+
+			// Action.
+			err = uCase.Handle(s.Ctx, freehands.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: managerID,
+			})
+
+			// Assert.
+			s.Require().ErrorIs(err, freehands.ErrManagerNotOnline)
+		})
+	}
+}
+
 func (s *UseCaseSuite) TestCanManagerTakeProblemError() {
 	// Arrange.
 	managerID := types.NewUserID()
@@ -110,6 +140,25 @@ func (s *UseCaseSuite) TestManagerPoolPutError() {
 	s.Require().Error(err)
 }
 
+func (s *UseCaseSuite) TestAuditLogError() {
+	// Arrange.
+	managerID := types.NewUserID()
+	req := freehands.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: managerID,
+	}
+
+	s.mLoadMock.EXPECT().CanManagerTakeProblem(s.Ctx, managerID).Return(true, nil)
+	s.mPoolMock.EXPECT().Put(s.Ctx, managerID).Return(nil)
+	s.auditMock.EXPECT().Create(s.Ctx, gomock.Any()).Return(errors.New("error"))
+
+	// Action.
+	err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+}
+
 func (s *UseCaseSuite) TestSuccess() {
 	// Arrange.
 	managerID := types.NewUserID()
@@ -120,6 +169,11 @@ func (s *UseCaseSuite) TestSuccess() {
 
 	s.mLoadMock.EXPECT().CanManagerTakeProblem(s.Ctx, managerID).Return(true, nil)
 	s.mPoolMock.EXPECT().Put(s.Ctx, managerID).Return(nil)
+	s.auditMock.EXPECT().Create(s.Ctx, auditrepo.Record{
+		ManagerID: managerID,
+		Action:    auditrepo.ActionFreeHands,
+		RequestID: req.ID,
+	}).Return(nil)
 
 	// Action.
 	err := s.uCase.Handle(s.Ctx, req)
@@ -35,10 +35,11 @@ type Response struct {
 }
 
 type Message struct {
-	ID        types.MessageID
-	AuthorID  types.UserID
-	Body      string
-	CreatedAt time.Time
+	ID             types.MessageID
+	AuthorID       types.UserID
+	Body           string
+	CreatedAt      time.Time
+	IsInternalNote bool
 }
 
 func adoptMessages(messages []messagesrepo.Message) []Message {
@@ -53,9 +54,10 @@ func adoptMessages(messages []messagesrepo.Message) []Message {
 
 func adoptMessage(m messagesrepo.Message) Message {
 	return Message{
-		ID:        m.ID,
-		AuthorID:  m.AuthorID,
-		Body:      m.Body,
-		CreatedAt: m.CreatedAt,
+		ID:             m.ID,
+		AuthorID:       m.AuthorID,
+		Body:           m.Body,
+		CreatedAt:      m.CreatedAt,
+		IsInternalNote: m.IsInternalNote,
 	}
 }
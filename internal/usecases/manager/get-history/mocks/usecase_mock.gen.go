@@ -9,6 +9,7 @@ import (
 	reflect "reflect"
 
 	gomock "github.com/golang/mock/gomock"
+	auditrepo "github.com/karasunokami/chat-service/internal/repositories/audit"
 	messagesrepo "github.com/karasunokami/chat-service/internal/repositories/messages"
 	types "github.com/karasunokami/chat-service/internal/types"
 )
@@ -51,3 +52,77 @@ func (mr *MockmessagesRepositoryMockRecorder) GetManagerChatMessages(ctx, chatID
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagerChatMessages", reflect.TypeOf((*MockmessagesRepository)(nil).GetManagerChatMessages), ctx, chatID, managerID, pageSize, cursor)
 }
+
+// MockauditLog is a mock of auditLog interface.
+type MockauditLog struct {
+	ctrl     *gomock.Controller
+	recorder *MockauditLogMockRecorder
+}
+
+// MockauditLogMockRecorder is the mock recorder for MockauditLog.
+type MockauditLogMockRecorder struct {
+	mock *MockauditLog
+}
+
+// NewMockauditLog creates a new mock instance.
+func NewMockauditLog(ctrl *gomock.Controller) *MockauditLog {
+	mock := &MockauditLog{ctrl: ctrl}
+	mock.recorder = &MockauditLogMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauditLog) EXPECT() *MockauditLogMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockauditLog) Create(ctx context.Context, rec auditrepo.Record) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, rec)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockauditLogMockRecorder) Create(ctx, rec interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockauditLog)(nil).Create), ctx, rec)
+}
+
+// Mocktransactor is a mock of transactor interface.
+type Mocktransactor struct {
+	ctrl     *gomock.Controller
+	recorder *MocktransactorMockRecorder
+}
+
+// MocktransactorMockRecorder is the mock recorder for Mocktransactor.
+type MocktransactorMockRecorder struct {
+	mock *Mocktransactor
+}
+
+// NewMocktransactor creates a new mock instance.
+func NewMocktransactor(ctrl *gomock.Controller) *Mocktransactor {
+	mock := &Mocktransactor{ctrl: ctrl}
+	mock.recorder = &MocktransactorMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *Mocktransactor) EXPECT() *MocktransactorMockRecorder {
+	return m.recorder
+}
+
+// RunInTx mocks base method.
+func (m *Mocktransactor) RunInTx(ctx context.Context, f func(context.Context) error) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "RunInTx", ctx, f)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// RunInTx indicates an expected call of RunInTx.
+func (mr *MocktransactorMockRecorder) RunInTx(ctx, f interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RunInTx", reflect.TypeOf((*Mocktransactor)(nil).RunInTx), ctx, f)
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 
 	"github.com/karasunokami/chat-service/internal/cursor"
+	auditrepo "github.com/karasunokami/chat-service/internal/repositories/audit"
 	messagesrepo "github.com/karasunokami/chat-service/internal/repositories/messages"
 	"github.com/karasunokami/chat-service/internal/types"
 )
@@ -27,9 +28,19 @@ type messagesRepository interface {
 	) ([]messagesrepo.Message, *messagesrepo.Cursor, error)
 }
 
+type auditLog interface {
+	Create(ctx context.Context, rec auditrepo.Record) error
+}
+
+type transactor interface {
+	RunInTx(ctx context.Context, f func(context.Context) error) error
+}
+
 //go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
 type Options struct {
-	msgRepo messagesRepository `option:"mandatory" validate:"required"`
+	msgRepo  messagesRepository `option:"mandatory" validate:"required"`
+	auditLog auditLog           `option:"mandatory" validate:"required"`
+	txtor    transactor         `option:"mandatory" validate:"required"`
 }
 
 type UseCase struct {
@@ -57,13 +68,35 @@ func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
 		}
 	}
 
-	msgs, nextCrs, err := u.msgRepo.GetManagerChatMessages(ctx, req.ChatID, req.ManagerID, req.PageSize, crs)
-	if err != nil {
-		if errors.Is(err, messagesrepo.ErrInvalidCursor) {
-			return Response{}, fmt.Errorf("get client chat messages, err=%w, err=%v", ErrInvalidCursor, err)
+	var (
+		msgs    []messagesrepo.Message
+		nextCrs *messagesrepo.Cursor
+	)
+
+	err = u.txtor.RunInTx(ctx, func(ctx context.Context) error {
+		msgs, nextCrs, err = u.msgRepo.GetManagerChatMessages(ctx, req.ChatID, req.ManagerID, req.PageSize, crs)
+		if err != nil {
+			if errors.Is(err, messagesrepo.ErrInvalidCursor) {
+				return fmt.Errorf("get client chat messages, err=%w, err=%v", ErrInvalidCursor, err)
+			}
+
+			return fmt.Errorf("get client chat messages, err=%w", err)
+		}
+
+		err = u.auditLog.Create(ctx, auditrepo.Record{
+			ManagerID: req.ManagerID,
+			Action:    auditrepo.ActionGetChatHistory,
+			ChatID:    req.ChatID,
+			RequestID: req.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("audit log, create record, err=%w", err)
 		}
 
-		return Response{}, fmt.Errorf("get client chat messages, err=%w", err)
+		return nil
+	})
+	if err != nil {
+		return Response{}, fmt.Errorf("get history in transaction, err=%w", err)
 	}
 
 	return formatResp(msgs, nextCrs)
@@ -12,6 +12,8 @@ type OptOptionsSetter func(o *Options)
 
 func NewOptions(
 	msgRepo messagesRepository,
+	auditLog auditLog,
+	txtor transactor,
 	options ...OptOptionsSetter,
 ) Options {
 	o := Options{}
@@ -19,6 +21,8 @@ func NewOptions(
 	// Setting defaults from field tag (if present)
 
 	o.msgRepo = msgRepo
+	o.auditLog = auditLog
+	o.txtor = txtor
 
 	for _, opt := range options {
 		opt(&o)
@@ -29,6 +33,8 @@ func NewOptions(
 func (o *Options) Validate() error {
 	errs := new(errors461e464ebed9.ValidationErrors)
 	errs.Add(errors461e464ebed9.NewValidationError("msgRepo", _validate_Options_msgRepo(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("auditLog", _validate_Options_auditLog(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("txtor", _validate_Options_txtor(o)))
 	return errs.AsError()
 }
 
@@ -38,3 +44,17 @@ func _validate_Options_msgRepo(o *Options) error {
 	}
 	return nil
 }
+
+func _validate_Options_auditLog(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.auditLog, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `auditLog` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_txtor(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.txtor, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `txtor` did not pass the test: %w", err)
+	}
+	return nil
+}
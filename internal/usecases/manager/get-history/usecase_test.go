@@ -1,11 +1,13 @@
 package gethistory_test
 
 import (
+	"context"
 	"errors"
 	"testing"
 	"time"
 
 	"github.com/karasunokami/chat-service/internal/cursor"
+	auditrepo "github.com/karasunokami/chat-service/internal/repositories/audit"
 	messagesrepo "github.com/karasunokami/chat-service/internal/repositories/messages"
 	"github.com/karasunokami/chat-service/internal/testingh"
 	"github.com/karasunokami/chat-service/internal/types"
@@ -20,9 +22,11 @@ import (
 type UseCaseSuite struct {
 	testingh.ContextSuite
 
-	ctrl    *gomock.Controller
-	msgRepo *gethistorymocks.MockmessagesRepository
-	uCase   gethistory.UseCase
+	ctrl     *gomock.Controller
+	msgRepo  *gethistorymocks.MockmessagesRepository
+	auditLog *gethistorymocks.MockauditLog
+	txtor    *gethistorymocks.Mocktransactor
+	uCase    gethistory.UseCase
 }
 
 func TestUseCaseSuite(t *testing.T) {
@@ -33,9 +37,11 @@ func TestUseCaseSuite(t *testing.T) {
 func (s *UseCaseSuite) SetupTest() {
 	s.ctrl = gomock.NewController(s.T())
 	s.msgRepo = gethistorymocks.NewMockmessagesRepository(s.ctrl)
+	s.auditLog = gethistorymocks.NewMockauditLog(s.ctrl)
+	s.txtor = gethistorymocks.NewMocktransactor(s.ctrl)
 
 	var err error
-	s.uCase, err = gethistory.New(gethistory.NewOptions(s.msgRepo))
+	s.uCase, err = gethistory.New(gethistory.NewOptions(s.msgRepo, s.auditLog, s.txtor))
 	s.Require().NoError(err)
 
 	s.ContextSuite.SetupTest()
@@ -89,6 +95,7 @@ func (s *UseCaseSuite) TestGetManagerChatMessages_InvalidCursor() {
 	cursorWithNegativePageSize, err := cursor.Encode(c)
 	s.Require().NoError(err)
 
+	s.expectTx()
 	s.msgRepo.EXPECT().GetManagerChatMessages(s.Ctx, chatID, managerID, 0, messagesrepo.NewCursorMatcher(c)).
 		Return(nil, nil, messagesrepo.ErrInvalidCursor)
 
@@ -116,6 +123,7 @@ func (s *UseCaseSuite) TestGetManagerChatMessages_SomeError() {
 	chatID := types.NewChatID()
 	errExpected := errors.New("any error")
 
+	s.expectTx()
 	s.msgRepo.EXPECT().GetManagerChatMessages(s.Ctx, chatID, managerID, 20, (*messagesrepo.Cursor)(nil)).
 		Return(nil, nil, errExpected)
 
@@ -135,6 +143,33 @@ func (s *UseCaseSuite) TestGetManagerChatMessages_SomeError() {
 	s.Empty(resp.NextCursor)
 }
 
+func (s *UseCaseSuite) TestAuditLogError() {
+	// Arrange.
+	managerID := types.NewUserID()
+	chatID := types.NewChatID()
+	errExpected := errors.New("any error")
+
+	s.expectTx()
+	s.msgRepo.EXPECT().GetManagerChatMessages(s.Ctx, chatID, managerID, 20, (*messagesrepo.Cursor)(nil)).
+		Return(s.createMessages(1, types.NewUserID(), chatID), nil, nil)
+	s.auditLog.EXPECT().Create(s.Ctx, gomock.Any()).Return(errExpected)
+
+	req := gethistory.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: managerID,
+		ChatID:    chatID,
+		PageSize:  20,
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, errExpected)
+	s.Empty(resp.Messages)
+}
+
 func (s *UseCaseSuite) TestGetManagerChatMessages_Success_SinglePage() {
 	// Arrange.
 	const messagesCount = 10
@@ -160,6 +195,7 @@ func (s *UseCaseSuite) TestGetManagerChatMessages_Success_SinglePage() {
 		expectedMsgs[2].IsVisibleForManager = false
 	}
 
+	s.expectTx()
 	s.msgRepo.EXPECT().GetManagerChatMessages(s.Ctx, chatID, managerID, pageSize, (*messagesrepo.Cursor)(nil)).
 		Return(expectedMsgs, nil, nil)
 
@@ -170,6 +206,13 @@ func (s *UseCaseSuite) TestGetManagerChatMessages_Success_SinglePage() {
 		PageSize:  pageSize,
 	}
 
+	s.auditLog.EXPECT().Create(s.Ctx, auditrepo.Record{
+		ManagerID: managerID,
+		Action:    auditrepo.ActionGetChatHistory,
+		ChatID:    chatID,
+		RequestID: req.ID,
+	}).Return(nil)
+
 	// Action.
 	resp, err := s.uCase.Handle(s.Ctx, req)
 	s.Require().NoError(err)
@@ -198,8 +241,10 @@ func (s *UseCaseSuite) TestGetManagerChatMessages_Success_FirstPage() {
 	lastMsg := expectedMsgs[len(expectedMsgs)-1]
 
 	nextCursor := &messagesrepo.Cursor{PageSize: pageSize, LastCreatedAt: lastMsg.CreatedAt}
+	s.expectTx()
 	s.msgRepo.EXPECT().GetManagerChatMessages(s.Ctx, chatID, managerID, pageSize, (*messagesrepo.Cursor)(nil)).
 		Return(expectedMsgs, nextCursor, nil)
+	s.auditLog.EXPECT().Create(s.Ctx, gomock.Any()).Return(nil)
 
 	req := gethistory.Request{
 		ID:        types.NewRequestID(),
@@ -228,8 +273,10 @@ func (s *UseCaseSuite) TestGetManagerChatMessages_Success_LastPage() {
 	expectedMsgs := s.createMessages(messagesCount, clientID, chatID)
 
 	c := messagesrepo.Cursor{PageSize: pageSize, LastCreatedAt: time.Now()}
+	s.expectTx()
 	s.msgRepo.EXPECT().GetManagerChatMessages(s.Ctx, chatID, managerID, 0, messagesrepo.NewCursorMatcher(c)).
 		Return(expectedMsgs, nil, nil)
+	s.auditLog.EXPECT().Create(s.Ctx, gomock.Any()).Return(nil)
 
 	cursorStr, err := cursor.Encode(c)
 	s.Require().NoError(err)
@@ -269,3 +316,10 @@ func (s *UseCaseSuite) createMessages(count int, authorID types.UserID, chatID t
 	}
 	return result
 }
+
+func (s *UseCaseSuite) expectTx() {
+	s.txtor.EXPECT().RunInTx(s.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+}
@@ -0,0 +1,29 @@
+package getscheduledmessages
+
+import (
+	"time"
+
+	"github.com/karasunokami/chat-service/internal/types"
+	"github.com/karasunokami/chat-service/internal/validator"
+)
+
+type Request struct {
+	ID        types.RequestID `validate:"required"`
+	ManagerID types.UserID    `validate:"required"`
+}
+
+func (r Request) Validate() error {
+	return validator.Validator.Struct(r)
+}
+
+type Response struct {
+	Messages []Message
+}
+
+type Message struct {
+	ID        types.ScheduledMessageID
+	ChatID    types.ChatID
+	Body      string
+	DeliverAt time.Time
+	CreatedAt time.Time
+}
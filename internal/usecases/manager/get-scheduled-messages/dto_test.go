@@ -0,0 +1,57 @@
+package getscheduledmessages_test
+
+import (
+	"testing"
+
+	"github.com/karasunokami/chat-service/internal/types"
+	getscheduledmessages "github.com/karasunokami/chat-service/internal/usecases/manager/get-scheduled-messages"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequest_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		request getscheduledmessages.Request
+		wantErr bool
+	}{
+		// Positive.
+		{
+			name: "valid request",
+			request: getscheduledmessages.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+			},
+			wantErr: false,
+		},
+
+		// Negative.
+		{
+			name: "require request id",
+			request: getscheduledmessages.Request{
+				ID:        types.RequestIDNil,
+				ManagerID: types.NewUserID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "require manager id",
+			request: getscheduledmessages.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.UserIDNil,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,52 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+
+// Package getscheduledmessagesmocks is a generated GoMock package.
+package getscheduledmessagesmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	scheduledmessagesrepo "github.com/karasunokami/chat-service/internal/repositories/scheduledmessages"
+	types "github.com/karasunokami/chat-service/internal/types"
+)
+
+// MockscheduledMessagesRepository is a mock of scheduledMessagesRepository interface.
+type MockscheduledMessagesRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockscheduledMessagesRepositoryMockRecorder
+}
+
+// MockscheduledMessagesRepositoryMockRecorder is the mock recorder for MockscheduledMessagesRepository.
+type MockscheduledMessagesRepositoryMockRecorder struct {
+	mock *MockscheduledMessagesRepository
+}
+
+// NewMockscheduledMessagesRepository creates a new mock instance.
+func NewMockscheduledMessagesRepository(ctrl *gomock.Controller) *MockscheduledMessagesRepository {
+	mock := &MockscheduledMessagesRepository{ctrl: ctrl}
+	mock.recorder = &MockscheduledMessagesRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockscheduledMessagesRepository) EXPECT() *MockscheduledMessagesRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetManagerMessages mocks base method.
+func (m *MockscheduledMessagesRepository) GetManagerMessages(ctx context.Context, managerID types.UserID) ([]scheduledmessagesrepo.Message, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetManagerMessages", ctx, managerID)
+	ret0, _ := ret[0].([]scheduledmessagesrepo.Message)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetManagerMessages indicates an expected call of GetManagerMessages.
+func (mr *MockscheduledMessagesRepositoryMockRecorder) GetManagerMessages(ctx, managerID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetManagerMessages", reflect.TypeOf((*MockscheduledMessagesRepository)(nil).GetManagerMessages), ctx, managerID)
+}
@@ -0,0 +1,59 @@
+package getscheduledmessages
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	scheduledmessagesrepo "github.com/karasunokami/chat-service/internal/repositories/scheduledmessages"
+	"github.com/karasunokami/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=getscheduledmessagesmocks
+
+var ErrInvalidRequest = errors.New("invalid request")
+
+type scheduledMessagesRepository interface {
+	GetManagerMessages(ctx context.Context, managerID types.UserID) ([]scheduledmessagesrepo.Message, error)
+}
+
+//go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
+type Options struct {
+	scheduledMessagesRepository scheduledMessagesRepository `option:"mandatory" validate:"required"`
+}
+
+type UseCase struct {
+	Options
+}
+
+func New(opts Options) (UseCase, error) {
+	if err := opts.Validate(); err != nil {
+		return UseCase{}, fmt.Errorf("validate options, err=%v", err)
+	}
+
+	return UseCase{opts}, nil
+}
+
+func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
+	if err := req.Validate(); err != nil {
+		return Response{}, ErrInvalidRequest
+	}
+
+	msgs, err := u.scheduledMessagesRepository.GetManagerMessages(ctx, req.ManagerID)
+	if err != nil {
+		return Response{}, fmt.Errorf("get manager scheduled messages, err=%w", err)
+	}
+
+	result := make([]Message, 0, len(msgs))
+	for _, m := range msgs {
+		result = append(result, Message{
+			ID:        m.ID,
+			ChatID:    m.ChatID,
+			Body:      m.Body,
+			DeliverAt: m.DeliverAt,
+			CreatedAt: m.CreatedAt,
+		})
+	}
+
+	return Response{Messages: result}, nil
+}
@@ -0,0 +1,40 @@
+// Code generated by options-gen. DO NOT EDIT.
+package getscheduledmessages
+
+import (
+	fmt461e464ebed9 "fmt"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	scheduledMessagesRepository scheduledMessagesRepository,
+	options ...OptOptionsSetter,
+) Options {
+	o := Options{}
+
+	// Setting defaults from field tag (if present)
+
+	o.scheduledMessagesRepository = scheduledMessagesRepository
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("scheduledMessagesRepository", _validate_Options_scheduledMessagesRepository(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_scheduledMessagesRepository(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.scheduledMessagesRepository, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `scheduledMessagesRepository` did not pass the test: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,107 @@
+package getscheduledmessages_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	scheduledmessagesrepo "github.com/karasunokami/chat-service/internal/repositories/scheduledmessages"
+	"github.com/karasunokami/chat-service/internal/testingh"
+	"github.com/karasunokami/chat-service/internal/types"
+	getscheduledmessages "github.com/karasunokami/chat-service/internal/usecases/manager/get-scheduled-messages"
+	getscheduledmessagesmocks "github.com/karasunokami/chat-service/internal/usecases/manager/get-scheduled-messages/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl          *gomock.Controller
+	scheduledRepo *getscheduledmessagesmocks.MockscheduledMessagesRepository
+	uCase         getscheduledmessages.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.scheduledRepo = getscheduledmessagesmocks.NewMockscheduledMessagesRepository(s.ctrl)
+
+	var err error
+	s.uCase, err = getscheduledmessages.New(getscheduledmessages.NewOptions(s.scheduledRepo))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Arrange.
+	req := getscheduledmessages.Request{}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, getscheduledmessages.ErrInvalidRequest)
+	s.Empty(resp.Messages)
+}
+
+func (s *UseCaseSuite) TestRepoError() {
+	// Arrange.
+	req := getscheduledmessages.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: types.NewUserID(),
+	}
+	s.scheduledRepo.EXPECT().GetManagerMessages(s.Ctx, req.ManagerID).Return(nil, errors.New("unexpected"))
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.Empty(resp.Messages)
+}
+
+func (s *UseCaseSuite) TestSuccess() {
+	// Arrange.
+	req := getscheduledmessages.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: types.NewUserID(),
+	}
+	msg := scheduledmessagesrepo.Message{
+		ID:        types.NewScheduledMessageID(),
+		ChatID:    types.NewChatID(),
+		ManagerID: req.ManagerID,
+		Body:      "Did it help?",
+		DeliverAt: time.Now().Add(time.Hour),
+		CreatedAt: time.Now(),
+	}
+	s.scheduledRepo.EXPECT().GetManagerMessages(s.Ctx, req.ManagerID).
+		Return([]scheduledmessagesrepo.Message{msg}, nil)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Require().Len(resp.Messages, 1)
+	s.Equal(getscheduledmessages.Message{
+		ID:        msg.ID,
+		ChatID:    msg.ChatID,
+		Body:      msg.Body,
+		DeliverAt: msg.DeliverAt,
+		CreatedAt: msg.CreatedAt,
+	}, resp.Messages[0])
+}
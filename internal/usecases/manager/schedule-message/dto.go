@@ -0,0 +1,26 @@
+package schedulemessage
+
+import (
+	"time"
+
+	"github.com/karasunokami/chat-service/internal/types"
+	"github.com/karasunokami/chat-service/internal/validator"
+)
+
+type Request struct {
+	ID          types.RequestID `validate:"required"`
+	ManagerID   types.UserID    `validate:"required"`
+	ChatID      types.ChatID    `validate:"required"`
+	MessageBody string          `validate:"required,max=3000"`
+	DeliverAt   time.Time       `validate:"required"`
+}
+
+func (r Request) Validate() error {
+	return validator.Validator.Struct(r)
+}
+
+type Response struct {
+	ScheduledMessageID types.ScheduledMessageID
+	DeliverAt          time.Time
+	CreatedAt          time.Time
+}
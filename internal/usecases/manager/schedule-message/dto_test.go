@@ -0,0 +1,113 @@
+package schedulemessage_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/karasunokami/chat-service/internal/types"
+	schedulemessage "github.com/karasunokami/chat-service/internal/usecases/manager/schedule-message"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequest_Validate(t *testing.T) {
+	deliverAt := time.Now().Add(time.Hour)
+
+	cases := []struct {
+		name    string
+		request schedulemessage.Request
+		wantErr bool
+	}{
+		// Positive.
+		{
+			name: "valid request",
+			request: schedulemessage.Request{
+				ID:          types.NewRequestID(),
+				ManagerID:   types.NewUserID(),
+				ChatID:      types.NewChatID(),
+				MessageBody: "Did it help?",
+				DeliverAt:   deliverAt,
+			},
+			wantErr: false,
+		},
+
+		// Negative.
+		{
+			name: "require request id",
+			request: schedulemessage.Request{
+				ID:          types.RequestIDNil,
+				ManagerID:   types.NewUserID(),
+				ChatID:      types.NewChatID(),
+				MessageBody: "Did it help?",
+				DeliverAt:   deliverAt,
+			},
+			wantErr: true,
+		},
+		{
+			name: "require manager id",
+			request: schedulemessage.Request{
+				ID:          types.NewRequestID(),
+				ManagerID:   types.UserIDNil,
+				ChatID:      types.NewChatID(),
+				MessageBody: "Did it help?",
+				DeliverAt:   deliverAt,
+			},
+			wantErr: true,
+		},
+		{
+			name: "require chat id",
+			request: schedulemessage.Request{
+				ID:          types.NewRequestID(),
+				ManagerID:   types.NewUserID(),
+				ChatID:      types.ChatIDNil,
+				MessageBody: "Did it help?",
+				DeliverAt:   deliverAt,
+			},
+			wantErr: true,
+		},
+		{
+			name: "require message body",
+			request: schedulemessage.Request{
+				ID:          types.NewRequestID(),
+				ManagerID:   types.NewUserID(),
+				ChatID:      types.NewChatID(),
+				MessageBody: "",
+				DeliverAt:   deliverAt,
+			},
+			wantErr: true,
+		},
+		{
+			name: "too big message body",
+			request: schedulemessage.Request{
+				ID:          types.NewRequestID(),
+				ManagerID:   types.NewUserID(),
+				ChatID:      types.NewChatID(),
+				MessageBody: strings.Repeat("x", 3001),
+				DeliverAt:   deliverAt,
+			},
+			wantErr: true,
+		},
+		{
+			name: "require deliver at",
+			request: schedulemessage.Request{
+				ID:          types.NewRequestID(),
+				ManagerID:   types.NewUserID(),
+				ChatID:      types.NewChatID(),
+				MessageBody: "Did it help?",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
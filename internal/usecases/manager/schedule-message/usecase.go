@@ -0,0 +1,141 @@
+package schedulemessage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	problemsrepo "github.com/karasunokami/chat-service/internal/repositories/problems"
+	scheduledmessagesrepo "github.com/karasunokami/chat-service/internal/repositories/scheduledmessages"
+	sendscheduledmessagejob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/send-scheduled-message"
+	"github.com/karasunokami/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=schedulemessagemocks
+
+var (
+	ErrInvalidRequest   = errors.New("invalid request")
+	ErrProblemNotFound  = errors.New("problem not found")
+	ErrDeliverAtInPast  = errors.New("deliver at is in the past")
+	ErrDeliverAtTooLate = errors.New("deliver at is too far in the future")
+)
+
+// maxScheduleAhead limits how far a message can be postponed.
+const maxScheduleAhead = 30 * 24 * time.Hour
+
+type scheduledMessagesRepository interface {
+	GetByRequestID(ctx context.Context, reqID types.RequestID) (*scheduledmessagesrepo.Message, error)
+	Create(
+		ctx context.Context,
+		reqID types.RequestID,
+		problemID types.ProblemID,
+		chatID types.ChatID,
+		managerID types.UserID,
+		msgBody string,
+		deliverAt time.Time,
+	) (*scheduledmessagesrepo.Message, error)
+}
+
+type outboxService interface {
+	Put(ctx context.Context, name, payload string, availableAt time.Time) (types.JobID, error)
+}
+
+type problemsRepository interface {
+	GetAssignedProblemID(ctx context.Context, managerID types.UserID, chatID types.ChatID) (types.ProblemID, error)
+}
+
+type transactor interface {
+	RunInTx(ctx context.Context, f func(context.Context) error) error
+}
+
+//go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
+type Options struct {
+	scheduledMessagesRepository scheduledMessagesRepository `option:"mandatory" validate:"required"`
+	outboxService               outboxService               `option:"mandatory" validate:"required"`
+	problemsRepository          problemsRepository          `option:"mandatory" validate:"required"`
+	txtor                       transactor                  `option:"mandatory" validate:"required"`
+}
+
+type UseCase struct {
+	Options
+}
+
+func New(opts Options) (UseCase, error) {
+	if err := opts.Validate(); err != nil {
+		return UseCase{}, fmt.Errorf("validate options, err=%v", err)
+	}
+
+	return UseCase{opts}, nil
+}
+
+func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
+	if err := req.Validate(); err != nil {
+		return Response{}, ErrInvalidRequest
+	}
+
+	now := time.Now()
+	if !req.DeliverAt.After(now) {
+		return Response{}, ErrDeliverAtInPast
+	}
+	if req.DeliverAt.After(now.Add(maxScheduleAhead)) {
+		return Response{}, ErrDeliverAtTooLate
+	}
+
+	problemID, err := u.problemsRepository.GetAssignedProblemID(ctx, req.ManagerID, req.ChatID)
+	if err != nil {
+		if errors.Is(err, problemsrepo.ErrNotFound) {
+			return Response{}, ErrProblemNotFound
+		}
+
+		return Response{}, fmt.Errorf("problems repository, get assigned problem id, err=%v", err)
+	}
+
+	var msg *scheduledmessagesrepo.Message
+
+	err = u.txtor.RunInTx(ctx, func(ctx context.Context) error {
+		msg, err = u.scheduledMessagesRepository.GetByRequestID(ctx, req.ID)
+		if err != nil && !errors.Is(err, scheduledmessagesrepo.ErrMsgNotFound) {
+			return fmt.Errorf("scheduled messages repository, get by request id, err=%w", err)
+		}
+
+		if msg != nil {
+			// The request is retried, the message is already scheduled.
+			return nil
+		}
+
+		msg, err = u.scheduledMessagesRepository.Create(
+			ctx,
+			req.ID,
+			problemID,
+			req.ChatID,
+			req.ManagerID,
+			req.MessageBody,
+			req.DeliverAt,
+		)
+		if err != nil {
+			return fmt.Errorf("scheduled messages repository, create, err=%w", err)
+		}
+
+		pl, err := sendscheduledmessagejob.MarshalPayload(msg.ID)
+		if err != nil {
+			return fmt.Errorf("marshal send scheduled message payload, err=%w", err)
+		}
+
+		_, err = u.outboxService.Put(ctx, sendscheduledmessagejob.Name, pl, msg.DeliverAt)
+		if err != nil {
+			return fmt.Errorf("put send scheduled message job to outbox service, err=%w", err)
+		}
+
+		return nil
+	})
+	if err != nil {
+		return Response{}, fmt.Errorf("create scheduled message in transaction, err=%w", err)
+	}
+
+	return Response{
+		ScheduledMessageID: msg.ID,
+		DeliverAt:          msg.DeliverAt,
+		CreatedAt:          msg.CreatedAt,
+	}, nil
+}
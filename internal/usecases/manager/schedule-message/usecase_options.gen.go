@@ -0,0 +1,70 @@
+// Code generated by options-gen. DO NOT EDIT.
+package schedulemessage
+
+import (
+	fmt461e464ebed9 "fmt"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	scheduledMessagesRepository scheduledMessagesRepository,
+	outboxService outboxService,
+	problemsRepository problemsRepository,
+	txtor transactor,
+	options ...OptOptionsSetter,
+) Options {
+	o := Options{}
+
+	// Setting defaults from field tag (if present)
+
+	o.scheduledMessagesRepository = scheduledMessagesRepository
+	o.outboxService = outboxService
+	o.problemsRepository = problemsRepository
+	o.txtor = txtor
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("scheduledMessagesRepository", _validate_Options_scheduledMessagesRepository(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("outboxService", _validate_Options_outboxService(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("problemsRepository", _validate_Options_problemsRepository(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("txtor", _validate_Options_txtor(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_scheduledMessagesRepository(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.scheduledMessagesRepository, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `scheduledMessagesRepository` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_outboxService(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.outboxService, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `outboxService` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_problemsRepository(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.problemsRepository, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `problemsRepository` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_txtor(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.txtor, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `txtor` did not pass the test: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,225 @@
+package schedulemessage_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	problemsrepo "github.com/karasunokami/chat-service/internal/repositories/problems"
+	scheduledmessagesrepo "github.com/karasunokami/chat-service/internal/repositories/scheduledmessages"
+	sendscheduledmessagejob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/send-scheduled-message"
+	"github.com/karasunokami/chat-service/internal/testingh"
+	"github.com/karasunokami/chat-service/internal/types"
+	schedulemessage "github.com/karasunokami/chat-service/internal/usecases/manager/schedule-message"
+	schedulemessagemocks "github.com/karasunokami/chat-service/internal/usecases/manager/schedule-message/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl          *gomock.Controller
+	scheduledRepo *schedulemessagemocks.MockscheduledMessagesRepository
+	problemRepo   *schedulemessagemocks.MockproblemsRepository
+	txtor         *schedulemessagemocks.Mocktransactor
+	outBoxSvc     *schedulemessagemocks.MockoutboxService
+	uCase         schedulemessage.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.scheduledRepo = schedulemessagemocks.NewMockscheduledMessagesRepository(s.ctrl)
+	s.outBoxSvc = schedulemessagemocks.NewMockoutboxService(s.ctrl)
+	s.problemRepo = schedulemessagemocks.NewMockproblemsRepository(s.ctrl)
+	s.txtor = schedulemessagemocks.NewMocktransactor(s.ctrl)
+
+	var err error
+	s.uCase, err = schedulemessage.New(schedulemessage.NewOptions(s.scheduledRepo, s.outBoxSvc, s.problemRepo, s.txtor))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) newRequest(deliverAt time.Time) schedulemessage.Request {
+	return schedulemessage.Request{
+		ID:          types.NewRequestID(),
+		ManagerID:   types.NewUserID(),
+		ChatID:      types.NewChatID(),
+		MessageBody: `Did it help?`,
+		DeliverAt:   deliverAt,
+	}
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Arrange.
+	req := schedulemessage.Request{}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, schedulemessage.ErrInvalidRequest)
+	s.Empty(resp.ScheduledMessageID)
+}
+
+func (s *UseCaseSuite) TestDeliverAtInPast() {
+	// Arrange.
+	req := s.newRequest(time.Now().Add(-time.Minute))
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, schedulemessage.ErrDeliverAtInPast)
+	s.Empty(resp.ScheduledMessageID)
+}
+
+func (s *UseCaseSuite) TestDeliverAtTooLate() {
+	// Arrange.
+	req := s.newRequest(time.Now().Add(365 * 24 * time.Hour))
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, schedulemessage.ErrDeliverAtTooLate)
+	s.Empty(resp.ScheduledMessageID)
+}
+
+func (s *UseCaseSuite) TestGetAssignedProblemError() {
+	// Arrange.
+	req := s.newRequest(time.Now().Add(time.Hour))
+
+	s.problemRepo.EXPECT().GetAssignedProblemID(s.Ctx, req.ManagerID, req.ChatID).
+		Return(types.ProblemIDNil, problemsrepo.ErrNotFound)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, schedulemessage.ErrProblemNotFound)
+	s.Empty(resp.ScheduledMessageID)
+}
+
+func (s *UseCaseSuite) TestCreateScheduledMessageError() {
+	// Arrange.
+	req := s.newRequest(time.Now().Add(time.Hour))
+	problemID := types.NewProblemID()
+	expectedError := io.EOF
+
+	s.problemRepo.EXPECT().GetAssignedProblemID(s.Ctx, req.ManagerID, req.ChatID).Return(problemID, nil)
+	s.txtor.EXPECT().RunInTx(s.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+	s.scheduledRepo.EXPECT().GetByRequestID(s.Ctx, req.ID).Return(nil, scheduledmessagesrepo.ErrMsgNotFound)
+	s.scheduledRepo.EXPECT().Create(s.Ctx, req.ID, problemID, req.ChatID, req.ManagerID, req.MessageBody, req.DeliverAt).
+		Return(nil, expectedError)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, expectedError)
+	s.Empty(resp.ScheduledMessageID)
+}
+
+func (s *UseCaseSuite) TestSuccess() {
+	// Arrange.
+	req := s.newRequest(time.Now().Add(time.Hour))
+	problemID := types.NewProblemID()
+	expectedMessage := &scheduledmessagesrepo.Message{
+		ID:        types.NewScheduledMessageID(),
+		DeliverAt: req.DeliverAt,
+		CreatedAt: time.Now(),
+	}
+
+	s.problemRepo.EXPECT().GetAssignedProblemID(s.Ctx, req.ManagerID, req.ChatID).Return(problemID, nil)
+	s.txtor.EXPECT().RunInTx(s.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+	s.scheduledRepo.EXPECT().GetByRequestID(s.Ctx, req.ID).Return(nil, scheduledmessagesrepo.ErrMsgNotFound)
+	s.scheduledRepo.EXPECT().Create(s.Ctx, req.ID, problemID, req.ChatID, req.ManagerID, req.MessageBody, req.DeliverAt).
+		Return(expectedMessage, nil)
+
+	expectedPayload, err := sendscheduledmessagejob.MarshalPayload(expectedMessage.ID)
+	s.Require().NoError(err)
+	s.outBoxSvc.EXPECT().Put(s.Ctx, sendscheduledmessagejob.Name, expectedPayload, req.DeliverAt).
+		Return(types.NewJobID(), nil)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(expectedMessage.ID, resp.ScheduledMessageID)
+	s.Equal(expectedMessage.DeliverAt, resp.DeliverAt)
+	s.Equal(expectedMessage.CreatedAt, resp.CreatedAt)
+}
+
+func (s *UseCaseSuite) TestGetByRequestIDError() {
+	// Arrange.
+	req := s.newRequest(time.Now().Add(time.Hour))
+	expectedError := io.EOF
+
+	s.problemRepo.EXPECT().GetAssignedProblemID(s.Ctx, req.ManagerID, req.ChatID).Return(types.NewProblemID(), nil)
+	s.txtor.EXPECT().RunInTx(s.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+	s.scheduledRepo.EXPECT().GetByRequestID(s.Ctx, req.ID).Return(nil, expectedError)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, expectedError)
+	s.Empty(resp.ScheduledMessageID)
+}
+
+func (s *UseCaseSuite) TestRetriedRequest() {
+	// Arrange.
+	req := s.newRequest(time.Now().Add(time.Hour))
+	existingMessage := &scheduledmessagesrepo.Message{
+		ID:        types.NewScheduledMessageID(),
+		DeliverAt: req.DeliverAt,
+		CreatedAt: time.Now().Add(-time.Second),
+	}
+
+	s.problemRepo.EXPECT().GetAssignedProblemID(s.Ctx, req.ManagerID, req.ChatID).Return(types.NewProblemID(), nil)
+	s.txtor.EXPECT().RunInTx(s.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+	s.scheduledRepo.EXPECT().GetByRequestID(s.Ctx, req.ID).Return(existingMessage, nil)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(existingMessage.ID, resp.ScheduledMessageID)
+	s.Equal(existingMessage.DeliverAt, resp.DeliverAt)
+	s.Equal(existingMessage.CreatedAt, resp.CreatedAt)
+}
@@ -0,0 +1,90 @@
+package sendinternalnote_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/karasunokami/chat-service/internal/types"
+	sendinternalnote "github.com/karasunokami/chat-service/internal/usecases/manager/send-internal-note"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequest_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		request sendinternalnote.Request
+		wantErr bool
+	}{
+		// Positive.
+		{
+			name: "valid request",
+			request: sendinternalnote.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				ChatID:    types.NewChatID(),
+				NoteBody:  "Client verified by phone",
+			},
+			wantErr: false,
+		},
+
+		// Negative.
+		{
+			name: "require request id",
+			request: sendinternalnote.Request{
+				ManagerID: types.NewUserID(),
+				ChatID:    types.NewChatID(),
+				NoteBody:  "Client verified by phone",
+			},
+			wantErr: true,
+		},
+		{
+			name: "require manager id",
+			request: sendinternalnote.Request{
+				ID:       types.NewRequestID(),
+				ChatID:   types.NewChatID(),
+				NoteBody: "Client verified by phone",
+			},
+			wantErr: true,
+		},
+		{
+			name: "require chat id",
+			request: sendinternalnote.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				NoteBody:  "Client verified by phone",
+			},
+			wantErr: true,
+		},
+		{
+			name: "require note body",
+			request: sendinternalnote.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				ChatID:    types.NewChatID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "too long note body",
+			request: sendinternalnote.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				ChatID:    types.NewChatID(),
+				NoteBody:  strings.Repeat("a", 3001),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
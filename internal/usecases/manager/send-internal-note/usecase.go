@@ -0,0 +1,126 @@
+package sendinternalnote
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	auditrepo "github.com/karasunokami/chat-service/internal/repositories/audit"
+	messagesrepo "github.com/karasunokami/chat-service/internal/repositories/messages"
+	problemsrepo "github.com/karasunokami/chat-service/internal/repositories/problems"
+	sendinternalnotejob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/send-internal-note"
+	"github.com/karasunokami/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=sendinternalnotemocks
+
+var (
+	ErrInvalidRequest  = errors.New("invalid request")
+	ErrProblemNotFound = errors.New("problem not found")
+)
+
+type messagesRepository interface {
+	CreateInternalNote(
+		ctx context.Context,
+		reqID types.RequestID,
+		problemID types.ProblemID,
+		chatID types.ChatID,
+		authorID types.UserID,
+		noteBody string,
+	) (*messagesrepo.Message, error)
+}
+
+type outboxService interface {
+	Put(ctx context.Context, name, payload string, availableAt time.Time) (types.JobID, error)
+}
+
+type problemsRepository interface {
+	GetAssignedProblemID(ctx context.Context, managerID types.UserID, chatID types.ChatID) (types.ProblemID, error)
+}
+
+type auditLog interface {
+	Create(ctx context.Context, rec auditrepo.Record) error
+}
+
+type transactor interface {
+	RunInTx(ctx context.Context, f func(context.Context) error) error
+}
+
+//go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
+type Options struct {
+	messagesRepository messagesRepository `option:"mandatory" validate:"required"`
+	outboxService      outboxService      `option:"mandatory" validate:"required"`
+	problemsRepository problemsRepository `option:"mandatory" validate:"required"`
+	txtor              transactor         `option:"mandatory" validate:"required"`
+	auditLog           auditLog           `option:"mandatory" validate:"required"`
+}
+
+// UseCase leaves the internal note in the chat of the manager.
+type UseCase struct {
+	Options
+}
+
+func New(opts Options) (UseCase, error) {
+	if err := opts.Validate(); err != nil {
+		return UseCase{}, fmt.Errorf("validate options, err=%v", err)
+	}
+
+	return UseCase{opts}, nil
+}
+
+func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
+	if err := req.Validate(); err != nil {
+		return Response{}, ErrInvalidRequest
+	}
+
+	problemID, err := u.problemsRepository.GetAssignedProblemID(ctx, req.ManagerID, req.ChatID)
+	if err != nil {
+		if errors.Is(err, problemsrepo.ErrNotFound) {
+			return Response{}, ErrProblemNotFound
+		}
+
+		return Response{}, fmt.Errorf("problems repository, get assigned problem id, err=%v", err)
+	}
+
+	var resp Response
+
+	err = u.txtor.RunInTx(ctx, func(ctx context.Context) error {
+		msg, err := u.messagesRepository.CreateInternalNote(ctx, req.ID, problemID, req.ChatID, req.ManagerID, req.NoteBody)
+		if err != nil {
+			return fmt.Errorf("messages repository, create internal note, err=%w", err)
+		}
+
+		pl, err := sendinternalnotejob.MarshalPayload(msg.ID, req.ManagerID)
+		if err != nil {
+			return fmt.Errorf("marshal send internal note payload, err=%w", err)
+		}
+
+		if _, err := u.outboxService.Put(ctx, sendinternalnotejob.Name, pl, time.Now()); err != nil {
+			return fmt.Errorf("put send internal note job to outbox service, err=%w", err)
+		}
+
+		err = u.auditLog.Create(ctx, auditrepo.Record{
+			ManagerID: req.ManagerID,
+			Action:    auditrepo.ActionSendInternalNote,
+			ChatID:    req.ChatID,
+			ProblemID: problemID,
+			RequestID: req.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("audit log, create record, err=%w", err)
+		}
+
+		resp = Response{
+			MessageID: msg.ID,
+			CreatedAt: msg.CreatedAt,
+		}
+
+		return nil
+	})
+	if err != nil {
+		return Response{}, fmt.Errorf("create internal note in transaction, err=%w", err)
+	}
+
+	return resp, nil
+}
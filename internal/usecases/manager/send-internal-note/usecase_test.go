@@ -0,0 +1,189 @@
+package sendinternalnote_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	auditrepo "github.com/karasunokami/chat-service/internal/repositories/audit"
+	messagesrepo "github.com/karasunokami/chat-service/internal/repositories/messages"
+	problemsrepo "github.com/karasunokami/chat-service/internal/repositories/problems"
+	sendinternalnotejob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/send-internal-note"
+	"github.com/karasunokami/chat-service/internal/testingh"
+	"github.com/karasunokami/chat-service/internal/types"
+	sendinternalnote "github.com/karasunokami/chat-service/internal/usecases/manager/send-internal-note"
+	sendinternalnotemocks "github.com/karasunokami/chat-service/internal/usecases/manager/send-internal-note/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl        *gomock.Controller
+	msgRepo     *sendinternalnotemocks.MockmessagesRepository
+	problemRepo *sendinternalnotemocks.MockproblemsRepository
+	txtor       *sendinternalnotemocks.Mocktransactor
+	outBoxSvc   *sendinternalnotemocks.MockoutboxService
+	auditLog    *sendinternalnotemocks.MockauditLog
+	uCase       sendinternalnote.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.msgRepo = sendinternalnotemocks.NewMockmessagesRepository(s.ctrl)
+	s.outBoxSvc = sendinternalnotemocks.NewMockoutboxService(s.ctrl)
+	s.problemRepo = sendinternalnotemocks.NewMockproblemsRepository(s.ctrl)
+	s.txtor = sendinternalnotemocks.NewMocktransactor(s.ctrl)
+	s.auditLog = sendinternalnotemocks.NewMockauditLog(s.ctrl)
+
+	var err error
+	s.uCase, err = sendinternalnote.New(sendinternalnote.NewOptions(s.msgRepo, s.outBoxSvc, s.problemRepo, s.txtor, s.auditLog))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, sendinternalnote.Request{})
+
+	// Assert.
+	s.Require().ErrorIs(err, sendinternalnote.ErrInvalidRequest)
+	s.Empty(resp.MessageID)
+}
+
+func (s *UseCaseSuite) TestProblemNotFound() {
+	// Arrange.
+	req := s.newRequest()
+	s.problemRepo.EXPECT().GetAssignedProblemID(s.Ctx, req.ManagerID, req.ChatID).
+		Return(types.ProblemIDNil, problemsrepo.ErrNotFound)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, sendinternalnote.ErrProblemNotFound)
+	s.Empty(resp.MessageID)
+}
+
+func (s *UseCaseSuite) TestCreateNoteError() {
+	// Arrange.
+	req := s.newRequest()
+	problemID := types.NewProblemID()
+
+	s.problemRepo.EXPECT().GetAssignedProblemID(s.Ctx, req.ManagerID, req.ChatID).Return(problemID, nil)
+	s.expectTx()
+	s.msgRepo.EXPECT().CreateInternalNote(s.Ctx, req.ID, problemID, req.ChatID, req.ManagerID, req.NoteBody).
+		Return(nil, io.EOF)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, io.EOF)
+	s.Empty(resp.MessageID)
+}
+
+func (s *UseCaseSuite) TestPutJobError() {
+	// Arrange.
+	req := s.newRequest()
+	problemID := types.NewProblemID()
+
+	s.problemRepo.EXPECT().GetAssignedProblemID(s.Ctx, req.ManagerID, req.ChatID).Return(problemID, nil)
+	s.expectTx()
+	s.msgRepo.EXPECT().CreateInternalNote(s.Ctx, req.ID, problemID, req.ChatID, req.ManagerID, req.NoteBody).
+		Return(&messagesrepo.Message{ID: types.NewMessageID()}, nil)
+	s.outBoxSvc.EXPECT().Put(s.Ctx, sendinternalnotejob.Name, gomock.Any(), gomock.Any()).Return(types.JobIDNil, io.EOF)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, io.EOF)
+	s.Empty(resp.MessageID)
+}
+
+func (s *UseCaseSuite) TestAuditLogError() {
+	// Arrange.
+	req := s.newRequest()
+	problemID := types.NewProblemID()
+
+	s.problemRepo.EXPECT().GetAssignedProblemID(s.Ctx, req.ManagerID, req.ChatID).Return(problemID, nil)
+	s.expectTx()
+	s.msgRepo.EXPECT().CreateInternalNote(s.Ctx, req.ID, problemID, req.ChatID, req.ManagerID, req.NoteBody).
+		Return(&messagesrepo.Message{ID: types.NewMessageID()}, nil)
+	s.outBoxSvc.EXPECT().Put(s.Ctx, sendinternalnotejob.Name, gomock.Any(), gomock.Any()).Return(types.NewJobID(), nil)
+	s.auditLog.EXPECT().Create(s.Ctx, gomock.Any()).Return(io.EOF)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, io.EOF)
+	s.Empty(resp.MessageID)
+}
+
+func (s *UseCaseSuite) TestSuccess() {
+	// Arrange.
+	req := s.newRequest()
+	problemID := types.NewProblemID()
+	note := &messagesrepo.Message{
+		ID:        types.NewMessageID(),
+		CreatedAt: time.Now(),
+	}
+
+	s.problemRepo.EXPECT().GetAssignedProblemID(s.Ctx, req.ManagerID, req.ChatID).Return(problemID, nil)
+	s.expectTx()
+	s.msgRepo.EXPECT().CreateInternalNote(s.Ctx, req.ID, problemID, req.ChatID, req.ManagerID, req.NoteBody).
+		Return(note, nil)
+
+	expectedPayload, err := sendinternalnotejob.MarshalPayload(note.ID, req.ManagerID)
+	s.Require().NoError(err)
+	s.outBoxSvc.EXPECT().Put(s.Ctx, sendinternalnotejob.Name, expectedPayload, gomock.Any()).Return(types.NewJobID(), nil)
+
+	s.auditLog.EXPECT().Create(s.Ctx, auditrepo.Record{
+		ManagerID: req.ManagerID,
+		Action:    auditrepo.ActionSendInternalNote,
+		ChatID:    req.ChatID,
+		ProblemID: problemID,
+		RequestID: req.ID,
+	}).Return(nil)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(note.ID, resp.MessageID)
+	s.Equal(note.CreatedAt, resp.CreatedAt)
+}
+
+func (s *UseCaseSuite) newRequest() sendinternalnote.Request {
+	return sendinternalnote.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: types.NewUserID(),
+		ChatID:    types.NewChatID(),
+		NoteBody:  "Client verified by phone",
+	}
+}
+
+func (s *UseCaseSuite) expectTx() {
+	s.txtor.EXPECT().RunInTx(s.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+}
@@ -6,6 +6,7 @@ import (
 	"fmt"
 	"time"
 
+	auditrepo "github.com/karasunokami/chat-service/internal/repositories/audit"
 	messagesrepo "github.com/karasunokami/chat-service/internal/repositories/messages"
 	problemsrepo "github.com/karasunokami/chat-service/internal/repositories/problems"
 	sendmanagermessagejob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/send-manager-message"
@@ -28,6 +29,14 @@ type messagesRepository interface {
 		authorID types.UserID,
 		msgBody string,
 	) (*messagesrepo.Message, error)
+	CreateManagerVisible(
+		ctx context.Context,
+		reqID types.RequestID,
+		problemID types.ProblemID,
+		chatID types.ChatID,
+		authorID types.UserID,
+		msgBody string,
+	) (*messagesrepo.Message, error)
 }
 
 type outboxService interface {
@@ -38,6 +47,10 @@ type problemsRepository interface {
 	GetAssignedProblemID(ctx context.Context, managerID types.UserID, chatID types.ChatID) (types.ProblemID, error)
 }
 
+type auditLog interface {
+	Create(ctx context.Context, rec auditrepo.Record) error
+}
+
 type transactor interface {
 	RunInTx(ctx context.Context, f func(context.Context) error) error
 }
@@ -48,6 +61,10 @@ type Options struct {
 	outboxService      outboxService      `option:"mandatory" validate:"required"`
 	problemsRepository problemsRepository `option:"mandatory" validate:"required"`
 	txtor              transactor         `option:"mandatory" validate:"required"`
+	auditLog           auditLog           `option:"mandatory" validate:"required"`
+
+	// holdForAFC keeps the new messages invisible for the client until the AFC verdict.
+	holdForAFC bool
 }
 
 type UseCase struct {
@@ -82,9 +99,9 @@ func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
 	)
 
 	err = u.txtor.RunInTx(ctx, func(ctx context.Context) error {
-		msg, err := u.messagesRepository.CreateFullVisible(ctx, req.ID, problemID, req.ChatID, req.ManagerID, req.MessageBody)
+		msg, err := u.createMessage(ctx, req, problemID)
 		if err != nil {
-			return fmt.Errorf("messages repository, create full visible, err=%w", err)
+			return err
 		}
 
 		pl, err := sendmanagermessagejob.MarshalPayload(msg.ID, req.ManagerID)
@@ -97,6 +114,17 @@ func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
 			return fmt.Errorf("put send manager message job to outbox service, err=%w", err)
 		}
 
+		err = u.auditLog.Create(ctx, auditrepo.Record{
+			ManagerID: req.ManagerID,
+			Action:    auditrepo.ActionSendMessage,
+			ChatID:    req.ChatID,
+			ProblemID: problemID,
+			RequestID: req.ID,
+		})
+		if err != nil {
+			return fmt.Errorf("audit log, create record, err=%w", err)
+		}
+
 		msgID = msg.ID
 		msgCreatedAt = msg.CreatedAt
 
@@ -111,3 +139,19 @@ func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
 		CreatedAt: msgCreatedAt,
 	}, nil
 }
+
+func (u UseCase) createMessage(ctx context.Context, req Request, problemID types.ProblemID) (*messagesrepo.Message, error) {
+	if u.holdForAFC {
+		msg, err := u.messagesRepository.CreateManagerVisible(ctx, req.ID, problemID, req.ChatID, req.ManagerID, req.MessageBody)
+		if err != nil {
+			return nil, fmt.Errorf("messages repository, create manager visible, err=%w", err)
+		}
+		return msg, nil
+	}
+
+	msg, err := u.messagesRepository.CreateFullVisible(ctx, req.ID, problemID, req.ChatID, req.ManagerID, req.MessageBody)
+	if err != nil {
+		return nil, fmt.Errorf("messages repository, create full visible, err=%w", err)
+	}
+	return msg, nil
+}
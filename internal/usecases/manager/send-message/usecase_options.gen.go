@@ -15,6 +15,7 @@ func NewOptions(
 	outboxService outboxService,
 	problemsRepository problemsRepository,
 	txtor transactor,
+	auditLog auditLog,
 	options ...OptOptionsSetter,
 ) Options {
 	o := Options{}
@@ -25,6 +26,7 @@ func NewOptions(
 	o.outboxService = outboxService
 	o.problemsRepository = problemsRepository
 	o.txtor = txtor
+	o.auditLog = auditLog
 
 	for _, opt := range options {
 		opt(&o)
@@ -32,12 +34,19 @@ func NewOptions(
 	return o
 }
 
+func WithHoldForAFC(opt bool) OptOptionsSetter {
+	return func(o *Options) {
+		o.holdForAFC = opt
+	}
+}
+
 func (o *Options) Validate() error {
 	errs := new(errors461e464ebed9.ValidationErrors)
 	errs.Add(errors461e464ebed9.NewValidationError("messagesRepository", _validate_Options_messagesRepository(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("outboxService", _validate_Options_outboxService(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("problemsRepository", _validate_Options_problemsRepository(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("txtor", _validate_Options_txtor(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("auditLog", _validate_Options_auditLog(o)))
 	return errs.AsError()
 }
 
@@ -68,3 +77,10 @@ func _validate_Options_txtor(o *Options) error {
 	}
 	return nil
 }
+
+func _validate_Options_auditLog(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.auditLog, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `auditLog` did not pass the test: %w", err)
+	}
+	return nil
+}
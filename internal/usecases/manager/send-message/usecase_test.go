@@ -5,6 +5,7 @@ import (
 	"io"
 	"testing"
 
+	auditrepo "github.com/karasunokami/chat-service/internal/repositories/audit"
 	messagesrepo "github.com/karasunokami/chat-service/internal/repositories/messages"
 	problemsrepo "github.com/karasunokami/chat-service/internal/repositories/problems"
 	sendmanagermessagejob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/send-manager-message"
@@ -25,6 +26,7 @@ type UseCaseSuite struct {
 	problemRepo *sendmessagemocks.MockproblemsRepository
 	txtor       *sendmessagemocks.Mocktransactor
 	outBoxSvc   *sendmessagemocks.MockoutboxService
+	auditLog    *sendmessagemocks.MockauditLog
 	uCase       sendmessage.UseCase
 }
 
@@ -39,9 +41,10 @@ func (s *UseCaseSuite) SetupTest() {
 	s.outBoxSvc = sendmessagemocks.NewMockoutboxService(s.ctrl)
 	s.problemRepo = sendmessagemocks.NewMockproblemsRepository(s.ctrl)
 	s.txtor = sendmessagemocks.NewMocktransactor(s.ctrl)
+	s.auditLog = sendmessagemocks.NewMockauditLog(s.ctrl)
 
 	var err error
-	s.uCase, err = sendmessage.New(sendmessage.NewOptions(s.msgRepo, s.outBoxSvc, s.problemRepo, s.txtor))
+	s.uCase, err = sendmessage.New(sendmessage.NewOptions(s.msgRepo, s.outBoxSvc, s.problemRepo, s.txtor, s.auditLog))
 	s.Require().NoError(err)
 
 	s.ContextSuite.SetupTest()
@@ -148,6 +151,38 @@ func (s *UseCaseSuite) TestPutJobToOutboxServiceMessageError() {
 		req.MessageBody,
 	).Return(expectedMessage, nil)
 	s.outBoxSvc.EXPECT().Put(s.Ctx, sendmanagermessagejob.Name, gomock.Any(), gomock.Any()).Return(types.NewJobID(), nil)
+	s.auditLog.EXPECT().Create(s.Ctx, gomock.Any()).Return(nil)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.ErrorIs(err, expectedError)
+	s.Empty(resp.MessageID)
+}
+
+func (s *UseCaseSuite) TestAuditLogError() {
+	// Arrange.
+	req := sendmessage.Request{
+		ID:          types.NewRequestID(),
+		ManagerID:   types.NewUserID(),
+		ChatID:      types.NewChatID(),
+		MessageBody: `Hi`,
+	}
+
+	problemID := types.NewProblemID()
+	expectedError := io.EOF
+
+	s.problemRepo.EXPECT().GetAssignedProblemID(s.Ctx, req.ManagerID, req.ChatID).Return(problemID, nil)
+	s.txtor.EXPECT().RunInTx(s.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+	s.msgRepo.EXPECT().CreateFullVisible(s.Ctx, req.ID, problemID, req.ChatID, req.ManagerID, req.MessageBody).
+		Return(&messagesrepo.Message{ID: types.NewMessageID()}, nil)
+	s.outBoxSvc.EXPECT().Put(s.Ctx, sendmanagermessagejob.Name, gomock.Any(), gomock.Any()).Return(types.NewJobID(), nil)
+	s.auditLog.EXPECT().Create(s.Ctx, gomock.Any()).Return(expectedError)
 
 	// Action.
 	resp, err := s.uCase.Handle(s.Ctx, req)
@@ -186,6 +221,13 @@ func (s *UseCaseSuite) TestSuccess() {
 		req.MessageBody,
 	).Return(expectedMessage, nil)
 	s.outBoxSvc.EXPECT().Put(s.Ctx, sendmanagermessagejob.Name, gomock.Any(), gomock.Any()).Return(types.NewJobID(), nil)
+	s.auditLog.EXPECT().Create(s.Ctx, auditrepo.Record{
+		ManagerID: req.ManagerID,
+		Action:    auditrepo.ActionSendMessage,
+		ChatID:    req.ChatID,
+		ProblemID: problemID,
+		RequestID: req.ID,
+	}).Return(nil)
 
 	// Action.
 	resp, err := s.uCase.Handle(s.Ctx, req)
@@ -195,3 +237,47 @@ func (s *UseCaseSuite) TestSuccess() {
 	s.NotEmpty(resp.MessageID)
 	s.EqualValues(expectedMessage.ID, resp.MessageID)
 }
+
+func (s *UseCaseSuite) TestSuccess_HoldForAFC() {
+	// Arrange.
+	uCase, err := sendmessage.New(sendmessage.NewOptions(
+		s.msgRepo, s.outBoxSvc, s.problemRepo, s.txtor, s.auditLog,
+		sendmessage.WithHoldForAFC(true),
+	))
+	s.Require().NoError(err)
+
+	req := sendmessage.Request{
+		ID:          types.NewRequestID(),
+		ManagerID:   types.NewUserID(),
+		ChatID:      types.NewChatID(),
+		MessageBody: `Hi`,
+	}
+
+	problemID := types.NewProblemID()
+	expectedMessage := &messagesrepo.Message{
+		ID: types.NewMessageID(),
+	}
+
+	s.problemRepo.EXPECT().GetAssignedProblemID(s.Ctx, req.ManagerID, req.ChatID).Return(problemID, nil)
+	s.txtor.EXPECT().RunInTx(s.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+	s.msgRepo.EXPECT().CreateManagerVisible(
+		s.Ctx,
+		req.ID,
+		problemID,
+		req.ChatID,
+		req.ManagerID,
+		req.MessageBody,
+	).Return(expectedMessage, nil)
+	s.outBoxSvc.EXPECT().Put(s.Ctx, sendmanagermessagejob.Name, gomock.Any(), gomock.Any()).Return(types.NewJobID(), nil)
+	s.auditLog.EXPECT().Create(s.Ctx, gomock.Any()).Return(nil)
+
+	// Action.
+	resp, err := uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.EqualValues(expectedMessage.ID, resp.MessageID)
+}
@@ -0,0 +1,22 @@
+package setstatus
+
+import (
+	managerpresence "github.com/karasunokami/chat-service/internal/services/manager-presence"
+	"github.com/karasunokami/chat-service/internal/types"
+	"github.com/karasunokami/chat-service/internal/validator"
+)
+
+type Request struct {
+	ID        types.RequestID `validate:"required"`
+	ManagerID types.UserID    `validate:"required"`
+	// Status is chosen by the manager, "offline" is derived from the connections only.
+	Status managerpresence.Status `validate:"required,oneof=online away"`
+}
+
+func (r Request) Validate() error {
+	return validator.Validator.Struct(r)
+}
+
+type Response struct {
+	Status managerpresence.Status
+}
@@ -0,0 +1,85 @@
+package setstatus_test
+
+import (
+	"testing"
+
+	managerpresence "github.com/karasunokami/chat-service/internal/services/manager-presence"
+	"github.com/karasunokami/chat-service/internal/types"
+	setstatus "github.com/karasunokami/chat-service/internal/usecases/manager/set-status"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequest_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		request setstatus.Request
+		wantErr bool
+	}{
+		// Positive.
+		{
+			name: "online",
+			request: setstatus.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				Status:    managerpresence.StatusOnline,
+			},
+			wantErr: false,
+		},
+		{
+			name: "away",
+			request: setstatus.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				Status:    managerpresence.StatusAway,
+			},
+			wantErr: false,
+		},
+
+		// Negative.
+		{
+			name: "offline cannot be set",
+			request: setstatus.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				Status:    managerpresence.StatusOffline,
+			},
+			wantErr: true,
+		},
+		{
+			name: "require status",
+			request: setstatus.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "require request id",
+			request: setstatus.Request{
+				ManagerID: types.NewUserID(),
+				Status:    managerpresence.StatusAway,
+			},
+			wantErr: true,
+		},
+		{
+			name: "require manager id",
+			request: setstatus.Request{
+				ID:     types.NewRequestID(),
+				Status: managerpresence.StatusAway,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,90 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+
+// Package setstatusmocks is a generated GoMock package.
+package setstatusmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	auditrepo "github.com/karasunokami/chat-service/internal/repositories/audit"
+	managerpresence "github.com/karasunokami/chat-service/internal/services/manager-presence"
+	types "github.com/karasunokami/chat-service/internal/types"
+)
+
+// MockmanagerPresence is a mock of managerPresence interface.
+type MockmanagerPresence struct {
+	ctrl     *gomock.Controller
+	recorder *MockmanagerPresenceMockRecorder
+}
+
+// MockmanagerPresenceMockRecorder is the mock recorder for MockmanagerPresence.
+type MockmanagerPresenceMockRecorder struct {
+	mock *MockmanagerPresence
+}
+
+// NewMockmanagerPresence creates a new mock instance.
+func NewMockmanagerPresence(ctrl *gomock.Controller) *MockmanagerPresence {
+	mock := &MockmanagerPresence{ctrl: ctrl}
+	mock.recorder = &MockmanagerPresenceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmanagerPresence) EXPECT() *MockmanagerPresenceMockRecorder {
+	return m.recorder
+}
+
+// SetAway mocks base method.
+func (m *MockmanagerPresence) SetAway(ctx context.Context, managerID types.UserID, away bool) (managerpresence.Status, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetAway", ctx, managerID, away)
+	ret0, _ := ret[0].(managerpresence.Status)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// SetAway indicates an expected call of SetAway.
+func (mr *MockmanagerPresenceMockRecorder) SetAway(ctx, managerID, away interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetAway", reflect.TypeOf((*MockmanagerPresence)(nil).SetAway), ctx, managerID, away)
+}
+
+// MockauditLog is a mock of auditLog interface.
+type MockauditLog struct {
+	ctrl     *gomock.Controller
+	recorder *MockauditLogMockRecorder
+}
+
+// MockauditLogMockRecorder is the mock recorder for MockauditLog.
+type MockauditLogMockRecorder struct {
+	mock *MockauditLog
+}
+
+// NewMockauditLog creates a new mock instance.
+func NewMockauditLog(ctrl *gomock.Controller) *MockauditLog {
+	mock := &MockauditLog{ctrl: ctrl}
+	mock.recorder = &MockauditLogMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockauditLog) EXPECT() *MockauditLogMockRecorder {
+	return m.recorder
+}
+
+// Create mocks base method.
+func (m *MockauditLog) Create(ctx context.Context, rec auditrepo.Record) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Create", ctx, rec)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Create indicates an expected call of Create.
+func (mr *MockauditLogMockRecorder) Create(ctx, rec interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Create", reflect.TypeOf((*MockauditLog)(nil).Create), ctx, rec)
+}
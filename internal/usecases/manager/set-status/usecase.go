@@ -0,0 +1,64 @@
+package setstatus
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	auditrepo "github.com/karasunokami/chat-service/internal/repositories/audit"
+	managerpresence "github.com/karasunokami/chat-service/internal/services/manager-presence"
+	"github.com/karasunokami/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=setstatusmocks
+
+var ErrInvalidRequest = errors.New("invalid request")
+
+type managerPresence interface {
+	SetAway(ctx context.Context, managerID types.UserID, away bool) (managerpresence.Status, error)
+}
+
+type auditLog interface {
+	Create(ctx context.Context, rec auditrepo.Record) error
+}
+
+//go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
+type Options struct {
+	presence managerPresence `option:"mandatory" validate:"required"`
+	auditLog auditLog        `option:"mandatory" validate:"required"`
+}
+
+// UseCase switches the manager between online and away.
+type UseCase struct {
+	Options
+}
+
+func New(opts Options) (UseCase, error) {
+	if err := opts.Validate(); err != nil {
+		return UseCase{}, fmt.Errorf("validate options, err=%v", err)
+	}
+
+	return UseCase{opts}, nil
+}
+
+func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
+	if err := req.Validate(); err != nil {
+		return Response{}, fmt.Errorf("validate request, err=%w", ErrInvalidRequest)
+	}
+
+	status, err := u.presence.SetAway(ctx, req.ManagerID, req.Status == managerpresence.StatusAway)
+	if err != nil {
+		return Response{}, fmt.Errorf("manager presence, set away, err=%v", err)
+	}
+
+	err = u.auditLog.Create(ctx, auditrepo.Record{
+		ManagerID: req.ManagerID,
+		Action:    auditrepo.ActionSetStatus,
+		RequestID: req.ID,
+	})
+	if err != nil {
+		return Response{}, fmt.Errorf("audit log, create record, err=%v", err)
+	}
+
+	return Response{Status: status}, nil
+}
@@ -0,0 +1,50 @@
+// Code generated by options-gen. DO NOT EDIT.
+package setstatus
+
+import (
+	fmt461e464ebed9 "fmt"
+
+	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
+	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+)
+
+type OptOptionsSetter func(o *Options)
+
+func NewOptions(
+	presence managerPresence,
+	auditLog auditLog,
+	options ...OptOptionsSetter,
+) Options {
+	o := Options{}
+
+	// Setting defaults from field tag (if present)
+
+	o.presence = presence
+	o.auditLog = auditLog
+
+	for _, opt := range options {
+		opt(&o)
+	}
+	return o
+}
+
+func (o *Options) Validate() error {
+	errs := new(errors461e464ebed9.ValidationErrors)
+	errs.Add(errors461e464ebed9.NewValidationError("presence", _validate_Options_presence(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("auditLog", _validate_Options_auditLog(o)))
+	return errs.AsError()
+}
+
+func _validate_Options_presence(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.presence, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `presence` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_auditLog(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.auditLog, "required"); err != nil {
+		return fmt461e464ebed9.Errorf("field `auditLog` did not pass the test: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,131 @@
+package setstatus_test
+
+import (
+	"errors"
+	"testing"
+
+	auditrepo "github.com/karasunokami/chat-service/internal/repositories/audit"
+	managerpresence "github.com/karasunokami/chat-service/internal/services/manager-presence"
+	"github.com/karasunokami/chat-service/internal/testingh"
+	"github.com/karasunokami/chat-service/internal/types"
+	setstatus "github.com/karasunokami/chat-service/internal/usecases/manager/set-status"
+	setstatusmocks "github.com/karasunokami/chat-service/internal/usecases/manager/set-status/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl     *gomock.Controller
+	presence *setstatusmocks.MockmanagerPresence
+	auditLog *setstatusmocks.MockauditLog
+	uCase    setstatus.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.presence = setstatusmocks.NewMockmanagerPresence(s.ctrl)
+	s.auditLog = setstatusmocks.NewMockauditLog(s.ctrl)
+
+	var err error
+	s.uCase, err = setstatus.New(setstatus.NewOptions(s.presence, s.auditLog))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Arrange.
+	req := setstatus.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: types.NewUserID(),
+		Status:    managerpresence.StatusOffline,
+	}
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, setstatus.ErrInvalidRequest)
+}
+
+func (s *UseCaseSuite) TestSetAwayError() {
+	// Arrange.
+	req := setstatus.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: types.NewUserID(),
+		Status:    managerpresence.StatusAway,
+	}
+	s.presence.EXPECT().SetAway(s.Ctx, req.ManagerID, true).Return(managerpresence.Status(""), errors.New("unexpected"))
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *UseCaseSuite) TestAuditLogError() {
+	// Arrange.
+	req := setstatus.Request{
+		ID:        types.NewRequestID(),
+		ManagerID: types.NewUserID(),
+		Status:    managerpresence.StatusAway,
+	}
+	s.presence.EXPECT().SetAway(s.Ctx, req.ManagerID, true).Return(managerpresence.StatusAway, nil)
+	s.auditLog.EXPECT().Create(s.Ctx, gomock.Any()).Return(errors.New("unexpected"))
+
+	// Action.
+	_, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+}
+
+func (s *UseCaseSuite) TestSuccess() {
+	for _, tt := range []struct {
+		requested managerpresence.Status
+		away      bool
+		actual    managerpresence.Status
+	}{
+		{requested: managerpresence.StatusAway, away: true, actual: managerpresence.StatusAway},
+		{requested: managerpresence.StatusOnline, away: false, actual: managerpresence.StatusOnline},
+		// The manager without connections stays offline.
+		{requested: managerpresence.StatusOnline, away: false, actual: managerpresence.StatusOffline},
+	} {
+		s.Run(string(tt.requested)+"/"+string(tt.actual), func() {
+			// Arrange.
+			req := setstatus.Request{
+				ID:        types.NewRequestID(),
+				ManagerID: types.NewUserID(),
+				Status:    tt.requested,
+			}
+			s.presence.EXPECT().SetAway(s.Ctx, req.ManagerID, tt.away).Return(tt.actual, nil)
+			s.auditLog.EXPECT().Create(s.Ctx, auditrepo.Record{
+				ManagerID: req.ManagerID,
+				Action:    auditrepo.ActionSetStatus,
+				RequestID: req.ID,
+			}).Return(nil)
+
+			// Action.
+			resp, err := s.uCase.Handle(s.Ctx, req)
+
+			// Assert.
+			s.Require().NoError(err)
+			s.Equal(tt.actual, resp.Status)
+		})
+	}
+}
@@ -0,0 +1,59 @@
+package getchathistory
+
+import (
+	"errors"
+	"time"
+
+	messagesrepo "github.com/karasunokami/chat-service/internal/repositories/messages"
+	"github.com/karasunokami/chat-service/internal/types"
+	"github.com/karasunokami/chat-service/internal/validator"
+)
+
+type Request struct {
+	ID           types.RequestID `validate:"required"`
+	ChatID       types.ChatID    `validate:"required"`
+	SupervisorID types.UserID    `validate:"required"`
+	PageSize     int             `validate:"omitempty,gte=10,lte=100"`
+	Cursor       string          `validate:"omitempty,base64url"`
+}
+
+func (r Request) Validate() error {
+	if r.PageSize == 0 && r.Cursor == "" {
+		return errors.New("page size or cursor must be provided")
+	}
+
+	if r.PageSize != 0 && r.Cursor != "" {
+		return errors.New("page size or cursor must be provided")
+	}
+
+	return validator.Validator.Struct(r)
+}
+
+type Response struct {
+	NextCursor string
+	Messages   []Message
+}
+
+type Message struct {
+	ID             types.MessageID
+	AuthorID       types.UserID
+	Body           string
+	CreatedAt      time.Time
+	IsInternalNote bool
+}
+
+func adoptMessages(messages []messagesrepo.Message) []Message {
+	msgs := make([]Message, 0, len(messages))
+
+	for _, m := range messages {
+		msgs = append(msgs, Message{
+			ID:             m.ID,
+			AuthorID:       m.AuthorID,
+			Body:           m.Body,
+			CreatedAt:      m.CreatedAt,
+			IsInternalNote: m.IsInternalNote,
+		})
+	}
+
+	return msgs
+}
@@ -0,0 +1,90 @@
+package getchathistory_test
+
+import (
+	"testing"
+
+	"github.com/karasunokami/chat-service/internal/types"
+	getchathistory "github.com/karasunokami/chat-service/internal/usecases/supervisor/get-chat-history"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequest_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		request getchathistory.Request
+		wantErr bool
+	}{
+		// Positive.
+		{
+			name: "cursor specified",
+			request: getchathistory.Request{
+				ID:           types.NewRequestID(),
+				ChatID:       types.NewChatID(),
+				SupervisorID: types.NewUserID(),
+				Cursor:       "eyJwYWdlX3NpemUiOjUwLCJsYXN0IjoxNjcwNTAyNTAyfQ==", // {"page_size":50,"last":1670502502}
+			},
+			wantErr: false,
+		},
+		{
+			name: "page size specified",
+			request: getchathistory.Request{
+				ID:           types.NewRequestID(),
+				ChatID:       types.NewChatID(),
+				SupervisorID: types.NewUserID(),
+				PageSize:     50,
+			},
+			wantErr: false,
+		},
+
+		// Negative.
+		{
+			name: "neither cursor nor page size specified",
+			request: getchathistory.Request{
+				ID:           types.NewRequestID(),
+				ChatID:       types.NewChatID(),
+				SupervisorID: types.NewUserID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "require chat id",
+			request: getchathistory.Request{
+				ID:           types.NewRequestID(),
+				SupervisorID: types.NewUserID(),
+				PageSize:     50,
+			},
+			wantErr: true,
+		},
+		{
+			name: "require supervisor id",
+			request: getchathistory.Request{
+				ID:       types.NewRequestID(),
+				ChatID:   types.NewChatID(),
+				PageSize: 50,
+			},
+			wantErr: true,
+		},
+		{
+			name: "too big page size",
+			request: getchathistory.Request{
+				ID:           types.NewRequestID(),
+				ChatID:       types.NewChatID(),
+				SupervisorID: types.NewUserID(),
+				PageSize:     101,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
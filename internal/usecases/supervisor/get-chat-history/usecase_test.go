@@ -0,0 +1,139 @@
+package getchathistory_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/karasunokami/chat-service/internal/cursor"
+	messagesrepo "github.com/karasunokami/chat-service/internal/repositories/messages"
+	"github.com/karasunokami/chat-service/internal/testingh"
+	"github.com/karasunokami/chat-service/internal/types"
+	getchathistory "github.com/karasunokami/chat-service/internal/usecases/supervisor/get-chat-history"
+	getchathistorymocks "github.com/karasunokami/chat-service/internal/usecases/supervisor/get-chat-history/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl    *gomock.Controller
+	msgRepo *getchathistorymocks.MockmessagesRepository
+	uCase   getchathistory.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.msgRepo = getchathistorymocks.NewMockmessagesRepository(s.ctrl)
+
+	var err error
+	s.uCase, err = getchathistory.New(getchathistory.NewOptions(s.msgRepo))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Arrange.
+	req := getchathistory.Request{}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, getchathistory.ErrInvalidRequest)
+	s.Empty(resp.Messages)
+}
+
+func (s *UseCaseSuite) TestGetChatMessages_InvalidCursor() {
+	// Arrange.
+	chatID := types.NewChatID()
+
+	s.msgRepo.EXPECT().GetChatMessages(s.Ctx, chatID, 20, (*messagesrepo.Cursor)(nil)).
+		Return(nil, nil, messagesrepo.ErrInvalidCursor)
+
+	req := getchathistory.Request{
+		ID:           types.NewRequestID(),
+		ChatID:       chatID,
+		SupervisorID: types.NewUserID(),
+		PageSize:     20,
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, getchathistory.ErrInvalidCursor)
+	s.Empty(resp.Messages)
+}
+
+func (s *UseCaseSuite) TestGetChatMessages_SomeError() {
+	// Arrange.
+	chatID := types.NewChatID()
+	errExpected := errors.New("any error")
+
+	s.msgRepo.EXPECT().GetChatMessages(s.Ctx, chatID, 20, (*messagesrepo.Cursor)(nil)).
+		Return(nil, nil, errExpected)
+
+	req := getchathistory.Request{
+		ID:           types.NewRequestID(),
+		ChatID:       chatID,
+		SupervisorID: types.NewUserID(),
+		PageSize:     20,
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, errExpected)
+	s.Empty(resp.Messages)
+}
+
+func (s *UseCaseSuite) TestGetChatMessages_Success() {
+	// Arrange.
+	chatID := types.NewChatID()
+	msgs := []messagesrepo.Message{
+		{ID: types.NewMessageID(), ChatID: chatID, AuthorID: types.NewUserID(), Body: "hello", CreatedAt: time.Now()},
+		{ID: types.NewMessageID(), ChatID: chatID, AuthorID: types.NewUserID(), Body: "hi", CreatedAt: time.Now()},
+	}
+	next := &messagesrepo.Cursor{PageSize: 20, LastCreatedAt: msgs[1].CreatedAt}
+
+	s.msgRepo.EXPECT().GetChatMessages(s.Ctx, chatID, 20, (*messagesrepo.Cursor)(nil)).Return(msgs, next, nil)
+
+	req := getchathistory.Request{
+		ID:           types.NewRequestID(),
+		ChatID:       chatID,
+		SupervisorID: types.NewUserID(),
+		PageSize:     20,
+	}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Require().Len(resp.Messages, len(msgs))
+	for i, m := range resp.Messages {
+		s.Equal(msgs[i].ID, m.ID)
+		s.Equal(msgs[i].AuthorID, m.AuthorID)
+		s.Equal(msgs[i].Body, m.Body)
+	}
+
+	var decoded messagesrepo.Cursor
+	s.Require().NoError(cursor.Decode(resp.NextCursor, &decoded))
+	s.Equal(next.PageSize, decoded.PageSize)
+}
@@ -0,0 +1,28 @@
+package getmanagerstatuses
+
+import (
+	"time"
+
+	managerpresence "github.com/karasunokami/chat-service/internal/services/manager-presence"
+	"github.com/karasunokami/chat-service/internal/types"
+	"github.com/karasunokami/chat-service/internal/validator"
+)
+
+type Request struct {
+	ID           types.RequestID `validate:"required"`
+	SupervisorID types.UserID    `validate:"required"`
+}
+
+func (r Request) Validate() error {
+	return validator.Validator.Struct(r)
+}
+
+type Response struct {
+	Managers []ManagerStatus
+}
+
+type ManagerStatus struct {
+	ManagerID types.UserID
+	Status    managerpresence.Status
+	Since     time.Time
+}
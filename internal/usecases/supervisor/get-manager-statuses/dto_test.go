@@ -0,0 +1,57 @@
+package getmanagerstatuses_test
+
+import (
+	"testing"
+
+	"github.com/karasunokami/chat-service/internal/types"
+	getmanagerstatuses "github.com/karasunokami/chat-service/internal/usecases/supervisor/get-manager-statuses"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequest_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		request getmanagerstatuses.Request
+		wantErr bool
+	}{
+		// Positive.
+		{
+			name: "valid request",
+			request: getmanagerstatuses.Request{
+				ID:           types.NewRequestID(),
+				SupervisorID: types.NewUserID(),
+			},
+			wantErr: false,
+		},
+
+		// Negative.
+		{
+			name: "require request id",
+			request: getmanagerstatuses.Request{
+				ID:           types.RequestIDNil,
+				SupervisorID: types.NewUserID(),
+			},
+			wantErr: true,
+		},
+		{
+			name: "require supervisor id",
+			request: getmanagerstatuses.Request{
+				ID:           types.NewRequestID(),
+				SupervisorID: types.UserIDNil,
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,49 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+
+// Package getmanagerstatusesmocks is a generated GoMock package.
+package getmanagerstatusesmocks
+
+import (
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	managerpresence "github.com/karasunokami/chat-service/internal/services/manager-presence"
+)
+
+// MockmanagerPresence is a mock of managerPresence interface.
+type MockmanagerPresence struct {
+	ctrl     *gomock.Controller
+	recorder *MockmanagerPresenceMockRecorder
+}
+
+// MockmanagerPresenceMockRecorder is the mock recorder for MockmanagerPresence.
+type MockmanagerPresenceMockRecorder struct {
+	mock *MockmanagerPresence
+}
+
+// NewMockmanagerPresence creates a new mock instance.
+func NewMockmanagerPresence(ctrl *gomock.Controller) *MockmanagerPresence {
+	mock := &MockmanagerPresence{ctrl: ctrl}
+	mock.recorder = &MockmanagerPresenceMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockmanagerPresence) EXPECT() *MockmanagerPresenceMockRecorder {
+	return m.recorder
+}
+
+// Statuses mocks base method.
+func (m *MockmanagerPresence) Statuses() []managerpresence.ManagerStatus {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Statuses")
+	ret0, _ := ret[0].([]managerpresence.ManagerStatus)
+	return ret0
+}
+
+// Statuses indicates an expected call of Statuses.
+func (mr *MockmanagerPresenceMockRecorder) Statuses() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Statuses", reflect.TypeOf((*MockmanagerPresence)(nil).Statuses))
+}
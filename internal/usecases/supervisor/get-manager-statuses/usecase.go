@@ -0,0 +1,54 @@
+package getmanagerstatuses
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	managerpresence "github.com/karasunokami/chat-service/internal/services/manager-presence"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=getmanagerstatusesmocks
+
+var ErrInvalidRequest = errors.New("invalid request")
+
+type managerPresence interface {
+	Statuses() []managerpresence.ManagerStatus
+}
+
+//go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
+type Options struct {
+	presence managerPresence `option:"mandatory" validate:"required"`
+}
+
+// UseCase returns the statuses of the managers connected since the service start.
+type UseCase struct {
+	Options
+}
+
+func New(opts Options) (UseCase, error) {
+	if err := opts.Validate(); err != nil {
+		return UseCase{}, fmt.Errorf("validate options, err=%v", err)
+	}
+
+	return UseCase{opts}, nil
+}
+
+func (u UseCase) Handle(_ context.Context, req Request) (Response, error) {
+	if err := req.Validate(); err != nil {
+		return Response{}, fmt.Errorf("validate request, err=%w", ErrInvalidRequest)
+	}
+
+	statuses := u.presence.Statuses()
+
+	resp := Response{Managers: make([]ManagerStatus, 0, len(statuses))}
+	for _, st := range statuses {
+		resp.Managers = append(resp.Managers, ManagerStatus{
+			ManagerID: st.ManagerID,
+			Status:    st.Status,
+			Since:     st.Since,
+		})
+	}
+
+	return resp, nil
+}
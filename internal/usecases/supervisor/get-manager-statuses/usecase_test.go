@@ -0,0 +1,78 @@
+package getmanagerstatuses_test
+
+import (
+	"testing"
+	"time"
+
+	managerpresence "github.com/karasunokami/chat-service/internal/services/manager-presence"
+	"github.com/karasunokami/chat-service/internal/testingh"
+	"github.com/karasunokami/chat-service/internal/types"
+	getmanagerstatuses "github.com/karasunokami/chat-service/internal/usecases/supervisor/get-manager-statuses"
+	getmanagerstatusesmocks "github.com/karasunokami/chat-service/internal/usecases/supervisor/get-manager-statuses/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl     *gomock.Controller
+	presence *getmanagerstatusesmocks.MockmanagerPresence
+	uCase    getmanagerstatuses.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.presence = getmanagerstatusesmocks.NewMockmanagerPresence(s.ctrl)
+
+	var err error
+	s.uCase, err = getmanagerstatuses.New(getmanagerstatuses.NewOptions(s.presence))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, getmanagerstatuses.Request{})
+
+	// Assert.
+	s.Require().ErrorIs(err, getmanagerstatuses.ErrInvalidRequest)
+	s.Empty(resp.Managers)
+}
+
+func (s *UseCaseSuite) TestSuccess() {
+	// Arrange.
+	req := getmanagerstatuses.Request{ID: types.NewRequestID(), SupervisorID: types.NewUserID()}
+
+	statuses := []managerpresence.ManagerStatus{
+		{ManagerID: types.NewUserID(), Status: managerpresence.StatusOnline, Since: time.Now().Add(-time.Hour)},
+		{ManagerID: types.NewUserID(), Status: managerpresence.StatusOffline, Since: time.Now()},
+	}
+	s.presence.EXPECT().Statuses().Return(statuses)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Require().Len(resp.Managers, len(statuses))
+
+	for i, m := range resp.Managers {
+		s.Equal(statuses[i].ManagerID, m.ManagerID)
+		s.Equal(statuses[i].Status, m.Status)
+		s.Equal(statuses[i].Since, m.Since)
+	}
+}
@@ -0,0 +1,31 @@
+package getmessagesforreview
+
+import (
+	"time"
+
+	"github.com/karasunokami/chat-service/internal/types"
+	"github.com/karasunokami/chat-service/internal/validator"
+)
+
+type Request struct {
+	ID           types.RequestID `validate:"required"`
+	SupervisorID types.UserID    `validate:"required"`
+}
+
+func (r Request) Validate() error {
+	return validator.Validator.Struct(r)
+}
+
+type Response struct {
+	Messages []Message
+}
+
+// Message is the suspicious client message waiting for the supervisor decision.
+type Message struct {
+	ID                types.MessageID
+	ChatID            types.ChatID
+	AuthorID          types.UserID
+	Body              string
+	CreatedAt         time.Time
+	ReviewRequestedAt time.Time
+}
@@ -0,0 +1,63 @@
+package getmessagesforreview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	messagesrepo "github.com/karasunokami/chat-service/internal/repositories/messages"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=getmessagesforreviewmocks
+
+// messagesLimit keeps the response reasonable if the review queue is overloaded.
+const messagesLimit = 500
+
+var ErrInvalidRequest = errors.New("invalid request")
+
+type messagesRepository interface {
+	GetMessagesForReview(ctx context.Context, limit int) ([]messagesrepo.Message, error)
+}
+
+//go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
+type Options struct {
+	msgRepo messagesRepository `option:"mandatory" validate:"required"`
+}
+
+// UseCase returns the manual review queue of suspicious messages, the oldest first.
+type UseCase struct {
+	Options
+}
+
+func New(opts Options) (UseCase, error) {
+	if err := opts.Validate(); err != nil {
+		return UseCase{}, fmt.Errorf("validate options, err=%v", err)
+	}
+
+	return UseCase{opts}, nil
+}
+
+func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
+	if err := req.Validate(); err != nil {
+		return Response{}, fmt.Errorf("validate request, err=%w", ErrInvalidRequest)
+	}
+
+	msgs, err := u.msgRepo.GetMessagesForReview(ctx, messagesLimit)
+	if err != nil {
+		return Response{}, fmt.Errorf("messages repo, get messages for review, err=%w", err)
+	}
+
+	resp := Response{Messages: make([]Message, 0, len(msgs))}
+	for _, m := range msgs {
+		resp.Messages = append(resp.Messages, Message{
+			ID:                m.ID,
+			ChatID:            m.ChatID,
+			AuthorID:          m.AuthorID,
+			Body:              m.Body,
+			CreatedAt:         m.CreatedAt,
+			ReviewRequestedAt: m.ReviewRequestedAt,
+		})
+	}
+
+	return resp, nil
+}
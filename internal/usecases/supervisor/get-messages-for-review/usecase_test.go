@@ -0,0 +1,111 @@
+package getmessagesforreview_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	messagesrepo "github.com/karasunokami/chat-service/internal/repositories/messages"
+	"github.com/karasunokami/chat-service/internal/testingh"
+	"github.com/karasunokami/chat-service/internal/types"
+	getmessagesforreview "github.com/karasunokami/chat-service/internal/usecases/supervisor/get-messages-for-review"
+	getmessagesforreviewmocks "github.com/karasunokami/chat-service/internal/usecases/supervisor/get-messages-for-review/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl    *gomock.Controller
+	msgRepo *getmessagesforreviewmocks.MockmessagesRepository
+	uCase   getmessagesforreview.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.msgRepo = getmessagesforreviewmocks.NewMockmessagesRepository(s.ctrl)
+
+	var err error
+	s.uCase, err = getmessagesforreview.New(getmessagesforreview.NewOptions(s.msgRepo))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, getmessagesforreview.Request{})
+
+	// Assert.
+	s.Require().ErrorIs(err, getmessagesforreview.ErrInvalidRequest)
+	s.Empty(resp.Messages)
+}
+
+func (s *UseCaseSuite) TestGetMessagesError() {
+	// Arrange.
+	req := getmessagesforreview.Request{ID: types.NewRequestID(), SupervisorID: types.NewUserID()}
+	errExpected := errors.New("any error")
+
+	s.msgRepo.EXPECT().GetMessagesForReview(s.Ctx, gomock.Any()).Return(nil, errExpected)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, errExpected)
+	s.Empty(resp.Messages)
+}
+
+func (s *UseCaseSuite) TestSuccess() {
+	// Arrange.
+	req := getmessagesforreview.Request{ID: types.NewRequestID(), SupervisorID: types.NewUserID()}
+
+	msgs := []messagesrepo.Message{
+		{
+			ID:                types.NewMessageID(),
+			ChatID:            types.NewChatID(),
+			AuthorID:          types.NewUserID(),
+			Body:              "My card number is 4242 4242 4242 4242",
+			CreatedAt:         time.Now().Add(-time.Hour),
+			ReviewRequestedAt: time.Now().Add(-time.Minute),
+		},
+		{
+			ID:                types.NewMessageID(),
+			ChatID:            types.NewChatID(),
+			AuthorID:          types.NewUserID(),
+			Body:              "Call me back",
+			CreatedAt:         time.Now().Add(-time.Minute),
+			ReviewRequestedAt: time.Now(),
+		},
+	}
+	s.msgRepo.EXPECT().GetMessagesForReview(s.Ctx, gomock.Any()).Return(msgs, nil)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Require().Len(resp.Messages, len(msgs))
+
+	for i, m := range resp.Messages {
+		s.Equal(msgs[i].ID, m.ID)
+		s.Equal(msgs[i].ChatID, m.ChatID)
+		s.Equal(msgs[i].AuthorID, m.AuthorID)
+		s.Equal(msgs[i].Body, m.Body)
+		s.Equal(msgs[i].CreatedAt, m.CreatedAt)
+		s.Equal(msgs[i].ReviewRequestedAt, m.ReviewRequestedAt)
+	}
+}
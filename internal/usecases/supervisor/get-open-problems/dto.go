@@ -0,0 +1,34 @@
+package getopenproblems
+
+import (
+	"time"
+
+	"github.com/karasunokami/chat-service/internal/types"
+	"github.com/karasunokami/chat-service/internal/validator"
+)
+
+type Request struct {
+	ID           types.RequestID `validate:"required"`
+	SupervisorID types.UserID    `validate:"required"`
+}
+
+func (r Request) Validate() error {
+	return validator.Validator.Struct(r)
+}
+
+type Response struct {
+	Problems []Problem
+}
+
+// Problem is the open problem. ManagerID is zero if the client is still waiting for the manager.
+type Problem struct {
+	ID        types.ProblemID
+	ChatID    types.ChatID
+	ClientID  types.UserID
+	ManagerID types.UserID
+	CreatedAt time.Time
+	// WaitTime is the time since the problem was opened.
+	WaitTime time.Duration
+	// ReassignRequestedAt is set if the manager of the problem has been offline for too long.
+	ReassignRequestedAt time.Time
+}
@@ -0,0 +1,51 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+
+// Package getopenproblemsmocks is a generated GoMock package.
+package getopenproblemsmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	problemsrepo "github.com/karasunokami/chat-service/internal/repositories/problems"
+)
+
+// MockproblemsRepository is a mock of problemsRepository interface.
+type MockproblemsRepository struct {
+	ctrl     *gomock.Controller
+	recorder *MockproblemsRepositoryMockRecorder
+}
+
+// MockproblemsRepositoryMockRecorder is the mock recorder for MockproblemsRepository.
+type MockproblemsRepositoryMockRecorder struct {
+	mock *MockproblemsRepository
+}
+
+// NewMockproblemsRepository creates a new mock instance.
+func NewMockproblemsRepository(ctrl *gomock.Controller) *MockproblemsRepository {
+	mock := &MockproblemsRepository{ctrl: ctrl}
+	mock.recorder = &MockproblemsRepositoryMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockproblemsRepository) EXPECT() *MockproblemsRepositoryMockRecorder {
+	return m.recorder
+}
+
+// GetOpenProblems mocks base method.
+func (m *MockproblemsRepository) GetOpenProblems(ctx context.Context, limit int) ([]problemsrepo.OpenProblem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenProblems", ctx, limit)
+	ret0, _ := ret[0].([]problemsrepo.OpenProblem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenProblems indicates an expected call of GetOpenProblems.
+func (mr *MockproblemsRepositoryMockRecorder) GetOpenProblems(ctx, limit interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenProblems", reflect.TypeOf((*MockproblemsRepository)(nil).GetOpenProblems), ctx, limit)
+}
@@ -0,0 +1,66 @@
+package getopenproblems
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	problemsrepo "github.com/karasunokami/chat-service/internal/repositories/problems"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=getopenproblemsmocks
+
+// problemsLimit keeps the response reasonable if the support is overloaded.
+const problemsLimit = 500
+
+var ErrInvalidRequest = errors.New("invalid request")
+
+type problemsRepository interface {
+	GetOpenProblems(ctx context.Context, limit int) ([]problemsrepo.OpenProblem, error)
+}
+
+//go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
+type Options struct {
+	problemsRepo problemsRepository `option:"mandatory" validate:"required"`
+}
+
+type UseCase struct {
+	Options
+}
+
+func New(opts Options) (UseCase, error) {
+	if err := opts.Validate(); err != nil {
+		return UseCase{}, fmt.Errorf("validate options, err=%v", err)
+	}
+
+	return UseCase{opts}, nil
+}
+
+func (u UseCase) Handle(ctx context.Context, req Request) (Response, error) {
+	if err := req.Validate(); err != nil {
+		return Response{}, fmt.Errorf("validate request, err=%w", ErrInvalidRequest)
+	}
+
+	problems, err := u.problemsRepo.GetOpenProblems(ctx, problemsLimit)
+	if err != nil {
+		return Response{}, fmt.Errorf("problems repo, get open problems, err=%w", err)
+	}
+
+	now := time.Now()
+
+	resp := Response{Problems: make([]Problem, 0, len(problems))}
+	for _, p := range problems {
+		resp.Problems = append(resp.Problems, Problem{
+			ID:                  p.ID,
+			ChatID:              p.ChatID,
+			ClientID:            p.ClientID,
+			ManagerID:           p.ManagerID,
+			CreatedAt:           p.CreatedAt,
+			WaitTime:            now.Sub(p.CreatedAt),
+			ReassignRequestedAt: p.ReassignRequestedAt,
+		})
+	}
+
+	return resp, nil
+}
@@ -0,0 +1,117 @@
+package getopenproblems_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	problemsrepo "github.com/karasunokami/chat-service/internal/repositories/problems"
+	"github.com/karasunokami/chat-service/internal/testingh"
+	"github.com/karasunokami/chat-service/internal/types"
+	getopenproblems "github.com/karasunokami/chat-service/internal/usecases/supervisor/get-open-problems"
+	getopenproblemsmocks "github.com/karasunokami/chat-service/internal/usecases/supervisor/get-open-problems/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl         *gomock.Controller
+	problemsRepo *getopenproblemsmocks.MockproblemsRepository
+	uCase        getopenproblems.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.problemsRepo = getopenproblemsmocks.NewMockproblemsRepository(s.ctrl)
+
+	var err error
+	s.uCase, err = getopenproblems.New(getopenproblems.NewOptions(s.problemsRepo))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Arrange.
+	req := getopenproblems.Request{}
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, getopenproblems.ErrInvalidRequest)
+	s.Empty(resp.Problems)
+}
+
+func (s *UseCaseSuite) TestGetOpenProblemsError() {
+	// Arrange.
+	req := getopenproblems.Request{ID: types.NewRequestID(), SupervisorID: types.NewUserID()}
+	errExpected := errors.New("any error")
+
+	s.problemsRepo.EXPECT().GetOpenProblems(s.Ctx, gomock.Any()).Return(nil, errExpected)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, errExpected)
+	s.Empty(resp.Problems)
+}
+
+func (s *UseCaseSuite) TestSuccess() {
+	// Arrange.
+	req := getopenproblems.Request{ID: types.NewRequestID(), SupervisorID: types.NewUserID()}
+
+	problems := []problemsrepo.OpenProblem{
+		{
+			ID:        types.NewProblemID(),
+			ChatID:    types.NewChatID(),
+			ClientID:  types.NewUserID(),
+			ManagerID: types.NewUserID(),
+			CreatedAt: time.Now().Add(-time.Hour),
+
+			ReassignRequestedAt: time.Now().Add(-time.Minute),
+		},
+		{
+			ID:        types.NewProblemID(),
+			ChatID:    types.NewChatID(),
+			ClientID:  types.NewUserID(),
+			CreatedAt: time.Now().Add(-time.Minute),
+		},
+	}
+	s.problemsRepo.EXPECT().GetOpenProblems(s.Ctx, gomock.Any()).Return(problems, nil)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Require().Len(resp.Problems, len(problems))
+
+	for i, p := range resp.Problems {
+		s.Equal(problems[i].ID, p.ID)
+		s.Equal(problems[i].ChatID, p.ChatID)
+		s.Equal(problems[i].ClientID, p.ClientID)
+		s.Equal(problems[i].ManagerID, p.ManagerID)
+		s.Equal(problems[i].CreatedAt, p.CreatedAt)
+		s.Equal(problems[i].ReassignRequestedAt, p.ReassignRequestedAt)
+	}
+
+	s.InDelta(time.Hour, resp.Problems[0].WaitTime, float64(time.Second))
+	s.InDelta(time.Minute, resp.Problems[1].WaitTime, float64(time.Second))
+	s.True(resp.Problems[1].ManagerID.IsZero())
+}
@@ -0,0 +1,26 @@
+package resolvemessagereview
+
+import (
+	"github.com/karasunokami/chat-service/internal/types"
+	"github.com/karasunokami/chat-service/internal/validator"
+)
+
+type Decision string
+
+const (
+	// DecisionApprove makes the message visible for the manager.
+	DecisionApprove Decision = "approve"
+	// DecisionReject blocks the message.
+	DecisionReject Decision = "reject"
+)
+
+type Request struct {
+	ID           types.RequestID `validate:"required"`
+	SupervisorID types.UserID    `validate:"required"`
+	MessageID    types.MessageID `validate:"required"`
+	Decision     Decision        `validate:"required,oneof=approve reject"`
+}
+
+func (r Request) Validate() error {
+	return validator.Validator.Struct(r)
+}
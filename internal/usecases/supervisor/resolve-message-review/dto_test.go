@@ -0,0 +1,90 @@
+package resolvemessagereview_test
+
+import (
+	"testing"
+
+	"github.com/karasunokami/chat-service/internal/types"
+	resolvemessagereview "github.com/karasunokami/chat-service/internal/usecases/supervisor/resolve-message-review"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequest_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		request resolvemessagereview.Request
+		wantErr bool
+	}{
+		// Positive.
+		{
+			name: "approve",
+			request: resolvemessagereview.Request{
+				ID:           types.NewRequestID(),
+				SupervisorID: types.NewUserID(),
+				MessageID:    types.NewMessageID(),
+				Decision:     resolvemessagereview.DecisionApprove,
+			},
+			wantErr: false,
+		},
+		{
+			name: "reject",
+			request: resolvemessagereview.Request{
+				ID:           types.NewRequestID(),
+				SupervisorID: types.NewUserID(),
+				MessageID:    types.NewMessageID(),
+				Decision:     resolvemessagereview.DecisionReject,
+			},
+			wantErr: false,
+		},
+
+		// Negative.
+		{
+			name: "require request id",
+			request: resolvemessagereview.Request{
+				SupervisorID: types.NewUserID(),
+				MessageID:    types.NewMessageID(),
+				Decision:     resolvemessagereview.DecisionApprove,
+			},
+			wantErr: true,
+		},
+		{
+			name: "require supervisor id",
+			request: resolvemessagereview.Request{
+				ID:        types.NewRequestID(),
+				MessageID: types.NewMessageID(),
+				Decision:  resolvemessagereview.DecisionApprove,
+			},
+			wantErr: true,
+		},
+		{
+			name: "require message id",
+			request: resolvemessagereview.Request{
+				ID:           types.NewRequestID(),
+				SupervisorID: types.NewUserID(),
+				Decision:     resolvemessagereview.DecisionApprove,
+			},
+			wantErr: true,
+		},
+		{
+			name: "unknown decision",
+			request: resolvemessagereview.Request{
+				ID:           types.NewRequestID(),
+				SupervisorID: types.NewUserID(),
+				MessageID:    types.NewMessageID(),
+				Decision:     "postpone",
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,64 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: usecase.go
+
+// Package resolvemessagereviewmocks is a generated GoMock package.
+package resolvemessagereviewmocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	gomock "github.com/golang/mock/gomock"
+	types "github.com/karasunokami/chat-service/internal/types"
+)
+
+// MockreviewResolver is a mock of reviewResolver interface.
+type MockreviewResolver struct {
+	ctrl     *gomock.Controller
+	recorder *MockreviewResolverMockRecorder
+}
+
+// MockreviewResolverMockRecorder is the mock recorder for MockreviewResolver.
+type MockreviewResolverMockRecorder struct {
+	mock *MockreviewResolver
+}
+
+// NewMockreviewResolver creates a new mock instance.
+func NewMockreviewResolver(ctrl *gomock.Controller) *MockreviewResolver {
+	mock := &MockreviewResolver{ctrl: ctrl}
+	mock.recorder = &MockreviewResolverMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockreviewResolver) EXPECT() *MockreviewResolverMockRecorder {
+	return m.recorder
+}
+
+// Approve mocks base method.
+func (m *MockreviewResolver) Approve(ctx context.Context, msgID types.MessageID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Approve", ctx, msgID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Approve indicates an expected call of Approve.
+func (mr *MockreviewResolverMockRecorder) Approve(ctx, msgID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Approve", reflect.TypeOf((*MockreviewResolver)(nil).Approve), ctx, msgID)
+}
+
+// Reject mocks base method.
+func (m *MockreviewResolver) Reject(ctx context.Context, msgID types.MessageID) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Reject", ctx, msgID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Reject indicates an expected call of Reject.
+func (mr *MockreviewResolverMockRecorder) Reject(ctx, msgID interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Reject", reflect.TypeOf((*MockreviewResolver)(nil).Reject), ctx, msgID)
+}
@@ -0,0 +1,61 @@
+package resolvemessagereview
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	messagesrepo "github.com/karasunokami/chat-service/internal/repositories/messages"
+	"github.com/karasunokami/chat-service/internal/types"
+)
+
+//go:generate mockgen -source=$GOFILE -destination=mocks/usecase_mock.gen.go -package=resolvemessagereviewmocks
+
+var (
+	ErrInvalidRequest        = errors.New("invalid request")
+	ErrMessageNotUnderReview = errors.New("message is not under review")
+)
+
+type reviewResolver interface {
+	Approve(ctx context.Context, msgID types.MessageID) error
+	Reject(ctx context.Context, msgID types.MessageID) error
+}
+
+//go:generate options-gen -out-filename=usecase_options.gen.go -from-struct=Options
+type Options struct {
+	resolver reviewResolver `option:"mandatory" validate:"required"`
+}
+
+// UseCase applies the supervisor decision to the message from the manual review queue.
+// The approved message is delivered as if AFC found it ok, the rejected one is blocked.
+type UseCase struct {
+	Options
+}
+
+func New(opts Options) (UseCase, error) {
+	if err := opts.Validate(); err != nil {
+		return UseCase{}, fmt.Errorf("validate options, err=%v", err)
+	}
+
+	return UseCase{opts}, nil
+}
+
+func (u UseCase) Handle(ctx context.Context, req Request) error {
+	if err := req.Validate(); err != nil {
+		return fmt.Errorf("validate request, err=%w", ErrInvalidRequest)
+	}
+
+	resolve := u.resolver.Reject
+	if req.Decision == DecisionApprove {
+		resolve = u.resolver.Approve
+	}
+
+	if err := resolve(ctx, req.MessageID); err != nil {
+		if errors.Is(err, messagesrepo.ErrMsgNotUnderReview) {
+			return ErrMessageNotUnderReview
+		}
+		return fmt.Errorf("resolve review, err=%w", err)
+	}
+
+	return nil
+}
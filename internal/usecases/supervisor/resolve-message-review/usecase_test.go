@@ -0,0 +1,115 @@
+package resolvemessagereview_test
+
+import (
+	"io"
+	"testing"
+
+	messagesrepo "github.com/karasunokami/chat-service/internal/repositories/messages"
+	"github.com/karasunokami/chat-service/internal/testingh"
+	"github.com/karasunokami/chat-service/internal/types"
+	resolvemessagereview "github.com/karasunokami/chat-service/internal/usecases/supervisor/resolve-message-review"
+	resolvemessagereviewmocks "github.com/karasunokami/chat-service/internal/usecases/supervisor/resolve-message-review/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl     *gomock.Controller
+	resolver *resolvemessagereviewmocks.MockreviewResolver
+	uCase    resolvemessagereview.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.resolver = resolvemessagereviewmocks.NewMockreviewResolver(s.ctrl)
+
+	var err error
+	s.uCase, err = resolvemessagereview.New(resolvemessagereview.NewOptions(s.resolver))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Action.
+	err := s.uCase.Handle(s.Ctx, resolvemessagereview.Request{})
+
+	// Assert.
+	s.Require().ErrorIs(err, resolvemessagereview.ErrInvalidRequest)
+}
+
+func (s *UseCaseSuite) TestMessageNotUnderReview() {
+	// Arrange.
+	req := s.newRequest(resolvemessagereview.DecisionApprove)
+
+	s.resolver.EXPECT().Approve(s.Ctx, req.MessageID).Return(messagesrepo.ErrMsgNotUnderReview)
+
+	// Action.
+	err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, resolvemessagereview.ErrMessageNotUnderReview)
+}
+
+func (s *UseCaseSuite) TestResolveError() {
+	// Arrange.
+	req := s.newRequest(resolvemessagereview.DecisionReject)
+
+	s.resolver.EXPECT().Reject(s.Ctx, req.MessageID).Return(io.EOF)
+
+	// Action.
+	err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, io.EOF)
+	s.NotErrorIs(err, resolvemessagereview.ErrMessageNotUnderReview)
+}
+
+func (s *UseCaseSuite) TestApproved() {
+	// Arrange.
+	req := s.newRequest(resolvemessagereview.DecisionApprove)
+
+	s.resolver.EXPECT().Approve(s.Ctx, req.MessageID).Return(nil)
+
+	// Action.
+	err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+}
+
+func (s *UseCaseSuite) TestRejected() {
+	// Arrange.
+	req := s.newRequest(resolvemessagereview.DecisionReject)
+
+	s.resolver.EXPECT().Reject(s.Ctx, req.MessageID).Return(nil)
+
+	// Action.
+	err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+}
+
+func (s *UseCaseSuite) newRequest(d resolvemessagereview.Decision) resolvemessagereview.Request {
+	return resolvemessagereview.Request{
+		ID:           types.NewRequestID(),
+		SupervisorID: types.NewUserID(),
+		MessageID:    types.NewMessageID(),
+		Decision:     d,
+	}
+}
@@ -0,0 +1,81 @@
+package sendwhisper_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/karasunokami/chat-service/internal/types"
+	sendwhisper "github.com/karasunokami/chat-service/internal/usecases/supervisor/send-whisper"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRequest_Validate(t *testing.T) {
+	cases := []struct {
+		name    string
+		request sendwhisper.Request
+		wantErr bool
+	}{
+		// Positive.
+		{
+			name: "valid request",
+			request: sendwhisper.Request{
+				ID:           types.NewRequestID(),
+				SupervisorID: types.NewUserID(),
+				ChatID:       types.NewChatID(),
+				NoteBody:     "Ask the client for the contract number",
+			},
+			wantErr: false,
+		},
+
+		// Negative.
+		{
+			name: "require request id",
+			request: sendwhisper.Request{
+				SupervisorID: types.NewUserID(),
+				ChatID:       types.NewChatID(),
+				NoteBody:     "Ask the client for the contract number",
+			},
+			wantErr: true,
+		},
+		{
+			name: "require supervisor id",
+			request: sendwhisper.Request{
+				ID:       types.NewRequestID(),
+				ChatID:   types.NewChatID(),
+				NoteBody: "Ask the client for the contract number",
+			},
+			wantErr: true,
+		},
+		{
+			name: "require chat id",
+			request: sendwhisper.Request{
+				ID:           types.NewRequestID(),
+				SupervisorID: types.NewUserID(),
+				NoteBody:     "Ask the client for the contract number",
+			},
+			wantErr: true,
+		},
+		{
+			name: "too long note body",
+			request: sendwhisper.Request{
+				ID:           types.NewRequestID(),
+				SupervisorID: types.NewUserID(),
+				ChatID:       types.NewChatID(),
+				NoteBody:     strings.Repeat("a", 3001),
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			err := tt.request.Validate()
+			if tt.wantErr {
+				assert.Error(t, err)
+			} else {
+				assert.NoError(t, err)
+			}
+		})
+	}
+}
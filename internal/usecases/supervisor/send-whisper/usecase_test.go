@@ -0,0 +1,183 @@
+package sendwhisper_test
+
+import (
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	messagesrepo "github.com/karasunokami/chat-service/internal/repositories/messages"
+	problemsrepo "github.com/karasunokami/chat-service/internal/repositories/problems"
+	sendinternalnotejob "github.com/karasunokami/chat-service/internal/services/outbox/jobs/send-internal-note"
+	"github.com/karasunokami/chat-service/internal/testingh"
+	"github.com/karasunokami/chat-service/internal/types"
+	sendwhisper "github.com/karasunokami/chat-service/internal/usecases/supervisor/send-whisper"
+	sendwhispermocks "github.com/karasunokami/chat-service/internal/usecases/supervisor/send-whisper/mocks"
+
+	"github.com/golang/mock/gomock"
+	"github.com/stretchr/testify/suite"
+)
+
+type UseCaseSuite struct {
+	testingh.ContextSuite
+
+	ctrl        *gomock.Controller
+	msgRepo     *sendwhispermocks.MockmessagesRepository
+	problemRepo *sendwhispermocks.MockproblemsRepository
+	txtor       *sendwhispermocks.Mocktransactor
+	outBoxSvc   *sendwhispermocks.MockoutboxService
+	uCase       sendwhisper.UseCase
+}
+
+func TestUseCaseSuite(t *testing.T) {
+	t.Parallel()
+	suite.Run(t, new(UseCaseSuite))
+}
+
+func (s *UseCaseSuite) SetupTest() {
+	s.ctrl = gomock.NewController(s.T())
+	s.msgRepo = sendwhispermocks.NewMockmessagesRepository(s.ctrl)
+	s.outBoxSvc = sendwhispermocks.NewMockoutboxService(s.ctrl)
+	s.problemRepo = sendwhispermocks.NewMockproblemsRepository(s.ctrl)
+	s.txtor = sendwhispermocks.NewMocktransactor(s.ctrl)
+
+	var err error
+	s.uCase, err = sendwhisper.New(sendwhisper.NewOptions(s.msgRepo, s.outBoxSvc, s.problemRepo, s.txtor))
+	s.Require().NoError(err)
+
+	s.ContextSuite.SetupTest()
+}
+
+func (s *UseCaseSuite) TearDownTest() {
+	s.ctrl.Finish()
+
+	s.ContextSuite.TearDownTest()
+}
+
+func (s *UseCaseSuite) TestRequestValidationError() {
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, sendwhisper.Request{})
+
+	// Assert.
+	s.Require().ErrorIs(err, sendwhisper.ErrInvalidRequest)
+	s.Empty(resp.MessageID)
+}
+
+func (s *UseCaseSuite) TestProblemNotFound() {
+	// Arrange.
+	req := s.newRequest()
+	s.problemRepo.EXPECT().GetChatOpenProblem(s.Ctx, req.ChatID).
+		Return(problemsrepo.OpenProblem{}, problemsrepo.ErrNotFound)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, sendwhisper.ErrProblemNotFound)
+	s.Empty(resp.MessageID)
+}
+
+func (s *UseCaseSuite) TestGetProblemError() {
+	// Arrange.
+	req := s.newRequest()
+	s.problemRepo.EXPECT().GetChatOpenProblem(s.Ctx, req.ChatID).Return(problemsrepo.OpenProblem{}, io.EOF)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().Error(err)
+	s.NotErrorIs(err, sendwhisper.ErrProblemNotFound)
+	s.Empty(resp.MessageID)
+}
+
+func (s *UseCaseSuite) TestCreateNoteError() {
+	// Arrange.
+	req := s.newRequest()
+	problem := s.newProblem(req.ChatID)
+
+	s.problemRepo.EXPECT().GetChatOpenProblem(s.Ctx, req.ChatID).Return(problem, nil)
+	s.expectTx()
+	s.msgRepo.EXPECT().CreateInternalNote(s.Ctx, req.ID, problem.ID, req.ChatID, req.SupervisorID, req.NoteBody).
+		Return(nil, io.EOF)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, io.EOF)
+	s.Empty(resp.MessageID)
+}
+
+func (s *UseCaseSuite) TestPutJobError() {
+	// Arrange.
+	req := s.newRequest()
+	problem := s.newProblem(req.ChatID)
+
+	s.problemRepo.EXPECT().GetChatOpenProblem(s.Ctx, req.ChatID).Return(problem, nil)
+	s.expectTx()
+	s.msgRepo.EXPECT().CreateInternalNote(s.Ctx, req.ID, problem.ID, req.ChatID, req.SupervisorID, req.NoteBody).
+		Return(&messagesrepo.Message{ID: types.NewMessageID()}, nil)
+	s.outBoxSvc.EXPECT().Put(s.Ctx, sendinternalnotejob.Name, gomock.Any(), gomock.Any()).Return(types.JobIDNil, io.EOF)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().ErrorIs(err, io.EOF)
+	s.Empty(resp.MessageID)
+}
+
+func (s *UseCaseSuite) TestSuccess() {
+	// Arrange.
+	req := s.newRequest()
+	problem := s.newProblem(req.ChatID)
+	note := &messagesrepo.Message{
+		ID:        types.NewMessageID(),
+		CreatedAt: time.Now(),
+	}
+
+	s.problemRepo.EXPECT().GetChatOpenProblem(s.Ctx, req.ChatID).Return(problem, nil)
+	s.expectTx()
+	s.msgRepo.EXPECT().CreateInternalNote(s.Ctx, req.ID, problem.ID, req.ChatID, req.SupervisorID, req.NoteBody).
+		Return(note, nil)
+
+	// The note is delivered to the manager of the problem.
+	expectedPayload, err := sendinternalnotejob.MarshalPayload(note.ID, problem.ManagerID)
+	s.Require().NoError(err)
+	s.outBoxSvc.EXPECT().Put(s.Ctx, sendinternalnotejob.Name, expectedPayload, gomock.Any()).Return(types.NewJobID(), nil)
+
+	// Action.
+	resp, err := s.uCase.Handle(s.Ctx, req)
+
+	// Assert.
+	s.Require().NoError(err)
+	s.Equal(note.ID, resp.MessageID)
+	s.Equal(note.CreatedAt, resp.CreatedAt)
+}
+
+func (s *UseCaseSuite) newRequest() sendwhisper.Request {
+	return sendwhisper.Request{
+		ID:           types.NewRequestID(),
+		SupervisorID: types.NewUserID(),
+		ChatID:       types.NewChatID(),
+		NoteBody:     "Ask the client for the contract number",
+	}
+}
+
+func (s *UseCaseSuite) newProblem(chatID types.ChatID) problemsrepo.OpenProblem {
+	return problemsrepo.OpenProblem{
+		ID:        types.NewProblemID(),
+		ChatID:    chatID,
+		ClientID:  types.NewUserID(),
+		ManagerID: types.NewUserID(),
+		CreatedAt: time.Now(),
+	}
+}
+
+func (s *UseCaseSuite) expectTx() {
+	s.txtor.EXPECT().RunInTx(s.Ctx, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, f func(ctx context.Context) error) error {
+			return f(ctx)
+		})
+}
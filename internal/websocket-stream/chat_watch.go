@@ -0,0 +1,184 @@
+package websocketstream
+
+import (
+	"context"
+	"errors"
+	"sync"
+
+	eventstream "github.com/karasunokami/chat-service/internal/services/event-stream"
+	"github.com/karasunokami/chat-service/internal/types"
+
+	"go.uber.org/zap"
+)
+
+const (
+	clientFrameWatchChat   = "WatchChat"
+	clientFrameUnwatchChat = "UnwatchChat"
+
+	maxChatWatchesPerSession = 100
+)
+
+var errTooManyChatWatches = errors.New("too many chat watches")
+
+type chatWatcher interface {
+	SubscribeChat(ctx context.Context, chatID types.ChatID) (<-chan eventstream.Event, error)
+}
+
+type ChatWatchStartedEvent struct {
+	EventType string       `json:"eventType"`
+	ChatID    types.ChatID `json:"chatId"`
+}
+
+func newChatWatchStartedEvent(chatID types.ChatID) ChatWatchStartedEvent {
+	return ChatWatchStartedEvent{EventType: "ChatWatchStartedEvent", ChatID: chatID}
+}
+
+type ChatWatchStoppedEvent struct {
+	EventType string       `json:"eventType"`
+	ChatID    types.ChatID `json:"chatId"`
+}
+
+func newChatWatchStoppedEvent(chatID types.ChatID) ChatWatchStoppedEvent {
+	return ChatWatchStoppedEvent{EventType: "ChatWatchStoppedEvent", ChatID: chatID}
+}
+
+type ChatWatchFailedEvent struct {
+	EventType string       `json:"eventType"`
+	ChatID    types.ChatID `json:"chatId"`
+	Reason    string       `json:"reason"`
+}
+
+func newChatWatchFailedEvent(chatID types.ChatID, reason string) ChatWatchFailedEvent {
+	return ChatWatchFailedEvent{EventType: "ChatWatchFailedEvent", ChatID: chatID, Reason: reason}
+}
+
+// chatWatches multiplexes the events of the chats watched by the session into the single stream.
+type chatWatches struct {
+	ctx     context.Context // Parent of the watches contexts.
+	watcher chatWatcher
+	events  chan eventstream.Event
+	wg      sync.WaitGroup
+
+	mu      sync.Mutex
+	cancels map[types.ChatID]context.CancelFunc
+}
+
+func newChatWatches(ctx context.Context, watcher chatWatcher) *chatWatches {
+	return &chatWatches{
+		ctx:     ctx,
+		watcher: watcher,
+		events:  make(chan eventstream.Event),
+		cancels: make(map[types.ChatID]context.CancelFunc),
+	}
+}
+
+func (w *chatWatches) Events() <-chan eventstream.Event {
+	return w.events
+}
+
+// Watch is idempotent, the repeated watch of the chat does not duplicate its events.
+func (w *chatWatches) Watch(chatID types.ChatID) error {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if _, ok := w.cancels[chatID]; ok {
+		return nil
+	}
+
+	if len(w.cancels) >= maxChatWatchesPerSession {
+		return errTooManyChatWatches
+	}
+
+	ctx, cancel := context.WithCancel(w.ctx)
+
+	events, err := w.watcher.SubscribeChat(ctx, chatID)
+	if err != nil {
+		cancel()
+		return err
+	}
+	w.cancels[chatID] = cancel
+
+	w.wg.Add(1)
+	go func() {
+		defer w.wg.Done()
+		w.forward(ctx, events)
+	}()
+
+	return nil
+}
+
+func (w *chatWatches) Unwatch(chatID types.ChatID) {
+	w.mu.Lock()
+	defer w.mu.Unlock()
+
+	if cancel, ok := w.cancels[chatID]; ok {
+		cancel()
+		delete(w.cancels, chatID)
+	}
+}
+
+// Stop cancels all the watches and waits for their completion.
+func (w *chatWatches) Stop() {
+	w.mu.Lock()
+	for chatID, cancel := range w.cancels {
+		cancel()
+		delete(w.cancels, chatID)
+	}
+	w.mu.Unlock()
+
+	w.wg.Wait()
+}
+
+func (w *chatWatches) forward(ctx context.Context, events <-chan eventstream.Event) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+
+		case event, ok := <-events:
+			if !ok {
+				return
+			}
+
+			select {
+			case <-ctx.Done():
+				return
+			case w.events <- event:
+			}
+		}
+	}
+}
+
+// watchChat subscribes the session to the events of any chat.
+func (h *HTTPHandler) watchChat(s *session, chatID types.ChatID) any {
+	if s.watches == nil {
+		return newChatWatchFailedEvent(chatID, "chat watching is not supported")
+	}
+
+	if !s.canWatchChats {
+		return newChatWatchFailedEvent(chatID, "forbidden")
+	}
+
+	if chatID.IsZero() {
+		return newChatWatchFailedEvent(chatID, "invalid chat id")
+	}
+
+	if err := s.watches.Watch(chatID); err != nil {
+		if errors.Is(err, errTooManyChatWatches) {
+			return newChatWatchFailedEvent(chatID, "too many watched chats")
+		}
+
+		h.logger.Warn("Cannot watch chat", zap.Stringer("user_id", s.uid), zap.Stringer("chat_id", chatID), zap.Error(err))
+		return newChatWatchFailedEvent(chatID, "chat cannot be watched")
+	}
+
+	return newChatWatchStartedEvent(chatID)
+}
+
+func (h *HTTPHandler) unwatchChat(s *session, chatID types.ChatID) any {
+	if s.watches != nil {
+		s.watches.Unwatch(chatID)
+	}
+
+	return newChatWatchStoppedEvent(chatID)
+}
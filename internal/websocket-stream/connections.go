@@ -0,0 +1,41 @@
+package websocketstream
+
+import (
+	"sync"
+
+	"github.com/karasunokami/chat-service/internal/types"
+)
+
+// userConnections counts open connections of each user.
+type userConnections struct {
+	mu     sync.Mutex
+	byUser map[types.UserID]int
+}
+
+func newUserConnections() *userConnections {
+	return &userConnections{byUser: make(map[types.UserID]int)}
+}
+
+// Acquire registers a new connection of the user if the limit allows. Zero limit means no limit.
+func (c *userConnections) Acquire(userID types.UserID, limit int) bool {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if limit > 0 && c.byUser[userID] >= limit {
+		return false
+	}
+
+	c.byUser[userID]++
+	return true
+}
+
+func (c *userConnections) Release(userID types.UserID) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.byUser[userID] <= 1 {
+		delete(c.byUser, userID)
+		return
+	}
+	c.byUser[userID]--
+}
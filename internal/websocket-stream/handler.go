@@ -4,12 +4,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"net/http"
 	"time"
 
+	keycloakclient "github.com/karasunokami/chat-service/internal/clients/keycloak"
+	internalerrors "github.com/karasunokami/chat-service/internal/errors"
 	"github.com/karasunokami/chat-service/internal/middlewares"
 	eventstream "github.com/karasunokami/chat-service/internal/services/event-stream"
 	"github.com/karasunokami/chat-service/internal/types"
 
+	"github.com/google/uuid"
 	gorillaws "github.com/gorilla/websocket"
 	"github.com/labstack/echo/v4"
 	"go.uber.org/zap"
@@ -21,12 +25,32 @@ const (
 	writeTimeout = time.Second
 )
 
+// CloseTokenRevoked is sent to the client when the session token becomes inactive before its expiration,
+// e.g. the user was logged out in Keycloak.
+const CloseTokenRevoked = 4001
+
+var (
+	ErrTooManyConnections = errors.New("too many connections")
+
+	errTokenRevoked = errors.New("token revoked")
+)
+
 type eventStream interface {
 	Subscribe(ctx context.Context, userID types.UserID) (<-chan eventstream.Event, error)
 }
 
 type tokenExpiration interface {
-	NewExpireContext(ctx context.Context, userID string, deadline time.Time) (context.Context, error)
+	NewExpireContext(ctx context.Context, id string, deadline time.Time) (context.Context, error)
+	Extend(id string, deadline time.Time) error
+}
+
+type presenceTracker interface {
+	Connected(ctx context.Context, userID types.UserID)
+	Disconnected(ctx context.Context, userID types.UserID)
+}
+
+type tokenIntrospector interface {
+	IntrospectToken(ctx context.Context, token string) (*keycloakclient.IntrospectTokenResult, error)
 }
 
 //go:generate options-gen -out-filename=handler_options.gen.go -from-struct=Options
@@ -40,12 +64,30 @@ type Options struct {
 	upgrader        Upgrader        `option:"mandatory" validate:"required"`
 	shutdownCh      <-chan struct{} `option:"mandatory" validate:"required"`
 	tokenExpiration tokenExpiration `option:"mandatory" validate:"required"`
+
+	// tokenVerifier enables in-band refresh of the session token.
+	tokenVerifier tokenVerifier
+
+	// tokenIntrospector enables periodic revalidation of the session token.
+	tokenIntrospector  tokenIntrospector
+	revalidationPeriod time.Duration `default:"1m" validate:"min=100ms,max=1h"`
+
+	// chatWatcher enables watching of any chat by the users allowed by canWatchChats.
+	chatWatcher   chatWatcher
+	canWatchChats func(eCtx echo.Context) bool
+
+	// maxConnectionsPerUser limits the concurrent connections of the user. Zero means no limit.
+	maxConnectionsPerUser int `validate:"min=0"`
+
+	// presence is notified about the opened and closed connections of the users.
+	presence presenceTracker
 }
 
 type HTTPHandler struct {
 	Options
-	pingPeriod time.Duration
-	pongWait   time.Duration
+	pingPeriod  time.Duration
+	pongWait    time.Duration
+	connections *userConnections
 }
 
 func NewHTTPHandler(opts Options) (*HTTPHandler, error) {
@@ -56,22 +98,41 @@ func NewHTTPHandler(opts Options) (*HTTPHandler, error) {
 	opts.logger = opts.logger.Named(serviceName)
 
 	return &HTTPHandler{
-		Options:    opts,
-		pingPeriod: opts.pingPeriod,
-		pongWait:   pongWait(opts.pingPeriod),
+		Options:     opts,
+		pingPeriod:  opts.pingPeriod,
+		pongWait:    pongWait(opts.pingPeriod),
+		connections: newUserConnections(),
 	}, nil
 }
 
 func (h *HTTPHandler) Serve(eCtx echo.Context) error {
+	uid := middlewares.MustUserID(eCtx)
+
+	if !h.connections.Acquire(uid, h.maxConnectionsPerUser) {
+		rejectedConnectionsCounter.Inc()
+		return internalerrors.NewServerError(http.StatusTooManyRequests, "too many connections", ErrTooManyConnections)
+	}
+	defer h.connections.Release(uid)
+
 	ws, err := h.upgrader.Upgrade(eCtx.Response(), eCtx.Request(), nil)
 	if err != nil {
 		return fmt.Errorf("upgrade request, err=%v", err)
 	}
 
+	openConnectionsGauge.Inc()
+	defer openConnectionsGauge.Dec()
+
+	if h.presence != nil {
+		h.presence.Connected(eCtx.Request().Context(), uid)
+		defer h.presence.Disconnected(eCtx.Request().Context(), uid)
+	}
+
 	exp := middlewares.MustExpiresAt(eCtx)
-	uid := middlewares.MustUserID(eCtx)
+	token, _ := middlewares.RawToken(eCtx)
+
+	sess := &session{id: uuid.NewString(), uid: uid, token: token}
 
-	ctxWithExpiration, err := h.tokenExpiration.NewExpireContext(eCtx.Request().Context(), uid.String(), exp)
+	ctxWithExpiration, err := h.tokenExpiration.NewExpireContext(eCtx.Request().Context(), sess.id, exp)
 	if err != nil {
 		return fmt.Errorf("create context with token expiration timeout, err=%v", err)
 	}
@@ -91,8 +152,19 @@ func (h *HTTPHandler) Serve(eCtx echo.Context) error {
 
 	eg, egCtx := errgroup.WithContext(wsCtx)
 
-	eg.Go(func() error { return h.writeLoop(egCtx, ws, events) })
-	eg.Go(func() error { return h.readLoop(egCtx, ws) })
+	var watched <-chan eventstream.Event
+	if h.chatWatcher != nil {
+		sess.canWatchChats = h.canWatchChats != nil && h.canWatchChats(eCtx)
+		sess.watches = newChatWatches(egCtx, h.chatWatcher)
+		defer sess.watches.Stop()
+
+		watched = sess.watches.Events()
+	}
+
+	replies := make(chan any)
+
+	eg.Go(func() error { return h.writeLoop(egCtx, ws, events, watched, replies) })
+	eg.Go(func() error { return h.readLoop(egCtx, ws, sess, replies) })
 	eg.Go(func() error {
 		select {
 		case <-egCtx.Done():
@@ -103,7 +175,26 @@ func (h *HTTPHandler) Serve(eCtx echo.Context) error {
 		return nil
 	})
 
+	if token != "" && h.tokenIntrospector != nil {
+		eg.Go(func() error { return h.revalidationLoop(egCtx, sess) })
+	}
+
 	if err := eg.Wait(); err != nil {
+		if errors.Is(err, errTokenOfAnotherUser) {
+			h.logger.Warn("Token of another user was sent, close connection", zap.Stringer("user_id", uid), zap.Error(err))
+			wsCloser.Close(gorillaws.ClosePolicyViolation)
+
+			return nil
+		}
+
+		if errors.Is(err, errTokenRevoked) {
+			h.logger.Info("Session token was revoked, close connection", zap.Stringer("user_id", uid))
+			revokedSessionsCounter.Inc()
+			wsCloser.Close(CloseTokenRevoked)
+
+			return nil
+		}
+
 		if gorillaws.IsUnexpectedCloseError(err, gorillaws.CloseNormalClosure, gorillaws.CloseNoStatusReceived) {
 			h.logger.Error("Unexpected error", zap.Error(err))
 			wsCloser.Close(gorillaws.CloseInternalServerErr)
@@ -117,8 +208,8 @@ func (h *HTTPHandler) Serve(eCtx echo.Context) error {
 	return nil
 }
 
-// readLoop listen PONGs.
-func (h *HTTPHandler) readLoop(ctx context.Context, ws Websocket) error {
+// readLoop listen PONGs and client frames.
+func (h *HTTPHandler) readLoop(ctx context.Context, ws Websocket, s *session, replies chan<- any) error {
 	ws.SetPongHandler(func(string) error {
 		return ws.SetReadDeadline(time.Now().Add(h.pongWait))
 	})
@@ -132,7 +223,7 @@ func (h *HTTPHandler) readLoop(ctx context.Context, ws Websocket) error {
 		case <-ctx.Done():
 			return nil
 		default:
-			_, _, err := ws.NextReader()
+			msgType, r, err := ws.NextReader()
 			if gorillaws.IsCloseError(err, gorillaws.CloseNormalClosure) {
 				return nil
 			}
@@ -140,12 +231,36 @@ func (h *HTTPHandler) readLoop(ctx context.Context, ws Websocket) error {
 			if err != nil {
 				return fmt.Errorf("get next reader, err=%w", err)
 			}
+
+			if msgType != gorillaws.TextMessage {
+				continue
+			}
+
+			reply, err := h.handleClientFrame(ctx, s, r)
+			if err != nil {
+				return err
+			}
+
+			if reply == nil {
+				continue
+			}
+
+			select {
+			case <-ctx.Done():
+				return nil
+			case replies <- reply:
+			}
 		}
 	}
 }
 
 // writeLoop listen events and writes them into Websocket.
-func (h *HTTPHandler) writeLoop(ctx context.Context, ws Websocket, events <-chan eventstream.Event) error {
+func (h *HTTPHandler) writeLoop(
+	ctx context.Context,
+	ws Websocket,
+	events, watched <-chan eventstream.Event,
+	replies <-chan any,
+) error {
 	pingTicker := time.NewTicker(h.pingPeriod)
 	defer pingTicker.Stop()
 
@@ -162,33 +277,84 @@ func (h *HTTPHandler) writeLoop(ctx context.Context, ws Websocket, events <-chan
 			if err := ws.WriteMessage(gorillaws.PingMessage, nil); err != nil {
 				return fmt.Errorf("write ping message, err=%w", err)
 			}
+		case reply := <-replies:
+			if err := h.write(ws, reply); err != nil {
+				return err
+			}
+
 		case event, ok := <-events:
 			if !ok {
 				return errors.New("events stream was closed")
 			}
 
-			adapted, err := h.eventAdapter.Adapt(event)
-			if err != nil {
-				h.logger.With(zap.Error(err)).Error("Cannot adapt event to out stream")
-
-				continue
+			if err := h.writeEvent(ws, event); err != nil {
+				return err
 			}
 
-			if err := ws.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil {
-				return fmt.Errorf("set write deadline, err=%w", err)
+		case event := <-watched:
+			if err := h.writeEvent(ws, event); err != nil {
+				return err
 			}
+		}
+	}
+}
+
+func (h *HTTPHandler) writeEvent(ws Websocket, event eventstream.Event) error {
+	adapted, err := h.eventAdapter.Adapt(event)
+	if err != nil {
+		h.logger.With(zap.Error(err)).Error("Cannot adapt event to out stream")
+
+		return nil
+	}
+
+	return h.write(ws, adapted)
+}
+
+func (h *HTTPHandler) write(ws Websocket, data any) error {
+	if err := ws.SetWriteDeadline(time.Now().Add(writeTimeout)); err != nil {
+		return fmt.Errorf("set write deadline, err=%w", err)
+	}
+
+	wr, err := ws.NextWriter(gorillaws.TextMessage)
+	if err != nil {
+		return fmt.Errorf("get next writer, err=%w", err)
+	}
 
-			wr, err := ws.NextWriter(gorillaws.TextMessage)
+	if err := h.eventWriter.Write(data, wr); err != nil {
+		return fmt.Errorf("write data to connection, err=%w", err)
+	}
+
+	if err := wr.Close(); err != nil {
+		return fmt.Errorf("flush writer, err=%w", err)
+	}
+
+	return nil
+}
+
+// revalidationLoop periodically introspects the session token to catch its revocation.
+func (h *HTTPHandler) revalidationLoop(ctx context.Context, s *session) error {
+	t := time.NewTicker(h.revalidationPeriod)
+	defer t.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+
+		case <-t.C:
+			res, err := h.tokenIntrospector.IntrospectToken(ctx, s.Token())
 			if err != nil {
-				return fmt.Errorf("get next writer, err=%w", err)
-			}
+				if ctx.Err() != nil {
+					return nil
+				}
 
-			if err := h.eventWriter.Write(adapted, wr); err != nil {
-				return fmt.Errorf("write data to connection, err=%w", err)
+				// Keycloak unavailability is not a reason to drop the session.
+				h.logger.Warn("Cannot revalidate session token", zap.Error(err))
+				continue
 			}
 
-			if err := wr.Close(); err != nil {
-				return fmt.Errorf("flush writer, err=%w", err)
+			if !res.Active {
+				return errTokenRevoked
 			}
 		}
 	}
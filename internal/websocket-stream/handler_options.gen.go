@@ -7,6 +7,7 @@ import (
 
 	errors461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/errors"
 	validator461e464ebed9 "github.com/kazhuravlev/options-gen/pkg/validator"
+	"github.com/labstack/echo/v4"
 	"go.uber.org/zap"
 )
 
@@ -26,6 +27,7 @@ func NewOptions(
 
 	// Setting defaults from field tag (if present)
 	o.pingPeriod, _ = time.ParseDuration("3s")
+	o.revalidationPeriod, _ = time.ParseDuration("1m")
 
 	o.logger = logger
 	o.eventStream = eventStream
@@ -47,6 +49,48 @@ func WithPingPeriod(opt time.Duration) OptOptionsSetter {
 	}
 }
 
+func WithTokenVerifier(opt tokenVerifier) OptOptionsSetter {
+	return func(o *Options) {
+		o.tokenVerifier = opt
+	}
+}
+
+func WithTokenIntrospector(opt tokenIntrospector) OptOptionsSetter {
+	return func(o *Options) {
+		o.tokenIntrospector = opt
+	}
+}
+
+func WithRevalidationPeriod(opt time.Duration) OptOptionsSetter {
+	return func(o *Options) {
+		o.revalidationPeriod = opt
+	}
+}
+
+func WithChatWatcher(opt chatWatcher) OptOptionsSetter {
+	return func(o *Options) {
+		o.chatWatcher = opt
+	}
+}
+
+func WithCanWatchChats(opt func(eCtx echo.Context) bool) OptOptionsSetter {
+	return func(o *Options) {
+		o.canWatchChats = opt
+	}
+}
+
+func WithMaxConnectionsPerUser(opt int) OptOptionsSetter {
+	return func(o *Options) {
+		o.maxConnectionsPerUser = opt
+	}
+}
+
+func WithPresence(opt presenceTracker) OptOptionsSetter {
+	return func(o *Options) {
+		o.presence = opt
+	}
+}
+
 func (o *Options) Validate() error {
 	errs := new(errors461e464ebed9.ValidationErrors)
 	errs.Add(errors461e464ebed9.NewValidationError("pingPeriod", _validate_Options_pingPeriod(o)))
@@ -57,6 +101,8 @@ func (o *Options) Validate() error {
 	errs.Add(errors461e464ebed9.NewValidationError("upgrader", _validate_Options_upgrader(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("shutdownCh", _validate_Options_shutdownCh(o)))
 	errs.Add(errors461e464ebed9.NewValidationError("tokenExpiration", _validate_Options_tokenExpiration(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("revalidationPeriod", _validate_Options_revalidationPeriod(o)))
+	errs.Add(errors461e464ebed9.NewValidationError("maxConnectionsPerUser", _validate_Options_maxConnectionsPerUser(o)))
 	return errs.AsError()
 }
 
@@ -115,3 +161,17 @@ func _validate_Options_tokenExpiration(o *Options) error {
 	}
 	return nil
 }
+
+func _validate_Options_revalidationPeriod(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.revalidationPeriod, "min=100ms,max=1h"); err != nil {
+		return fmt461e464ebed9.Errorf("field `revalidationPeriod` did not pass the test: %w", err)
+	}
+	return nil
+}
+
+func _validate_Options_maxConnectionsPerUser(o *Options) error {
+	if err := validator461e464ebed9.GetValidatorFor(o).Var(o.maxConnectionsPerUser, "min=0"); err != nil {
+		return fmt461e464ebed9.Errorf("field `maxConnectionsPerUser` did not pass the test: %w", err)
+	}
+	return nil
+}
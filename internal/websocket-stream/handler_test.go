@@ -2,13 +2,17 @@ package websocketstream_test
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
+	"sync"
 	"testing"
 	"time"
 
+	keycloakclient "github.com/karasunokami/chat-service/internal/clients/keycloak"
+	internalerrors "github.com/karasunokami/chat-service/internal/errors"
 	"github.com/karasunokami/chat-service/internal/logger"
 	"github.com/karasunokami/chat-service/internal/middlewares"
 	eventstream "github.com/karasunokami/chat-service/internal/services/event-stream"
@@ -187,10 +191,353 @@ func TestCloseWsOnTokenExpired(t *testing.T) {
 	})
 }
 
+func TestCloseWsOnTokenRevoked(t *testing.T) {
+	const (
+		rawToken           = "raw-token"
+		revalidationPeriod = 100 * time.Millisecond
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	uid := types.NewUserID()
+	introspector := &introspectorMock{}
+
+	h, err := newHTTPHandler(uid, make(chan eventstream.Event), make(chan struct{}),
+		websocketstream.WithTokenIntrospector(introspector),
+		websocketstream.WithRevalidationPeriod(revalidationPeriod),
+	)
+	require.NoError(t, err)
+
+	e := echo.New()
+	e.GET("/ws", middlewares.AuthWithRawToken(uid, rawToken)(h.Serve))
+	s := httptest.NewServer(e)
+
+	u := url.URL{Scheme: "ws", Host: s.Listener.Addr().String(), Path: "/ws"}
+
+	header := http.Header{}
+	header.Add(echo.HeaderOrigin, origin)
+	header.Add(headerSecWsProtocol, secWsProtocol)
+
+	c, resp, err := gorillaws.DefaultDialer.DialContext(ctx, u.String(), header)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, c.Close())
+		require.NoError(t, resp.Body.Close())
+	}()
+
+	// Session survives the revalidation of the active token.
+	time.Sleep(revalidationPeriod * 2)
+	introspector.revoke()
+
+	_, _, err = c.NextReader()
+	require.Error(t, err)
+	assert.True(t, gorillaws.IsCloseError(err, websocketstream.CloseTokenRevoked), "err: %v", err)
+
+	calls, tokens := introspector.stats()
+	assert.GreaterOrEqual(t, calls, 2)
+	assert.Equal(t, []string{rawToken}, tokens)
+}
+
+func TestTokenRefresh(t *testing.T) {
+	const (
+		rawToken      = "raw-token"
+		tokenLiveTime = time.Second
+	)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	uid := types.NewUserID()
+	refreshedExp := time.Now().Add(time.Hour).Truncate(time.Second)
+
+	verifier := tokenVerifierMock{tokens: map[string]types.UserID{
+		"refreshed-token":     uid,
+		"another-users-token": types.NewUserID(),
+	}, exp: refreshedExp}
+
+	h, err := newHTTPHandler(uid, make(chan eventstream.Event), make(chan struct{}),
+		websocketstream.WithTokenVerifier(verifier),
+	)
+	require.NoError(t, err)
+
+	e := echo.New()
+	e.GET("/ws", middlewares.AuthWithExp(uid, time.Now().Add(tokenLiveTime).Unix())(h.Serve))
+	s := httptest.NewServer(e)
+
+	u := url.URL{Scheme: "ws", Host: s.Listener.Addr().String(), Path: "/ws"}
+
+	header := http.Header{}
+	header.Add(echo.HeaderOrigin, origin)
+	header.Add(headerSecWsProtocol, secWsProtocol)
+
+	c, resp, err := gorillaws.DefaultDialer.DialContext(ctx, u.String(), header)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, c.Close())
+		require.NoError(t, resp.Body.Close())
+	}()
+
+	t.Run("invalid token is rejected", func(t *testing.T) {
+		require.NoError(t, c.WriteJSON(map[string]string{"type": "TokenRefresh", "token": "invalid-token"}))
+
+		var event websocketstream.TokenRefreshFailedEvent
+		require.NoError(t, c.ReadJSON(&event))
+		assert.Equal(t, "TokenRefreshFailedEvent", event.EventType)
+		assert.Equal(t, "invalid token", event.Reason)
+	})
+
+	t.Run("unknown frame is ignored", func(t *testing.T) {
+		require.NoError(t, c.WriteJSON(map[string]string{"type": "Unknown"}))
+	})
+
+	t.Run("session is extended", func(t *testing.T) {
+		require.NoError(t, c.WriteJSON(map[string]string{"type": "TokenRefresh", "token": "refreshed-token"}))
+
+		var event websocketstream.TokenRefreshedEvent
+		require.NoError(t, c.ReadJSON(&event))
+		assert.Equal(t, "TokenRefreshedEvent", event.EventType)
+		assert.True(t, refreshedExp.Equal(event.ExpiresAt))
+
+		// Connection outlives the original token.
+		require.NoError(t, c.SetReadDeadline(time.Now().Add(tokenLiveTime*3/2)))
+		_, _, err := c.NextReader()
+		var netErr interface{ Timeout() bool }
+		require.ErrorAs(t, err, &netErr)
+		assert.True(t, netErr.Timeout())
+	})
+}
+
+func TestTokenRefresh_AnotherUser(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	uid := types.NewUserID()
+
+	verifier := tokenVerifierMock{tokens: map[string]types.UserID{
+		"another-users-token": types.NewUserID(),
+	}, exp: time.Now().Add(time.Hour)}
+
+	h, err := newHTTPHandler(uid, make(chan eventstream.Event), make(chan struct{}),
+		websocketstream.WithTokenVerifier(verifier),
+	)
+	require.NoError(t, err)
+
+	e := echo.New()
+	e.GET("/ws", middlewares.AuthWith(uid)(h.Serve))
+	s := httptest.NewServer(e)
+
+	u := url.URL{Scheme: "ws", Host: s.Listener.Addr().String(), Path: "/ws"}
+
+	header := http.Header{}
+	header.Add(echo.HeaderOrigin, origin)
+	header.Add(headerSecWsProtocol, secWsProtocol)
+
+	c, resp, err := gorillaws.DefaultDialer.DialContext(ctx, u.String(), header)
+	require.NoError(t, err)
+	defer func() {
+		require.NoError(t, c.Close())
+		require.NoError(t, resp.Body.Close())
+	}()
+
+	require.NoError(t, c.WriteJSON(map[string]string{"type": "TokenRefresh", "token": "another-users-token"}))
+
+	_, _, err = c.NextReader()
+	require.Error(t, err)
+	assert.True(t, gorillaws.IsCloseError(err, gorillaws.ClosePolicyViolation), "err: %v", err)
+}
+
+func TestMaxConnectionsPerUser(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	uid := types.NewUserID()
+
+	h, err := newHTTPHandler(uid, make(chan eventstream.Event), make(chan struct{}),
+		websocketstream.WithMaxConnectionsPerUser(1),
+	)
+	require.NoError(t, err)
+
+	errCh := make(chan error, 1)
+	e := echo.New()
+	e.HTTPErrorHandler = func(err error, c echo.Context) {
+		errCh <- err
+		_ = c.NoContent(http.StatusTooManyRequests)
+	}
+	e.GET("/ws", middlewares.AuthWith(uid)(h.Serve))
+	s := httptest.NewServer(e)
+
+	u := url.URL{Scheme: "ws", Host: s.Listener.Addr().String(), Path: "/ws"}
+
+	header := http.Header{}
+	header.Add(echo.HeaderOrigin, origin)
+	header.Add(headerSecWsProtocol, secWsProtocol)
+
+	c, resp, err := gorillaws.DefaultDialer.DialContext(ctx, u.String(), header)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	t.Run("second connection is rejected", func(t *testing.T) {
+		_, resp, err := gorillaws.DefaultDialer.DialContext(ctx, u.String(), header)
+		require.ErrorIs(t, err, gorillaws.ErrBadHandshake)
+		require.NoError(t, resp.Body.Close())
+		assert.Equal(t, http.StatusTooManyRequests, resp.StatusCode)
+
+		srvErr := <-errCh
+		require.ErrorIs(t, srvErr, websocketstream.ErrTooManyConnections)
+		assert.Equal(t, http.StatusTooManyRequests, internalerrors.GetServerErrorCode(srvErr))
+	})
+
+	t.Run("connection is available after close", func(t *testing.T) {
+		require.NoError(t, c.Close())
+
+		assert.Eventually(t, func() bool {
+			c, resp, err := gorillaws.DefaultDialer.DialContext(ctx, u.String(), header)
+			if err != nil {
+				<-errCh
+				return false
+			}
+			_ = resp.Body.Close()
+			_ = c.Close()
+			return true
+		}, 5*time.Second, 100*time.Millisecond)
+	})
+}
+
+func TestPresence(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	uid := types.NewUserID()
+	presence := newPresenceMock()
+
+	h, err := newHTTPHandler(uid, make(chan eventstream.Event), make(chan struct{}),
+		websocketstream.WithPresence(presence),
+	)
+	require.NoError(t, err)
+
+	e := echo.New()
+	e.GET("/ws", middlewares.AuthWith(uid)(h.Serve))
+	s := httptest.NewServer(e)
+	defer s.Close()
+
+	u := url.URL{Scheme: "ws", Host: s.Listener.Addr().String(), Path: "/ws"}
+
+	header := http.Header{}
+	header.Add(echo.HeaderOrigin, origin)
+	header.Add(headerSecWsProtocol, secWsProtocol)
+
+	c, resp, err := gorillaws.DefaultDialer.DialContext(ctx, u.String(), header)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+
+	assert.Eventually(t, func() bool { return presence.connections(uid) == 1 }, time.Second, 10*time.Millisecond)
+
+	require.NoError(t, c.Close())
+	assert.Eventually(t, func() bool { return presence.connections(uid) == 0 }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestWatchChat(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	uid := types.NewUserID()
+	chatID := types.NewChatID()
+	watcher := chatWatcherMock{
+		chats: map[types.ChatID]chan eventstream.Event{
+			chatID: make(chan eventstream.Event),
+		},
+		subs: make(chan context.Context, 1),
+	}
+
+	dial := func(t *testing.T, canWatch bool) *gorillaws.Conn {
+		t.Helper()
+
+		h, err := newHTTPHandler(uid, make(chan eventstream.Event), make(chan struct{}),
+			websocketstream.WithChatWatcher(watcher),
+			websocketstream.WithCanWatchChats(func(echo.Context) bool { return canWatch }),
+		)
+		require.NoError(t, err)
+
+		e := echo.New()
+		e.GET("/ws", middlewares.AuthWith(uid)(h.Serve))
+		s := httptest.NewServer(e)
+		t.Cleanup(s.Close)
+
+		u := url.URL{Scheme: "ws", Host: s.Listener.Addr().String(), Path: "/ws"}
+
+		header := http.Header{}
+		header.Add(echo.HeaderOrigin, origin)
+		header.Add(headerSecWsProtocol, secWsProtocol)
+
+		c, resp, err := gorillaws.DefaultDialer.DialContext(ctx, u.String(), header)
+		require.NoError(t, err)
+		t.Cleanup(func() {
+			_ = c.Close()
+			_ = resp.Body.Close()
+		})
+
+		return c
+	}
+
+	t.Run("user without access cannot watch", func(t *testing.T) {
+		c := dial(t, false)
+		require.NoError(t, c.WriteJSON(map[string]string{"type": "WatchChat", "chatId": chatID.String()}))
+
+		var event websocketstream.ChatWatchFailedEvent
+		require.NoError(t, c.ReadJSON(&event))
+		assert.Equal(t, "ChatWatchFailedEvent", event.EventType)
+		assert.Equal(t, chatID, event.ChatID)
+		assert.Equal(t, "forbidden", event.Reason)
+	})
+
+	t.Run("unknown chat", func(t *testing.T) {
+		c := dial(t, true)
+		require.NoError(t, c.WriteJSON(map[string]string{"type": "WatchChat", "chatId": types.NewChatID().String()}))
+
+		var event websocketstream.ChatWatchFailedEvent
+		require.NoError(t, c.ReadJSON(&event))
+		assert.Equal(t, "ChatWatchFailedEvent", event.EventType)
+		assert.Equal(t, "chat cannot be watched", event.Reason)
+	})
+
+	t.Run("chat events are streamed until unwatch", func(t *testing.T) {
+		c := dial(t, true)
+		require.NoError(t, c.WriteJSON(map[string]string{"type": "WatchChat", "chatId": chatID.String()}))
+
+		var started websocketstream.ChatWatchStartedEvent
+		require.NoError(t, c.ReadJSON(&started))
+		assert.Equal(t, "ChatWatchStartedEvent", started.EventType)
+		assert.Equal(t, chatID, started.ChatID)
+		subCtx := <-watcher.subs
+
+		watched := &eventstream.MessageSentEvent{MessageID: types.NewMessageID()}
+		watcher.chats[chatID] <- watched
+
+		var event eventstream.MessageSentEvent
+		require.NoError(t, c.ReadJSON(&event))
+		assert.Equal(t, watched, &event)
+
+		require.NoError(t, c.WriteJSON(map[string]string{"type": "UnwatchChat", "chatId": chatID.String()}))
+
+		var stopped websocketstream.ChatWatchStoppedEvent
+		require.NoError(t, c.ReadJSON(&stopped))
+		assert.Equal(t, "ChatWatchStoppedEvent", stopped.EventType)
+		assert.Equal(t, chatID, stopped.ChatID)
+
+		select {
+		case <-subCtx.Done():
+		case <-time.After(time.Second):
+			t.Fatal("chat subscription was not canceled")
+		}
+	})
+}
+
 func newHTTPHandler(
 	uid types.UserID,
 	eventsCh chan eventstream.Event,
 	shutdownCh chan struct{},
+	opts ...websocketstream.OptOptionsSetter,
 ) (*websocketstream.HTTPHandler, error) {
 	return websocketstream.NewHTTPHandler(websocketstream.NewOptions(
 		zap.L(),
@@ -200,7 +547,7 @@ func newHTTPHandler(
 		websocketstream.NewUpgrader([]string{origin}, secWsProtocol),
 		shutdownCh,
 		tokenexpiration.New(),
-		websocketstream.WithPingPeriod(pingInterval),
+		append([]websocketstream.OptOptionsSetter{websocketstream.WithPingPeriod(pingInterval)}, opts...)...,
 	))
 }
 
@@ -216,8 +563,93 @@ func (e eventStreamMock) Subscribe(_ context.Context, userID types.UserID) (<-ch
 	return e.ch, nil
 }
 
+type chatWatcherMock struct {
+	chats map[types.ChatID]chan eventstream.Event
+	subs  chan context.Context
+}
+
+func (m chatWatcherMock) SubscribeChat(ctx context.Context, chatID types.ChatID) (<-chan eventstream.Event, error) {
+	ch, ok := m.chats[chatID]
+	if !ok {
+		return nil, fmt.Errorf("unknown chat: %v", chatID)
+	}
+	m.subs <- ctx
+	return ch, nil
+}
+
+type presenceMock struct {
+	mu     sync.Mutex
+	byUser map[types.UserID]int
+}
+
+func newPresenceMock() *presenceMock {
+	return &presenceMock{byUser: make(map[types.UserID]int)}
+}
+
+func (m *presenceMock) Connected(_ context.Context, userID types.UserID) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.byUser[userID]++
+}
+
+func (m *presenceMock) Disconnected(_ context.Context, userID types.UserID) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.byUser[userID]--
+}
+
+func (m *presenceMock) connections(userID types.UserID) int {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.byUser[userID]
+}
+
 type eventAdapter struct{}
 
 func (eventAdapter) Adapt(event eventstream.Event) (any, error) {
 	return event, nil
 }
+
+type introspectorMock struct {
+	mu      sync.Mutex
+	revoked bool
+	calls   int
+	tokens  []string
+}
+
+func (m *introspectorMock) IntrospectToken(_ context.Context, token string) (*keycloakclient.IntrospectTokenResult, error) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.calls++
+	if len(m.tokens) == 0 || m.tokens[len(m.tokens)-1] != token {
+		m.tokens = append(m.tokens, token)
+	}
+
+	return &keycloakclient.IntrospectTokenResult{Active: !m.revoked}, nil
+}
+
+func (m *introspectorMock) revoke() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	m.revoked = true
+}
+
+func (m *introspectorMock) stats() (int, []string) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+	return m.calls, m.tokens
+}
+
+type tokenVerifierMock struct {
+	tokens map[string]types.UserID
+	exp    time.Time
+}
+
+func (m tokenVerifierMock) VerifyUserToken(_ context.Context, token string) (types.UserID, time.Time, error) {
+	uid, ok := m.tokens[token]
+	if !ok {
+		return types.UserIDNil, time.Time{}, errors.New("invalid token")
+	}
+	return uid, m.exp, nil
+}
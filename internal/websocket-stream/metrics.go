@@ -0,0 +1,38 @@
+package websocketstream
+
+import (
+	"github.com/karasunokami/chat-service/internal/metrics"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+)
+
+var (
+	openConnectionsGauge = promauto.NewGauge(prometheus.GaugeOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "websocket",
+		Name:      "open_connections",
+		Help:      "Number of open websocket connections.",
+	})
+
+	rejectedConnectionsCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "websocket",
+		Name:      "rejected_connections_total",
+		Help:      "Number of websocket connections rejected due to the per-user limit.",
+	})
+
+	revokedSessionsCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "websocket",
+		Name:      "revoked_sessions_total",
+		Help:      "Number of websocket connections closed due to the token revocation.",
+	})
+
+	tokenRefreshesCounter = promauto.NewCounter(prometheus.CounterOpts{
+		Namespace: metrics.Namespace,
+		Subsystem: "websocket",
+		Name:      "token_refreshes_total",
+		Help:      "Number of websocket sessions extended by the refreshed token.",
+	})
+)
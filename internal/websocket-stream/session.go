@@ -0,0 +1,34 @@
+package websocketstream
+
+import (
+	"sync"
+
+	"github.com/karasunokami/chat-service/internal/types"
+)
+
+// session is a single websocket connection of the user.
+type session struct {
+	// id identifies the connection expiration, because the user could have several connections.
+	id  string
+	uid types.UserID
+
+	// canWatchChats allows to watch any chat, see chatWatches.
+	canWatchChats bool
+	// watches is nil if the chat watching is not supported.
+	watches *chatWatches
+
+	mu    sync.RWMutex
+	token string
+}
+
+func (s *session) Token() string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.token
+}
+
+func (s *session) SetToken(token string) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.token = token
+}
@@ -0,0 +1,102 @@
+package websocketstream
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/karasunokami/chat-service/internal/types"
+
+	"go.uber.org/zap"
+)
+
+const (
+	clientFrameTokenRefresh = "TokenRefresh"
+
+	// maxClientFrameSize is enough for the frame with the access token.
+	maxClientFrameSize = 16 << 10
+)
+
+var errTokenOfAnotherUser = errors.New("refreshed token belongs to another user")
+
+type tokenVerifier interface {
+	VerifyUserToken(ctx context.Context, token string) (types.UserID, time.Time, error)
+}
+
+// clientFrame is the message sent by the client over the websocket.
+type clientFrame struct {
+	Type   string       `json:"type"`
+	Token  string       `json:"token"`
+	ChatID types.ChatID `json:"chatId"`
+}
+
+type TokenRefreshedEvent struct {
+	EventType string    `json:"eventType"`
+	ExpiresAt time.Time `json:"expiresAt"`
+}
+
+func newTokenRefreshedEvent(exp time.Time) TokenRefreshedEvent {
+	return TokenRefreshedEvent{EventType: "TokenRefreshedEvent", ExpiresAt: exp}
+}
+
+type TokenRefreshFailedEvent struct {
+	EventType string `json:"eventType"`
+	Reason    string `json:"reason"`
+}
+
+func newTokenRefreshFailedEvent(reason string) TokenRefreshFailedEvent {
+	return TokenRefreshFailedEvent{EventType: "TokenRefreshFailedEvent", Reason: reason}
+}
+
+// handleClientFrame returns the reply for the client frame.
+// The unknown frames are ignored to keep the connection compatible with future clients.
+func (h *HTTPHandler) handleClientFrame(ctx context.Context, s *session, r io.Reader) (any, error) {
+	var frame clientFrame
+	if err := json.NewDecoder(io.LimitReader(r, maxClientFrameSize)).Decode(&frame); err != nil {
+		h.logger.Debug("Cannot decode client frame", zap.Error(err))
+		return nil, nil
+	}
+
+	switch frame.Type {
+	case clientFrameTokenRefresh:
+		return h.refreshToken(ctx, s, frame.Token)
+	case clientFrameWatchChat:
+		return h.watchChat(s, frame.ChatID), nil
+	case clientFrameUnwatchChat:
+		return h.unwatchChat(s, frame.ChatID), nil
+	default:
+		h.logger.Debug("Unknown client frame", zap.String("type", frame.Type))
+		return nil, nil
+	}
+}
+
+// refreshToken extends the session up to the expiration of the new token.
+// The session keeps its current expiration if the token is invalid.
+func (h *HTTPHandler) refreshToken(ctx context.Context, s *session, token string) (any, error) {
+	if h.tokenVerifier == nil {
+		return newTokenRefreshFailedEvent("token refresh is not supported"), nil
+	}
+
+	uid, exp, err := h.tokenVerifier.VerifyUserToken(ctx, token)
+	if err != nil {
+		h.logger.Info("Invalid refreshed token", zap.Stringer("user_id", s.uid), zap.Error(err))
+		return newTokenRefreshFailedEvent("invalid token"), nil
+	}
+
+	if uid != s.uid {
+		return nil, fmt.Errorf("%w: %v", errTokenOfAnotherUser, uid)
+	}
+
+	if err := h.tokenExpiration.Extend(s.id, exp); err != nil {
+		h.logger.Warn("Cannot extend session", zap.Stringer("user_id", s.uid), zap.Error(err))
+		return newTokenRefreshFailedEvent("session cannot be extended"), nil
+	}
+
+	s.SetToken(token)
+	tokenRefreshesCounter.Inc()
+
+	return newTokenRefreshedEvent(exp), nil
+}